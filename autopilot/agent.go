@@ -58,6 +58,11 @@ type Config struct {
 	// when opening channels.
 	Constraints AgentConstraints
 
+	// Blacklist, if set, records the nodes we failed to connect or open
+	// channels to, and temporarily hides them from the Graph so they
+	// aren't selected again until their penalty has decayed.
+	Blacklist *Blacklist
+
 	// TODO(roasbeef): add additional signals from fee rates and revenue of
 	// currently opened channels
 }
@@ -189,6 +194,10 @@ func New(cfg Config, initialState []Channel) (*Agent, error) {
 		a.chanState[c.ChanID] = c
 	}
 
+	if cfg.Blacklist != nil {
+		a.cfg.Graph = cfg.Blacklist.Graph(cfg.Graph)
+	}
+
 	return a, nil
 }
 
@@ -741,6 +750,7 @@ func (a *Agent) executeDirective(directive AttachmentDirective) {
 		delete(a.pendingConns, nodeID)
 		a.failedNodes[nodeID] = struct{}{}
 		a.pendingMtx.Unlock()
+		a.recordFailure(nodeID)
 
 		// Finally, we'll trigger the agent to select new peers to
 		// connect to.
@@ -807,6 +817,7 @@ func (a *Agent) executeDirective(directive AttachmentDirective) {
 		delete(a.pendingOpens, nodeID)
 		a.failedNodes[nodeID] = struct{}{}
 		a.pendingMtx.Unlock()
+		a.recordFailure(nodeID)
 
 		// Trigger the agent to re-evaluate everything and possibly
 		// retry with a different node.
@@ -833,3 +844,16 @@ func (a *Agent) executeDirective(directive AttachmentDirective) {
 	// directive in goroutine?
 	a.OnChannelPendingOpen()
 }
+
+// recordFailure records a failed connection or channel open attempt with the
+// given node in the blacklist, if one is configured.
+func (a *Agent) recordFailure(nodeID NodeID) {
+	if a.cfg.Blacklist == nil {
+		return
+	}
+
+	if err := a.cfg.Blacklist.RecordFailure(nodeID); err != nil {
+		log.Errorf("Unable to record failure for node %x: %v",
+			nodeID[:], err)
+	}
+}
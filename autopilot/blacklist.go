@@ -0,0 +1,261 @@
+package autopilot
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrlnd/channeldb"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// DefaultFailureHalfLife is the default amount of time after which the
+	// penalty of a failed channel open attempt with a node is halved.
+	DefaultFailureHalfLife = time.Hour
+
+	// blacklistThreshold is the decayed failure score above which a node
+	// is skipped during node selection. A single failure starts out with
+	// a score of 1, so the node will be skipped for one half-life.
+	blacklistThreshold = 0.5
+
+	// nodeFailureLen is the length of a serialized NodeFailure.
+	nodeFailureLen = 16
+)
+
+var (
+	// nodeFailuresBucketKey is the key that points to a bucket containing
+	// the decaying failure scores of nodes we failed to open channels
+	// with.
+	//
+	// maps: nodeID -> score || timestamp
+	nodeFailuresBucketKey = []byte("autopilot-node-failures")
+
+	// errNoNodeFailuresBucket is returned when the node failures bucket
+	// can't be found.
+	errNoNodeFailuresBucket = errors.New("node failures bucket not found")
+)
+
+// NodeFailure records the failed channel open attempts with a node.
+type NodeFailure struct {
+	// Score is the failure score of the node as of Timestamp. Each failure
+	// adds 1 to the score, which then decays exponentially over time.
+	Score float64
+
+	// Timestamp is the time of the last failure.
+	Timestamp time.Time
+}
+
+// FailureStore persists the failures the autopilot agent encountered when
+// attempting to open channels with nodes.
+type FailureStore interface {
+	// PutNodeFailure stores the failure record of the given node,
+	// replacing any previous one.
+	PutNodeFailure(node NodeID, failure NodeFailure) error
+
+	// FetchNodeFailures returns the failure records of all nodes.
+	FetchNodeFailures() (map[NodeID]NodeFailure, error)
+}
+
+type failureStore struct {
+	db *channeldb.DB
+}
+
+// NewFailureStore returns a new bolt backed FailureStore.
+func NewFailureStore(db *channeldb.DB) (FailureStore, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(nodeFailuresBucketKey)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &failureStore{
+		db: db,
+	}, nil
+}
+
+// PutNodeFailure stores the failure record of the given node, replacing any
+// previous one.
+//
+// NOTE: This is part of the FailureStore interface.
+func (s *failureStore) PutNodeFailure(node NodeID, failure NodeFailure) error {
+	var v [nodeFailureLen]byte
+	binary.BigEndian.PutUint64(v[:8], math.Float64bits(failure.Score))
+	binary.BigEndian.PutUint64(
+		v[8:], uint64(failure.Timestamp.UnixNano()),
+	)
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		failures := tx.Bucket(nodeFailuresBucketKey)
+		if failures == nil {
+			return errNoNodeFailuresBucket
+		}
+
+		return failures.Put(node[:], v[:])
+	})
+}
+
+// FetchNodeFailures returns the failure records of all nodes.
+//
+// NOTE: This is part of the FailureStore interface.
+func (s *failureStore) FetchNodeFailures() (map[NodeID]NodeFailure, error) {
+	nodeFailures := make(map[NodeID]NodeFailure)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		failures := tx.Bucket(nodeFailuresBucketKey)
+		if failures == nil {
+			return errNoNodeFailuresBucket
+		}
+
+		return failures.ForEach(func(k, v []byte) error {
+			// Skip any malformed entries rather than failing the
+			// whole fetch.
+			var node NodeID
+			if len(k) != len(node) || len(v) != nodeFailureLen {
+				return nil
+			}
+			copy(node[:], k)
+
+			score := binary.BigEndian.Uint64(v[:8])
+			ts := binary.BigEndian.Uint64(v[8:])
+			nodeFailures[node] = NodeFailure{
+				Score:     math.Float64frombits(score),
+				Timestamp: time.Unix(0, int64(ts)),
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeFailures, nil
+}
+
+// Compile-time constraint to ensure failureStore implements FailureStore.
+var _ FailureStore = (*failureStore)(nil)
+
+// Blacklist keeps track of the nodes we recently failed to open channels
+// with, so they can be temporarily skipped when selecting nodes to attach to.
+// The penalty of each failure decays exponentially with the configured
+// half-life, so nodes that repeatedly fail are skipped for longer.
+type Blacklist struct {
+	store    FailureStore
+	halfLife time.Duration
+
+	// now returns the current time. It can be overridden in tests.
+	now func() time.Time
+
+	failures map[NodeID]NodeFailure
+	mtx      sync.Mutex
+}
+
+// NewBlacklist creates a new Blacklist backed by the passed store, loading
+// any previously recorded failures.
+func NewBlacklist(store FailureStore, halfLife time.Duration) (*Blacklist,
+	error) {
+
+	if halfLife <= 0 {
+		return nil, errors.New("failure half-life must be positive")
+	}
+
+	failures, err := store.FetchNodeFailures()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Blacklist{
+		store:    store,
+		halfLife: halfLife,
+		now:      time.Now,
+		failures: failures,
+	}, nil
+}
+
+// score returns the failure score of the given record decayed to the passed
+// time.
+func (b *Blacklist) score(failure NodeFailure, now time.Time) float64 {
+	age := now.Sub(failure.Timestamp)
+	if age <= 0 {
+		return failure.Score
+	}
+
+	halvings := float64(age) / float64(b.halfLife)
+	return failure.Score * math.Exp2(-halvings)
+}
+
+// RecordFailure records a failed channel open attempt with the given node.
+func (b *Blacklist) RecordFailure(node NodeID) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	now := b.now()
+
+	var score float64
+	if failure, ok := b.failures[node]; ok {
+		score = b.score(failure, now)
+	}
+
+	failure := NodeFailure{
+		Score:     score + 1,
+		Timestamp: now,
+	}
+	if err := b.store.PutNodeFailure(node, failure); err != nil {
+		return err
+	}
+	b.failures[node] = failure
+
+	return nil
+}
+
+// IsBlacklisted returns true if the given node failed recently enough that it
+// should be skipped during node selection.
+func (b *Blacklist) IsBlacklisted(node NodeID) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	failure, ok := b.failures[node]
+	if !ok {
+		return false
+	}
+
+	return b.score(failure, b.now()) > blacklistThreshold
+}
+
+// Graph wraps the passed ChannelGraph such that ForEachNode skips all nodes
+// that are currently blacklisted.
+func (b *Blacklist) Graph(graph ChannelGraph) ChannelGraph {
+	return &blacklistGraph{
+		graph:     graph,
+		blacklist: b,
+	}
+}
+
+// blacklistGraph is a ChannelGraph that hides the nodes of the underlying
+// graph that are currently blacklisted.
+type blacklistGraph struct {
+	graph     ChannelGraph
+	blacklist *Blacklist
+}
+
+// A compile time assertion to ensure blacklistGraph meets the
+// autopilot.ChannelGraph interface.
+var _ ChannelGraph = (*blacklistGraph)(nil)
+
+// ForEachNode is a higher-order function that should be called once for each
+// connected node within the channel graph. If the passed callback returns an
+// error, then execution should be terminated.
+//
+// NOTE: Part of the autopilot.ChannelGraph interface.
+func (g *blacklistGraph) ForEachNode(cb func(Node) error) error {
+	return g.graph.ForEachNode(func(node Node) error {
+		if g.blacklist.IsBlacklisted(NodeID(node.PubKey())) {
+			return nil
+		}
+
+		return cb(node)
+	})
+}
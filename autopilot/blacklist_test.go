@@ -0,0 +1,121 @@
+package autopilot
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/channeldb"
+)
+
+// visibleNodes returns the set of nodes the passed graph iterates over.
+func visibleNodes(t *testing.T, graph ChannelGraph) map[NodeID]struct{} {
+	t.Helper()
+
+	nodes := make(map[NodeID]struct{})
+	err := graph.ForEachNode(func(node Node) error {
+		nodes[NodeID(node.PubKey())] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to iterate nodes: %v", err)
+	}
+
+	return nodes
+}
+
+// TestBlacklistDecay asserts that a node we recorded a failure for is skipped
+// by the blacklisted graph until its penalty has decayed, and that recorded
+// failures survive a restart.
+func TestBlacklistDecay(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "blacklist")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := channeldb.Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	defer db.Close()
+
+	store, err := NewFailureStore(db)
+	if err != nil {
+		t.Fatalf("unable to create failure store: %v", err)
+	}
+
+	const halfLife = time.Hour
+	now := time.Unix(1000000, 0)
+	newBlacklist := func() *Blacklist {
+		b, err := NewBlacklist(store, halfLife)
+		if err != nil {
+			t.Fatalf("unable to create blacklist: %v", err)
+		}
+		b.now = func() time.Time { return now }
+		return b
+	}
+	blacklist := newBlacklist()
+
+	memGraph := newMemChannelGraph()
+	failedPub, err := memGraph.addRandNode()
+	if err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+	otherPub, err := memGraph.addRandNode()
+	if err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+	failedNode := NewNodeID(failedPub)
+	otherNode := NewNodeID(otherPub)
+
+	graph := blacklist.Graph(memGraph)
+
+	// Without any failures, both nodes are visible.
+	nodes := visibleNodes(t, graph)
+	if len(nodes) != 2 {
+		t.Fatalf("expected 2 nodes, got %d", len(nodes))
+	}
+
+	// After recording a failure, the node should be skipped.
+	if err := blacklist.RecordFailure(failedNode); err != nil {
+		t.Fatalf("unable to record failure: %v", err)
+	}
+	nodes = visibleNodes(t, graph)
+	if _, ok := nodes[failedNode]; ok {
+		t.Fatalf("expected failed node to be skipped")
+	}
+	if _, ok := nodes[otherNode]; !ok {
+		t.Fatalf("expected other node to be visible")
+	}
+
+	// The failure must have been persisted, so a blacklist loaded from
+	// the same store also skips the node.
+	blacklist = newBlacklist()
+	graph = blacklist.Graph(memGraph)
+
+	// Right before a half-life has elapsed, the node is still skipped.
+	now = now.Add(halfLife - time.Minute)
+	if _, ok := visibleNodes(t, graph)[failedNode]; ok {
+		t.Fatalf("expected failed node to still be skipped")
+	}
+
+	// Once the penalty has decayed, the node is visible again.
+	now = now.Add(2 * time.Minute)
+	if _, ok := visibleNodes(t, graph)[failedNode]; !ok {
+		t.Fatalf("expected failed node to be visible after decay")
+	}
+
+	// Failing again while part of the previous penalty remains should
+	// keep the node skipped for longer than a single half-life.
+	if err := blacklist.RecordFailure(failedNode); err != nil {
+		t.Fatalf("unable to record failure: %v", err)
+	}
+	now = now.Add(halfLife + time.Minute)
+	if _, ok := visibleNodes(t, graph)[failedNode]; ok {
+		t.Fatalf("expected repeatedly failed node to be skipped")
+	}
+}
@@ -0,0 +1,46 @@
+package autopilot
+
+import "github.com/decred/dcrlnd/lnwire"
+
+// featureFilterGraph is a ChannelGraph that only exposes the nodes of the
+// underlying graph which advertise all of a set of features.
+type featureFilterGraph struct {
+	graph    ChannelGraph
+	features []lnwire.FeatureBit
+}
+
+// A compile time assertion to ensure featureFilterGraph meets the
+// autopilot.ChannelGraph interface.
+var _ ChannelGraph = (*featureFilterGraph)(nil)
+
+// FeatureFilterGraph wraps the passed ChannelGraph such that ForEachNode only
+// visits nodes that advertise all of the given features. This allows the
+// attachment heuristics to only consider peers that support a feature we
+// need. A feature is considered advertised if either its required or its
+// optional bit is set.
+func FeatureFilterGraph(graph ChannelGraph,
+	features ...lnwire.FeatureBit) ChannelGraph {
+
+	return &featureFilterGraph{
+		graph:    graph,
+		features: features,
+	}
+}
+
+// ForEachNode is a higher-order function that should be called once for each
+// connected node within the channel graph. If the passed callback returns an
+// error, then execution should be terminated.
+//
+// NOTE: Part of the autopilot.ChannelGraph interface.
+func (g *featureFilterGraph) ForEachNode(cb func(Node) error) error {
+	return g.graph.ForEachNode(func(node Node) error {
+		nodeFeatures := node.Features()
+		for _, feature := range g.features {
+			if !nodeFeatures.HasFeature(feature) {
+				return nil
+			}
+		}
+
+		return cb(node)
+	})
+}
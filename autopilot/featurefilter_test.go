@@ -0,0 +1,107 @@
+package autopilot
+
+import (
+	"testing"
+
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// TestFeatureFilterGraph asserts that the feature filtered graph only visits
+// the nodes advertising all of the requested features.
+func TestFeatureFilterGraph(t *testing.T) {
+	t.Parallel()
+
+	graph := newMemChannelGraph()
+
+	// Add three nodes: one without any features, one supporting the TLV
+	// onion payload as optional, and one requiring it along with static
+	// remote keys.
+	var nodes []NodeID
+	featureSets := [][]lnwire.FeatureBit{
+		nil,
+		{lnwire.TLVOnionPayloadOptional},
+		{
+			lnwire.TLVOnionPayloadRequired,
+			lnwire.StaticRemoteKeyOptional,
+		},
+	}
+	for _, bits := range featureSets {
+		pub, err := graph.addRandNode()
+		if err != nil {
+			t.Fatalf("unable to add node: %v", err)
+		}
+		nodeID := NewNodeID(pub)
+
+		node := graph.graph[nodeID]
+		node.features = lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(bits...),
+			lnwire.GlobalFeatures,
+		)
+		graph.graph[nodeID] = node
+
+		nodes = append(nodes, nodeID)
+	}
+
+	// Every node advertises its feature vector, or an empty one if it has
+	// none.
+	err := graph.ForEachNode(func(node Node) error {
+		if node.Features() == nil {
+			t.Fatalf("expected non-nil feature vector")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to iterate nodes: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		features []lnwire.FeatureBit
+		expected []NodeID
+	}{
+		{
+			name:     "no features",
+			expected: nodes,
+		},
+		{
+			name: "tlv onion",
+			features: []lnwire.FeatureBit{
+				lnwire.TLVOnionPayloadOptional,
+			},
+			expected: nodes[1:],
+		},
+		{
+			name: "tlv onion and static remote key",
+			features: []lnwire.FeatureBit{
+				lnwire.TLVOnionPayloadOptional,
+				lnwire.StaticRemoteKeyOptional,
+			},
+			expected: nodes[2:],
+		},
+	}
+
+	for _, test := range tests {
+		filtered := FeatureFilterGraph(graph, test.features...)
+
+		visited := make(map[NodeID]struct{})
+		err := filtered.ForEachNode(func(node Node) error {
+			visited[NodeID(node.PubKey())] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("%s: unable to iterate nodes: %v", test.name,
+				err)
+		}
+
+		if len(visited) != len(test.expected) {
+			t.Fatalf("%s: expected %d nodes, got %d", test.name,
+				len(test.expected), len(visited))
+		}
+		for _, nodeID := range test.expected {
+			if _, ok := visited[nodeID]; !ok {
+				t.Fatalf("%s: expected node %x to be visited",
+					test.name, nodeID[:])
+			}
+		}
+	}
+}
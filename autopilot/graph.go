@@ -50,6 +50,8 @@ func ChannelGraphFromDatabase(db *channeldb.ChannelGraph) ChannelGraph {
 // channeldb.LightningNode. The wrapper method implement the autopilot.Node
 // interface.
 type dbNode struct {
+	// tx is the transaction the node was read within. If it's nil, a new
+	// transaction is created when traversing the node's channels.
 	tx *bolt.Tx
 
 	node *channeldb.LightningNode
@@ -76,6 +78,18 @@ func (d dbNode) Addrs() []net.Addr {
 	return d.node.Addresses
 }
 
+// Features returns the feature vector advertised by the node in its latest
+// node announcement.
+//
+// NOTE: Part of the autopilot.Node interface.
+func (d dbNode) Features() *lnwire.FeatureVector {
+	if d.node.Features == nil {
+		return lnwire.NewFeatureVector(nil, lnwire.GlobalFeatures)
+	}
+
+	return d.node.Features
+}
+
 // ForEachChannel is a higher-order function that will be used to iterate
 // through all edges emanating from/to the target node. For each active
 // channel, this function should be called with the populated ChannelEdge that
@@ -137,6 +151,70 @@ func (d *databaseChannelGraph) ForEachNode(cb func(Node) error) error {
 	})
 }
 
+// ForEachNodeChangedSince calls the passed callback once for each node that
+// either announced itself, or had one of its channels' edge policies updated,
+// since the passed time. This allows callers to maintain an incremental view
+// of the graph without having to traverse it as a whole. As with ForEachNode,
+// nodes without any advertised addresses are skipped. If the callback returns
+// an error, then execution is terminated.
+func (d *databaseChannelGraph) ForEachNodeChangedSince(since time.Time,
+	cb func(Node) error) error {
+
+	now := time.Now()
+
+	// Gather the set of nodes that changed within the horizon, either
+	// through a node announcement or through an update to one of the
+	// policies of their channels.
+	changed := make(map[NodeID]struct{})
+	nodes, err := d.db.NodeUpdatesInHorizon(since, now)
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		changed[NodeID(node.PubKeyBytes)] = struct{}{}
+	}
+
+	edges, err := d.db.ChanUpdatesInHorizon(since, now)
+	if err != nil {
+		return err
+	}
+	for _, edge := range edges {
+		changed[NodeID(edge.Info.NodeKey1Bytes)] = struct{}{}
+		changed[NodeID(edge.Info.NodeKey2Bytes)] = struct{}{}
+	}
+
+	for nodeID := range changed {
+		pub, err := secp256k1.ParsePubKey(nodeID[:])
+		if err != nil {
+			return err
+		}
+
+		node, err := d.db.FetchLightningNode(pub)
+		switch {
+		// We may know of a channel without having received the
+		// announcement of one of its nodes yet.
+		case err == channeldb.ErrGraphNodeNotFound:
+			continue
+
+		case err != nil:
+			return err
+		}
+
+		if len(node.Addresses) == 0 {
+			continue
+		}
+
+		// The node isn't read within a long lived transaction, so we
+		// leave the tx nil for its channels to be fetched within a
+		// new one.
+		if err := cb(dbNode{node: node}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // addRandChannel creates a new channel two target nodes. This function is
 // meant to aide in the generation of random graphs for use within test cases
 // the exercise the autopilot package.
@@ -332,6 +410,36 @@ func (m memChannelGraph) ForEachNode(cb func(Node) error) error {
 	return nil
 }
 
+// PruneClosedChannels removes the edges of the given channels from the
+// in-memory graph, so that heuristics working off of it won't consider
+// channels that have since been closed. The nodes themselves are left in
+// place, even if they're left without any channels.
+func (m *memChannelGraph) PruneClosedChannels(ids []lnwire.ShortChannelID) {
+	if len(ids) == 0 {
+		return
+	}
+
+	closed := make(map[lnwire.ShortChannelID]struct{}, len(ids))
+	for _, id := range ids {
+		closed[id] = struct{}{}
+	}
+
+	for nodeID, node := range m.graph {
+		// We'll build a new slice, as copies of the node held by its
+		// peers' edges share the backing array of the current one.
+		chans := make([]ChannelEdge, 0, len(node.chans))
+		for _, edge := range node.chans {
+			if _, ok := closed[edge.ChanID]; ok {
+				continue
+			}
+			chans = append(chans, edge)
+		}
+
+		node.chans = chans
+		m.graph[nodeID] = node
+	}
+}
+
 // randChanID generates a new random channel ID.
 func randChanID() lnwire.ShortChannelID {
 	id := atomic.AddUint64(&chanIDCounter, 1)
@@ -462,6 +570,8 @@ type memNode struct {
 	chans []ChannelEdge
 
 	addrs []net.Addr
+
+	features *lnwire.FeatureVector
 }
 
 // A compile time assertion to ensure memNode meets the autopilot.Node
@@ -487,6 +597,17 @@ func (m memNode) Addrs() []net.Addr {
 	return m.addrs
 }
 
+// Features returns the feature vector advertised by the node.
+//
+// NOTE: Part of the autopilot.Node interface.
+func (m memNode) Features() *lnwire.FeatureVector {
+	if m.features == nil {
+		return lnwire.NewFeatureVector(nil, lnwire.GlobalFeatures)
+	}
+
+	return m.features
+}
+
 // ForEachChannel is a higher-order function that will be used to iterate
 // through all edges emanating from/to the target node. For each active
 // channel, this function should be called with the populated ChannelEdge that
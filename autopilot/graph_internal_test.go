@@ -0,0 +1,167 @@
+package autopilot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// TestForEachNodeChangedSince asserts that only the nodes whose channels were
+// updated within the horizon are visited.
+func TestForEachNodeChangedSince(t *testing.T) {
+	t.Parallel()
+
+	graph, cleanUp, err := newDiskChanGraph()
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer cleanUp()
+	dbGraph := graph.(*databaseChannelGraph)
+
+	// Add two channels between distinct pairs of nodes. Both of them will
+	// have their policies updated right away.
+	recent1, recent2, err := dbGraph.addRandChannel(nil, nil, 100)
+	if err != nil {
+		t.Fatalf("unable to add channel: %v", err)
+	}
+	old1, old2, err := dbGraph.addRandChannel(nil, nil, 100)
+	if err != nil {
+		t.Fatalf("unable to add channel: %v", err)
+	}
+
+	// Backdate the policies of the second channel, so it's only within
+	// the larger of our horizons.
+	oldUpdate := time.Now().Add(-2 * time.Hour)
+	for _, flags := range []lnwire.ChanUpdateChanFlags{0, 1} {
+		policy := &channeldb.ChannelEdgePolicy{
+			SigBytes:                  testSig.Serialize(),
+			ChannelID:                 old1.ChanID.ToUint64(),
+			LastUpdate:                oldUpdate,
+			TimeLockDelta:             10,
+			MinHTLC:                   1,
+			MaxHTLC:                   lnwire.NewMAtomsFromAtoms(100),
+			FeeBaseMAtoms:             10,
+			FeeProportionalMillionths: 10000,
+			MessageFlags:              1,
+			ChannelFlags:              flags,
+		}
+		if err := dbGraph.db.UpdateEdgePolicy(policy); err != nil {
+			t.Fatalf("unable to update policy: %v", err)
+		}
+	}
+
+	changedSince := func(since time.Time) map[NodeID]struct{} {
+		nodes := make(map[NodeID]struct{})
+		err := dbGraph.ForEachNodeChangedSince(since, func(n Node) error {
+			// The returned nodes must still allow traversing
+			// their channels.
+			var numChans int
+			err := n.ForEachChannel(func(ChannelEdge) error {
+				numChans++
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if numChans != 1 {
+				t.Fatalf("expected 1 channel, got %d", numChans)
+			}
+
+			nodes[NodeID(n.PubKey())] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unable to fetch changed nodes: %v", err)
+		}
+
+		return nodes
+	}
+
+	assertNodes := func(nodes map[NodeID]struct{}, expected ...Node) {
+		t.Helper()
+
+		if len(nodes) != len(expected) {
+			t.Fatalf("expected %d nodes, got %d", len(expected),
+				len(nodes))
+		}
+		for _, node := range expected {
+			nodeID := NodeID(node.PubKey())
+			if _, ok := nodes[nodeID]; !ok {
+				t.Fatalf("expected node %x to be visited",
+					nodeID[:])
+			}
+		}
+	}
+
+	// Within the last hour, only the nodes of the first channel changed.
+	assertNodes(
+		changedSince(time.Now().Add(-time.Hour)),
+		recent1.Peer, recent2.Peer,
+	)
+
+	// Within the last three hours, the nodes of both channels changed.
+	assertNodes(
+		changedSince(time.Now().Add(-3*time.Hour)),
+		recent1.Peer, recent2.Peer, old1.Peer, old2.Peer,
+	)
+
+	// Nothing changed in the future.
+	assertNodes(changedSince(time.Now().Add(time.Hour)))
+}
+
+// TestMemChannelGraphPruneClosedChannels asserts that pruning closed channels
+// removes their edges from both endpoints, while leaving other channels
+// intact.
+func TestMemChannelGraphPruneClosedChannels(t *testing.T) {
+	t.Parallel()
+
+	graph := newMemChannelGraph()
+
+	// Create a hub with two channels, one of which will be closed, and a
+	// third channel between two other nodes that will also be closed.
+	hub, err := graph.addRandNode()
+	if err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+	open, _, err := graph.addRandChannel(hub, nil, 100)
+	if err != nil {
+		t.Fatalf("unable to add channel: %v", err)
+	}
+	closed1, _, err := graph.addRandChannel(hub, nil, 200)
+	if err != nil {
+		t.Fatalf("unable to add channel: %v", err)
+	}
+	closed2, _, err := graph.addRandChannel(nil, nil, 300)
+	if err != nil {
+		t.Fatalf("unable to add channel: %v", err)
+	}
+
+	graph.PruneClosedChannels([]lnwire.ShortChannelID{
+		closed1.ChanID, closed2.ChanID,
+	})
+
+	// Only the open channel should remain, seen from both of its
+	// endpoints. All nodes should still be part of the graph.
+	var numNodes int
+	remaining := make(map[lnwire.ShortChannelID]int)
+	err = graph.ForEachNode(func(node Node) error {
+		numNodes++
+		return node.ForEachChannel(func(edge ChannelEdge) error {
+			remaining[edge.ChanID]++
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("unable to traverse graph: %v", err)
+	}
+
+	if numNodes != 5 {
+		t.Fatalf("expected 5 nodes, got %v", numNodes)
+	}
+	if len(remaining) != 1 || remaining[open.ChanID] != 2 {
+		t.Fatalf("expected only channel %v to remain on both ends, "+
+			"got %v", open.ChanID, remaining)
+	}
+}
@@ -29,6 +29,10 @@ type Node interface {
 	// that the peer is known to be listening on.
 	Addrs() []net.Addr
 
+	// Features returns the feature vector advertised by the node. If the
+	// node hasn't advertised any features, an empty vector is returned.
+	Features() *lnwire.FeatureVector
+
 	// ForEachChannel is a higher-order function that will be used to
 	// iterate through all edges emanating from/to the target node. For
 	// each active channel, this function should be called with the
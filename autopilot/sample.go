@@ -0,0 +1,85 @@
+package autopilot
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+)
+
+// ErrNoNodeCapacity is returned when sampling a node by capacity from a graph
+// in which no node has any channel capacity.
+var ErrNoNodeCapacity = errors.New("no node with channel capacity found")
+
+// nodeCapacity returns the total capacity of all channels of the given node.
+func nodeCapacity(node Node) (dcrutil.Amount, error) {
+	var capacity dcrutil.Amount
+	err := node.ForEachChannel(func(edge ChannelEdge) error {
+		capacity += edge.Capacity
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return capacity, nil
+}
+
+// sampleNodeByCapacity returns a random node of the graph, sampled with a
+// probability proportional to the node's total channel capacity. The graph is
+// traversed only once, using weighted reservoir sampling: each node replaces
+// the current pick with a probability of its capacity over the total capacity
+// seen so far.
+func sampleNodeByCapacity(graph ChannelGraph, r *rand.Rand) (Node, error) {
+	var (
+		picked Node
+		total  dcrutil.Amount
+	)
+	err := graph.ForEachNode(func(node Node) error {
+		capacity, err := nodeCapacity(node)
+		if err != nil {
+			return err
+		}
+		if capacity <= 0 {
+			return nil
+		}
+
+		total += capacity
+		if r.Int63n(int64(total)) < int64(capacity) {
+			picked = node
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if picked == nil {
+		return nil, ErrNoNodeCapacity
+	}
+
+	return picked, nil
+}
+
+// SampleNodeByCapacity returns a random node of the graph, sampled with a
+// probability proportional to the node's total channel capacity. Well
+// capitalized nodes are thus more likely to be returned, while nodes in the
+// long tail still have a chance of being explored. If no node has any
+// channel capacity, ErrNoNodeCapacity is returned.
+//
+// NOTE: The returned node was read within a database transaction that is
+// closed by the time it's returned, so only its PubKey, Addrs and Features
+// may be used.
+func (d *databaseChannelGraph) SampleNodeByCapacity(r *rand.Rand) (Node,
+	error) {
+
+	return sampleNodeByCapacity(d, r)
+}
+
+// SampleNodeByCapacity returns a random node of the graph, sampled with a
+// probability proportional to the node's total channel capacity. If no node
+// has any channel capacity, ErrNoNodeCapacity is returned.
+func (m memChannelGraph) SampleNodeByCapacity(r *rand.Rand) (Node, error) {
+	return sampleNodeByCapacity(m, r)
+}
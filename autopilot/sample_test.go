@@ -0,0 +1,71 @@
+package autopilot
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestSampleNodeByCapacity asserts that nodes are sampled with a probability
+// proportional to their total channel capacity.
+func TestSampleNodeByCapacity(t *testing.T) {
+	t.Parallel()
+
+	graph := newMemChannelGraph()
+	r := rand.New(rand.NewSource(1))
+
+	// Sampling from an empty graph fails.
+	if _, err := graph.SampleNodeByCapacity(r); err != ErrNoNodeCapacity {
+		t.Fatalf("expected ErrNoNodeCapacity, got %v", err)
+	}
+
+	// Create a skewed graph of a hub with a large channel to one node and
+	// a small one to another. This gives the hub a capacity of 100, the
+	// large node 90 and the small node 10.
+	hub, err := graph.addRandNode()
+	if err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+	large, _, err := graph.addRandChannel(hub, nil, 90)
+	if err != nil {
+		t.Fatalf("unable to add channel: %v", err)
+	}
+	small, _, err := graph.addRandChannel(hub, nil, 10)
+	if err != nil {
+		t.Fatalf("unable to add channel: %v", err)
+	}
+
+	// Nodes without any channels must never be sampled.
+	lonely, err := graph.addRandNode()
+	if err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+
+	expected := map[NodeID]float64{
+		NewNodeID(hub):              0.5,
+		NodeID(large.Peer.PubKey()): 0.45,
+		NodeID(small.Peer.PubKey()): 0.05,
+	}
+
+	const numSamples = 20000
+	counts := make(map[NodeID]int)
+	for i := 0; i < numSamples; i++ {
+		node, err := graph.SampleNodeByCapacity(r)
+		if err != nil {
+			t.Fatalf("unable to sample node: %v", err)
+		}
+		counts[NodeID(node.PubKey())]++
+	}
+
+	if counts[NewNodeID(lonely)] != 0 {
+		t.Fatalf("node without channels was sampled")
+	}
+
+	for nodeID, share := range expected {
+		freq := float64(counts[nodeID]) / numSamples
+		if math.Abs(freq-share) > 0.02 {
+			t.Fatalf("expected node %x to be sampled with "+
+				"frequency %v, got %v", nodeID[:], share, freq)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package build
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/decred/slog"
+)
+
+// maxRepeatKeys is the maximum number of distinct messages whose repetitions
+// are tracked at once. Messages logged while this many are tracked are passed
+// through as is, which bounds the memory and timers used by the logger.
+const maxRepeatKeys = 100
+
+// repeatKey identifies the messages logged at a particular level with a
+// particular format.
+type repeatKey struct {
+	level  slog.Level
+	format string
+}
+
+// repeatEntry tracks the repetitions of a message within its current window.
+type repeatEntry struct {
+	// count is the number of times the message was repeated.
+	count int
+
+	// lastMsg is the last repetition of the message, which may differ
+	// from the first one in its formatted parameters.
+	lastMsg string
+}
+
+// rateLimitedLogger is a logger that collapses warnings, errors and critical
+// messages logged with the same format within a window into a single summary
+// line. All other messages are passed through to the underlying logger as is.
+type rateLimitedLogger struct {
+	slog.Logger
+
+	window time.Duration
+
+	mu sync.Mutex
+
+	// repeats tracks the messages logged within their current window,
+	// along with their repetitions since. It holds at most maxRepeatKeys
+	// entries.
+	repeats map[repeatKey]*repeatEntry
+}
+
+// NewRateLimitedLogger wraps the given logger, such that warnings, errors and
+// critical messages repeated with the same format within window are
+// collapsed. The first occurrence of a message is logged right away, while its
+// repetitions are counted and logged as a single "message (repeated N times)"
+// line, showing the last repetition, once the window expires. This bounds the
+// log output of error paths that an attacker may be able to trigger at will.
+func NewRateLimitedLogger(logger slog.Logger,
+	window time.Duration) slog.Logger {
+
+	return &rateLimitedLogger{
+		Logger:  logger,
+		window:  window,
+		repeats: make(map[repeatKey]*repeatEntry),
+	}
+}
+
+// log logs the message through logFn, unless a message with the same format
+// was already logged within the current window, in which case it is only
+// counted.
+func (l *rateLimitedLogger) log(level slog.Level, logFn func(...interface{}),
+	format, msg string) {
+
+	key := repeatKey{level: level, format: format}
+
+	l.mu.Lock()
+	if entry, ok := l.repeats[key]; ok {
+		entry.count++
+		entry.lastMsg = msg
+		l.mu.Unlock()
+		return
+	}
+
+	// If we're already tracking as many messages as we're willing to,
+	// we'll log this one without collapsing its repetitions.
+	if len(l.repeats) >= maxRepeatKeys {
+		l.mu.Unlock()
+		logFn(msg)
+		return
+	}
+	l.repeats[key] = &repeatEntry{}
+	l.mu.Unlock()
+
+	logFn(msg)
+
+	time.AfterFunc(l.window, func() {
+		l.mu.Lock()
+		entry := l.repeats[key]
+		delete(l.repeats, key)
+		l.mu.Unlock()
+
+		if entry.count > 0 {
+			logFn(fmt.Sprintf("%s (repeated %d times)",
+				entry.lastMsg, entry.count))
+		}
+	})
+}
+
+// Warnf formats message according to format specifier and writes to log with
+// LevelWarn, collapsing repetitions.
+func (l *rateLimitedLogger) Warnf(format string, params ...interface{}) {
+	if l.Level() > slog.LevelWarn {
+		return
+	}
+	l.log(
+		slog.LevelWarn, l.Logger.Warn, format,
+		fmt.Sprintf(format, params...),
+	)
+}
+
+// Errorf formats message according to format specifier and writes to log with
+// LevelError, collapsing repetitions.
+func (l *rateLimitedLogger) Errorf(format string, params ...interface{}) {
+	if l.Level() > slog.LevelError {
+		return
+	}
+	l.log(
+		slog.LevelError, l.Logger.Error, format,
+		fmt.Sprintf(format, params...),
+	)
+}
+
+// Criticalf formats message according to format specifier and writes to log
+// with LevelCritical, collapsing repetitions.
+func (l *rateLimitedLogger) Criticalf(format string, params ...interface{}) {
+	if l.Level() > slog.LevelCritical {
+		return
+	}
+	l.log(
+		slog.LevelCritical, l.Logger.Critical, format,
+		fmt.Sprintf(format, params...),
+	)
+}
+
+// Warn formats message using the default formats for its operands and writes
+// to log with LevelWarn, collapsing repetitions.
+func (l *rateLimitedLogger) Warn(v ...interface{}) {
+	if l.Level() > slog.LevelWarn {
+		return
+	}
+	msg := fmt.Sprint(v...)
+	l.log(slog.LevelWarn, l.Logger.Warn, msg, msg)
+}
+
+// Error formats message using the default formats for its operands and writes
+// to log with LevelError, collapsing repetitions.
+func (l *rateLimitedLogger) Error(v ...interface{}) {
+	if l.Level() > slog.LevelError {
+		return
+	}
+	msg := fmt.Sprint(v...)
+	l.log(slog.LevelError, l.Logger.Error, msg, msg)
+}
+
+// Critical formats message using the default formats for its operands and
+// writes to log with LevelCritical, collapsing repetitions.
+func (l *rateLimitedLogger) Critical(v ...interface{}) {
+	if l.Level() > slog.LevelCritical {
+		return
+	}
+	msg := fmt.Sprint(v...)
+	l.log(slog.LevelCritical, l.Logger.Critical, msg, msg)
+}
@@ -0,0 +1,143 @@
+package build
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/decred/slog"
+)
+
+// syncBuffer is a buffer that can be written to and read from concurrently.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) lines() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return strings.Split(strings.TrimSpace(b.buf.String()), "\n")
+}
+
+// TestRateLimitedLogger asserts that many identical errors logged within the
+// window produce a bounded number of log lines, while distinct messages are
+// all logged.
+func TestRateLimitedLogger(t *testing.T) {
+	t.Parallel()
+
+	const (
+		window    = 100 * time.Millisecond
+		numErrors = 1000
+	)
+
+	var buf syncBuffer
+	logger := slog.NewBackend(&buf).Logger("TEST")
+	logger.SetLevel(slog.LevelInfo)
+
+	rateLimited := NewRateLimitedLogger(logger, window)
+
+	for i := 0; i < numErrors; i++ {
+		rateLimited.Errorf("unable to process onion packet: %v",
+			"invalid")
+	}
+	rateLimited.Errorf("unable to decode onion packet")
+
+	// Only the first occurrence of each message is logged right away.
+	lines := buf.lines()
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 log lines, got %v: %v", len(lines), lines)
+	}
+
+	// Once the window expires, the repetitions are logged as a single
+	// line.
+	time.Sleep(3 * window)
+
+	lines = buf.lines()
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 log lines, got %v: %v", len(lines), lines)
+	}
+	expected := "unable to process onion packet: invalid (repeated " +
+		"999 times)"
+	if !strings.HasSuffix(lines[2], expected) {
+		t.Fatalf("expected summary line %q, got %q", expected, lines[2])
+	}
+
+	// After the window, the message is logged right away again.
+	rateLimited.Errorf("unable to process onion packet: %v", "invalid")
+	if lines := buf.lines(); len(lines) != 4 {
+		t.Fatalf("expected 4 log lines, got %v: %v", len(lines), lines)
+	}
+
+	// Messages below the level of the logger aren't tracked at all.
+	logger.SetLevel(slog.LevelCritical)
+	rateLimited.Errorf("unable to decode onion packet")
+	if lines := buf.lines(); len(lines) != 4 {
+		t.Fatalf("expected 4 log lines, got %v: %v", len(lines), lines)
+	}
+}
+
+// TestRateLimitedLoggerFormat asserts that messages are collapsed based on
+// their format rather than their formatted parameters, and that the number of
+// tracked messages is bounded.
+func TestRateLimitedLoggerFormat(t *testing.T) {
+	t.Parallel()
+
+	const window = 100 * time.Millisecond
+
+	var buf syncBuffer
+	logger := slog.NewBackend(&buf).Logger("TEST")
+	logger.SetLevel(slog.LevelInfo)
+
+	rateLimited := NewRateLimitedLogger(logger, window)
+
+	// Messages differing only in their parameters should be collapsed,
+	// with the summary showing the last one.
+	for i := 0; i < 10; i++ {
+		rateLimited.Warnf("invalid onion from peer %d", i)
+	}
+	if lines := buf.lines(); len(lines) != 1 {
+		t.Fatalf("expected 1 log line, got %v: %v", len(lines), lines)
+	}
+
+	time.Sleep(3 * window)
+
+	lines := buf.lines()
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 log lines, got %v: %v", len(lines), lines)
+	}
+	expected := "invalid onion from peer 9 (repeated 9 times)"
+	if !strings.HasSuffix(lines[1], expected) {
+		t.Fatalf("expected summary line %q, got %q", expected, lines[1])
+	}
+
+	// Logging more distinct messages than we track should log all of
+	// them, without tracking more than maxRepeatKeys.
+	for i := 0; i < maxRepeatKeys+10; i++ {
+		rateLimited.Warn(fmt.Sprintf("distinct message %d", i))
+	}
+	if lines := buf.lines(); len(lines) != maxRepeatKeys+12 {
+		t.Fatalf("expected %v log lines, got %v", maxRepeatKeys+12,
+			len(lines))
+	}
+
+	l := rateLimited.(*rateLimitedLogger)
+	l.mu.Lock()
+	numTracked := len(l.repeats)
+	l.mu.Unlock()
+	if numTracked != maxRepeatKeys {
+		t.Fatalf("expected %v tracked messages, got %v",
+			maxRepeatKeys, numTracked)
+	}
+}
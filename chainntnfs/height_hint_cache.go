@@ -20,6 +20,13 @@ var (
 	// confirmed within.
 	confirmHintBucket = []byte("confirm-hints")
 
+	// scriptSpendHintBucket is the name of the bucket which houses the
+	// height hints for outputs identified by their parsed pkScript and
+	// value rather than by their outpoint. Each height hint represents the
+	// earliest height at which an output paying to that script could have
+	// been spent within.
+	scriptSpendHintBucket = []byte("script-spend-hints")
+
 	// ErrCorruptedHeightHintCache indicates that the on-disk bucketing
 	// structure has altered since the height hint cache instance was
 	// initialized.
@@ -102,10 +109,97 @@ func (c *HeightHintCache) initBuckets() error {
 		}
 
 		_, err = tx.CreateBucketIfNotExists(confirmHintBucket)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists(scriptSpendHintBucket)
 		return err
 	})
 }
 
+// scriptSpendHintKey returns the key under which the spend hint of an output
+// with the given pkScript and value is stored. The key is composed of the
+// script class, script version, the unpadded script and the value, which is
+// a canonical and compact representation of the output.
+func scriptSpendHintKey(pkScript PkScript, value int64) ([]byte, error) {
+	script := pkScript.Script()
+	if script == nil {
+		return nil, ErrUnsupportedScriptType
+	}
+
+	var key bytes.Buffer
+	err := channeldb.WriteElements(
+		&key, uint8(pkScript.Class()), pkScript.ScriptVersion(),
+		script, value,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.Bytes(), nil
+}
+
+// PutSpendHint commits a spend hint for outputs paying value to the given
+// pkScript.
+func (c *HeightHintCache) PutSpendHint(pkScript PkScript, value int64,
+	height uint32) error {
+
+	key, err := scriptSpendHintKey(pkScript, value)
+	if err != nil {
+		return err
+	}
+
+	Log.Tracef("Updating spend hint to height %d for script %v with "+
+		"value %d", height, pkScript, value)
+
+	return c.db.Batch(func(tx *bolt.Tx) error {
+		spendHints := tx.Bucket(scriptSpendHintBucket)
+		if spendHints == nil {
+			return ErrCorruptedHeightHintCache
+		}
+
+		var hint bytes.Buffer
+		if err := channeldb.WriteElement(&hint, height); err != nil {
+			return err
+		}
+
+		return spendHints.Put(key, hint.Bytes())
+	})
+}
+
+// GetSpendHint returns the latest spend hint for outputs paying value to the
+// given pkScript. ErrSpendHintNotFound is returned if a spend hint does not
+// exist within the cache for the script and value.
+func (c *HeightHintCache) GetSpendHint(pkScript PkScript,
+	value int64) (uint32, error) {
+
+	key, err := scriptSpendHintKey(pkScript, value)
+	if err != nil {
+		return 0, err
+	}
+
+	var hint uint32
+	err = c.db.View(func(tx *bolt.Tx) error {
+		spendHints := tx.Bucket(scriptSpendHintBucket)
+		if spendHints == nil {
+			return ErrCorruptedHeightHintCache
+		}
+
+		spendHint := spendHints.Get(key)
+		if spendHint == nil {
+			return ErrSpendHintNotFound
+		}
+
+		return channeldb.ReadElement(bytes.NewReader(spendHint), &hint)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return hint, nil
+}
+
 // CommitSpendHint commits a spend hint for the outpoints to the cache.
 func (c *HeightHintCache) CommitSpendHint(height uint32,
 	spendRequests ...SpendRequest) error {
@@ -151,3 +151,63 @@ func TestHeightHintCacheSpends(t *testing.T) {
 		}
 	}
 }
+
+// TestHeightHintCacheScriptSpends ensures that the height hint cache properly
+// persists spend hints keyed by a parsed pkScript and value.
+func TestHeightHintCacheScriptSpends(t *testing.T) {
+	t.Parallel()
+
+	hintCache := initHintCache(t)
+
+	p2pkhScript := []byte{
+		// OP_DUP
+		0x76,
+		// OP_HASH160
+		0xa9,
+		// OP_DATA_20
+		0x14,
+		// <20-byte pubkey hash>
+		0xf0, 0x7a, 0xb8, 0xce, 0x72, 0xda, 0x4e, 0x76,
+		0x0b, 0x74, 0x7d, 0x48, 0xd6, 0x65, 0xec, 0x96,
+		0xad, 0xf0, 0x24, 0xf5,
+		// OP_EQUALVERIFY
+		0x88,
+		// OP_CHECKSIG
+		0xac,
+	}
+	pkScript, err := ParsePkScript(0, p2pkhScript)
+	if err != nil {
+		t.Fatalf("unable to parse pkScript: %v", err)
+	}
+
+	const (
+		height = 100
+		value  = 1e8
+	)
+
+	// Querying for a script not found within the cache should return an
+	// error indicating so.
+	_, err = hintCache.GetSpendHint(pkScript, value)
+	if err != ErrSpendHintNotFound {
+		t.Fatalf("expected ErrSpendHintNotFound, got: %v", err)
+	}
+
+	if err := hintCache.PutSpendHint(pkScript, value, height); err != nil {
+		t.Fatalf("unable to add entry to cache: %v", err)
+	}
+
+	spendHint, err := hintCache.GetSpendHint(pkScript, value)
+	if err != nil {
+		t.Fatalf("unable to query for hint: %v", err)
+	}
+	if spendHint != height {
+		t.Fatalf("expected spend hint %d, got %d", height, spendHint)
+	}
+
+	// The value is part of the key, so the same script with a different
+	// value must not have a hint.
+	_, err = hintCache.GetSpendHint(pkScript, value+1)
+	if err != ErrSpendHintNotFound {
+		t.Fatalf("expected ErrSpendHintNotFound, got: %v", err)
+	}
+}
@@ -17,6 +17,7 @@ func TestParsePkScript(t *testing.T) {
 		name     string
 		pkScript []byte
 		valid    bool
+		address  string
 	}{
 		{
 			name:     "empty output script",
@@ -41,7 +42,8 @@ func TestParsePkScript(t *testing.T) {
 				// OP_CHECKSIG
 				0xac,
 			},
-			valid: true,
+			valid:   true,
+			address: "DsntSgLVbTCa7AJAaVpUmCCPQhZSRkMVEZL",
 		},
 		// Invalid P2PKH - same as above but replaced OP_CHECKSIG with
 		// OP_CHECKSIGVERIFY.
@@ -79,7 +81,8 @@ func TestParsePkScript(t *testing.T) {
 				// OP_EQUAL
 				0x87,
 			},
-			valid: true,
+			valid:   true,
+			address: "Dcu1k5Jq4KVPjMfzQKL1TFnVBuDuanjwLuz",
 		},
 		// Invalid P2SH - same as above but replaced OP_EQUAL with
 		// OP_EQUALVERIFY.
@@ -126,6 +129,15 @@ func TestParsePkScript(t *testing.T) {
 					"got pkScript=%x", test.pkScript,
 					pkScript.Script())
 			}
+
+			addr, err := pkScript.Address(chaincfg.MainNetParams())
+			if err != nil {
+				t.Fatalf("unable to derive address: %v", err)
+			}
+			if addr.Address() != test.address {
+				t.Fatalf("expected address %v, got %v",
+					test.address, addr.Address())
+			}
 		})
 	}
 }
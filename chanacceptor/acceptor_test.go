@@ -2,6 +2,7 @@ package chanacceptor
 
 import (
 	"bytes"
+	"fmt"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -154,3 +155,47 @@ func TestRPCMultipleAcceptClients(t *testing.T) {
 		}
 	}
 }
+
+// TestChainedAcceptorRejectReason tests that the ChainedAcceptor reports which
+// of its acceptors rejected a request.
+func TestChainedAcceptorRejectReason(t *testing.T) {
+	t.Parallel()
+
+	req := &ChannelAcceptRequest{
+		Node:        randKey(t),
+		OpenChanMsg: &lnwire.OpenChannel{},
+	}
+
+	acceptAll := func(*ChannelAcceptRequest) bool { return true }
+	rejectAll := func(*ChannelAcceptRequest) bool { return false }
+
+	chainedAcceptor := NewChainedAcceptor()
+	chainedAcceptor.AddAcceptor(NewRPCAcceptor(acceptAll))
+
+	accepted, reason := chainedAcceptor.AcceptWithReason(req)
+	if !accepted {
+		t.Fatalf("expected request to be accepted")
+	}
+	if reason != "" {
+		t.Fatalf("expected no reason, got %q", reason)
+	}
+
+	rejectID := chainedAcceptor.AddAcceptor(NewRPCAcceptor(rejectAll))
+
+	accepted, reason = chainedAcceptor.AcceptWithReason(req)
+	if accepted {
+		t.Fatalf("expected request to be rejected")
+	}
+	expectedReason := fmt.Sprintf("rejected by acceptor %d "+
+		"(*chanacceptor.RPCAcceptor)", rejectID)
+	if reason != expectedReason {
+		t.Fatalf("expected reason %q, got %q", expectedReason, reason)
+	}
+
+	// Once the rejecting acceptor is removed, the request should be
+	// accepted again.
+	chainedAcceptor.RemoveAcceptor(rejectID)
+	if !chainedAcceptor.Accept(req) {
+		t.Fatalf("expected request to be accepted")
+	}
+}
@@ -1,6 +1,9 @@
 package chanacceptor
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 )
@@ -47,19 +50,51 @@ func (c *ChainedAcceptor) RemoveAcceptor(id uint64) {
 //
 // NOTE: Part of the ChannelAcceptor interface.
 func (c *ChainedAcceptor) Accept(req *ChannelAcceptRequest) bool {
-	result := true
+	result, _ := c.AcceptWithReason(req)
+	return result
+}
+
+// AcceptWithReason evaluates the results of all ChannelAcceptors in the
+// acceptors map and returns the conjunction of all these predicates. If the
+// request is rejected, the returned reason names the acceptors that rejected
+// it.
+//
+// NOTE: Part of the ReasonedChannelAcceptor interface.
+func (c *ChainedAcceptor) AcceptWithReason(req *ChannelAcceptRequest) (bool,
+	string) {
+
+	var rejectedBy []uint64
 
 	c.acceptorsMtx.RLock()
-	for _, acceptor := range c.acceptors {
-		// We call Accept first in case any acceptor (perhaps an RPCAcceptor)
-		// wishes to be notified about ChannelAcceptRequest.
-		result = acceptor.Accept(req) && result
+	acceptorTypes := make(map[uint64]string, len(c.acceptors))
+	for id, acceptor := range c.acceptors {
+		// We call Accept on every acceptor, as any acceptor (perhaps an
+		// RPCAcceptor) may wish to be notified about the
+		// ChannelAcceptRequest.
+		if !acceptor.Accept(req) {
+			rejectedBy = append(rejectedBy, id)
+			acceptorTypes[id] = fmt.Sprintf("%T", acceptor)
+		}
 	}
 	c.acceptorsMtx.RUnlock()
 
-	return result
+	if len(rejectedBy) == 0 {
+		return true, ""
+	}
+
+	sort.Slice(rejectedBy, func(i, j int) bool {
+		return rejectedBy[i] < rejectedBy[j]
+	})
+
+	rejections := make([]string, 0, len(rejectedBy))
+	for _, id := range rejectedBy {
+		rejections = append(rejections, fmt.Sprintf("acceptor %d (%v)",
+			id, acceptorTypes[id]))
+	}
+
+	return false, "rejected by " + strings.Join(rejections, ", ")
 }
 
 // A compile-time constraint to ensure ChainedAcceptor implements the
-// ChannelAcceptor interface.
-var _ ChannelAcceptor = (*ChainedAcceptor)(nil)
+// ReasonedChannelAcceptor interface.
+var _ ReasonedChannelAcceptor = (*ChainedAcceptor)(nil)
@@ -23,3 +23,13 @@ type ChannelAcceptRequest struct {
 type ChannelAcceptor interface {
 	Accept(req *ChannelAcceptRequest) bool
 }
+
+// ReasonedChannelAcceptor is a ChannelAcceptor that is also able to report why
+// a ChannelAcceptRequest was rejected.
+type ReasonedChannelAcceptor interface {
+	ChannelAcceptor
+
+	// AcceptWithReason evaluates the request like Accept, additionally
+	// returning a human readable reason if the request is rejected.
+	AcceptWithReason(req *ChannelAcceptRequest) (bool, string)
+}
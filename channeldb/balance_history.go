@@ -0,0 +1,158 @@
+package channeldb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwire"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// balanceHistoryBucket is a sub-bucket of a channel's bucket which
+	// stores a bounded number of snapshots of the channel's balances, taken
+	// each time the local commitment is updated. Snapshots are keyed by a
+	// monotonically increasing sequence number, such that iterating over
+	// the bucket yields them in chronological order.
+	//
+	// seqNum -> BalanceSnapshot
+	balanceHistoryBucket = []byte("balance-history-key")
+)
+
+// BalanceSnapshot records the balances of a channel at a particular
+// commitment height.
+type BalanceSnapshot struct {
+	// CommitHeight is the height of the local commitment the snapshot was
+	// taken at.
+	CommitHeight uint64
+
+	// LocalBalance is our balance at this commitment height.
+	LocalBalance lnwire.MilliAtom
+
+	// RemoteBalance is the remote party's balance at this commitment
+	// height.
+	RemoteBalance lnwire.MilliAtom
+
+	// Timestamp is the time at which the commitment was persisted.
+	Timestamp time.Time
+}
+
+// putBalanceSnapshot appends a snapshot of the balances of the given
+// commitment to the channel's balance history, discarding the oldest
+// snapshots so that at most maxSnapshots are retained.
+func putBalanceSnapshot(chanBucket *bolt.Bucket, commit *ChannelCommitment,
+	now time.Time, maxSnapshots int) error {
+
+	historyBucket, err := chanBucket.CreateBucketIfNotExists(
+		balanceHistoryBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	seqNum, err := historyBucket.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	err = WriteElements(
+		&b, commit.CommitHeight, commit.LocalBalance,
+		commit.RemoteBalance, uint64(now.UnixNano()),
+	)
+	if err != nil {
+		return err
+	}
+
+	var seqKey [8]byte
+	byteOrder.PutUint64(seqKey[:], seqNum)
+	if err := historyBucket.Put(seqKey[:], b.Bytes()); err != nil {
+		return err
+	}
+
+	// Now that the new snapshot is in place, prune everything but the
+	// latest maxSnapshots entries. We collect the keys first, as deleting
+	// while iterating with a cursor may skip entries.
+	if seqNum <= uint64(maxSnapshots) {
+		return nil
+	}
+	oldest := seqNum - uint64(maxSnapshots)
+
+	var staleKeys [][]byte
+	cursor := historyBucket.Cursor()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		if byteOrder.Uint64(k) > oldest {
+			break
+		}
+		staleKeys = append(staleKeys, k)
+	}
+	for _, k := range staleKeys {
+		if err := historyBucket.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BalanceHistory returns up to maxPoints of the most recent balance
+// snapshots recorded for the channel, in chronological order. If maxPoints is
+// zero or negative, all retained snapshots are returned. Snapshots are only
+// recorded if the database was opened with a non-zero
+// OptionSetBalanceHistorySize.
+func (c *OpenChannel) BalanceHistory(maxPoints int) ([]BalanceSnapshot, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	var snapshots []BalanceSnapshot
+	err := c.Db.View(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		historyBucket := chanBucket.Bucket(balanceHistoryBucket)
+		if historyBucket == nil {
+			return nil
+		}
+
+		// We walk backwards from the latest snapshot so we only need
+		// to read the ones we'll return.
+		cursor := historyBucket.Cursor()
+		for k, v := cursor.Last(); k != nil; k, v = cursor.Prev() {
+			if maxPoints > 0 && len(snapshots) >= maxPoints {
+				break
+			}
+
+			var (
+				snapshot  BalanceSnapshot
+				timestamp uint64
+			)
+			err := ReadElements(
+				bytes.NewReader(v), &snapshot.CommitHeight,
+				&snapshot.LocalBalance, &snapshot.RemoteBalance,
+				&timestamp,
+			)
+			if err != nil {
+				return err
+			}
+			snapshot.Timestamp = time.Unix(0, int64(timestamp))
+
+			snapshots = append(snapshots, snapshot)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Reverse the snapshots so they're returned oldest first.
+	for i, j := 0, len(snapshots)-1; i < j; i, j = i+1, j-1 {
+		snapshots[i], snapshots[j] = snapshots[j], snapshots[i]
+	}
+
+	return snapshots, nil
+}
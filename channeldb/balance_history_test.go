@@ -0,0 +1,148 @@
+package channeldb
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// TestBalanceHistory asserts that balance snapshots are only recorded when
+// enabled, that only the configured number of snapshots is retained, and that
+// they survive reopening the database.
+func TestBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	const historySize = 3
+	cdb, err := Open(
+		tempDirName, OptionSetBalanceHistorySize(historySize),
+	)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+
+	testNow := time.Unix(1500000000, 0)
+	cdb.now = func() time.Time {
+		return testNow
+	}
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	if err := channel.SyncPending(addr, 101); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// Update the commitment a few more times than the number of snapshots
+	// we retain, shifting some balance over to the remote party each
+	// time.
+	const numUpdates = 5
+	for i := uint64(1); i <= numUpdates; i++ {
+		commitment := channel.LocalCommitment
+		commitment.CommitHeight = i
+		commitment.LocalBalance -= 1000
+		commitment.RemoteBalance += 1000
+
+		testNow = testNow.Add(time.Minute)
+		if err := channel.UpdateCommitment(&commitment); err != nil {
+			t.Fatalf("unable to update commitment: %v", err)
+		}
+	}
+	finalCommitment := channel.LocalCommitment
+
+	// Close and reopen the database, then fetch the channel anew to make
+	// sure the snapshots were persisted.
+	if err := cdb.Close(); err != nil {
+		t.Fatalf("unable to close db: %v", err)
+	}
+	cdb, err = Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to reopen db: %v", err)
+	}
+	defer cdb.Close()
+
+	channels, err := cdb.FetchOpenChannels(channel.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	if len(channels) != 1 {
+		t.Fatalf("expected 1 channel, got %v", len(channels))
+	}
+
+	history, err := channels[0].BalanceHistory(0)
+	if err != nil {
+		t.Fatalf("unable to fetch balance history: %v", err)
+	}
+	if len(history) != historySize {
+		t.Fatalf("expected %v snapshots, got %v", historySize,
+			len(history))
+	}
+
+	// Only the latest snapshots should have been retained, oldest first.
+	for i, snapshot := range history {
+		commitHeight := uint64(numUpdates - historySize + 1 + i)
+		if snapshot.CommitHeight != commitHeight {
+			t.Fatalf("snapshot %d: expected commit height %v, "+
+				"got %v", i, commitHeight, snapshot.CommitHeight)
+		}
+
+		stepsBack := lnwire.MilliAtom(numUpdates - commitHeight)
+		localBalance := finalCommitment.LocalBalance + stepsBack*1000
+		if snapshot.LocalBalance != localBalance {
+			t.Fatalf("snapshot %d: expected local balance %v, "+
+				"got %v", i, localBalance, snapshot.LocalBalance)
+		}
+		remoteBalance := finalCommitment.RemoteBalance - stepsBack*1000
+		if snapshot.RemoteBalance != remoteBalance {
+			t.Fatalf("snapshot %d: expected remote balance %v, "+
+				"got %v", i, remoteBalance, snapshot.RemoteBalance)
+		}
+
+		timestamp := time.Unix(1500000000, 0).Add(
+			time.Duration(commitHeight) * time.Minute,
+		)
+		if !snapshot.Timestamp.Equal(timestamp) {
+			t.Fatalf("snapshot %d: expected timestamp %v, got %v",
+				i, timestamp, snapshot.Timestamp)
+		}
+	}
+
+	// Limiting the number of points should return the most recent ones.
+	latest, err := channels[0].BalanceHistory(1)
+	if err != nil {
+		t.Fatalf("unable to fetch balance history: %v", err)
+	}
+	if len(latest) != 1 || latest[0].CommitHeight != numUpdates {
+		t.Fatalf("expected only the latest snapshot, got %v", latest)
+	}
+
+	// The database was reopened without balance history enabled, so
+	// further updates shouldn't record any new snapshots.
+	commitment := channels[0].LocalCommitment
+	commitment.CommitHeight++
+	if err := channels[0].UpdateCommitment(&commitment); err != nil {
+		t.Fatalf("unable to update commitment: %v", err)
+	}
+	history, err = channels[0].BalanceHistory(0)
+	if err != nil {
+		t.Fatalf("unable to fetch balance history: %v", err)
+	}
+	if history[len(history)-1].CommitHeight != numUpdates {
+		t.Fatalf("expected no new snapshot while disabled")
+	}
+}
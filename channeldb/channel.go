@@ -27,6 +27,14 @@ var (
 	// previously open, but now closed channels.
 	closedChannelBucket = []byte("closed-chan-bucket")
 
+	// closedChanCommitsBucket stores a snapshot of the latest commitments
+	// of channels that are pending close, taken at the time the channel
+	// was closed. The snapshot is removed once the channel is marked as
+	// fully closed.
+	//
+	// closedChanCommits -> chanPoint -> (chanCommitmentKey|commitDiffKey)
+	closedChanCommitsBucket = []byte("closed-chan-commits-bucket")
+
 	// openChanBucket stores all the currently open channels. This bucket
 	// has a second, nested bucket which is keyed by a node's ID. Within
 	// that node ID bucket, all attributes required to track, update, and
@@ -37,6 +45,13 @@ var (
 	// TODO(roasbeef): flesh out comment
 	openChannelBucket = []byte("open-chan-bucket")
 
+	// chanShortIDIndexBucket indexes all fully open channels by their
+	// short channel ID, pointing to the location of the channel's state
+	// within the openChannelBucket.
+	//
+	// shortChanID -> nodeID || chainHash || chanPoint
+	chanShortIDIndexBucket = []byte("chan-short-id-index")
+
 	// chanInfoKey can be accessed within the bucket for a channel
 	// (identified by its chanPoint). This key stores all the static
 	// information for a channel which is decided at the end of  the
@@ -59,10 +74,20 @@ var (
 	// remote peer during a channel sync in case we have lost channel state.
 	dataLossCommitPointKey = []byte("data-loss-commit-point-key")
 
+	// minCommitFeePerKBKey stores the minimum fee rate, in atoms/kB, that
+	// any new local commitment of the channel must pay. If absent, no
+	// floor is enforced.
+	minCommitFeePerKBKey = []byte("min-commit-fee-per-kb-key")
+
 	// closingTxKey points to a the closing tx that we broadcasted when
 	// moving the channel to state CommitBroadcasted.
 	closingTxKey = []byte("closing-tx-key")
 
+	// closingTxHeightKey points to the block height at which the closing
+	// tx stored under closingTxKey was broadcast. Channels marked as
+	// broadcast before this key was introduced won't have it set.
+	closingTxHeightKey = []byte("closing-tx-height-key")
+
 	// commitDiffKey stores the current pending commitment state we've
 	// extended to the remote party (if any). Each time we propose a new
 	// state, we store the information necessary to reconstruct this state
@@ -664,6 +689,99 @@ func fetchChanBucket(tx *bolt.Tx, nodeKey *secp256k1.PublicKey,
 	return chanBucket, nil
 }
 
+// putChanShortIDIndex adds an entry for the channel to the short channel ID
+// index, pointing from its current short channel ID to the location of its
+// state.
+func putChanShortIDIndex(tx *bolt.Tx, c *OpenChannel) error {
+	indexBucket, err := tx.CreateBucketIfNotExists(chanShortIDIndexBucket)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if _, err := b.Write(c.IdentityPub.SerializeCompressed()); err != nil {
+		return err
+	}
+	if _, err := b.Write(c.ChainHash[:]); err != nil {
+		return err
+	}
+	if err := writeOutpoint(&b, &c.FundingOutpoint); err != nil {
+		return err
+	}
+
+	var sidBytes [8]byte
+	byteOrder.PutUint64(sidBytes[:], c.ShortChannelID.ToUint64())
+
+	return indexBucket.Put(sidBytes[:], b.Bytes())
+}
+
+// delChanShortIDIndex removes the entry for the given short channel ID from
+// the short channel ID index, if it exists.
+func delChanShortIDIndex(tx *bolt.Tx, sid lnwire.ShortChannelID) error {
+	indexBucket := tx.Bucket(chanShortIDIndexBucket)
+	if indexBucket == nil {
+		return nil
+	}
+
+	var sidBytes [8]byte
+	byteOrder.PutUint64(sidBytes[:], sid.ToUint64())
+
+	return indexBucket.Delete(sidBytes[:])
+}
+
+// fetchChanBucketByShortID looks up the channel with the given short channel
+// ID within the short channel ID index, and returns the bucket where its data
+// resides along with its funding outpoint. ErrChannelNotFound is returned if
+// no such channel is currently open.
+func fetchChanBucketByShortID(tx *bolt.Tx, sid lnwire.ShortChannelID) (
+	*bolt.Bucket, *wire.OutPoint, error) {
+
+	indexBucket := tx.Bucket(chanShortIDIndexBucket)
+	if indexBucket == nil {
+		return nil, nil, ErrChannelNotFound
+	}
+
+	var sidBytes [8]byte
+	byteOrder.PutUint64(sidBytes[:], sid.ToUint64())
+
+	chanLoc := indexBucket.Get(sidBytes[:])
+	if chanLoc == nil {
+		return nil, nil, ErrChannelNotFound
+	}
+
+	r := bytes.NewReader(chanLoc)
+
+	var nodePub [33]byte
+	if _, err := io.ReadFull(r, nodePub[:]); err != nil {
+		return nil, nil, err
+	}
+	nodeKey, err := secp256k1.ParsePubKey(nodePub[:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var chainHash chainhash.Hash
+	if _, err := io.ReadFull(r, chainHash[:]); err != nil {
+		return nil, nil, err
+	}
+
+	var chanPoint wire.OutPoint
+	if err := readOutpoint(r, &chanPoint); err != nil {
+		return nil, nil, err
+	}
+
+	chanBucket, err := fetchChanBucket(tx, nodeKey, &chanPoint, chainHash)
+	switch err {
+	case nil:
+	case ErrNoActiveChannels, ErrChannelNotFound:
+		return nil, nil, ErrChannelNotFound
+	default:
+		return nil, nil, err
+	}
+
+	return chanBucket, &chanPoint, nil
+}
+
 // fullSync syncs the contents of an OpenChannel while re-using an existing
 // database transaction.
 func (c *OpenChannel) fullSync(tx *bolt.Tx) error {
@@ -729,10 +847,23 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 			return err
 		}
 
+		// If the channel was already open under a different short
+		// channel ID, we'll remove its stale index entry first.
+		if !channel.IsPending {
+			err := delChanShortIDIndex(tx, channel.ShortChannelID)
+			if err != nil {
+				return err
+			}
+		}
+
 		channel.IsPending = false
 		channel.ShortChannelID = openLoc
 
-		return putOpenChannel(chanBucket, channel)
+		if err := putOpenChannel(chanBucket, channel); err != nil {
+			return err
+		}
+
+		return putChanShortIDIndex(tx, channel)
 	}); err != nil {
 		return err
 	}
@@ -798,6 +929,100 @@ func (c *OpenChannel) DataLossCommitPoint() (*secp256k1.PublicKey, error) {
 	return commitPoint, nil
 }
 
+// ErrCommitFeeBelowMin is returned by UpdateCommitment when the fee rate of
+// the new commitment is below the minimum configured for the channel through
+// SetMinCommitFeePerKB.
+type ErrCommitFeeBelowMin struct {
+	// FeePerKB is the fee rate of the rejected commitment.
+	FeePerKB dcrutil.Amount
+
+	// MinFeePerKB is the minimum fee rate configured for the channel.
+	MinFeePerKB dcrutil.Amount
+}
+
+// Error returns a human readable description of the error.
+func (e ErrCommitFeeBelowMin) Error() string {
+	return fmt.Sprintf("commitment fee rate of %v atoms/kB is below the "+
+		"channel's minimum of %v atoms/kB", int64(e.FeePerKB),
+		int64(e.MinFeePerKB))
+}
+
+// SetMinCommitFeePerKB sets the minimum fee rate, in atoms/kB, that any
+// subsequent local commitment of the channel must pay in order to be accepted
+// by UpdateCommitment. This allows keeping the commitment confirmable
+// regardless of the fee rate negotiated with the remote party. Setting a zero
+// fee rate removes the floor.
+func (c *OpenChannel) SetMinCommitFeePerKB(minFeePerKB dcrutil.Amount) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if minFeePerKB < 0 {
+		return fmt.Errorf("invalid minimum commitment fee rate: %v",
+			int64(minFeePerKB))
+	}
+
+	return c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if minFeePerKB == 0 {
+			return chanBucket.Delete(minCommitFeePerKBKey)
+		}
+
+		var b bytes.Buffer
+		if err := WriteElement(&b, minFeePerKB); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(minCommitFeePerKBKey, b.Bytes())
+	})
+}
+
+// MinCommitFeePerKB returns the minimum commitment fee rate set for the
+// channel through SetMinCommitFeePerKB, or zero if none is set.
+func (c *OpenChannel) MinCommitFeePerKB() (dcrutil.Amount, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	var minFeePerKB dcrutil.Amount
+	err := c.Db.View(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		minFeePerKB, err = fetchMinCommitFeePerKB(chanBucket)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return minFeePerKB, nil
+}
+
+// fetchMinCommitFeePerKB reads the minimum commitment fee rate stored within
+// the channel's bucket, returning zero if none is stored.
+func fetchMinCommitFeePerKB(chanBucket *bolt.Bucket) (dcrutil.Amount, error) {
+	bs := chanBucket.Get(minCommitFeePerKBKey)
+	if bs == nil {
+		return 0, nil
+	}
+
+	var minFeePerKB dcrutil.Amount
+	if err := ReadElement(bytes.NewReader(bs), &minFeePerKB); err != nil {
+		return 0, err
+	}
+
+	return minFeePerKB, nil
+}
+
 // MarkBorked marks the event when the channel as reached an irreconcilable
 // state, such as a channel breach or state desynchronization. Borked channels
 // should never be added to the switch.
@@ -928,6 +1153,35 @@ func (c *OpenChannel) MarkCommitmentBroadcasted(closeTx *wire.MsgTx) error {
 	return c.putChanStatus(ChanStatusCommitBroadcasted, putClosingTx)
 }
 
+// MarkCommitmentBroadcastedAtHeight is identical to MarkCommitmentBroadcasted,
+// but additionally records the block height at which the closing tx was
+// broadcast, such that it can later be retrieved using BroadcastHeight.
+func (c *OpenChannel) MarkCommitmentBroadcastedAtHeight(closeTx *wire.MsgTx,
+	height uint32) error {
+
+	c.Lock()
+	defer c.Unlock()
+
+	var b bytes.Buffer
+	if err := WriteElement(&b, closeTx); err != nil {
+		return err
+	}
+
+	var heightBytes [4]byte
+	byteOrder.PutUint32(heightBytes[:], height)
+
+	putClosingTx := func(chanBucket *bolt.Bucket) error {
+		err := chanBucket.Put(closingTxKey, b.Bytes())
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Put(closingTxHeightKey, heightBytes[:])
+	}
+
+	return c.putChanStatus(ChanStatusCommitBroadcasted, putClosingTx)
+}
+
 // BroadcastedCommitment retrieves the stored closing tx set during
 // MarkCommitmentBroadcasted. If not found ErrNoCloseTx is returned.
 func (c *OpenChannel) BroadcastedCommitment() (*wire.MsgTx, error) {
@@ -959,6 +1213,48 @@ func (c *OpenChannel) BroadcastedCommitment() (*wire.MsgTx, error) {
 	return closeTx, nil
 }
 
+// BroadcastHeight retrieves the block height at which the closing tx returned
+// by BroadcastedCommitment was broadcast. If the closing tx was stored without
+// a height, e.g. by MarkCommitmentBroadcasted or by an older version, zero is
+// returned. If no closing tx was stored at all, ErrNoCloseTx is returned.
+func (c *OpenChannel) BroadcastHeight() (uint32, error) {
+	var height uint32
+
+	err := c.Db.View(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch err {
+		case nil:
+		case ErrNoChanDBExists, ErrNoActiveChannels, ErrChannelNotFound:
+			return ErrNoCloseTx
+		default:
+			return err
+		}
+
+		if chanBucket.Get(closingTxKey) == nil {
+			return ErrNoCloseTx
+		}
+
+		heightBytes := chanBucket.Get(closingTxHeightKey)
+		if heightBytes == nil {
+			return nil
+		}
+		if len(heightBytes) != 4 {
+			return fmt.Errorf("invalid closing tx height length: "+
+				"%v", len(heightBytes))
+		}
+		height = byteOrder.Uint32(heightBytes)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
+
 // putChanStatus appends the given status to the channel. fs is an optional
 // list of closures that are given the chanBucket in order to atomically add
 // extra information together with the new status.
@@ -1156,7 +1452,17 @@ func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment) error {
 		return ErrNoRestoredChannelMutation
 	}
 
-	err := c.Db.Update(func(tx *bolt.Tx) error {
+	// If enabled, the update is batched with concurrent updates of other
+	// channels. As we hold the channel's lock until the batch has been
+	// committed, updates of a single channel are still written in order.
+	// Note that the closure below may be executed more than once if the
+	// batch fails, in which case it is retried on its own.
+	update := c.Db.Update
+	if c.Db.commitBatcher != nil {
+		update = c.Db.commitBatcher.Update
+	}
+
+	err := update(func(tx *bolt.Tx) error {
 		chanBucket, err := fetchChanBucket(
 			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
 		)
@@ -1174,6 +1480,19 @@ func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment) error {
 			return ErrChanBorked
 		}
 
+		// Refuse to commit to a state paying less than the minimum fee
+		// rate configured for this channel, if any.
+		minFeePerKB, err := fetchMinCommitFeePerKB(chanBucket)
+		if err != nil {
+			return err
+		}
+		if newCommitment.FeePerKB < minFeePerKB {
+			return ErrCommitFeeBelowMin{
+				FeePerKB:    newCommitment.FeePerKB,
+				MinFeePerKB: minFeePerKB,
+			}
+		}
+
 		if err = putChanInfo(chanBucket, c); err != nil {
 			return fmt.Errorf("unable to store chan info: %v", err)
 		}
@@ -1188,6 +1507,19 @@ func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment) error {
 				"revocations: %v", err)
 		}
 
+		// If balance history is enabled, record a snapshot of the new
+		// balances as well.
+		if c.Db.balanceHistorySize > 0 {
+			err = putBalanceSnapshot(
+				chanBucket, newCommitment, c.Db.now(),
+				c.Db.balanceHistorySize,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to store balance "+
+					"snapshot: %v", err)
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -1405,11 +1737,60 @@ func (k *CircuitKey) Decode(r io.Reader) error {
 	return nil
 }
 
-// String returns a string representation of the CircuitKey.
+// String returns a string representation of the CircuitKey. It can be turned
+// back into a CircuitKey using ParseCircuitKey.
 func (k CircuitKey) String() string {
 	return fmt.Sprintf("(Chan ID=%s, HTLC ID=%d)", k.ChanID, k.HtlcID)
 }
 
+// Equal returns true if the other CircuitKey refers to the same htlc.
+func (k CircuitKey) Equal(other CircuitKey) bool {
+	return k == other
+}
+
+// ParseCircuitKey parses a CircuitKey from its string representation, as
+// returned by CircuitKey.String.
+func ParseCircuitKey(s string) (CircuitKey, error) {
+	var (
+		blockHeight, txIndex uint32
+		txPosition           uint16
+		htlcID               uint64
+	)
+	_, err := fmt.Sscanf(
+		s, "(Chan ID=%d:%d:%d, HTLC ID=%d)", &blockHeight, &txIndex,
+		&txPosition, &htlcID,
+	)
+	if err != nil {
+		return CircuitKey{}, fmt.Errorf("invalid circuit key %q: %v",
+			s, err)
+	}
+
+	// The block height and tx index of a short channel id are limited
+	// to 3 bytes each.
+	if blockHeight > 0xFFFFFF || txIndex > 0xFFFFFF {
+		return CircuitKey{}, fmt.Errorf("invalid circuit key %q: "+
+			"short channel id out of range", s)
+	}
+
+	key := CircuitKey{
+		ChanID: lnwire.ShortChannelID{
+			BlockHeight: blockHeight,
+			TxIndex:     txIndex,
+			TxPosition:  txPosition,
+		},
+		HtlcID: htlcID,
+	}
+
+	// Only accept the canonical form, which also rejects any trailing
+	// data that isn't consumed by the scan.
+	if key.String() != s {
+		return CircuitKey{}, fmt.Errorf("invalid circuit key %q: not "+
+			"in canonical form", s)
+	}
+
+	return key, nil
+}
+
 // CommitDiff represents the delta needed to apply the state transition between
 // two subsequent commitment states. Given state N and state N+1, one is able
 // to apply the set of messages contained within the CommitDiff to N to arrive
@@ -2043,6 +2424,211 @@ func (c *OpenChannel) FindPreviousState(updateNum uint64) (*ChannelCommitment, e
 	return &commit, nil
 }
 
+// ErrPreviousStateNotFound is returned when a past state of a channel can't be
+// found in its revocation log, for example because it was already pruned.
+type ErrPreviousStateNotFound struct {
+	// Height is the commitment height of the missing state.
+	Height uint64
+}
+
+// Error returns a human readable description of the error.
+func (e ErrPreviousStateNotFound) Error() string {
+	return fmt.Sprintf("previous state at height %v not found in "+
+		"revocation log", e.Height)
+}
+
+// FindPreviousStates returns the past states of the channel with commitment
+// heights within the inclusive range [from, to], in ascending order. All of
+// them are read from the revocation log within a single transaction. If any
+// state in the range is missing, ErrPreviousStateNotFound is returned.
+func (c *OpenChannel) FindPreviousStates(from,
+	to uint64) ([]*ChannelCommitment, error) {
+
+	if from > to {
+		return nil, fmt.Errorf("invalid range: from height %v is "+
+			"above to height %v", from, to)
+	}
+
+	c.RLock()
+	defer c.RUnlock()
+
+	var commits []*ChannelCommitment
+	err := c.Db.View(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		logBucket := chanBucket.Bucket(revocationLogBucket)
+		if logBucket == nil {
+			return ErrNoPastDeltas
+		}
+
+		// As the log is keyed by the big-endian commit height, the
+		// states of the range are stored contiguously, so we can read
+		// them with a single cursor scan.
+		fromKey := makeLogKey(from)
+		cursor := logBucket.Cursor()
+		k, v := cursor.Seek(fromKey[:])
+		for height := from; ; height++ {
+			key := makeLogKey(height)
+			if k == nil || !bytes.Equal(k, key[:]) {
+				return ErrPreviousStateNotFound{Height: height}
+			}
+
+			commit, err := deserializeChanCommit(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+			commits = append(commits, &commit)
+
+			// Checking the end of the range here prevents the
+			// height from overflowing if to is the max height.
+			if height == to {
+				return nil
+			}
+
+			k, v = cursor.Next()
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
+// MinRevocationLogDepth is the minimum number of the most recent revoked
+// states that must be retained in full when pruning the revocation log of a
+// channel.
+const MinRevocationLogDepth = 1000
+
+// PruneRevocationLog compacts all entries of the revocation log of the
+// channel, except for the keepDepth most recently revoked states. Compacted
+// entries retain everything needed to penalize a breach of their state: the
+// commitment height and transaction, both balances, the fee rate and the
+// outputs of all HTLCs. Only the signatures and onion blobs, which make up the
+// bulk of an entry, are dropped. The number of compacted entries is returned.
+func (c *OpenChannel) PruneRevocationLog(keepDepth uint64) (uint64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if keepDepth < MinRevocationLogDepth {
+		return 0, ErrRevocationLogDepthTooLow
+	}
+
+	// A restored channel doesn't have a revocation log to prune, and we
+	// refuse to mutate its state in any way.
+	if c.hasChanStatus(ChanStatusRestored) {
+		return 0, ErrNoRestoredChannelMutation
+	}
+
+	// If the channel may be closing, any of its prior states may end up
+	// on chain, so we leave the log alone.
+	if c.hasChanStatus(ChanStatusCommitBroadcasted) ||
+		c.hasChanStatus(ChanStatusLocalDataLoss) {
+
+		return 0, ErrRevocationLogPruneClosing
+	}
+
+	// The log holds the states below the current remote commitment
+	// height. If there are no more than keepDepth of them, there's
+	// nothing to prune.
+	tipHeight := c.RemoteCommitment.CommitHeight
+	if tipHeight <= keepDepth {
+		return 0, nil
+	}
+	pruneHeight := tipHeight - keepDepth
+
+	var numPruned uint64
+	err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		// If the channel is marked as borked, then for safety reasons,
+		// we shouldn't attempt any further updates.
+		isBorked, err := c.isBorked(chanBucket)
+		if err != nil {
+			return err
+		}
+		if isBorked {
+			return ErrChanBorked
+		}
+
+		logBucket := chanBucket.Bucket(revocationLogBucket)
+		if logBucket == nil {
+			return nil
+		}
+
+		// As the log is keyed by the big-endian commit height, the
+		// entries to compact are all found at the start of the bucket.
+		// We collect them first, as modifying the bucket while
+		// iterating may cause the cursor to skip entries.
+		pruneKey := makeLogKey(pruneHeight)
+		var commits []ChannelCommitment
+		cursor := logBucket.Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			if bytes.Compare(k, pruneKey[:]) >= 0 {
+				break
+			}
+
+			r := bytes.NewReader(v)
+			commit, err := deserializeChanCommit(r)
+			if err != nil {
+				return err
+			}
+
+			// Entries compacted by a prior call are skipped.
+			if !compactChanCommit(&commit) {
+				continue
+			}
+			commits = append(commits, commit)
+		}
+
+		for i := range commits {
+			err := appendChannelLogEntry(logBucket, &commits[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		numPruned = uint64(len(commits))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numPruned, nil
+}
+
+// compactChanCommit drops the signatures and onion blobs of a revoked
+// commitment, as they aren't needed to penalize a breach of it. It returns
+// whether anything was dropped.
+func compactChanCommit(commit *ChannelCommitment) bool {
+	compacted := len(commit.CommitSig) > 0
+	commit.CommitSig = nil
+
+	for i := range commit.Htlcs {
+		htlc := &commit.Htlcs[i]
+		if len(htlc.Signature) > 0 || len(htlc.OnionBlob) > 0 {
+			compacted = true
+		}
+		htlc.Signature = nil
+		htlc.OnionBlob = nil
+	}
+
+	return compacted
+}
+
 // ClosureType is an enum like structure that details exactly _how_ a channel
 // was closed. Three closure types are currently possible: none, cooperative,
 // local force close, remote force close, and (remote) breach.
@@ -2079,6 +2665,57 @@ const (
 	Abandoned ClosureType = 5
 )
 
+// CloseCause is an enum that details _why_ we decided to force close a
+// channel.
+type CloseCause uint8
+
+const (
+	// CloseCauseUnknown indicates that the reason for the closure wasn't
+	// recorded. This is the case for any channel that wasn't force closed
+	// by us, or was closed before causes were recorded.
+	CloseCauseUnknown CloseCause = 0
+
+	// CloseCauseUserRequest indicates that the channel was force closed
+	// due to an explicit request by the user.
+	CloseCauseUserRequest CloseCause = 1
+
+	// CloseCauseLinkFailure indicates that the channel was force closed
+	// because the link with the remote peer failed in an unrecoverable
+	// manner.
+	CloseCauseLinkFailure CloseCause = 2
+
+	// CloseCauseHtlcTimeout indicates that we went to chain because an
+	// outgoing HTLC was about to expire.
+	CloseCauseHtlcTimeout CloseCause = 3
+
+	// CloseCauseHtlcClaim indicates that we went to chain in order to
+	// claim an incoming HTLC we know the preimage for before it expires.
+	CloseCauseHtlcClaim CloseCause = 4
+)
+
+// String returns a human readable string describing the close cause.
+func (c CloseCause) String() string {
+	switch c {
+	case CloseCauseUnknown:
+		return "unknown"
+
+	case CloseCauseUserRequest:
+		return "user-request"
+
+	case CloseCauseLinkFailure:
+		return "link-failure"
+
+	case CloseCauseHtlcTimeout:
+		return "htlc-timeout"
+
+	case CloseCauseHtlcClaim:
+		return "htlc-claim"
+
+	default:
+		return fmt.Sprintf("<unknown close cause %d>", uint8(c))
+	}
+}
+
 // ChannelCloseSummary contains the final state of a channel at the point it
 // was closed. Once a channel is closed, all the information pertaining to that
 // channel within the openChannelBucket is deleted, and a compact summary is
@@ -2158,6 +2795,10 @@ type ChannelCloseSummary struct {
 	// LastChanSyncMsg is the ChannelReestablish message for this channel
 	// for the state at the point where it was closed.
 	LastChanSyncMsg *lnwire.ChannelReestablish
+
+	// CloseCause details why we decided to force close the channel. This
+	// is only set for channels we force closed ourselves.
+	CloseCause CloseCause
 }
 
 // CloseChannel closes a previously active Lightning channel. Closing a channel
@@ -2206,6 +2847,25 @@ func (c *OpenChannel) CloseChannel(summary *ChannelCloseSummary) error {
 			return err
 		}
 
+		// Remove the channel from the short channel ID index, as it
+		// will no longer be open.
+		if !chanState.IsPending {
+			err = delChanShortIDIndex(tx, chanState.ShortChannelID)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Snapshot the latest commitments before they're deleted, as
+		// they're needed to resolve the contracts of the channel in
+		// case its confirmed commit set was never logged.
+		err = putClosedChanCommitments(
+			tx, chanPointBuf.Bytes(), chanBucket,
+		)
+		if err != nil {
+			return err
+		}
+
 		// Now that the index to this channel has been deleted, purge
 		// the remaining channel metadata from the database.
 		err = deleteOpenChannel(chanBucket, chanPointBuf.Bytes())
@@ -2348,6 +3008,106 @@ func (c *OpenChannel) RemoteRevocationStore() (shachain.Store, error) {
 	return c.RevocationStore, nil
 }
 
+// ClosedChannelCommitments is a snapshot of the latest commitments of a
+// channel, taken at the time the channel was closed.
+type ClosedChannelCommitments struct {
+	// LocalCommitment is the latest local commitment of the channel.
+	LocalCommitment ChannelCommitment
+
+	// RemoteCommitment is the latest remote commitment of the channel.
+	RemoteCommitment ChannelCommitment
+
+	// RemotePendingCommitment is the remote commitment we had extended,
+	// but that wasn't revoked yet. This is nil if there was none.
+	RemotePendingCommitment *ChannelCommitment
+}
+
+// putClosedChanCommitments copies the latest commitments stored within the
+// bucket of a channel that is being closed into the closed channel
+// commitments bucket.
+func putClosedChanCommitments(tx *bolt.Tx, chanID []byte,
+	chanBucket *bolt.Bucket) error {
+
+	localKey := append(chanCommitmentKey, byte(0x00))
+	remoteKey := append(chanCommitmentKey, byte(0x01))
+
+	// Restored channels don't have any commitments to store.
+	localCommit := chanBucket.Get(localKey)
+	remoteCommit := chanBucket.Get(remoteKey)
+	if localCommit == nil || remoteCommit == nil {
+		return nil
+	}
+
+	closedCommitsBucket, err := tx.CreateBucketIfNotExists(
+		closedChanCommitsBucket,
+	)
+	if err != nil {
+		return err
+	}
+	commitsBucket, err := closedCommitsBucket.CreateBucketIfNotExists(
+		chanID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := commitsBucket.Put(localKey, localCommit); err != nil {
+		return err
+	}
+	if err := commitsBucket.Put(remoteKey, remoteCommit); err != nil {
+		return err
+	}
+
+	if diff := chanBucket.Get(commitDiffKey); diff != nil {
+		return commitsBucket.Put(commitDiffKey, diff)
+	}
+
+	return nil
+}
+
+// fetchClosedChanCommitments reads the snapshot of the latest commitments of
+// a closed channel.
+func fetchClosedChanCommitments(tx *bolt.Tx,
+	chanID []byte) (*ClosedChannelCommitments, error) {
+
+	closedCommitsBucket := tx.Bucket(closedChanCommitsBucket)
+	if closedCommitsBucket == nil {
+		return nil, ErrNoCommitmentsFound
+	}
+	commitsBucket := closedCommitsBucket.Bucket(chanID)
+	if commitsBucket == nil {
+		return nil, ErrNoCommitmentsFound
+	}
+
+	var (
+		commits ClosedChannelCommitments
+		err     error
+	)
+	commits.LocalCommitment, err = fetchChanCommitment(commitsBucket, true)
+	if err != nil {
+		return nil, err
+	}
+	commits.RemoteCommitment, err = fetchChanCommitment(
+		commitsBucket, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tipBytes := commitsBucket.Get(commitDiffKey)
+	if tipBytes == nil {
+		return &commits, nil
+	}
+
+	diff, err := deserializeCommitDiff(bytes.NewReader(tipBytes))
+	if err != nil {
+		return nil, err
+	}
+	commits.RemotePendingCommitment = &diff.Commitment
+
+	return &commits, nil
+}
+
 func putChannelCloseSummary(tx *bolt.Tx, chanID []byte,
 	summary *ChannelCloseSummary, lastChanState *OpenChannel) error {
 
@@ -2379,9 +3139,9 @@ func serializeChannelCloseSummary(w io.Writer, cs *ChannelCloseSummary) error {
 	}
 
 	// If this is a close channel summary created before the addition of
-	// the new fields, then we can exit here.
+	// the new fields, then we can exit here after writing the close cause.
 	if cs.RemoteCurrentRevocation == nil {
-		return WriteElements(w, false)
+		return WriteElements(w, false, cs.CloseCause)
 	}
 
 	// If fields are present, write boolean to indicate this, and continue.
@@ -2424,7 +3184,8 @@ func serializeChannelCloseSummary(w io.Writer, cs *ChannelCloseSummary) error {
 		}
 	}
 
-	return nil
+	// Finally, write the close cause.
+	return WriteElements(w, cs.CloseCause)
 }
 
 func deserializeCloseChannelSummary(r io.Reader) (*ChannelCloseSummary, error) {
@@ -2447,9 +3208,10 @@ func deserializeCloseChannelSummary(r io.Reader) (*ChannelCloseSummary, error) {
 		return nil, err
 	}
 
-	// If fields are not present, we can return.
+	// If fields are not present, we'll only attempt to read the close
+	// cause, which may not be present for older summaries.
 	if !hasNewFields {
-		return c, nil
+		return c, readCloseCause(r, c)
 	}
 
 	// Otherwise read the new fields.
@@ -2505,7 +3267,19 @@ func deserializeCloseChannelSummary(r io.Reader) (*ChannelCloseSummary, error) {
 		c.LastChanSyncMsg = chanSync
 	}
 
-	return c, nil
+	return c, readCloseCause(r, c)
+}
+
+// readCloseCause reads the optional close cause trailing a serialized close
+// summary. Summaries written before the close cause was added end before it,
+// in which case the cause is left unknown.
+func readCloseCause(r io.Reader, c *ChannelCloseSummary) error {
+	err := ReadElements(r, &c.CloseCause)
+	if err == io.EOF {
+		return nil
+	}
+
+	return err
 }
 
 func writeChanConfig(b io.Writer, c *ChannelConfig) error {
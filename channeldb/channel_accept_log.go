@@ -0,0 +1,148 @@
+package channeldb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// channelAcceptLogBucket is a top-level bucket which stores a record
+	// of each decision made on an inbound channel open request. Records
+	// are keyed by a monotonically increasing sequence number, such that
+	// iterating over the bucket yields them in chronological order.
+	//
+	// seqNum -> ChannelAcceptDecision
+	channelAcceptLogBucket = []byte("channel-accept-log")
+)
+
+// ChannelAcceptDecision records whether an inbound channel open request was
+// accepted, and why.
+type ChannelAcceptDecision struct {
+	// Timestamp is the time at which the decision was logged.
+	Timestamp time.Time
+
+	// NodeKey is the public key of the node that requested the channel.
+	NodeKey *secp256k1.PublicKey
+
+	// PendingChanID is the pending channel ID of the request.
+	PendingChanID [32]byte
+
+	// Accepted is true if the channel open request was accepted.
+	Accepted bool
+
+	// Reason is a human readable description of why the request was
+	// rejected. It's empty for accepted requests.
+	Reason string
+}
+
+// LogChannelAcceptDecision appends the given decision to the channel accept
+// log. The timestamp of the decision is set to the current time. If the log
+// holds more than the configured number of decisions afterwards, the oldest
+// ones are pruned.
+func (d *DB) LogChannelAcceptDecision(decision *ChannelAcceptDecision) error {
+	decision.Timestamp = d.now()
+
+	var b bytes.Buffer
+	err := WriteElements(
+		&b, uint64(decision.Timestamp.UnixNano()), decision.NodeKey,
+		decision.PendingChanID, decision.Accepted,
+		[]byte(decision.Reason),
+	)
+	if err != nil {
+		return err
+	}
+
+	return d.Update(func(tx *bolt.Tx) error {
+		logBucket, err := tx.CreateBucketIfNotExists(
+			channelAcceptLogBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		seqNum, err := logBucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		var seqKey [8]byte
+		byteOrder.PutUint64(seqKey[:], seqNum)
+		if err := logBucket.Put(seqKey[:], b.Bytes()); err != nil {
+			return err
+		}
+
+		if d.channelAcceptLogSize == 0 ||
+			seqNum <= uint64(d.channelAcceptLogSize) {
+
+			return nil
+		}
+
+		return pruneChannelAcceptLog(
+			logBucket, seqNum-uint64(d.channelAcceptLogSize),
+		)
+	})
+}
+
+// pruneChannelAcceptLog removes all decisions with a sequence number up to and
+// including maxSeqNum from the channel accept log.
+func pruneChannelAcceptLog(logBucket *bolt.Bucket, maxSeqNum uint64) error {
+	// We collect the keys first, as deleting while iterating with a
+	// cursor may skip entries.
+	var pruneKeys [][]byte
+	cursor := logBucket.Cursor()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		if byteOrder.Uint64(k) > maxSeqNum {
+			break
+		}
+		pruneKeys = append(pruneKeys, k)
+	}
+
+	for _, k := range pruneKeys {
+		if err := logBucket.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FetchChannelAcceptDecisions returns all logged channel accept decisions, in
+// chronological order.
+func (d *DB) FetchChannelAcceptDecisions() ([]ChannelAcceptDecision, error) {
+	var decisions []ChannelAcceptDecision
+	err := d.View(func(tx *bolt.Tx) error {
+		logBucket := tx.Bucket(channelAcceptLogBucket)
+		if logBucket == nil {
+			return nil
+		}
+
+		return logBucket.ForEach(func(_, v []byte) error {
+			var (
+				decision  ChannelAcceptDecision
+				timestamp uint64
+				reason    []byte
+			)
+			err := ReadElements(
+				bytes.NewReader(v), &timestamp,
+				&decision.NodeKey, &decision.PendingChanID,
+				&decision.Accepted, &reason,
+			)
+			if err != nil {
+				return err
+			}
+			decision.Timestamp = time.Unix(0, int64(timestamp))
+			decision.Reason = string(reason)
+
+			decisions = append(decisions, decision)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return decisions, nil
+}
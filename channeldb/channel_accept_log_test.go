@@ -0,0 +1,131 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+)
+
+// TestChannelAcceptLog asserts that channel accept decisions are stored along
+// with the requesting node and rejection reason, and can be read back in
+// chronological order.
+func TestChannelAcceptLog(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	testNow := time.Unix(1500000000, 0)
+	cdb.now = func() time.Time {
+		return testNow
+	}
+
+	// With nothing logged yet, no decisions should be returned.
+	decisions, err := cdb.FetchChannelAcceptDecisions()
+	if err != nil {
+		t.Fatalf("unable to fetch decisions: %v", err)
+	}
+	if len(decisions) != 0 {
+		t.Fatalf("expected no decisions, got %v", len(decisions))
+	}
+
+	accepted := &ChannelAcceptDecision{
+		NodeKey:       pubKey,
+		PendingChanID: [32]byte{1},
+		Accepted:      true,
+	}
+	if err := cdb.LogChannelAcceptDecision(accepted); err != nil {
+		t.Fatalf("unable to log decision: %v", err)
+	}
+
+	rejected := &ChannelAcceptDecision{
+		NodeKey:       pubKey,
+		PendingChanID: [32]byte{2},
+		Accepted:      false,
+		Reason:        "rejected by channel acceptor",
+	}
+	if err := cdb.LogChannelAcceptDecision(rejected); err != nil {
+		t.Fatalf("unable to log decision: %v", err)
+	}
+
+	decisions, err = cdb.FetchChannelAcceptDecisions()
+	if err != nil {
+		t.Fatalf("unable to fetch decisions: %v", err)
+	}
+	if len(decisions) != 2 {
+		t.Fatalf("expected 2 decisions, got %v", len(decisions))
+	}
+
+	for i, expected := range []*ChannelAcceptDecision{accepted, rejected} {
+		decision := decisions[i]
+
+		if !decision.Timestamp.Equal(testNow) {
+			t.Fatalf("decision %v: expected timestamp %v, got %v",
+				i, testNow, decision.Timestamp)
+		}
+		if !decision.NodeKey.IsEqual(expected.NodeKey) {
+			t.Fatalf("decision %v: node key mismatch", i)
+		}
+		if decision.PendingChanID != expected.PendingChanID {
+			t.Fatalf("decision %v: expected pending chan id %x, "+
+				"got %x", i, expected.PendingChanID,
+				decision.PendingChanID)
+		}
+		if decision.Accepted != expected.Accepted {
+			t.Fatalf("decision %v: expected accepted=%v, got %v",
+				i, expected.Accepted, decision.Accepted)
+		}
+		if decision.Reason != expected.Reason {
+			t.Fatalf("decision %v: expected reason %q, got %q",
+				i, expected.Reason, decision.Reason)
+		}
+	}
+}
+
+// TestChannelAcceptLogPrune asserts that the channel accept log only retains
+// the configured number of most recent decisions.
+func TestChannelAcceptLogPrune(t *testing.T) {
+	t.Parallel()
+
+	const logSize = 3
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	cdb.channelAcceptLogSize = logSize
+
+	const numDecisions = 5
+	for i := 0; i < numDecisions; i++ {
+		decision := &ChannelAcceptDecision{
+			NodeKey:       pubKey,
+			PendingChanID: [32]byte{byte(i)},
+			Accepted:      true,
+		}
+		if err := cdb.LogChannelAcceptDecision(decision); err != nil {
+			t.Fatalf("unable to log decision: %v", err)
+		}
+	}
+
+	decisions, err := cdb.FetchChannelAcceptDecisions()
+	if err != nil {
+		t.Fatalf("unable to fetch decisions: %v", err)
+	}
+	if len(decisions) != logSize {
+		t.Fatalf("expected %v decisions, got %v", logSize,
+			len(decisions))
+	}
+
+	// Only the most recent decisions should have been retained.
+	for i, decision := range decisions {
+		expected := [32]byte{byte(numDecisions - logSize + i)}
+		if decision.PendingChanID != expected {
+			t.Fatalf("decision %v: expected pending chan id %x, "+
+				"got %x", i, expected, decision.PendingChanID)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package channeldb
 import (
 	"bytes"
 	"io/ioutil"
+	"math"
 	"math/rand"
 	"net"
 	"os"
@@ -19,6 +20,7 @@ import (
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/shachain"
+	bolt "go.etcd.io/bbolt"
 )
 
 var (
@@ -96,6 +98,21 @@ func makeTestDB() (*DB, func(), error) {
 	return cdb, cleanUp, nil
 }
 
+// makeInMemoryTestDB opens a new channeldb using OptionInMemory. The returned
+// cleanup function closes the database, which also discards its contents.
+func makeInMemoryTestDB() (*DB, func(), error) {
+	cdb, err := Open("", OptionInMemory())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cleanUp := func() {
+		cdb.Close()
+	}
+
+	return cdb, cleanUp, nil
+}
+
 func createTestChannelState(cdb *DB) (*OpenChannel, error) {
 	// Simulate 1000 channel updates.
 	producer, err := shachain.NewRevocationProducerFromBytes(key[:])
@@ -848,6 +865,77 @@ func TestFetchClosedChannels(t *testing.T) {
 	}
 }
 
+// TestFetchClosedChannelCommitments asserts that the latest commitments of a
+// channel are still available after it was closed, until it's marked as fully
+// closed.
+func TestFetchClosedChannelCommitments(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// No commitments are stored for the channel while it's still open.
+	_, err = cdb.FetchClosedChannelCommitments(&state.FundingOutpoint)
+	if err != ErrNoCommitmentsFound {
+		t.Fatalf("expected ErrNoCommitmentsFound, got %v", err)
+	}
+
+	summary := &ChannelCloseSummary{
+		ChanPoint:       state.FundingOutpoint,
+		ClosingTXID:     state.LocalCommitment.CommitTx.TxHash(),
+		RemotePub:       state.IdentityPub,
+		Capacity:        state.Capacity,
+		CloseType:       LocalForceClose,
+		IsPending:       true,
+		LocalChanConfig: state.LocalChanCfg,
+	}
+	if err := state.CloseChannel(summary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	// The commitments should survive the close of the channel.
+	commits, err := cdb.FetchClosedChannelCommitments(
+		&state.FundingOutpoint,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch closed channel commitments: %v", err)
+	}
+	assertCommitmentEqual(
+		t, &state.LocalCommitment, &commits.LocalCommitment,
+	)
+	assertCommitmentEqual(
+		t, &state.RemoteCommitment, &commits.RemoteCommitment,
+	)
+	if commits.RemotePendingCommitment != nil {
+		t.Fatalf("expected no remote pending commitment")
+	}
+
+	// Once the channel is fully closed, the commitments are removed.
+	err = cdb.MarkChanFullyClosed(&state.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("failed fully closing channel: %v", err)
+	}
+	_, err = cdb.FetchClosedChannelCommitments(&state.FundingOutpoint)
+	if err != ErrNoCommitmentsFound {
+		t.Fatalf("expected ErrNoCommitmentsFound, got %v", err)
+	}
+}
+
 // TestFetchWaitingCloseChannels ensures that the correct channels that are
 // waiting to be closed are returned.
 func TestFetchWaitingCloseChannels(t *testing.T) {
@@ -941,6 +1029,166 @@ func TestFetchWaitingCloseChannels(t *testing.T) {
 	}
 }
 
+// TestBroadcastHeight asserts that the height at which a commitment was
+// broadcast can be retrieved if it was recorded, and defaults to zero if it
+// wasn't.
+func TestBroadcastHeight(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	const broadcastHeight = 99
+	channels := make([]*OpenChannel, 2)
+	for i := range channels {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel: %v", err)
+		}
+		err = channel.SyncPending(addr, broadcastHeight)
+		if err != nil {
+			t.Fatalf("unable to sync channel: %v", err)
+		}
+		channels[i] = channel
+	}
+
+	// Before any commitment has been broadcast, there's no height to
+	// retrieve.
+	if _, err := channels[0].BroadcastHeight(); err != ErrNoCloseTx {
+		t.Fatalf("expected ErrNoCloseTx, got %v", err)
+	}
+
+	newCloseTx := func(channel *OpenChannel) *wire.MsgTx {
+		closeTx := wire.NewMsgTx()
+		closeTx.Version = 2
+		closeTx.AddTxIn(
+			&wire.TxIn{
+				PreviousOutPoint: channel.FundingOutpoint,
+			},
+		)
+		return closeTx
+	}
+
+	// The first channel is marked without a height, as was done before
+	// heights were recorded, so its height should default to zero.
+	err = channels[0].MarkCommitmentBroadcasted(newCloseTx(channels[0]))
+	if err != nil {
+		t.Fatalf("unable to mark commitment broadcast: %v", err)
+	}
+	height, err := channels[0].BroadcastHeight()
+	if err != nil {
+		t.Fatalf("unable to fetch broadcast height: %v", err)
+	}
+	if height != 0 {
+		t.Fatalf("expected zero broadcast height, got %v", height)
+	}
+
+	// The second channel records the height along with the closing tx.
+	const closeHeight = 120
+	err = channels[1].MarkCommitmentBroadcastedAtHeight(
+		newCloseTx(channels[1]), closeHeight,
+	)
+	if err != nil {
+		t.Fatalf("unable to mark commitment broadcast: %v", err)
+	}
+
+	waitingCloseChannels, err := cdb.FetchWaitingCloseChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch waiting close channels: %v", err)
+	}
+	for _, channel := range waitingCloseChannels {
+		if channel.FundingOutpoint != channels[1].FundingOutpoint {
+			continue
+		}
+
+		height, err := channel.BroadcastHeight()
+		if err != nil {
+			t.Fatalf("unable to fetch broadcast height: %v", err)
+		}
+		if height != closeHeight {
+			t.Fatalf("expected broadcast height %v, got %v",
+				closeHeight, height)
+		}
+
+		closeTx, err := channel.BroadcastedCommitment()
+		if err != nil {
+			t.Fatalf("unable to retrieve commitment: %v", err)
+		}
+		if closeTx.TxIn[0].PreviousOutPoint != channel.FundingOutpoint {
+			t.Fatalf("expected outpoint %v, got %v",
+				channel.FundingOutpoint,
+				closeTx.TxIn[0].PreviousOutPoint)
+		}
+
+		return
+	}
+
+	t.Fatalf("channel %v not found waiting close",
+		channels[1].FundingOutpoint)
+}
+
+// TestCloseSummaryCloseCause asserts that the close cause of a channel close
+// summary is properly serialized, and that summaries written before the close
+// cause was added are decoded with an unknown cause.
+func TestCloseSummaryCloseCause(t *testing.T) {
+	t.Parallel()
+
+	summaries := []*ChannelCloseSummary{
+		{
+			RemotePub:  pubKey,
+			CloseType:  LocalForceClose,
+			CloseCause: CloseCauseHtlcTimeout,
+		},
+		{
+			RemotePub:               pubKey,
+			CloseType:               LocalForceClose,
+			RemoteCurrentRevocation: pubKey,
+			CloseCause:              CloseCauseUserRequest,
+		},
+	}
+
+	for i, summary := range summaries {
+		var b bytes.Buffer
+		if err := serializeChannelCloseSummary(&b, summary); err != nil {
+			t.Fatalf("unable to serialize summary: %v", err)
+		}
+
+		decoded, err := deserializeCloseChannelSummary(
+			bytes.NewReader(b.Bytes()),
+		)
+		if err != nil {
+			t.Fatalf("unable to deserialize summary: %v", err)
+		}
+		if decoded.CloseCause != summary.CloseCause {
+			t.Fatalf("summary #%d: expected close cause %v, got %v",
+				i, summary.CloseCause, decoded.CloseCause)
+		}
+
+		// Strip the trailing close cause to simulate a summary written
+		// before it was added. It should decode with an unknown cause.
+		legacy := b.Bytes()[:b.Len()-1]
+		decoded, err = deserializeCloseChannelSummary(
+			bytes.NewReader(legacy),
+		)
+		if err != nil {
+			t.Fatalf("unable to deserialize legacy summary: %v",
+				err)
+		}
+		if decoded.CloseCause != CloseCauseUnknown {
+			t.Fatalf("summary #%d: expected unknown close cause, "+
+				"got %v", i, decoded.CloseCause)
+		}
+	}
+}
+
 // TestRefreshShortChanID asserts that RefreshShortChanID updates the in-memory
 // short channel ID of another OpenChannel to reflect a preceding call to
 // MarkOpen on a different OpenChannel.
@@ -1042,3 +1290,517 @@ func TestRefreshShortChanID(t *testing.T) {
 			pendingChannel.Packager.(*ChannelPackager).source)
 	}
 }
+
+// TestFetchChannelByID asserts that a channel can be looked up by its short
+// channel ID once it has been marked as open, and no longer after it has been
+// closed.
+func TestFetchChannelByID(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	const broadcastHeight = 99
+	if err := state.SyncPending(addr, broadcastHeight); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// While the channel is still pending, it shouldn't be found by its
+	// short channel ID.
+	_, err = cdb.FetchChannelByID(state.ShortChanID())
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound for pending channel, "+
+			"got %v", err)
+	}
+
+	chanOpenLoc := lnwire.ShortChannelID{
+		BlockHeight: 105,
+		TxIndex:     10,
+		TxPosition:  15,
+	}
+	if err := state.MarkAsOpen(chanOpenLoc); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+
+	// Now that the channel is open, we should be able to look it up by
+	// its assigned short channel ID.
+	channel, err := cdb.FetchChannelByID(chanOpenLoc)
+	if err != nil {
+		t.Fatalf("unable to fetch channel by id: %v", err)
+	}
+	if channel.FundingOutpoint != state.FundingOutpoint {
+		t.Fatalf("wrong channel fetched: want %v, got %v",
+			state.FundingOutpoint, channel.FundingOutpoint)
+	}
+	if channel.ShortChanID() != chanOpenLoc {
+		t.Fatalf("wrong short channel id: want %v, got %v",
+			chanOpenLoc, channel.ShortChanID())
+	}
+
+	// An unknown short channel ID should result in ErrChannelNotFound.
+	unknownLoc := chanOpenLoc
+	unknownLoc.TxIndex++
+	_, err = cdb.FetchChannelByID(unknownLoc)
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound, got %v", err)
+	}
+
+	// Finally, once the channel is closed it should no longer be found.
+	summary := &ChannelCloseSummary{
+		ChanPoint:       state.FundingOutpoint,
+		ClosingTXID:     rev,
+		RemotePub:       state.IdentityPub,
+		Capacity:        state.Capacity,
+		SettledBalance:  state.LocalCommitment.LocalBalance.ToAtoms(),
+		CloseType:       CooperativeClose,
+		LocalChanConfig: state.LocalChanCfg,
+	}
+	if err := state.CloseChannel(summary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	_, err = cdb.FetchChannelByID(chanOpenLoc)
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound for closed channel, "+
+			"got %v", err)
+	}
+}
+
+// TestUpdateCommitmentFeeFloor asserts that UpdateCommitment rejects a new
+// commitment paying less than the channel's minimum fee rate, and accepts one
+// paying at least as much.
+func TestUpdateCommitmentFeeFloor(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	if err := channel.SyncPending(addr, 101); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// No floor is set by default.
+	minFeePerKB, err := channel.MinCommitFeePerKB()
+	if err != nil {
+		t.Fatalf("unable to fetch min commit fee: %v", err)
+	}
+	if minFeePerKB != 0 {
+		t.Fatalf("expected no min commit fee, got %v", minFeePerKB)
+	}
+
+	const floor = dcrutil.Amount(1e4)
+	if err := channel.SetMinCommitFeePerKB(floor); err != nil {
+		t.Fatalf("unable to set min commit fee: %v", err)
+	}
+
+	// A commitment paying less than the floor should be rejected, leaving
+	// the current commitment untouched.
+	oldCommitment := channel.LocalCommitment
+	commitment := channel.LocalCommitment
+	commitment.CommitHeight++
+	commitment.FeePerKB = floor - 1
+	err = channel.UpdateCommitment(&commitment)
+	if _, ok := err.(ErrCommitFeeBelowMin); !ok {
+		t.Fatalf("expected ErrCommitFeeBelowMin, got %v", err)
+	}
+	channels, err := cdb.FetchOpenChannels(channel.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	assertCommitmentEqual(t, &oldCommitment, &channels[0].LocalCommitment)
+
+	// A commitment paying exactly the floor should be accepted.
+	commitment.FeePerKB = floor
+	if err := channel.UpdateCommitment(&commitment); err != nil {
+		t.Fatalf("unable to update commitment: %v", err)
+	}
+	channels, err = cdb.FetchOpenChannels(channel.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	assertCommitmentEqual(t, &commitment, &channels[0].LocalCommitment)
+
+	// Once the floor is removed, lower fee rates are accepted again.
+	if err := channel.SetMinCommitFeePerKB(0); err != nil {
+		t.Fatalf("unable to clear min commit fee: %v", err)
+	}
+	commitment.CommitHeight++
+	commitment.FeePerKB = floor / 2
+	if err := channel.UpdateCommitment(&commitment); err != nil {
+		t.Fatalf("unable to update commitment: %v", err)
+	}
+}
+
+// TestPruneRevocationLog asserts that pruning the revocation log compacts all
+// but the most recent states while keeping the data needed to penalize a
+// breach, and that it is refused for an insufficient retention depth or a
+// closing channel.
+func TestPruneRevocationLog(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	if err := channel.SyncPending(addr, 101); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// Populate the revocation log directly, as going through the full
+	// state machine for this many states would be slow.
+	const numStates = MinRevocationLogDepth + 500
+	err = cdb.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, channel.IdentityPub, &channel.FundingOutpoint,
+			channel.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		logBucket, err := chanBucket.CreateBucketIfNotExists(
+			revocationLogBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		commit := channel.RemoteCommitment
+		commit.Htlcs = []HTLC{{
+			Signature:     bytes.Repeat([]byte{2}, 71),
+			RHash:         [32]byte{1},
+			Amt:           1000,
+			RefundTimeout: 500,
+			OutputIndex:   2,
+			Incoming:      true,
+			OnionBlob:     bytes.Repeat([]byte{3}, 1366),
+			HtlcIndex:     1,
+			LogIndex:      2,
+		}}
+		for i := uint64(0); i < numStates; i++ {
+			commit.CommitHeight = i
+			err := appendChannelLogEntry(logBucket, &commit)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to populate revocation log: %v", err)
+	}
+	channel.RemoteCommitment.CommitHeight = numStates
+
+	// Pruning with less than the minimum retention depth is refused.
+	_, err = channel.PruneRevocationLog(MinRevocationLogDepth - 1)
+	if err != ErrRevocationLogDepthTooLow {
+		t.Fatalf("expected ErrRevocationLogDepthTooLow, got %v", err)
+	}
+
+	numPruned, err := channel.PruneRevocationLog(MinRevocationLogDepth)
+	if err != nil {
+		t.Fatalf("unable to prune revocation log: %v", err)
+	}
+	if numPruned != 500 {
+		t.Fatalf("expected 500 pruned states, got %v", numPruned)
+	}
+
+	// The pruned states are compacted, but still hold all data needed to
+	// penalize a breach.
+	remoteCommit := channel.RemoteCommitment
+	for _, height := range []uint64{0, 499} {
+		commit, err := channel.FindPreviousState(height)
+		if err != nil {
+			t.Fatalf("unable to find state %v: %v", height, err)
+		}
+		if commit.CommitHeight != height {
+			t.Fatalf("expected height %v, got %v", height,
+				commit.CommitHeight)
+		}
+		if len(commit.CommitSig) != 0 {
+			t.Fatalf("expected commit sig of state %v to be "+
+				"pruned", height)
+		}
+		if commit.CommitTx.TxHash() != remoteCommit.CommitTx.TxHash() {
+			t.Fatalf("commit tx of state %v doesn't match", height)
+		}
+		if commit.LocalBalance != remoteCommit.LocalBalance ||
+			commit.RemoteBalance != remoteCommit.RemoteBalance {
+
+			t.Fatalf("balances of state %v don't match", height)
+		}
+		if len(commit.Htlcs) != 1 {
+			t.Fatalf("expected 1 htlc, got %v", len(commit.Htlcs))
+		}
+		htlc := commit.Htlcs[0]
+		if len(htlc.Signature) != 0 || len(htlc.OnionBlob) != 0 {
+			t.Fatalf("expected htlc sig and onion blob of state "+
+				"%v to be pruned", height)
+		}
+		if htlc.RHash != [32]byte{1} || htlc.Amt != 1000 ||
+			htlc.RefundTimeout != 500 || htlc.OutputIndex != 2 ||
+			!htlc.Incoming {
+
+			t.Fatalf("htlc of state %v doesn't match: %v", height,
+				spew.Sdump(htlc))
+		}
+	}
+
+	// The retained states are intact.
+	for _, height := range []uint64{500, numStates - 1} {
+		commit, err := channel.FindPreviousState(height)
+		if err != nil {
+			t.Fatalf("unable to find state %v: %v", height, err)
+		}
+		if commit.CommitHeight != height {
+			t.Fatalf("expected height %v, got %v", height,
+				commit.CommitHeight)
+		}
+		if len(commit.CommitSig) == 0 {
+			t.Fatalf("expected commit sig of state %v", height)
+		}
+		if len(commit.Htlcs[0].OnionBlob) != 1366 {
+			t.Fatalf("expected onion blob of state %v", height)
+		}
+	}
+	tail, err := channel.RevocationLogTail()
+	if err != nil {
+		t.Fatalf("unable to fetch log tail: %v", err)
+	}
+	if tail.CommitHeight != numStates-1 {
+		t.Fatalf("expected tail height %v, got %v", numStates-1,
+			tail.CommitHeight)
+	}
+
+	// Pruning again with the same depth is a noop.
+	numPruned, err = channel.PruneRevocationLog(MinRevocationLogDepth)
+	if err != nil {
+		t.Fatalf("unable to prune revocation log: %v", err)
+	}
+	if numPruned != 0 {
+		t.Fatalf("expected no pruned states, got %v", numPruned)
+	}
+
+	// Once the channel is closing, the log may no longer be pruned.
+	if err := channel.MarkCommitmentBroadcasted(testTx); err != nil {
+		t.Fatalf("unable to mark commitment broadcast: %v", err)
+	}
+	_, err = channel.PruneRevocationLog(MinRevocationLogDepth)
+	if err != ErrRevocationLogPruneClosing {
+		t.Fatalf("expected ErrRevocationLogPruneClosing, got %v", err)
+	}
+}
+
+// TestFindPreviousStates asserts that a range of past states can be read from
+// the revocation log, and that a missing state within the range is reported.
+func TestFindPreviousStates(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	if err := channel.SyncPending(addr, 101); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// Before any state was revoked, there's no log to read from.
+	if _, err := channel.FindPreviousStates(0, 1); err != ErrNoPastDeltas {
+		t.Fatalf("expected ErrNoPastDeltas, got %v", err)
+	}
+
+	// Insert a number of states into the revocation log, leaving a gap
+	// at height 7.
+	const numStates = 10
+	err = cdb.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, channel.IdentityPub, &channel.FundingOutpoint,
+			channel.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		logBucket, err := chanBucket.CreateBucketIfNotExists(
+			revocationLogBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		commit := channel.RemoteCommitment
+		for i := uint64(0); i < numStates; i++ {
+			if i == 7 {
+				continue
+			}
+
+			commit.CommitHeight = i
+			commit.LocalBalance = lnwire.MilliAtom(i * 1000)
+			err := appendChannelLogEntry(logBucket, &commit)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to populate revocation log: %v", err)
+	}
+
+	commits, err := channel.FindPreviousStates(2, 5)
+	if err != nil {
+		t.Fatalf("unable to find previous states: %v", err)
+	}
+	if len(commits) != 4 {
+		t.Fatalf("expected 4 states, got %v", len(commits))
+	}
+	for i, commit := range commits {
+		height := uint64(i + 2)
+		if commit.CommitHeight != height {
+			t.Fatalf("expected height %v, got %v", height,
+				commit.CommitHeight)
+		}
+		if commit.LocalBalance != lnwire.MilliAtom(height*1000) {
+			t.Fatalf("unexpected balance %v for height %v",
+				commit.LocalBalance, height)
+		}
+	}
+
+	// A range covering the gap, or extending past the last state, fails
+	// with the height of the first missing state.
+	testCases := []struct {
+		from, to      uint64
+		missingHeight uint64
+	}{
+		{from: 5, to: 8, missingHeight: 7},
+		{from: 8, to: 12, missingHeight: 10},
+	}
+	for _, test := range testCases {
+		_, err := channel.FindPreviousStates(test.from, test.to)
+		notFoundErr, ok := err.(ErrPreviousStateNotFound)
+		if !ok {
+			t.Fatalf("expected ErrPreviousStateNotFound, got %v",
+				err)
+		}
+		if notFoundErr.Height != test.missingHeight {
+			t.Fatalf("expected missing height %v, got %v",
+				test.missingHeight, notFoundErr.Height)
+		}
+	}
+
+	// An inverted range is rejected.
+	if _, err := channel.FindPreviousStates(5, 2); err == nil {
+		t.Fatalf("expected error for inverted range")
+	}
+}
+
+// TestCircuitKeyStringRoundTrip asserts that circuit keys can be parsed back
+// from their string representation, and that malformed strings are rejected.
+func TestCircuitKeyStringRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	keys := []CircuitKey{
+		{},
+		{
+			ChanID: lnwire.NewShortChanIDFromInt(math.MaxUint64),
+			HtlcID: 0,
+		},
+		{
+			ChanID: lnwire.NewShortChanIDFromInt(math.MaxUint64),
+			HtlcID: math.MaxUint64,
+		},
+		{
+			ChanID: lnwire.ShortChannelID{
+				BlockHeight: 500000,
+				TxIndex:     12,
+				TxPosition:  1,
+			},
+			HtlcID: 42,
+		},
+	}
+	for _, key := range keys {
+		parsed, err := ParseCircuitKey(key.String())
+		if err != nil {
+			t.Fatalf("unable to parse %v: %v", key, err)
+		}
+		if !parsed.Equal(key) {
+			t.Fatalf("expected %v, got %v", key, parsed)
+		}
+	}
+
+	// Keys differing in either field aren't equal.
+	key := keys[3]
+	other := key
+	other.HtlcID++
+	if key.Equal(other) {
+		t.Fatalf("expected %v and %v to differ", key, other)
+	}
+	other = key
+	other.ChanID.TxPosition++
+	if key.Equal(other) {
+		t.Fatalf("expected %v and %v to differ", key, other)
+	}
+
+	invalid := []string{
+		"",
+		"(Chan ID=1:2:3)",
+		"(Chan ID=1:2:3, HTLC ID=-4)",
+		"(Chan ID=16777216:2:3, HTLC ID=4)",
+		"(Chan ID=1:16777216:3, HTLC ID=4)",
+		"(Chan ID=1:2:65536, HTLC ID=4)",
+		"(Chan ID=1:2:3, HTLC ID=4) ",
+		"(Chan ID=01:2:3, HTLC ID=4)",
+	}
+	for _, s := range invalid {
+		if _, err := ParseCircuitKey(s); err == nil {
+			t.Fatalf("expected %q to be rejected", s)
+		}
+	}
+}
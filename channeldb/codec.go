@@ -198,6 +198,11 @@ func WriteElement(w io.Writer, element interface{}) error {
 			return err
 		}
 
+	case CloseCause:
+		if err := binary.Write(w, byteOrder, e); err != nil {
+			return err
+		}
+
 	case lnwire.FundingFlag:
 		if err := binary.Write(w, byteOrder, e); err != nil {
 			return err
@@ -412,6 +417,11 @@ func ReadElement(r io.Reader, element interface{}) error {
 			return err
 		}
 
+	case *CloseCause:
+		if err := binary.Read(r, byteOrder, e); err != nil {
+			return err
+		}
+
 	case *lnwire.FundingFlag:
 		if err := binary.Read(r, byteOrder, e); err != nil {
 			return err
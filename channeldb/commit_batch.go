@@ -0,0 +1,120 @@
+package channeldb
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// errCommitBatchRetry is sent to a batched call whose update failed, to signal
+// that it needs to be retried in a transaction of its own.
+var errCommitBatchRetry = errors.New("retry commit batch call")
+
+// commitBatcher coalesces concurrent commitment updates into a single database
+// transaction. Unlike bolt's Batch, it uses its own batch window, so other
+// users of the database aren't affected by it.
+type commitBatcher struct {
+	db     *bolt.DB
+	window time.Duration
+
+	mu    sync.Mutex
+	batch *commitBatch
+}
+
+// commitBatch is a set of updates that are committed in a single transaction
+// once the batch window has expired.
+type commitBatch struct {
+	batcher *commitBatcher
+	timer   *time.Timer
+	start   sync.Once
+	calls   []commitBatchCall
+}
+
+// commitBatchCall is a single update of a commit batch, along with the channel
+// its result is delivered on.
+type commitBatchCall struct {
+	fn  func(*bolt.Tx) error
+	err chan<- error
+}
+
+// newCommitBatcher returns a commitBatcher that collects updates for the given
+// window before committing them.
+func newCommitBatcher(db *bolt.DB, window time.Duration) *commitBatcher {
+	return &commitBatcher{
+		db:     db,
+		window: window,
+	}
+}
+
+// Update adds fn to the current batch and waits until the batch has been
+// committed. If the batch fails, fn is retried on its own, so it may be
+// executed more than once and must be idempotent.
+func (b *commitBatcher) Update(fn func(*bolt.Tx) error) error {
+	errChan := make(chan error, 1)
+
+	b.mu.Lock()
+	if b.batch == nil {
+		b.batch = &commitBatch{batcher: b}
+		b.batch.timer = time.AfterFunc(b.window, b.batch.trigger)
+	}
+	b.batch.calls = append(b.batch.calls, commitBatchCall{
+		fn:  fn,
+		err: errChan,
+	})
+	b.mu.Unlock()
+
+	err := <-errChan
+	if err == errCommitBatchRetry {
+		err = b.db.Update(fn)
+	}
+
+	return err
+}
+
+// trigger runs the batch if it hasn't already been run.
+func (c *commitBatch) trigger() {
+	c.start.Do(c.run)
+}
+
+// run commits all calls of the batch in a single transaction. If one of them
+// fails, it is taken out of the batch and told to retry on its own, after
+// which the remaining calls are committed again.
+func (c *commitBatch) run() {
+	c.batcher.mu.Lock()
+	c.timer.Stop()
+	if c.batcher.batch == c {
+		c.batcher.batch = nil
+	}
+	c.batcher.mu.Unlock()
+
+	for len(c.calls) > 0 {
+		failIdx := -1
+		err := c.batcher.db.Update(func(tx *bolt.Tx) error {
+			for i, call := range c.calls {
+				if err := call.fn(tx); err != nil {
+					failIdx = i
+					return err
+				}
+			}
+
+			return nil
+		})
+
+		if failIdx >= 0 {
+			last := len(c.calls) - 1
+			failed := c.calls[failIdx]
+			c.calls[failIdx] = c.calls[last]
+			c.calls = c.calls[:last]
+
+			failed.err <- errCommitBatchRetry
+			continue
+		}
+
+		for _, call := range c.calls {
+			call.err <- err
+		}
+		break
+	}
+}
@@ -0,0 +1,188 @@
+package channeldb
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwire"
+	bolt "go.etcd.io/bbolt"
+)
+
+// createBatchTestChannels opens a channeldb with the given commit batch window
+// and adds numChannels pending channels to it.
+func createBatchTestChannels(window time.Duration, numChannels int) (*DB,
+	[]*OpenChannel, func(), error) {
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	cdb, err := Open(tempDirName, OptionSetCommitBatchWindow(window))
+	if err != nil {
+		os.RemoveAll(tempDirName)
+		return nil, nil, nil, err
+	}
+
+	cleanUp := func() {
+		cdb.Close()
+		os.RemoveAll(tempDirName)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+
+	channels := make([]*OpenChannel, numChannels)
+	for i := range channels {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			cleanUp()
+			return nil, nil, nil, err
+		}
+		if err := channel.SyncPending(addr, 101); err != nil {
+			cleanUp()
+			return nil, nil, nil, err
+		}
+
+		channels[i] = channel
+	}
+
+	return cdb, channels, cleanUp, nil
+}
+
+// updateCommitmentBurst concurrently updates the commitment of each channel
+// numUpdates times, shifting balance to the remote party with each update.
+func updateCommitmentBurst(channels []*OpenChannel, numUpdates int) error {
+	var wg sync.WaitGroup
+	errChan := make(chan error, len(channels))
+	for _, channel := range channels {
+		wg.Add(1)
+		go func(channel *OpenChannel) {
+			defer wg.Done()
+
+			for i := 0; i < numUpdates; i++ {
+				commitment := channel.LocalCommitment
+				commitment.CommitHeight++
+				commitment.LocalBalance -= 10
+				commitment.RemoteBalance += 10
+
+				err := channel.UpdateCommitment(&commitment)
+				if err != nil {
+					errChan <- err
+					return
+				}
+			}
+		}(channel)
+	}
+	wg.Wait()
+	close(errChan)
+
+	return <-errChan
+}
+
+// TestCommitBatchWindow asserts that with commitment batching enabled, the
+// state persisted after a burst of concurrent updates matches the last update
+// of each channel.
+func TestCommitBatchWindow(t *testing.T) {
+	t.Parallel()
+
+	const (
+		numChannels = 5
+		numUpdates  = 20
+	)
+
+	cdb, channels, cleanUp, err := createBatchTestChannels(
+		10*time.Millisecond, numChannels,
+	)
+	if err != nil {
+		t.Fatalf("unable to create channels: %v", err)
+	}
+	defer cleanUp()
+
+	// The commit batch window must not change the batch delay used by
+	// other users of the database.
+	if cdb.MaxBatchDelay != bolt.DefaultMaxBatchDelay {
+		t.Fatalf("expected max batch delay %v, got %v",
+			bolt.DefaultMaxBatchDelay, cdb.MaxBatchDelay)
+	}
+
+	if err := updateCommitmentBurst(channels, numUpdates); err != nil {
+		t.Fatalf("unable to update commitments: %v", err)
+	}
+
+	dbChannels, err := cdb.FetchOpenChannels(channels[0].IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(dbChannels) != numChannels {
+		t.Fatalf("expected %v channels, got %v", numChannels,
+			len(dbChannels))
+	}
+
+	for _, channel := range channels {
+		var dbChannel *OpenChannel
+		for _, c := range dbChannels {
+			if c.FundingOutpoint == channel.FundingOutpoint {
+				dbChannel = c
+				break
+			}
+		}
+		if dbChannel == nil {
+			t.Fatalf("channel %v not found", channel.FundingOutpoint)
+		}
+
+		commitment := dbChannel.LocalCommitment
+		if commitment.CommitHeight != numUpdates {
+			t.Fatalf("expected commit height %v, got %v",
+				numUpdates, commitment.CommitHeight)
+		}
+
+		expectedBalance := lnwire.MilliAtom(9000 - 10*numUpdates)
+		if commitment.LocalBalance != expectedBalance {
+			t.Fatalf("expected local balance %v, got %v",
+				expectedBalance, commitment.LocalBalance)
+		}
+		expectedBalance = lnwire.MilliAtom(3000 + 10*numUpdates)
+		if commitment.RemoteBalance != expectedBalance {
+			t.Fatalf("expected remote balance %v, got %v",
+				expectedBalance, commitment.RemoteBalance)
+		}
+	}
+}
+
+func benchmarkUpdateCommitment(b *testing.B, window time.Duration) {
+	const numChannels = 10
+
+	_, channels, cleanUp, err := createBatchTestChannels(
+		window, numChannels,
+	)
+	if err != nil {
+		b.Fatalf("unable to create channels: %v", err)
+	}
+	defer cleanUp()
+
+	b.ResetTimer()
+
+	// Each iteration updates the commitment of every channel once.
+	if err := updateCommitmentBurst(channels, b.N); err != nil {
+		b.Fatalf("unable to update commitments: %v", err)
+	}
+}
+
+// BenchmarkUpdateCommitment measures concurrent commitment updates of several
+// channels, each written in its own transaction.
+func BenchmarkUpdateCommitment(b *testing.B) {
+	benchmarkUpdateCommitment(b, 0)
+}
+
+// BenchmarkUpdateCommitmentBatched measures concurrent commitment updates of
+// several channels, coalesced within a batch window.
+func BenchmarkUpdateCommitmentBatched(b *testing.B) {
+	benchmarkUpdateCommitment(b, time.Millisecond)
+}
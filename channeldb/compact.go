@@ -0,0 +1,212 @@
+package channeldb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// compactTxMaxSize is the maximum number of key/value bytes copied
+	// within a single write transaction of the destination database while
+	// compacting. Bounding the transaction size keeps memory usage in
+	// check for very large databases.
+	compactTxMaxSize = 64 * 1024 * 1024
+
+	// compactOpenTimeout is the amount of time we'll wait to obtain the
+	// exclusive lock on the database file before concluding that it's in
+	// use by someone else.
+	compactOpenTimeout = time.Second
+
+	// compactTempSuffix is appended to the database file name to form the
+	// name of the file the compacted copy is written to.
+	compactTempSuffix = ".compact"
+)
+
+// Compact reclaims the free space of the channeldb stored within dbPath by
+// copying all of its buckets and key/value pairs into a fresh file, which is
+// then atomically swapped in place of the original one. bbolt never shrinks
+// its file on its own, so this is the only way to recover disk space after a
+// large number of deletions, e.g. after channel closes and invoice pruning.
+//
+// NOTE: The database must not be open while it's being compacted. If the
+// database file is locked by another user, ErrDBInUse is returned and the
+// database is left untouched.
+func Compact(dbPath string) error {
+	path := filepath.Join(dbPath, dbName)
+	if !fileExists(path) {
+		return ErrNoChanDBExists
+	}
+
+	// We open the source database for writing, which requires an exclusive
+	// lock on the file. This both ensures that nobody else is using the
+	// database right now, and prevents anybody from opening it while the
+	// compaction is in progress.
+	src, err := bolt.Open(path, dbFilePermission, &bolt.Options{
+		Timeout: compactOpenTimeout,
+	})
+	if err == bolt.ErrTimeout {
+		return ErrDBInUse
+	}
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	srcInfo, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	// Remove any leftovers from a previous, interrupted compaction before
+	// writing the compacted copy.
+	tempPath := path + compactTempSuffix
+	if err := os.Remove(tempPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	dst, err := bolt.Open(tempPath, dbFilePermission, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := compactDB(dst, src); err != nil {
+		dst.Close()
+		os.Remove(tempPath)
+		return fmt.Errorf("unable to compact channeldb: %v", err)
+	}
+
+	if err := dst.Close(); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+
+	dstInfo, err := os.Stat(tempPath)
+	if err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+
+	// With the compacted copy fully written and synced, we can now swap it
+	// in place of the original database file. We still hold the lock on
+	// the source file at this point, so nobody can sneak in between.
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+
+	log.Infof("Compacted channeldb from %d to %d bytes", srcInfo.Size(),
+		dstInfo.Size())
+
+	return nil
+}
+
+// compactWalkFunc is the function called for every bucket and key/value pair
+// while walking the source database. keys holds the path of parent buckets
+// leading to k, and v is nil if k refers to a nested bucket, in which case
+// seq holds the bucket's sequence number.
+type compactWalkFunc func(keys [][]byte, k, v []byte, seq uint64) error
+
+// compactDB copies all buckets and key/value pairs from src into dst,
+// committing the destination transaction every compactTxMaxSize bytes.
+func compactDB(dst, src *bolt.DB) error {
+	var size int64
+
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	copyFn := func(keys [][]byte, k, v []byte, seq uint64) error {
+		// Commit the current transaction if adding this entry would
+		// exceed our size limit, and continue within a new one.
+		sz := int64(len(k) + len(v))
+		if size+sz > compactTxMaxSize {
+			if err := tx.Commit(); err != nil {
+				return err
+			}
+
+			tx, err = dst.Begin(true)
+			if err != nil {
+				return err
+			}
+			size = 0
+		}
+		size += sz
+
+		// Top level entries are always buckets.
+		if len(keys) == 0 {
+			bkt, err := tx.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return bkt.SetSequence(seq)
+		}
+
+		// Otherwise, locate the parent bucket the entry belongs to.
+		b := tx.Bucket(keys[0])
+		for _, key := range keys[1:] {
+			b = b.Bucket(key)
+		}
+
+		// Since keys are copied in order, we can pack the pages
+		// completely.
+		b.FillPercent = 1.0
+
+		if v == nil {
+			bkt, err := b.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return bkt.SetSequence(seq)
+		}
+
+		return b.Put(k, v)
+	}
+
+	err = src.View(func(srcTx *bolt.Tx) error {
+		return srcTx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return compactWalkBucket(
+				b, nil, name, nil, b.Sequence(), copyFn,
+			)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// compactWalkBucket calls fn for the entry k/v, and if it refers to a bucket,
+// recursively for all of the bucket's contents.
+func compactWalkBucket(b *bolt.Bucket, keyPath [][]byte, k, v []byte,
+	seq uint64, fn compactWalkFunc) error {
+
+	if err := fn(keyPath, k, v, seq); err != nil {
+		return err
+	}
+
+	// Nothing more to do for a plain key/value pair.
+	if v != nil {
+		return nil
+	}
+
+	keyPath = append(keyPath, k)
+	return b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			bkt := b.Bucket(k)
+			return compactWalkBucket(
+				bkt, keyPath, k, nil, bkt.Sequence(), fn,
+			)
+		}
+
+		return compactWalkBucket(b, keyPath, k, v, b.Sequence(), fn)
+	})
+}
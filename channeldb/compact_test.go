@@ -0,0 +1,169 @@
+package channeldb
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrlnd/lnwire"
+	bolt "go.etcd.io/bbolt"
+)
+
+// TestCompact asserts that compacting the database reclaims free space, is
+// refused while the database is open and preserves all stored data.
+func TestCompact(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	cdb, err := Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to create channeldb: %v", err)
+	}
+
+	// Store an open channel and a handful of invoices.
+	channelState, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channelState.SyncPending(addr, 9); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+	err = channelState.MarkAsOpen(lnwire.NewShortChanIDFromInt(99))
+	if err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+
+	const numInvoices = 20
+	amt := lnwire.NewMAtomsFromAtoms(1000)
+	for i := 0; i < numInvoices; i++ {
+		invoice, err := randInvoice(amt)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := cdb.AddInvoice(invoice, hash); err != nil {
+			t.Fatalf("unable to add invoice %v", err)
+		}
+	}
+
+	invoices, err := cdb.FetchAllInvoices(false)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+
+	// Simulate churn by writing a large amount of data and deleting it
+	// again, which leaves the file with a lot of free pages.
+	churnBucket := []byte("churn")
+	err = cdb.Update(func(tx *bolt.Tx) error {
+		bkt, err := tx.CreateBucket(churnBucket)
+		if err != nil {
+			return err
+		}
+
+		value := make([]byte, 1024)
+		for i := 0; i < 4096; i++ {
+			key := []byte{byte(i >> 8), byte(i)}
+			if err := bkt.Put(key, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to write churn data: %v", err)
+	}
+	err = cdb.Update(func(tx *bolt.Tx) error {
+		return tx.DeleteBucket(churnBucket)
+	})
+	if err != nil {
+		t.Fatalf("unable to delete churn data: %v", err)
+	}
+
+	// Compaction must be refused while the database is open.
+	if err := Compact(tempDirName); err != ErrDBInUse {
+		t.Fatalf("expected ErrDBInUse, got %v", err)
+	}
+
+	if err := cdb.Close(); err != nil {
+		t.Fatalf("unable to close channeldb: %v", err)
+	}
+
+	dbFile := filepath.Join(tempDirName, dbName)
+	before, err := os.Stat(dbFile)
+	if err != nil {
+		t.Fatalf("unable to stat db: %v", err)
+	}
+
+	if err := Compact(tempDirName); err != nil {
+		t.Fatalf("unable to compact channeldb: %v", err)
+	}
+
+	after, err := os.Stat(dbFile)
+	if err != nil {
+		t.Fatalf("unable to stat db: %v", err)
+	}
+	if after.Size() >= before.Size() {
+		t.Fatalf("compaction didn't shrink db: before=%v, after=%v",
+			before.Size(), after.Size())
+	}
+	if fileExists(dbFile + compactTempSuffix) {
+		t.Fatalf("temporary compaction file left behind")
+	}
+
+	// Reopen the database and ensure all our data survived.
+	cdb, err = Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to reopen channeldb: %v", err)
+	}
+	defer cdb.Close()
+
+	dbChannel, err := cdb.FetchChannel(channelState.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch channel: %v", err)
+	}
+	channelState.Db = cdb
+	if !reflect.DeepEqual(channelState, dbChannel) {
+		t.Fatalf("channel state doesn't match:: %v vs %v",
+			spew.Sdump(channelState), spew.Sdump(dbChannel))
+	}
+
+	dbInvoices, err := cdb.FetchAllInvoices(false)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	if !reflect.DeepEqual(invoices, dbInvoices) {
+		t.Fatalf("invoices don't match: %v vs %v",
+			spew.Sdump(invoices), spew.Sdump(dbInvoices))
+	}
+
+	// The bucket sequences backing the invoice indexes must also have been
+	// carried over, so that new invoices continue the add index.
+	invoice, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	addIndex, err := cdb.AddInvoice(
+		invoice, invoice.Terms.PaymentPreimage.Hash(),
+	)
+	if err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+	if addIndex != numInvoices+1 {
+		t.Fatalf("expected add index %v, got %v", numInvoices+1,
+			addIndex)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
 	"path/filepath"
@@ -115,6 +116,11 @@ var (
 			number:    11,
 			migration: migrateInvoices,
 		},
+		{
+			// Index all open channels by their short channel ID.
+			number:    12,
+			migration: migrateChanShortIDIndex,
+		},
 	}
 
 	// Big endian is the preferred byte order, due to cursor scans over
@@ -130,11 +136,47 @@ type DB struct {
 	dbPath string
 	graph  *ChannelGraph
 	now    func() time.Time
+
+	// inMemory is true if the database was opened with OptionInMemory, in
+	// which case the backing store is removed on Close.
+	inMemory bool
+
+	// balanceHistorySize is the maximum number of balance snapshots kept
+	// per channel. If zero, no snapshots are recorded.
+	balanceHistorySize int
+
+	// maxInvoiceHtlcs is the maximum number of htlcs that can be recorded
+	// for a single invoice. If zero, there is no limit.
+	maxInvoiceHtlcs int
+
+	// channelAcceptLogSize is the maximum number of decisions retained in
+	// the channel accept log. If zero, there is no limit.
+	channelAcceptLogSize int
+
+	// commitBatcher, if non-nil, coalesces concurrent commitment updates
+	// into a single transaction.
+	commitBatcher *commitBatcher
 }
 
 // Open opens an existing channeldb. Any necessary schemas migrations due to
 // updates will take place as necessary.
 func Open(dbPath string, modifiers ...OptionModifier) (*DB, error) {
+	opts := DefaultOptions()
+	for _, modifier := range modifiers {
+		modifier(&opts)
+	}
+
+	// bbolt can only operate on an mmap'd file, so an in-memory database
+	// is backed by a fresh file on a memory-backed filesystem (when one is
+	// available) which is removed once the database is closed.
+	if opts.InMemory {
+		var err error
+		dbPath, err = ioutil.TempDir(inMemoryDir(), "channeldb")
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	path := filepath.Join(dbPath, dbName)
 
 	if !fileExists(path) {
@@ -143,11 +185,6 @@ func Open(dbPath string, modifiers ...OptionModifier) (*DB, error) {
 		}
 	}
 
-	opts := DefaultOptions()
-	for _, modifier := range modifiers {
-		modifier(&opts)
-	}
-
 	// Specify bbolt freelist options to reduce heap pressure in case the
 	// freelist grows to be very large.
 	options := &bolt.Options{
@@ -157,13 +194,33 @@ func Open(dbPath string, modifiers ...OptionModifier) (*DB, error) {
 
 	bdb, err := bolt.Open(path, dbFilePermission, options)
 	if err != nil {
+		if opts.InMemory {
+			os.RemoveAll(dbPath)
+		}
 		return nil, err
 	}
 
+	// There's no point in paying for fsync calls if nothing is meant to
+	// outlive this process.
+	if opts.InMemory {
+		bdb.NoSync = true
+	}
+
 	chanDB := &DB{
-		DB:     bdb,
-		dbPath: dbPath,
-		now:    time.Now,
+		DB:       bdb,
+		dbPath:   dbPath,
+		now:      opts.Clock.Now,
+		inMemory: opts.InMemory,
+
+		balanceHistorySize: opts.BalanceHistorySize,
+		maxInvoiceHtlcs:    opts.MaxInvoiceHtlcs,
+
+		channelAcceptLogSize: opts.ChannelAcceptLogSize,
+	}
+	if opts.CommitBatchWindow > 0 {
+		chanDB.commitBatcher = newCommitBatcher(
+			bdb, opts.CommitBatchWindow,
+		)
 	}
 	chanDB.graph = newChannelGraph(
 		chanDB, opts.RejectCacheSize, opts.ChannelCacheSize,
@@ -171,13 +228,40 @@ func Open(dbPath string, modifiers ...OptionModifier) (*DB, error) {
 
 	// Synchronize the version of database and apply migrations if needed.
 	if err := chanDB.syncVersions(dbVersions); err != nil {
-		bdb.Close()
+		chanDB.Close()
 		return nil, err
 	}
 
 	return chanDB, nil
 }
 
+// Close closes the underlying database. If the database was opened with
+// OptionInMemory, its backing store is discarded as well.
+func (d *DB) Close() error {
+	err := d.DB.Close()
+
+	if d.inMemory {
+		if rmErr := os.RemoveAll(d.dbPath); err == nil {
+			err = rmErr
+		}
+	}
+
+	return err
+}
+
+// inMemoryDir returns the directory under which in-memory databases are
+// created. A memory-backed filesystem is preferred, falling back to the
+// default temporary directory if none is available.
+func inMemoryDir() string {
+	const shmDir = "/dev/shm"
+
+	if info, err := os.Stat(shmDir); err == nil && info.IsDir() {
+		return shmDir
+	}
+
+	return ""
+}
+
 // Path returns the file path to the channel database.
 func (d *DB) Path() string {
 	return d.dbPath
@@ -193,11 +277,21 @@ func (d *DB) Wipe() error {
 			return err
 		}
 
+		err = tx.DeleteBucket(chanShortIDIndexBucket)
+		if err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+
 		err = tx.DeleteBucket(closedChannelBucket)
 		if err != nil && err != bolt.ErrBucketNotFound {
 			return err
 		}
 
+		err = tx.DeleteBucket(closedChanCommitsBucket)
+		if err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+
 		err = tx.DeleteBucket(invoiceBucket)
 		if err != nil && err != bolt.ErrBucketNotFound {
 			return err
@@ -416,9 +510,25 @@ func (d *DB) fetchNodeChannels(chainBucket *bolt.Bucket) ([]*OpenChannel, error)
 
 	var channels []*OpenChannel
 
+	err := d.forEachNodeChannel(chainBucket, func(c *OpenChannel) error {
+		channels = append(channels, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// forEachNodeChannel decodes each channel stored within the passed chain
+// bucket of a node, and invokes cb with it.
+func (d *DB) forEachNodeChannel(chainBucket *bolt.Bucket,
+	cb func(*OpenChannel) error) error {
+
 	// A node may have channels on several chains, so for each known chain,
 	// we'll extract all the channels.
-	err := chainBucket.ForEach(func(chanPoint, v []byte) error {
+	return chainBucket.ForEach(func(chanPoint, v []byte) error {
 		// If there's a value, it's not a bucket so ignore it.
 		if v != nil {
 			return nil
@@ -440,15 +550,8 @@ func (d *DB) fetchNodeChannels(chainBucket *bolt.Bucket) ([]*OpenChannel, error)
 		}
 		oChannel.Db = d
 
-		channels = append(channels, oChannel)
-
-		return nil
+		return cb(oChannel)
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	return channels, nil
 }
 
 // FetchChannel attempts to locate a channel specified by the passed channel
@@ -547,6 +650,42 @@ func (d *DB) FetchChannel(chanPoint wire.OutPoint) (*OpenChannel, error) {
 	return nil, ErrChannelNotFound
 }
 
+// FetchChannelByID attempts to locate the open channel with the passed short
+// channel ID. Only channels that have been marked as open are indexed by their
+// short channel ID, so ErrChannelNotFound is returned for pending channels as
+// well as for unknown ones.
+func (d *DB) FetchChannelByID(chanID lnwire.ShortChannelID) (*OpenChannel,
+	error) {
+
+	var channel *OpenChannel
+	err := d.View(func(tx *bolt.Tx) error {
+		chanBucket, chanPoint, err := fetchChanBucketByShortID(tx, chanID)
+		if err != nil {
+			return err
+		}
+
+		channel, err = fetchOpenChannel(chanBucket, chanPoint)
+		if err != nil {
+			return err
+		}
+
+		// Guard against a stale index entry pointing to a channel that
+		// has since been assigned a different short channel ID.
+		if channel.ShortChannelID != chanID {
+			return ErrChannelNotFound
+		}
+
+		channel.Db = d
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
 // FetchAllChannels attempts to retrieve all open channels currently stored
 // within the database, including pending open, fully open and channels waiting
 // for a closing transaction to confirm.
@@ -575,6 +714,28 @@ func (d *DB) FetchAllChannels() ([]*OpenChannel, error) {
 	return channels, nil
 }
 
+// ForEachChannel invokes cb for every channel currently stored within the
+// database, including pending open, fully open and channels waiting for a
+// closing transaction to confirm, i.e. the same set of channels returned by
+// FetchAllChannels, without first loading all of them into memory. All
+// channels are read within a single database transaction. If cb returns an
+// error, iteration stops and the error is returned.
+//
+// Each channel passed to cb is freshly decoded and owned by the caller, so it
+// remains valid and may be retained after cb returns. However, as cb runs
+// while the read transaction is still open, it must not call any method that
+// writes to the database, such as the state mutating methods of the passed
+// channel. Doing so may deadlock.
+func (d *DB) ForEachChannel(cb func(*OpenChannel) error) error {
+	return d.View(func(tx *bolt.Tx) error {
+		return forEachChainBucket(tx, func(_, _ []byte,
+			chainBucket *bolt.Bucket) error {
+
+			return d.forEachNodeChannel(chainBucket, cb)
+		})
+	})
+}
+
 // FetchAllOpenChannels will return all channels that have the funding
 // transaction confirmed, and is not waiting for a closing transaction to be
 // confirmed.
@@ -616,74 +777,35 @@ func fetchChannels(d *DB, pending, waitingClose bool) ([]*OpenChannel, error) {
 	var channels []*OpenChannel
 
 	err := d.View(func(tx *bolt.Tx) error {
-		// Get the bucket dedicated to storing the metadata for open
-		// channels.
-		openChanBucket := tx.Bucket(openChannelBucket)
-		if openChanBucket == nil {
-			return ErrNoActiveChannels
-		}
-
-		// Next, fetch the bucket dedicated to storing metadata related
-		// to all nodes. All keys within this bucket are the serialized
-		// public keys of all our direct counterparties.
-		nodeMetaBucket := tx.Bucket(nodeInfoBucket)
-		if nodeMetaBucket == nil {
-			return fmt.Errorf("node bucket not created")
-		}
+		return forEachChainBucket(tx, func(nodeKey, chainHash []byte,
+			chainBucket *bolt.Bucket) error {
 
-		// Finally for each node public key in the bucket, fetch all
-		// the channels related to this particular node.
-		return nodeMetaBucket.ForEach(func(k, v []byte) error {
-			nodeChanBucket := openChanBucket.Bucket(k)
-			if nodeChanBucket == nil {
-				return nil
+			nodeChans, err := d.fetchNodeChannels(chainBucket)
+			if err != nil {
+				return fmt.Errorf("unable to read channel for "+
+					"chain_hash=%x, node_key=%x: %v",
+					chainHash, nodeKey, err)
 			}
-
-			return nodeChanBucket.ForEach(func(chainHash, v []byte) error {
-				// If there's a value, it's not a bucket so
-				// ignore it.
-				if v != nil {
-					return nil
+			for _, channel := range nodeChans {
+				if channel.IsPending != pending {
+					continue
 				}
 
-				// If we've found a valid chainhash bucket,
-				// then we'll retrieve that so we can extract
-				// all the channels.
-				chainBucket := nodeChanBucket.Bucket(chainHash)
-				if chainBucket == nil {
-					return fmt.Errorf("unable to read "+
-						"bucket for chain=%x", chainHash)
-				}
+				// If the channel is in any other state than
+				// Default, then it means it is waiting to be
+				// closed.
+				status := channel.ChanStatus()
+				isWaitingClose := status != ChanStatusDefault
 
-				nodeChans, err := d.fetchNodeChannels(chainBucket)
-				if err != nil {
-					return fmt.Errorf("unable to read "+
-						"channel for chain_hash=%x, "+
-						"node_key=%x: %v", chainHash, k, err)
+				// Only include it if we requested channels
+				// with the same waitingClose status.
+				if isWaitingClose != waitingClose {
+					continue
 				}
-				for _, channel := range nodeChans {
-					if channel.IsPending != pending {
-						continue
-					}
-
-					// If the channel is in any other state
-					// than Default, then it means it is
-					// waiting to be closed.
-					channelWaitingClose :=
-						channel.ChanStatus() != ChanStatusDefault
-
-					// Only include it if we requested
-					// channels with the same waitingClose
-					// status.
-					if channelWaitingClose != waitingClose {
-						continue
-					}
-
-					channels = append(channels, channel)
-				}
-				return nil
-			})
 
+				channels = append(channels, channel)
+			}
+			return nil
 		})
 	})
 	if err != nil {
@@ -693,6 +815,54 @@ func fetchChannels(d *DB, pending, waitingClose bool) ([]*OpenChannel, error) {
 	return channels, nil
 }
 
+// forEachChainBucket invokes cb with the bucket holding the channels of each
+// node on each chain, along with the node's public key and the chain hash,
+// within the passed transaction. If no channels were ever stored, then
+// ErrNoActiveChannels is returned.
+func forEachChainBucket(tx *bolt.Tx, cb func(nodeKey, chainHash []byte,
+	chainBucket *bolt.Bucket) error) error {
+
+	// Get the bucket dedicated to storing the metadata for open channels.
+	openChanBucket := tx.Bucket(openChannelBucket)
+	if openChanBucket == nil {
+		return ErrNoActiveChannels
+	}
+
+	// Next, fetch the bucket dedicated to storing metadata related to all
+	// nodes. All keys within this bucket are the serialized public keys of
+	// all our direct counterparties.
+	nodeMetaBucket := tx.Bucket(nodeInfoBucket)
+	if nodeMetaBucket == nil {
+		return fmt.Errorf("node bucket not created")
+	}
+
+	// Finally for each node public key in the bucket, visit the channels
+	// related to this particular node on each chain.
+	return nodeMetaBucket.ForEach(func(nodeKey, _ []byte) error {
+		nodeChanBucket := openChanBucket.Bucket(nodeKey)
+		if nodeChanBucket == nil {
+			return nil
+		}
+
+		return nodeChanBucket.ForEach(func(chainHash, v []byte) error {
+			// If there's a value, it's not a bucket so ignore it.
+			if v != nil {
+				return nil
+			}
+
+			// If we've found a valid chainhash bucket, then we'll
+			// hand it over so the channels can be extracted.
+			chainBucket := nodeChanBucket.Bucket(chainHash)
+			if chainBucket == nil {
+				return fmt.Errorf("unable to read bucket for "+
+					"chain=%x", chainHash)
+			}
+
+			return cb(nodeKey, chainHash, chainBucket)
+		})
+	})
+}
+
 // FetchClosedChannels attempts to fetch all closed channels from the database.
 // The pendingOnly bool toggles if channels that aren't yet fully closed should
 // be returned in the response or not. When a channel was cooperatively closed,
@@ -816,6 +986,31 @@ func (d *DB) FetchClosedChannelForID(cid lnwire.ChannelID) (
 	return chanSummary, nil
 }
 
+// FetchClosedChannelCommitments returns the latest commitments of a channel
+// that is pending close, as they were at the time the channel was closed. If
+// no commitments were stored for the channel, ErrNoCommitmentsFound is
+// returned.
+func (d *DB) FetchClosedChannelCommitments(chanPoint *wire.OutPoint) (
+	*ClosedChannelCommitments, error) {
+
+	var b bytes.Buffer
+	if err := writeOutpoint(&b, chanPoint); err != nil {
+		return nil, err
+	}
+
+	var commits *ClosedChannelCommitments
+	err := d.View(func(tx *bolt.Tx) error {
+		var err error
+		commits, err = fetchClosedChanCommitments(tx, b.Bytes())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
 // MarkChanFullyClosed marks a channel as fully closed within the database. A
 // channel should be marked as fully closed if the channel was initially
 // cooperatively closed and it's reached a single confirmation, or after all
@@ -864,6 +1059,18 @@ func (d *DB) MarkChanFullyClosed(chanPoint *wire.OutPoint) error {
 			return err
 		}
 
+		// The commitments of the channel are no longer needed once
+		// all of its contracts are resolved.
+		closedCommitsBucket := tx.Bucket(closedChanCommitsBucket)
+		if closedCommitsBucket != nil &&
+			closedCommitsBucket.Bucket(chanID) != nil {
+
+			err := closedCommitsBucket.DeleteBucket(chanID)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Now that the channel is closed, we'll check if we have any
 		// other open channels with this peer. If we don't we'll
 		// garbage collect it to ensure we don't establish persistent
@@ -1,6 +1,7 @@
 package channeldb
 
 import (
+	"errors"
 	"io/ioutil"
 	"math"
 	"math/rand"
@@ -47,6 +48,43 @@ func TestOpenWithCreate(t *testing.T) {
 	}
 }
 
+// TestOpenInMemory tests that a database opened with OptionInMemory leaves
+// the passed path untouched and discards its backing store once closed.
+func TestOpenInMemory(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	dbPath := filepath.Join(tempDirName, "cdb")
+	cdb, err := Open(dbPath, OptionInMemory())
+	if err != nil {
+		t.Fatalf("unable to create channeldb: %v", err)
+	}
+
+	// Nothing should have been created at the requested path.
+	if fileExists(dbPath) {
+		t.Fatalf("in-memory channeldb created data directory")
+	}
+
+	memPath := cdb.Path()
+	if !fileExists(memPath) {
+		t.Fatalf("in-memory channeldb backing store not found")
+	}
+
+	if err := cdb.Close(); err != nil {
+		t.Fatalf("unable to close channeldb: %v", err)
+	}
+
+	// Once closed, the backing store should be gone.
+	if fileExists(memPath) {
+		t.Fatalf("in-memory channeldb backing store not removed")
+	}
+}
+
 // TestWipe tests that the database wipe operation completes successfully
 // and that the buckets are deleted. It also checks that attempts to fetch
 // information while the buckets are not set return the correct errors.
@@ -344,6 +382,86 @@ func genRandomChannelShell() (*ChannelShell, error) {
 	}, nil
 }
 
+// TestForEachChannel asserts that ForEachChannel visits the same set of
+// channels returned by FetchAllChannels, and that an error returned by the
+// callback aborts the iteration.
+func TestForEachChannel(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	// We'll create a pending channel, an open channel and a channel
+	// waiting to be closed.
+	channels := make([]*OpenChannel, 3)
+	for i := range channels {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 9); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+		channels[i] = channel
+	}
+	err = channels[1].MarkAsOpen(lnwire.NewShortChanIDFromInt(99))
+	if err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+	err = channels[2].MarkCommitmentBroadcasted(wire.NewMsgTx())
+	if err != nil {
+		t.Fatalf("unable to mark commitment broadcast: %v", err)
+	}
+
+	visited := make(map[wire.OutPoint]struct{})
+	err = cdb.ForEachChannel(func(c *OpenChannel) error {
+		visited[c.FundingOutpoint] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to iterate channels: %v", err)
+	}
+
+	allChannels, err := cdb.FetchAllChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch all channels: %v", err)
+	}
+	if len(visited) != len(allChannels) {
+		t.Fatalf("expected %d channels, visited %d", len(allChannels),
+			len(visited))
+	}
+	for _, c := range allChannels {
+		if _, ok := visited[c.FundingOutpoint]; !ok {
+			t.Fatalf("channel %v not visited", c.FundingOutpoint)
+		}
+	}
+
+	// Returning an error from the callback should stop the iteration and
+	// surface the error.
+	errStop := errors.New("stop")
+	numVisited := 0
+	err = cdb.ForEachChannel(func(c *OpenChannel) error {
+		numVisited++
+		return errStop
+	})
+	if err != errStop {
+		t.Fatalf("expected errStop, got %v", err)
+	}
+	if numVisited != 1 {
+		t.Fatalf("expected iteration to stop after 1 channel, "+
+			"visited %d", numVisited)
+	}
+}
+
 // TestRestoreChannelShells tests that we're able to insert a partially channel
 // populated to disk. This is useful for channel recovery purposes. We should
 // find the new channel shell on disk, and also the db should be populated with
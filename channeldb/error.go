@@ -10,6 +10,10 @@ var (
 	// created.
 	ErrNoChanDBExists = fmt.Errorf("channel db has not yet been created")
 
+	// ErrDBInUse is returned when attempting to compact a channeldb that
+	// is currently opened by another user.
+	ErrDBInUse = fmt.Errorf("channel db is in use")
+
 	// ErrDBReversion is returned when detecting an attempt to revert to a
 	// prior database version.
 	ErrDBReversion = fmt.Errorf("channel db cannot revert to prior version")
@@ -26,6 +30,18 @@ var (
 	// created.
 	ErrNoPastDeltas = fmt.Errorf("channel has no recorded deltas")
 
+	// ErrRevocationLogDepthTooLow is returned when attempting to prune the
+	// revocation log of a channel while retaining fewer than
+	// MinRevocationLogDepth states.
+	ErrRevocationLogDepthTooLow = fmt.Errorf("revocation log retention "+
+		"depth must be at least %v", MinRevocationLogDepth)
+
+	// ErrRevocationLogPruneClosing is returned when attempting to prune
+	// the revocation log of a channel that may be in the process of being
+	// closed, as the log may be needed to handle a breach.
+	ErrRevocationLogPruneClosing = fmt.Errorf("cannot prune revocation " +
+		"log of a closing channel")
+
 	// ErrInvoiceNotFound is returned when a targeted invoice can't be
 	// found.
 	ErrInvoiceNotFound = fmt.Errorf("unable to locate invoice")
@@ -38,6 +54,21 @@ var (
 	// payment hash already exists.
 	ErrDuplicateInvoice = fmt.Errorf("invoice with payment hash already exists")
 
+	// ErrDuplicateInvoiceToken is returned when an invoice is added with
+	// an idempotency token that was already used for a different invoice,
+	// either with a different payment hash or different contents.
+	ErrDuplicateInvoiceToken = fmt.Errorf("invoice idempotency token " +
+		"already used for a different invoice")
+
+	// ErrInvoiceHtlcLimit is returned when an update would add more htlcs
+	// to an invoice than it is allowed to hold. None of the htlcs of the
+	// update are recorded.
+	ErrInvoiceHtlcLimit = fmt.Errorf("invoice htlc limit reached")
+
+	// ErrAMPSetUnderpaid is returned when an invoice is settled with an
+	// AMP set whose accepted htlcs don't pay the full invoice amount.
+	ErrAMPSetUnderpaid = fmt.Errorf("amp set doesn't pay invoice amount")
+
 	// ErrNoPaymentsCreated is returned when bucket of payments hasn't been
 	// created.
 	ErrNoPaymentsCreated = fmt.Errorf("there are no existing payments")
@@ -1,12 +1,17 @@
 package channeldb
 
 import (
+	"bytes"
 	"crypto/rand"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrlnd/clock"
+	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwire"
 )
 
@@ -49,7 +54,19 @@ func randInvoice(value lnwire.MilliAtom) (*Invoice, error) {
 func TestInvoiceWorkflow(t *testing.T) {
 	t.Parallel()
 
-	db, cleanUp, err := makeTestDB()
+	testInvoiceWorkflow(t, makeTestDB)
+}
+
+// TestInvoiceWorkflowInMemory runs the invoice workflow test against a
+// database opened with OptionInMemory.
+func TestInvoiceWorkflowInMemory(t *testing.T) {
+	t.Parallel()
+
+	testInvoiceWorkflow(t, makeInMemoryTestDB)
+}
+
+func testInvoiceWorkflow(t *testing.T, makeDB func() (*DB, func(), error)) {
+	db, cleanUp, err := makeDB()
 	defer cleanUp()
 	if err != nil {
 		t.Fatalf("unable to make test db: %v", err)
@@ -322,13 +339,118 @@ func TestInvoiceAddTimeSeries(t *testing.T) {
 	}
 }
 
+// TestInvoicesAddedSinceStrict asserts that InvoicesAddedSinceStrict reports
+// whether any invoices were ever created, while InvoicesAddedSince remains
+// lenient.
+func TestInvoicesAddedSinceStrict(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// On a fresh database, the strict variant should report that no
+	// invoices were created, for any index.
+	for _, sinceAddIndex := range []uint64{0, 1} {
+		_, err := db.InvoicesAddedSinceStrict(sinceAddIndex)
+		if err != ErrNoInvoicesCreated {
+			t.Fatalf("expected ErrNoInvoicesCreated for index %v, "+
+				"got %v", sinceAddIndex, err)
+		}
+
+		resp, err := db.InvoicesAddedSince(sinceAddIndex)
+		if err != nil {
+			t.Fatalf("unable to query: %v", err)
+		}
+		if len(resp) != 0 {
+			t.Fatalf("expected no invoices, got %v", len(resp))
+		}
+	}
+
+	const numInvoices = 3
+	amt := lnwire.NewMAtomsFromAtoms(1000)
+	invoices := make([]Invoice, numInvoices)
+	for i := 0; i < len(invoices); i++ {
+		invoice, err := randInvoice(amt)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+
+		paymentHash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.AddInvoice(invoice, paymentHash); err != nil {
+			t.Fatalf("unable to add invoice %v", err)
+		}
+
+		invoices[i] = *invoice
+	}
+
+	queries := []struct {
+		sinceAddIndex uint64
+
+		resp []Invoice
+	}{
+		// Only older invoices exist, so the result is empty without
+		// an error.
+		{
+			sinceAddIndex: 0,
+		},
+		{
+			sinceAddIndex: numInvoices,
+		},
+
+		// Newer invoices exist.
+		{
+			sinceAddIndex: 1,
+			resp:          invoices[1:],
+		},
+	}
+
+	for i, query := range queries {
+		strictResp, err := db.InvoicesAddedSinceStrict(
+			query.sinceAddIndex,
+		)
+		if err != nil {
+			t.Fatalf("test #%v: unable to query: %v", i, err)
+		}
+		if !reflect.DeepEqual(query.resp, strictResp) {
+			t.Fatalf("test #%v: expected %v, got %v", i,
+				spew.Sdump(query.resp), spew.Sdump(strictResp))
+		}
+
+		resp, err := db.InvoicesAddedSince(query.sinceAddIndex)
+		if err != nil {
+			t.Fatalf("test #%v: unable to query: %v", i, err)
+		}
+		if !reflect.DeepEqual(query.resp, resp) {
+			t.Fatalf("test #%v: expected %v, got %v", i,
+				spew.Sdump(query.resp), spew.Sdump(resp))
+		}
+	}
+}
+
 // TestDuplicateSettleInvoice tests that if we add a new invoice and settle it
 // twice, then the second time we also receive the invoice that we settled as a
 // return argument.
 func TestDuplicateSettleInvoice(t *testing.T) {
 	t.Parallel()
 
-	db, cleanUp, err := makeTestDB()
+	testDuplicateSettleInvoice(t, makeTestDB)
+}
+
+// TestDuplicateSettleInvoiceInMemory runs the duplicate settle test against a
+// database opened with OptionInMemory.
+func TestDuplicateSettleInvoiceInMemory(t *testing.T) {
+	t.Parallel()
+
+	testDuplicateSettleInvoice(t, makeInMemoryTestDB)
+}
+
+func testDuplicateSettleInvoice(t *testing.T,
+	makeDB func() (*DB, func(), error)) {
+
+	db, cleanUp, err := makeDB()
 	defer cleanUp()
 	if err != nil {
 		t.Fatalf("unable to make test db: %v", err)
@@ -671,24 +793,1449 @@ func TestQueryInvoices(t *testing.T) {
 	}
 }
 
-// getUpdateInvoice returns an invoice update callback that, when called,
-// settles the invoice with the given amount.
-func getUpdateInvoice(amt lnwire.MilliAtom) InvoiceUpdateCallback {
-	return func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
-		if invoice.Terms.State == ContractSettled {
-			return nil, ErrInvoiceAlreadySettled
+// TestQueryInvoicesSettleIndex ensures that we can page through the invoice
+// database in the order invoices were settled.
+func TestQueryInvoicesSettleIndex(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// We'll add 10 invoices to the database, then settle the even ones in
+	// reverse order so that the settle order differs from the add order.
+	const numInvoices = 10
+	var hashes []lntypes.Hash
+	for i := lnwire.MilliAtom(1); i <= numInvoices; i++ {
+		invoice, err := randInvoice(i)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
 		}
 
-		update := &InvoiceUpdateDesc{
-			Preimage: invoice.Terms.PaymentPreimage,
-			State:    ContractSettled,
-			Htlcs: map[CircuitKey]*HtlcAcceptDesc{
-				{}: {
-					Amt: amt,
-				},
+		paymentHash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.AddInvoice(invoice, paymentHash); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+		hashes = append(hashes, paymentHash)
+	}
+
+	var settled []Invoice
+	for i := numInvoices; i > 0; i -= 2 {
+		invoice, err := db.UpdateInvoice(
+			hashes[i-1], getUpdateInvoice(lnwire.MilliAtom(i)),
+		)
+		if err != nil {
+			t.Fatalf("unable to settle invoice: %v", err)
+		}
+		settled = append(settled, *invoice)
+	}
+
+	// Retrieve the settled invoices from disk, so we can compare them
+	// against the query responses.
+	invoices, err := db.FetchAllInvoices(false)
+	if err != nil {
+		t.Fatalf("unable to retrieve invoices: %v", err)
+	}
+	for i := range settled {
+		settled[i] = invoices[settled[i].AddIndex-1]
+	}
+
+	testCases := []struct {
+		query       InvoiceQuery
+		expected    []Invoice
+		firstOffset uint64
+		lastOffset  uint64
+	}{
+		// Fetch all settled invoices with a single query.
+		{
+			query: InvoiceQuery{
+				IndexType:      InvoiceSettleIndex,
+				NumMaxInvoices: numInvoices,
 			},
+			expected:    settled,
+			firstOffset: 1,
+			lastOffset:  5,
+		},
+		// Fetch two invoices after settle index 2.
+		{
+			query: InvoiceQuery{
+				IndexType:      InvoiceSettleIndex,
+				IndexOffset:    2,
+				NumMaxInvoices: 2,
+			},
+			expected:    settled[2:4],
+			firstOffset: 3,
+			lastOffset:  4,
+		},
+		// Fetch the last two settled invoices, iterating backwards.
+		{
+			query: InvoiceQuery{
+				IndexType:      InvoiceSettleIndex,
+				Reversed:       true,
+				NumMaxInvoices: 2,
+			},
+			expected:    settled[3:],
+			firstOffset: 4,
+			lastOffset:  5,
+		},
+		// Fetch all invoices before settle index 3, iterating
+		// backwards.
+		{
+			query: InvoiceQuery{
+				IndexType:      InvoiceSettleIndex,
+				IndexOffset:    3,
+				Reversed:       true,
+				NumMaxInvoices: numInvoices,
+			},
+			expected:    settled[:2],
+			firstOffset: 1,
+			lastOffset:  2,
+		},
+		// There are no invoices after the last settle index.
+		{
+			query: InvoiceQuery{
+				IndexType:      InvoiceSettleIndex,
+				IndexOffset:    5,
+				NumMaxInvoices: numInvoices,
+			},
+			expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		response, err := db.QueryInvoices(testCase.query)
+		if err != nil {
+			t.Fatalf("unable to query invoice database: %v", err)
 		}
 
-		return update, nil
+		if !reflect.DeepEqual(response.Invoices, testCase.expected) {
+			t.Fatalf("test #%d: query returned incorrect set of "+
+				"invoices: expected %v, got %v", i,
+				spew.Sdump(testCase.expected),
+				spew.Sdump(response.Invoices))
+		}
+		if response.FirstIndexOffset != testCase.firstOffset {
+			t.Fatalf("test #%d: expected first offset %v, got %v",
+				i, testCase.firstOffset,
+				response.FirstIndexOffset)
+		}
+		if response.LastIndexOffset != testCase.lastOffset {
+			t.Fatalf("test #%d: expected last offset %v, got %v",
+				i, testCase.lastOffset,
+				response.LastIndexOffset)
+		}
+	}
+}
+
+// TestFetchSettledInvoicesInRange asserts that only the invoices settled
+// within the requested settle index or time range are returned.
+func TestFetchSettledInvoicesInRange(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Settle 5 invoices an hour apart from each other.
+	const numInvoices = 5
+	settleTime := time.Unix(1500000000, 0)
+	db.now = func() time.Time {
+		return settleTime
+	}
+
+	settleTimes := make([]time.Time, numInvoices)
+	for i := 0; i < numInvoices; i++ {
+		amt := lnwire.MilliAtom(i + 1)
+		invoice, err := randInvoice(amt)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+
+		paymentHash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.AddInvoice(invoice, paymentHash); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+
+		settleTimes[i] = settleTime
+		_, err = db.UpdateInvoice(paymentHash, getUpdateInvoice(amt))
+		if err != nil {
+			t.Fatalf("unable to settle invoice: %v", err)
+		}
+
+		settleTime = settleTime.Add(time.Hour)
+	}
+
+	// assertSettleIndexes checks that the invoices have the expected
+	// settle indexes, in order.
+	assertSettleIndexes := func(invoices []Invoice, expected ...uint64) {
+		t.Helper()
+
+		if len(invoices) != len(expected) {
+			t.Fatalf("expected %v invoices, got %v", len(expected),
+				len(invoices))
+		}
+		for i, invoice := range invoices {
+			if invoice.SettleIndex != expected[i] {
+				t.Fatalf("expected settle index %v, got %v",
+					expected[i], invoice.SettleIndex)
+			}
+		}
+	}
+
+	invoices, err := db.FetchSettledInvoicesInRange(2, 4)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertSettleIndexes(invoices, 2, 3, 4)
+
+	invoices, err = db.FetchSettledInvoicesInRange(0, 1)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertSettleIndexes(invoices, 1)
+
+	invoices, err = db.FetchSettledInvoicesInRange(4, 100)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertSettleIndexes(invoices, 4, 5)
+
+	invoices, err = db.FetchSettledInvoicesInRange(4, 3)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertSettleIndexes(invoices)
+
+	invoices, err = db.FetchSettledInvoicesInTimeRange(
+		settleTimes[1], settleTimes[3], 0, 0,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertSettleIndexes(invoices, 2, 3, 4)
+
+	invoices, err = db.FetchSettledInvoicesInTimeRange(
+		settleTimes[0].Add(time.Minute),
+		settleTimes[1].Add(time.Minute), 0, 0,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertSettleIndexes(invoices, 2)
+
+	// A range starting before the first and ending after the last
+	// settlement returns every invoice, while one beyond the last
+	// settlement returns none.
+	invoices, err = db.FetchSettledInvoicesInTimeRange(
+		settleTimes[0].Add(-time.Hour),
+		settleTimes[4].Add(time.Hour), 0, 0,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertSettleIndexes(invoices, 1, 2, 3, 4, 5)
+
+	invoices, err = db.FetchSettledInvoicesInTimeRange(
+		settleTimes[4].Add(time.Minute),
+		settleTimes[4].Add(time.Hour), 0, 0,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertSettleIndexes(invoices)
+
+	// Paging through a time range should return at most the requested
+	// number of invoices, resuming after the given settle index.
+	invoices, err = db.FetchSettledInvoicesInTimeRange(
+		settleTimes[1], settleTimes[4], 0, 2,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertSettleIndexes(invoices, 2, 3)
+
+	invoices, err = db.FetchSettledInvoicesInTimeRange(
+		settleTimes[1], settleTimes[4], invoices[1].SettleIndex, 2,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertSettleIndexes(invoices, 4, 5)
+
+	invoices, err = db.FetchSettledInvoicesInTimeRange(
+		settleTimes[1], settleTimes[4], invoices[1].SettleIndex, 2,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	assertSettleIndexes(invoices)
+}
+
+// TestQueryInvoicesScanLimit asserts that a bounded query stops after
+// examining the configured number of index entries, and that the returned
+// continuation offset allows the caller to resume where the query stopped.
+func TestQueryInvoicesScanLimit(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// We'll add 200 invoices to the database, settling 95% of them. Only
+	// every 20th invoice is left pending.
+	const numInvoices = 200
+	for i := lnwire.MilliAtom(1); i <= numInvoices; i++ {
+		invoice, err := randInvoice(i)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+
+		paymentHash := invoice.Terms.PaymentPreimage.Hash()
+
+		if _, err := db.AddInvoice(invoice, paymentHash); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+
+		if i%20 != 0 {
+			_, err := db.UpdateInvoice(
+				paymentHash, getUpdateInvoice(i),
+			)
+			if err != nil {
+				t.Fatalf("unable to settle invoice: %v", err)
+			}
+		}
+	}
+
+	pendingInvoices, err := db.FetchAllInvoices(true)
+	if err != nil {
+		t.Fatalf("unable to fetch pending invoices: %v", err)
+	}
+	if len(pendingInvoices) != numInvoices/20 {
+		t.Fatalf("expected %v pending invoices, got %v",
+			numInvoices/20, len(pendingInvoices))
+	}
+
+	// Querying for the last 5 pending invoices while only allowing 20
+	// entries to be examined should stop early with a single result.
+	query := InvoiceQuery{
+		NumMaxInvoices:      5,
+		PendingOnly:         true,
+		Reversed:            true,
+		ScanLimitMultiplier: 4,
+	}
+	resp, err := db.QueryInvoices(query)
+	if err != nil {
+		t.Fatalf("unable to query invoice database: %v", err)
+	}
+	if !resp.ScanLimitReached {
+		t.Fatalf("expected scan limit to be reached")
+	}
+	if len(resp.Invoices) != 1 || resp.Invoices[0].AddIndex != 200 {
+		t.Fatalf("expected only invoice 200, got %v",
+			spew.Sdump(resp.Invoices))
+	}
+	if resp.ContinuationOffset != 181 {
+		t.Fatalf("expected continuation offset 181, got %v",
+			resp.ContinuationOffset)
+	}
+
+	// By repeatedly resuming from the continuation offset, we should
+	// eventually retrieve all pending invoices.
+	var collected []Invoice
+	for {
+		collected = append(resp.Invoices, collected...)
+
+		if len(resp.Invoices) == 0 && !resp.ScanLimitReached {
+			break
+		}
+
+		query.IndexOffset = resp.ContinuationOffset
+		resp, err = db.QueryInvoices(query)
+		if err != nil {
+			t.Fatalf("unable to query invoice database: %v", err)
+		}
+	}
+	if !reflect.DeepEqual(pendingInvoices, collected) {
+		t.Fatalf("expected %v, got %v", spew.Sdump(pendingInvoices),
+			spew.Sdump(collected))
+	}
+
+	// Without a scan limit, the same query should scan as far as needed to
+	// return the maximum number of invoices.
+	resp, err = db.QueryInvoices(InvoiceQuery{
+		NumMaxInvoices: 5,
+		PendingOnly:    true,
+		Reversed:       true,
+	})
+	if err != nil {
+		t.Fatalf("unable to query invoice database: %v", err)
+	}
+	if resp.ScanLimitReached {
+		t.Fatalf("expected scan limit not to be reached")
+	}
+	if !reflect.DeepEqual(pendingInvoices[5:], resp.Invoices) {
+		t.Fatalf("expected %v, got %v",
+			spew.Sdump(pendingInvoices[5:]),
+			spew.Sdump(resp.Invoices))
+	}
+}
+
+// TestInvoiceStats asserts that the invoice statistics properly tally the
+// number of invoices in each state and the total amount settled.
+func TestInvoiceStats(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// An empty database should result in empty stats.
+	stats, err := db.InvoiceStats()
+	if err != nil {
+		t.Fatalf("unable to fetch invoice stats: %v", err)
+	}
+	if stats != (InvoiceStats{}) {
+		t.Fatalf("expected empty stats, got %v", spew.Sdump(stats))
+	}
+
+	// We'll add six invoices. The first three will be settled, the fourth
+	// canceled and the remaining two left open.
+	const numInvoices = 6
+	var totalSettled lnwire.MilliAtom
+	for i := 1; i <= numInvoices; i++ {
+		amt := lnwire.NewMAtomsFromAtoms(dcrutil.Amount(i * 1000))
+		invoice, err := randInvoice(amt)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+
+		paymentHash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.AddInvoice(invoice, paymentHash); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+
+		switch {
+		case i <= 3:
+			_, err = db.UpdateInvoice(
+				paymentHash, getUpdateInvoice(amt),
+			)
+			totalSettled += amt
+
+		case i == 4:
+			_, err = db.UpdateInvoice(paymentHash,
+				func(*Invoice) (*InvoiceUpdateDesc, error) {
+					return &InvoiceUpdateDesc{
+						State: ContractCanceled,
+					}, nil
+				},
+			)
+		}
+		if err != nil {
+			t.Fatalf("unable to update invoice: %v", err)
+		}
+	}
+
+	stats, err = db.InvoiceStats()
+	if err != nil {
+		t.Fatalf("unable to fetch invoice stats: %v", err)
+	}
+
+	expected := InvoiceStats{
+		NumOpen:      2,
+		NumSettled:   3,
+		NumCanceled:  1,
+		TotalSettled: totalSettled,
+	}
+	if stats != expected {
+		t.Fatalf("expected stats %v, got %v", spew.Sdump(expected),
+			spew.Sdump(stats))
+	}
+}
+
+// getUpdateInvoice returns an invoice update callback that, when called,
+// settles the invoice with the given amount.
+func getUpdateInvoice(amt lnwire.MilliAtom) InvoiceUpdateCallback {
+	return func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+		if invoice.Terms.State == ContractSettled {
+			return nil, ErrInvoiceAlreadySettled
+		}
+
+		update := &InvoiceUpdateDesc{
+			Preimage: invoice.Terms.PaymentPreimage,
+			State:    ContractSettled,
+			Htlcs: map[CircuitKey]*HtlcAcceptDesc{
+				{}: {
+					Amt: amt,
+				},
+			},
+		}
+
+		return update, nil
+	}
+}
+
+// TestAddInvoiceIdempotencyToken asserts that adding an invoice again with the
+// same idempotency token returns the existing add index, while reusing a
+// payment hash or a token for a different invoice fails.
+func TestAddInvoiceIdempotencyToken(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	invoice, err := randInvoice(lnwire.NewMAtomsFromAtoms(1000))
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	invoice.IdempotencyToken = []byte("token")
+	paymentHash := invoice.Terms.PaymentPreimage.Hash()
+
+	addIndex, err := db.AddInvoice(invoice, paymentHash)
+	if err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	// The token should be persisted along with the invoice.
+	dbInvoice, err := db.LookupInvoice(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to find invoice: %v", err)
+	}
+	if !reflect.DeepEqual(*invoice, dbInvoice) {
+		t.Fatalf("invoice fetched from db doesn't match original %v vs %v",
+			spew.Sdump(invoice), spew.Sdump(dbInvoice))
+	}
+
+	// Retrying the insertion with the same token should succeed and
+	// return the original add index.
+	retry := *invoice
+	retry.AddIndex = 0
+	retryAddIndex, err := db.AddInvoice(&retry, paymentHash)
+	if err != nil {
+		t.Fatalf("unable to retry adding invoice: %v", err)
+	}
+	if retryAddIndex != addIndex {
+		t.Fatalf("expected add index %v, got %v", addIndex,
+			retryAddIndex)
+	}
+
+	// Reusing the token for the same payment hash but a different
+	// invoice isn't a retry, and should be rejected.
+	changed := *invoice
+	changed.AddIndex = 0
+	changed.Terms.Value++
+	_, err = db.AddInvoice(&changed, paymentHash)
+	if err != ErrDuplicateInvoiceToken {
+		t.Fatalf("expected ErrDuplicateInvoiceToken, got %v", err)
+	}
+
+	changed = *invoice
+	changed.AddIndex = 0
+	changed.Memo = []byte("other memo")
+	_, err = db.AddInvoice(&changed, paymentHash)
+	if err != ErrDuplicateInvoiceToken {
+		t.Fatalf("expected ErrDuplicateInvoiceToken, got %v", err)
+	}
+
+	// Adding an invoice with the same payment hash but a different token
+	// is a genuine collision and should fail.
+	collision := *invoice
+	collision.IdempotencyToken = []byte("other token")
+	_, err = db.AddInvoice(&collision, paymentHash)
+	if err != ErrDuplicateInvoice {
+		t.Fatalf("expected ErrDuplicateInvoice, got %v", err)
+	}
+
+	// The same applies to an invoice without a token.
+	collision.IdempotencyToken = nil
+	_, err = db.AddInvoice(&collision, paymentHash)
+	if err != ErrDuplicateInvoice {
+		t.Fatalf("expected ErrDuplicateInvoice, got %v", err)
+	}
+
+	// Reusing the token for a different payment hash should fail as well.
+	other, err := randInvoice(lnwire.NewMAtomsFromAtoms(1000))
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	other.IdempotencyToken = invoice.IdempotencyToken
+	_, err = db.AddInvoice(other, other.Terms.PaymentPreimage.Hash())
+	if err != ErrDuplicateInvoiceToken {
+		t.Fatalf("expected ErrDuplicateInvoiceToken, got %v", err)
+	}
+}
+
+// TestAddInvoiceIdempotencyTokenUnknownPreimage asserts that an invoice added
+// without a preimage can still be retried with the same token after its
+// preimage has been filled in.
+func TestAddInvoiceIdempotencyTokenUnknownPreimage(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	invoice, err := randInvoice(lnwire.NewMAtomsFromAtoms(1000))
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	preimage := invoice.Terms.PaymentPreimage
+	paymentHash := preimage.Hash()
+	invoice.Terms.PaymentPreimage = UnknownPreimage
+	invoice.IdempotencyToken = []byte("token")
+
+	retry := *invoice
+	addIndex, err := db.AddInvoice(invoice, paymentHash)
+	if err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	// Settle the invoice, which fills in the preimage.
+	_, err = db.UpdateInvoice(paymentHash,
+		func(*Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				State:    ContractSettled,
+				Preimage: preimage,
+			}, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+
+	// Retrying the original insertion should still be recognized as a
+	// retry and return the original add index.
+	retryAddIndex, err := db.AddInvoice(&retry, paymentHash)
+	if err != nil {
+		t.Fatalf("unable to retry adding invoice: %v", err)
+	}
+	if retryAddIndex != addIndex {
+		t.Fatalf("expected add index %v, got %v", addIndex,
+			retryAddIndex)
+	}
+
+	// A retry for a different invoice should still be rejected.
+	retry.Terms.Value++
+	_, err = db.AddInvoice(&retry, paymentHash)
+	if err != ErrDuplicateInvoiceToken {
+		t.Fatalf("expected ErrDuplicateInvoiceToken, got %v", err)
+	}
+}
+
+// TestAddInvoiceReplaceCanceled asserts that a payment hash can only be reused
+// for a new invoice if the prior invoice was canceled and the new invoice opts
+// in to replace it.
+func TestAddInvoiceReplaceCanceled(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	cancelInvoice := func(*Invoice) (*InvoiceUpdateDesc, error) {
+		return &InvoiceUpdateDesc{
+			State: ContractCanceled,
+		}, nil
+	}
+
+	// Add an invoice and cancel it.
+	invoice, err := randInvoice(lnwire.NewMAtomsFromAtoms(1000))
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	invoice.IdempotencyToken = []byte("token")
+	paymentHash := invoice.Terms.PaymentPreimage.Hash()
+
+	oldAddIndex, err := db.AddInvoice(invoice, paymentHash)
+	if err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	reissue := *invoice
+	reissue.AddIndex = 0
+	reissue.Memo = []byte("reissued")
+	reissue.ReplaceCanceled = true
+
+	// Replacing the invoice while it is still open should fail. We drop
+	// the token here, as reusing it would be treated as a retry.
+	openReissue := reissue
+	openReissue.IdempotencyToken = nil
+	_, err = db.AddInvoice(&openReissue, paymentHash)
+	if err != ErrDuplicateInvoice {
+		t.Fatalf("expected ErrDuplicateInvoice, got %v", err)
+	}
+
+	if _, err := db.UpdateInvoice(paymentHash, cancelInvoice); err != nil {
+		t.Fatalf("unable to cancel invoice: %v", err)
+	}
+
+	// Without opting in, the canceled invoice's hash can't be reused.
+	noReplace := openReissue
+	noReplace.ReplaceCanceled = false
+	_, err = db.AddInvoice(&noReplace, paymentHash)
+	if err != ErrDuplicateInvoice {
+		t.Fatalf("expected ErrDuplicateInvoice, got %v", err)
+	}
+
+	// Opting in replaces the canceled invoice, even though the token is
+	// reused.
+	newAddIndex, err := db.AddInvoice(&reissue, paymentHash)
+	if err != nil {
+		t.Fatalf("unable to replace canceled invoice: %v", err)
+	}
+	if newAddIndex <= oldAddIndex {
+		t.Fatalf("expected new add index, got %v", newAddIndex)
+	}
+
+	dbInvoice, err := db.LookupInvoice(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to find invoice: %v", err)
+	}
+	if dbInvoice.Terms.State != ContractOpen {
+		t.Fatalf("expected open invoice, got %v",
+			dbInvoice.Terms.State)
+	}
+	if !bytes.Equal(dbInvoice.Memo, reissue.Memo) {
+		t.Fatalf("expected memo %s, got %s", reissue.Memo,
+			dbInvoice.Memo)
+	}
+
+	// The canceled invoice should no longer be returned.
+	invoices, err := db.InvoicesAddedSince(0)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	if len(invoices) != 1 || invoices[0].AddIndex != newAddIndex {
+		t.Fatalf("expected only the replacing invoice, got %v",
+			spew.Sdump(invoices))
+	}
+
+	// Once settled, the invoice can't be replaced anymore.
+	_, err = db.UpdateInvoice(
+		paymentHash, getUpdateInvoice(reissue.Terms.Value),
+	)
+	if err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+
+	settledReissue := openReissue
+	_, err = db.AddInvoice(&settledReissue, paymentHash)
+	if err != ErrDuplicateInvoice {
+		t.Fatalf("expected ErrDuplicateInvoice, got %v", err)
+	}
+}
+
+// TestInvoiceMetadata asserts that invoice metadata is persisted, size capped
+// and can be used to look up invoices.
+func TestInvoiceMetadata(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	amt := lnwire.NewMAtomsFromAtoms(1000)
+	invoices := make([]*Invoice, 3)
+	for i := range invoices {
+		invoice, err := randInvoice(amt)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+		invoices[i] = invoice
+	}
+	invoices[0].Metadata = map[string][]byte{
+		"order":    []byte("1"),
+		"customer": []byte("alice"),
+	}
+	invoices[1].Metadata = map[string][]byte{
+		"order": []byte("2"),
+	}
+
+	for _, invoice := range invoices {
+		hash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.AddInvoice(invoice, hash); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+	}
+
+	// The metadata should be returned as is when looking up the invoice.
+	hash := invoices[0].Terms.PaymentPreimage.Hash()
+	dbInvoice, err := db.LookupInvoice(hash)
+	if err != nil {
+		t.Fatalf("unable to find invoice: %v", err)
+	}
+	if !reflect.DeepEqual(*invoices[0], dbInvoice) {
+		t.Fatalf("invoice fetched from db doesn't match original %v vs %v",
+			spew.Sdump(invoices[0]), spew.Sdump(dbInvoice))
+	}
+
+	// Looking up by metadata should only return the matching invoice.
+	found, err := db.LookupInvoicesByMetadata("order", []byte("2"))
+	if err != nil {
+		t.Fatalf("unable to lookup invoices: %v", err)
+	}
+	if len(found) != 1 || !reflect.DeepEqual(*invoices[1], found[0]) {
+		t.Fatalf("unexpected invoices found: %v", spew.Sdump(found))
+	}
+
+	found, err = db.LookupInvoicesByMetadata("order", []byte("3"))
+	if err != nil {
+		t.Fatalf("unable to lookup invoices: %v", err)
+	}
+	if len(found) != 0 {
+		t.Fatalf("expected no invoices, got %v", len(found))
+	}
+
+	// Metadata exceeding the maximum size should be rejected.
+	invoice, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	invoice.Metadata = map[string][]byte{
+		"big": make([]byte, MaxMetadataSize),
+	}
+	_, err = db.AddInvoice(invoice, invoice.Terms.PaymentPreimage.Hash())
+	if err == nil {
+		t.Fatalf("expected oversized metadata to be rejected")
+	}
+}
+
+// TestKeysendInvoice asserts that the keysend flag and payer pubkey of an
+// invoice round trip through the database, and that regular invoices don't
+// carry them.
+func TestKeysendInvoice(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	amt := lnwire.NewMAtomsFromAtoms(1000)
+
+	// A keysend invoice along with the payer's pubkey should be persisted
+	// as is.
+	invoice, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	invoice.Keysend = true
+	invoice.PayerPubKey = pubKey
+	paymentHash := invoice.Terms.PaymentPreimage.Hash()
+
+	if _, err := db.AddInvoice(invoice, paymentHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	dbInvoice, err := db.LookupInvoice(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to find invoice: %v", err)
+	}
+	if !reflect.DeepEqual(*invoice, dbInvoice) {
+		t.Fatalf("invoice fetched from db doesn't match original %v vs %v",
+			spew.Sdump(invoice), spew.Sdump(dbInvoice))
+	}
+	if !dbInvoice.IsKeysend() {
+		t.Fatalf("expected keysend invoice")
+	}
+
+	// A regular invoice shouldn't be reported as keysend and shouldn't
+	// have a payer pubkey.
+	regular, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	regularHash := regular.Terms.PaymentPreimage.Hash()
+	if _, err := db.AddInvoice(regular, regularHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	dbInvoice, err = db.LookupInvoice(regularHash)
+	if err != nil {
+		t.Fatalf("unable to find invoice: %v", err)
+	}
+	if dbInvoice.IsKeysend() {
+		t.Fatalf("expected regular invoice")
+	}
+	if dbInvoice.PayerPubKey != nil {
+		t.Fatalf("expected no payer pubkey, got %x",
+			dbInvoice.PayerPubKey.SerializeCompressed())
+	}
+
+	// Setting a payer pubkey on a regular invoice should be rejected.
+	other, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	other.PayerPubKey = pubKey
+	_, err = db.AddInvoice(other, other.Terms.PaymentPreimage.Hash())
+	if err == nil {
+		t.Fatalf("expected payer pubkey on regular invoice to be " +
+			"rejected")
+	}
+}
+
+// TestInvoiceAMPSets asserts that the htlcs of two distinct AMP sets paying
+// to the same invoice are persisted with their set id, and that settling the
+// invoice settles the htlcs of the chosen set while canceling the others.
+func TestInvoiceAMPSets(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	invoice, err := randInvoice(500)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	paymentHash := invoice.Terms.PaymentPreimage.Hash()
+	if _, err := db.AddInvoice(invoice, paymentHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	setA := [32]byte{0x01}
+	setB := [32]byte{0x02}
+	keyA1 := CircuitKey{HtlcID: 1}
+	keyA2 := CircuitKey{HtlcID: 2}
+	keyB := CircuitKey{HtlcID: 3}
+
+	// Accept two htlcs of the first set and a single htlc of the second
+	// one.
+	_, err = db.UpdateInvoice(paymentHash,
+		func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				State: ContractOpen,
+				Htlcs: map[CircuitKey]*HtlcAcceptDesc{
+					keyA1: {Amt: 300, AMPSetID: setA},
+					keyA2: {Amt: 200, AMPSetID: setA},
+					keyB:  {Amt: 400, AMPSetID: setB},
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to accept htlcs: %v", err)
+	}
+
+	dbInvoice, err := db.LookupInvoice(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to find invoice: %v", err)
+	}
+	for key, setID := range map[CircuitKey][32]byte{
+		keyA1: setA, keyA2: setA, keyB: setB,
+	} {
+		if dbInvoice.Htlcs[key].AMPSetID != setID {
+			t.Fatalf("expected set id %x for htlc %v, got %x",
+				setID, key, dbInvoice.Htlcs[key].AMPSetID)
+		}
+	}
+	if n := len(dbInvoice.HTLCSet(&setA)); n != 2 {
+		t.Fatalf("expected 2 htlcs in first set, got %v", n)
+	}
+	if n := len(dbInvoice.HTLCSet(&setB)); n != 1 {
+		t.Fatalf("expected 1 htlc in second set, got %v", n)
+	}
+	if n := len(dbInvoice.HTLCSet(nil)); n != 3 {
+		t.Fatalf("expected 3 accepted htlcs, got %v", n)
+	}
+
+	// The second set doesn't pay the full invoice amount, so it can't be
+	// used to settle the invoice.
+	_, err = db.UpdateInvoice(paymentHash,
+		func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				State:    ContractSettled,
+				Preimage: invoice.Terms.PaymentPreimage,
+				SetID:    &setB,
+			}, nil
+		},
+	)
+	if err != ErrAMPSetUnderpaid {
+		t.Fatalf("expected ErrAMPSetUnderpaid, got: %v", err)
+	}
+
+	// Settling the first set should cancel the htlc of the second set in
+	// the same update.
+	_, err = db.UpdateInvoice(paymentHash,
+		func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				State:    ContractSettled,
+				Preimage: invoice.Terms.PaymentPreimage,
+				SetID:    &setA,
+			}, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+
+	dbInvoice, err = db.LookupInvoice(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to find invoice: %v", err)
+	}
+	for key, state := range map[CircuitKey]HtlcState{
+		keyA1: HtlcStateSettled,
+		keyA2: HtlcStateSettled,
+		keyB:  HtlcStateCanceled,
+	} {
+		if dbInvoice.Htlcs[key].State != state {
+			t.Fatalf("expected state %v for htlc %v, got %v",
+				state, key, dbInvoice.Htlcs[key].State)
+		}
+	}
+	if dbInvoice.AmtPaid != 500 {
+		t.Fatalf("expected amount paid 500, got %v", dbInvoice.AmtPaid)
+	}
+}
+
+// TestInvoiceHtlcLimit asserts that an invoice accepts htlcs up to the
+// configured limit, and that an htlc beyond it is rejected without being
+// recorded.
+func TestInvoiceHtlcLimit(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	const maxHtlcs = 3
+	db.maxInvoiceHtlcs = maxHtlcs
+
+	invoice, err := randInvoice(lnwire.NewMAtomsFromAtoms(1000))
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	paymentHash := invoice.Terms.PaymentPreimage.Hash()
+	if _, err := db.AddInvoice(invoice, paymentHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	acceptHtlc := func(htlcID uint64) (*Invoice, error) {
+		return db.UpdateInvoice(paymentHash,
+			func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+				return &InvoiceUpdateDesc{
+					State: ContractOpen,
+					Htlcs: map[CircuitKey]*HtlcAcceptDesc{
+						{HtlcID: htlcID}: {Amt: 100},
+					},
+				}, nil
+			},
+		)
+	}
+
+	for i := uint64(0); i < maxHtlcs; i++ {
+		if _, err := acceptHtlc(i); err != nil {
+			t.Fatalf("unable to accept htlc %v: %v", i, err)
+		}
+	}
+
+	// The next htlc goes beyond the limit and should be rejected.
+	_, err = acceptHtlc(maxHtlcs)
+	if err != ErrInvoiceHtlcLimit {
+		t.Fatalf("expected ErrInvoiceHtlcLimit, got %v", err)
+	}
+
+	dbInvoice, err := db.LookupInvoice(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to find invoice: %v", err)
+	}
+	if len(dbInvoice.Htlcs) != maxHtlcs {
+		t.Fatalf("expected %v htlcs, got %v", maxHtlcs,
+			len(dbInvoice.Htlcs))
+	}
+	if _, ok := dbInvoice.Htlcs[CircuitKey{HtlcID: maxHtlcs}]; ok {
+		t.Fatalf("rejected htlc was recorded")
+	}
+	if dbInvoice.AmtPaid != maxHtlcs*100 {
+		t.Fatalf("expected amount paid %v, got %v", maxHtlcs*100,
+			dbInvoice.AmtPaid)
+	}
+}
+
+// TestSettleInvoicePreimageResolver asserts that an invoice can be settled
+// with a preimage that is either passed inline or fetched through a preimage
+// resolver, and that the fetched preimage must match the payment hash.
+func TestSettleInvoicePreimageResolver(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	errResolver := errors.New("preimage unavailable")
+
+	tests := []struct {
+		name string
+
+		// resolver returns the resolver used to fetch the preimage,
+		// given the actual preimage of the invoice. If nil, the
+		// preimage is passed in the update itself.
+		resolver func(preimage lntypes.Preimage) PreimageResolver
+
+		expectSettled bool
+	}{
+		{
+			name:          "inline preimage",
+			expectSettled: true,
+		},
+		{
+			name: "resolver preimage",
+			resolver: func(p lntypes.Preimage) PreimageResolver {
+				return func(lntypes.Hash) (lntypes.Preimage,
+					error) {
+
+					return p, nil
+				}
+			},
+			expectSettled: true,
+		},
+		{
+			name: "resolver wrong preimage",
+			resolver: func(lntypes.Preimage) PreimageResolver {
+				return func(lntypes.Hash) (lntypes.Preimage,
+					error) {
+
+					return lntypes.Preimage{0x01}, nil
+				}
+			},
+			expectSettled: false,
+		},
+		{
+			name: "resolver error",
+			resolver: func(lntypes.Preimage) PreimageResolver {
+				return func(lntypes.Hash) (lntypes.Preimage,
+					error) {
+
+					return lntypes.Preimage{}, errResolver
+				}
+			},
+			expectSettled: false,
+		},
+	}
+
+	for _, test := range tests {
+		// Add an invoice of which only the payment hash is known.
+		invoice, err := randInvoice(lnwire.NewMAtomsFromAtoms(1000))
+		if err != nil {
+			t.Fatalf("%v: unable to create invoice: %v", test.name,
+				err)
+		}
+		preimage := invoice.Terms.PaymentPreimage
+		paymentHash := preimage.Hash()
+		invoice.Terms.PaymentPreimage = UnknownPreimage
+
+		if _, err := db.AddInvoice(invoice, paymentHash); err != nil {
+			t.Fatalf("%v: unable to add invoice: %v", test.name,
+				err)
+		}
+
+		update := &InvoiceUpdateDesc{
+			State: ContractSettled,
+		}
+		callback := func(*Invoice) (*InvoiceUpdateDesc, error) {
+			return update, nil
+		}
+
+		if test.resolver != nil {
+			_, err = db.UpdateInvoiceWithResolver(
+				paymentHash, test.resolver(preimage), callback,
+			)
+		} else {
+			update.Preimage = preimage
+			_, err = db.UpdateInvoice(paymentHash, callback)
+		}
+		if test.expectSettled && err != nil {
+			t.Fatalf("%v: unable to settle invoice: %v", test.name,
+				err)
+		}
+		if !test.expectSettled && err == nil {
+			t.Fatalf("%v: expected settle to fail", test.name)
+		}
+
+		dbInvoice, err := db.LookupInvoice(paymentHash)
+		if err != nil {
+			t.Fatalf("%v: unable to find invoice: %v", test.name,
+				err)
+		}
+
+		expectedState := ContractOpen
+		expectedPreimage := UnknownPreimage
+		if test.expectSettled {
+			expectedState = ContractSettled
+			expectedPreimage = preimage
+		}
+		if dbInvoice.Terms.State != expectedState {
+			t.Fatalf("%v: expected state %v, got %v", test.name,
+				expectedState, dbInvoice.Terms.State)
+		}
+		if dbInvoice.Terms.PaymentPreimage != expectedPreimage {
+			t.Fatalf("%v: expected preimage %v, got %v", test.name,
+				expectedPreimage, dbInvoice.Terms.PaymentPreimage)
+		}
+	}
+}
+
+// TestDeserializeInvoiceWithoutTail asserts that invoices serialized before
+// the optional fields were appended can still be deserialized.
+func TestDeserializeInvoiceWithoutTail(t *testing.T) {
+	t.Parallel()
+
+	invoice, err := randInvoice(lnwire.NewMAtomsFromAtoms(1000))
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	invoice.Htlcs[CircuitKey{HtlcID: 1}] = &InvoiceHTLC{
+		Amt:         1000,
+		AcceptTime:  time.Unix(1, 0),
+		ResolveTime: time.Unix(2, 0),
+	}
+
+	var b bytes.Buffer
+	if err := serializeInvoice(&b, invoice); err != nil {
+		t.Fatalf("unable to serialize invoice: %v", err)
+	}
+
+	// Without any optional fields, the serialized invoice ends with the
+	// htlc terminator followed by an empty stream. Stripping the
+	// terminator yields the format of older invoices.
+	oldFormat := b.Bytes()[:b.Len()-8]
+
+	dbInvoice, err := deserializeInvoice(bytes.NewReader(oldFormat))
+	if err != nil {
+		t.Fatalf("unable to deserialize invoice: %v", err)
+	}
+	if !reflect.DeepEqual(*invoice, dbInvoice) {
+		t.Fatalf("invoice doesn't match original %v vs %v",
+			spew.Sdump(invoice), spew.Sdump(dbInvoice))
+	}
+}
+
+// TestInvoiceClock asserts that the accept, resolve and settle times recorded
+// for an invoice are taken from the clock the database was opened with.
+func TestInvoiceClock(t *testing.T) {
+	t.Parallel()
+
+	testTime := time.Unix(1500000000, 0)
+	testClock := clock.NewTestClock(testTime)
+
+	db, err := Open("", OptionInMemory(), OptionClock(testClock))
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+	defer db.Close()
+
+	amt := lnwire.NewMAtomsFromAtoms(1000)
+	invoice, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+
+	payHash := invoice.Terms.PaymentPreimage.Hash()
+	if _, err := db.AddInvoice(invoice, payHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	settled, err := db.UpdateInvoice(payHash, getUpdateInvoice(amt))
+	if err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+
+	// Advance the clock, so that a duplicate payment to the settled
+	// invoice is recorded at a different time.
+	resolveTime := testTime.Add(time.Hour)
+	testClock.SetTime(resolveTime)
+
+	duplicateKey := CircuitKey{HtlcID: 1}
+	_, err = db.UpdateInvoice(payHash,
+		func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				State: ContractSettled,
+				Htlcs: map[CircuitKey]*HtlcAcceptDesc{
+					duplicateKey: {Amt: amt},
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to add duplicate htlc: %v", err)
+	}
+
+	dbInvoice, err := db.LookupInvoice(payHash)
+	if err != nil {
+		t.Fatalf("unable to lookup invoice: %v", err)
+	}
+
+	if !settled.SettleDate.Equal(testTime) {
+		t.Fatalf("expected settle date %v, got %v", testTime,
+			settled.SettleDate)
+	}
+	if !dbInvoice.SettleDate.Equal(testTime) {
+		t.Fatalf("expected settle date %v, got %v", testTime,
+			dbInvoice.SettleDate)
+	}
+
+	htlc := dbInvoice.Htlcs[CircuitKey{}]
+	if !htlc.AcceptTime.Equal(testTime) {
+		t.Fatalf("expected accept time %v, got %v", testTime,
+			htlc.AcceptTime)
+	}
+	if !htlc.ResolveTime.Equal(testTime) {
+		t.Fatalf("expected resolve time %v, got %v", testTime,
+			htlc.ResolveTime)
+	}
+
+	duplicate := dbInvoice.Htlcs[duplicateKey]
+	if !duplicate.AcceptTime.Equal(resolveTime) {
+		t.Fatalf("expected accept time %v, got %v", resolveTime,
+			duplicate.AcceptTime)
+	}
+	if !duplicate.ResolveTime.Equal(resolveTime) {
+		t.Fatalf("expected resolve time %v, got %v", resolveTime,
+			duplicate.ResolveTime)
+	}
+}
+
+// TestAddInvoices asserts that a batch of invoices is assigned contiguous add
+// indices, and that a batch containing a duplicate payment hash is aborted as a
+// whole.
+func TestAddInvoices(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// createBatch creates a batch of random invoices along with their
+	// payment hashes.
+	createBatch := func(n int) ([]*Invoice, []lntypes.Hash) {
+		invoices := make([]*Invoice, n)
+		hashes := make([]lntypes.Hash, n)
+		for i := 0; i < n; i++ {
+			invoice, err := randInvoice(lnwire.MilliAtom(i + 1))
+			if err != nil {
+				t.Fatalf("unable to create invoice: %v", err)
+			}
+
+			invoices[i] = invoice
+			hashes[i] = invoice.Terms.PaymentPreimage.Hash()
+		}
+
+		return invoices, hashes
+	}
+
+	// Add a single invoice first, so that the batch doesn't start at the
+	// first add index.
+	invoices, hashes := createBatch(1)
+	if _, err := db.AddInvoice(invoices[0], hashes[0]); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	const batchSize = 10
+	invoices, hashes = createBatch(batchSize)
+	addIndexes, err := db.AddInvoices(invoices, hashes)
+	if err != nil {
+		t.Fatalf("unable to add invoices: %v", err)
+	}
+	if len(addIndexes) != batchSize {
+		t.Fatalf("expected %v add indices, got %v", batchSize,
+			len(addIndexes))
+	}
+
+	batchHashes := hashes
+
+	for i, addIndex := range addIndexes {
+		expectedIndex := uint64(i + 2)
+		if addIndex != expectedIndex {
+			t.Fatalf("expected add index %v, got %v",
+				expectedIndex, addIndex)
+		}
+
+		dbInvoice, err := db.LookupInvoice(hashes[i])
+		if err != nil {
+			t.Fatalf("unable to lookup invoice: %v", err)
+		}
+		if dbInvoice.AddIndex != expectedIndex {
+			t.Fatalf("expected add index %v, got %v",
+				expectedIndex, dbInvoice.AddIndex)
+		}
+		if dbInvoice.Terms.Value != invoices[i].Terms.Value {
+			t.Fatalf("expected value %v, got %v",
+				invoices[i].Terms.Value, dbInvoice.Terms.Value)
+		}
+	}
+
+	// A batch that contains an already known payment hash must be
+	// rejected, without adding any of the other invoices.
+	invoices, hashes = createBatch(3)
+	duplicate, err := randInvoice(1)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	invoices = append(invoices, duplicate)
+	hashes = append(hashes, batchHashes[3])
+
+	_, err = db.AddInvoices(invoices, hashes)
+	if err != ErrDuplicateInvoice {
+		t.Fatalf("expected ErrDuplicateInvoice, got %v", err)
+	}
+	for i, hash := range hashes[:3] {
+		_, err := db.LookupInvoice(hash)
+		if err != ErrInvoiceNotFound {
+			t.Fatalf("expected invoice not found error, got %v",
+				err)
+		}
+		if invoices[i].AddIndex != 0 {
+			t.Fatalf("expected add index to be reset, got %v",
+				invoices[i].AddIndex)
+		}
+	}
+
+	// The same goes for a batch containing a payment hash twice.
+	invoices, hashes = createBatch(2)
+	hashes[1] = hashes[0]
+	_, err = db.AddInvoices(invoices, hashes)
+	if err != ErrDuplicateInvoice {
+		t.Fatalf("expected ErrDuplicateInvoice, got %v", err)
+	}
+	if _, err := db.LookupInvoice(hashes[0]); err != ErrInvoiceNotFound {
+		t.Fatalf("expected invoice not found error, got %v", err)
+	}
+
+	// Since the aborted batches didn't consume any add indices, the next
+	// invoice continues right after the first batch.
+	invoices, hashes = createBatch(1)
+	addIndexes, err = db.AddInvoices(invoices, hashes)
+	if err != nil {
+		t.Fatalf("unable to add invoices: %v", err)
+	}
+	if addIndexes[0] != batchSize+2 {
+		t.Fatalf("expected add index %v, got %v", batchSize+2,
+			addIndexes[0])
 	}
 }
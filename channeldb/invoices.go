@@ -6,8 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"sort"
 	"time"
 
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwire"
@@ -63,6 +66,14 @@ var (
 	//   settleIndexNo => invoiceKey
 	settleIndexBucket = []byte("invoice-settle-index")
 
+	// invoiceTokenIndexBucket is the name of the sub-bucket within the
+	// invoiceBucket which indexes invoices by the idempotency token
+	// supplied by the client that created them. This index allows a client
+	// to safely retry adding an invoice.
+	//
+	// maps: idempotencyToken => payHash
+	invoiceTokenIndexBucket = []byte("invoice-token-index")
+
 	// ErrInvoiceAlreadySettled is returned when the invoice is already
 	// settled.
 	ErrInvoiceAlreadySettled = errors.New("invoice already settled")
@@ -94,6 +105,14 @@ const (
 	// lengths are final.
 	MaxPaymentRequestSize = 4096
 
+	// MaxIdempotencyTokenSize is the maximum size of the idempotency token
+	// that can be supplied when adding an invoice.
+	MaxIdempotencyTokenSize = 64
+
+	// MaxMetadataSize is the maximum total size of the keys and values of
+	// the metadata stored along side an invoice.
+	MaxMetadataSize = 1024
+
 	// A set of tlv type definitions used to serialize invoice htlcs to the
 	// database.
 	chanIDType       tlv.Type = 1
@@ -104,6 +123,14 @@ const (
 	resolveTimeType  tlv.Type = 11
 	expiryHeightType tlv.Type = 13
 	stateType        tlv.Type = 15
+	ampSetIDType     tlv.Type = 17
+
+	// A set of tlv type definitions used to serialize the optional invoice
+	// fields that are appended to the end of the serialized invoice.
+	idempotencyTokenType tlv.Type = 1
+	metadataType         tlv.Type = 3
+	keysendType          tlv.Type = 5
+	payerPubKeyType      tlv.Type = 7
 )
 
 // ContractState describes the state the invoice is in.
@@ -232,6 +259,59 @@ type Invoice struct {
 	// Htlcs records all htlcs that paid to this invoice. Some of these
 	// htlcs may have been marked as canceled.
 	Htlcs map[CircuitKey]*InvoiceHTLC
+
+	// IdempotencyToken is an optional token supplied by the client that
+	// created the invoice. Adding the same invoice with the same token
+	// again returns the existing invoice instead of failing with
+	// ErrDuplicateInvoice.
+	IdempotencyToken []byte
+
+	// ReplaceCanceled allows adding this invoice under the payment hash of
+	// a prior invoice that was canceled, replacing the canceled invoice.
+	// Adding the invoice still fails with ErrDuplicateInvoice if the prior
+	// invoice is open or settled.
+	//
+	// NOTE: This flag is only consulted by AddInvoice and isn't persisted.
+	ReplaceCanceled bool
+
+	// Metadata is an optional set of structured key/value pairs stored
+	// along side the invoice, such as an order id or a customer reference.
+	// Unlike the memo, it can be used to look up invoices.
+	Metadata map[string][]byte
+
+	// Keysend is true if the invoice wasn't added explicitly, but created
+	// implicitly upon receiving a spontaneous payment.
+	Keysend bool
+
+	// PayerPubKey is the node public key of the payer, as optionally
+	// provided along with a spontaneous payment. It is only set for
+	// keysend invoices.
+	PayerPubKey *secp256k1.PublicKey
+}
+
+// IsKeysend returns true if the invoice was created implicitly from a
+// spontaneous payment which carried its own preimage.
+func (i *Invoice) IsKeysend() bool {
+	return i.Keysend && i.Terms.PaymentPreimage != UnknownPreimage
+}
+
+// HTLCSet returns the accepted htlcs of the AMP set with the given id. If no
+// set id is given, all accepted htlcs are returned.
+func (i *Invoice) HTLCSet(setID *[32]byte) map[CircuitKey]*InvoiceHTLC {
+	htlcSet := make(map[CircuitKey]*InvoiceHTLC)
+	for key, htlc := range i.Htlcs {
+		if htlc.State != HtlcStateAccepted {
+			continue
+		}
+
+		if setID != nil && htlc.AMPSetID != *setID {
+			continue
+		}
+
+		htlcSet[key] = htlc
+	}
+
+	return htlcSet
 }
 
 // HtlcState defines the states an htlc paying to an invoice can be in.
@@ -274,6 +354,10 @@ type InvoiceHTLC struct {
 	// canceled htlc isn't just removed from the invoice htlcs map, because
 	// we need AcceptHeight to properly cancel the htlc back.
 	State HtlcState
+
+	// AMPSetID identifies the AMP sub-payment set this htlc belongs to.
+	// It is all zeroes for htlcs that aren't part of an AMP payment.
+	AMPSetID [32]byte
 }
 
 // HtlcAcceptDesc describes the details of a newly accepted htlc.
@@ -286,6 +370,9 @@ type HtlcAcceptDesc struct {
 
 	// Expiry is the expiry height of this htlc.
 	Expiry uint32
+
+	// AMPSetID is the id of the AMP set this htlc belongs to, if any.
+	AMPSetID [32]byte
 }
 
 // InvoiceUpdateDesc describes the changes that should be applied to the
@@ -299,10 +386,20 @@ type InvoiceUpdateDesc struct {
 	// be added. If the map value is nil, the htlc should be canceled.
 	Htlcs map[CircuitKey]*HtlcAcceptDesc
 
-	// Preimage must be set to the preimage when state is settled.
+	// Preimage must be set to the preimage when state is settled, unless
+	// the update is applied through UpdateInvoiceWithResolver.
 	Preimage lntypes.Preimage
+
+	// SetID, if set, restricts the settlement of the invoice to the
+	// accepted htlcs of the AMP set with this id. Accepted htlcs of other
+	// sets are canceled in the same update.
+	SetID *[32]byte
 }
 
+// PreimageResolver is a callback used to fetch the preimage for the given
+// payment hash from an external source.
+type PreimageResolver = func(hash lntypes.Hash) (lntypes.Preimage, error)
+
 // InvoiceUpdateCallback is a callback used in the db transaction to update the
 // invoice.
 type InvoiceUpdateCallback = func(invoice *Invoice) (*InvoiceUpdateDesc, error)
@@ -322,14 +419,45 @@ func validateInvoice(i *Invoice) error {
 			"provided was %v", MaxPaymentRequestSize,
 			len(i.PaymentRequest))
 	}
+	if len(i.IdempotencyToken) > MaxIdempotencyTokenSize {
+		return fmt.Errorf("max length of idempotency token is %v, "+
+			"length provided was %v", MaxIdempotencyTokenSize,
+			len(i.IdempotencyToken))
+	}
+	if metadataSize(i.Metadata) > MaxMetadataSize {
+		return fmt.Errorf("max size of metadata is %v, size provided "+
+			"was %v", MaxMetadataSize, metadataSize(i.Metadata))
+	}
+	if i.PayerPubKey != nil && !i.Keysend {
+		return errors.New("payer pubkey can only be set on keysend " +
+			"invoices")
+	}
 	return nil
 }
 
+// metadataSize returns the total size of the keys and values of the given
+// invoice metadata.
+func metadataSize(metadata map[string][]byte) int {
+	var size int
+	for k, v := range metadata {
+		size += len(k) + len(v)
+	}
+
+	return size
+}
+
 // AddInvoice inserts the targeted invoice into the database. If the invoice has
 // *any* payment hashes which already exists within the database, then the
 // insertion will be aborted and rejected due to the strict policy banning any
 // duplicate payment hashes. A side effect of this function is that it sets
 // AddIndex on newInvoice.
+//
+// If the invoice carries an idempotency token and an invoice with the same
+// payment hash was previously added with the same token, the insertion is
+// treated as a retry and the add index of the existing invoice is returned. A
+// token that was previously used for a different payment hash, or for an
+// invoice with the same payment hash but different contents, results in
+// ErrDuplicateInvoiceToken.
 func (d *DB) AddInvoice(newInvoice *Invoice, paymentHash lntypes.Hash) (
 	uint64, error) {
 
@@ -339,61 +467,193 @@ func (d *DB) AddInvoice(newInvoice *Invoice, paymentHash lntypes.Hash) (
 
 	var invoiceAddIndex uint64
 	err := d.Update(func(tx *bolt.Tx) error {
-		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
-		if err != nil {
-			return err
-		}
+		var err error
+		invoiceAddIndex, err = addInvoice(tx, newInvoice, paymentHash)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
 
-		invoiceIndex, err := invoices.CreateBucketIfNotExists(
-			invoiceIndexBucket,
-		)
-		if err != nil {
-			return err
+	return invoiceAddIndex, err
+}
+
+// AddInvoices inserts the given invoices, paying to the payment hashes at the
+// same positions, into the database within a single transaction. The invoices
+// are assigned contiguous add indices, which are returned in order. If any of
+// the invoices can't be added, for example because its payment hash already
+// exists, the whole batch is aborted and none of the invoices are added.
+//
+// Like AddInvoice, an invoice carrying an idempotency token that was already
+// used for the same invoice is treated as a retry, in which case the add index
+// of the existing invoice is returned for it.
+func (d *DB) AddInvoices(newInvoices []*Invoice, paymentHashes []lntypes.Hash) (
+	[]uint64, error) {
+
+	if len(newInvoices) != len(paymentHashes) {
+		return nil, fmt.Errorf("number of invoices (%v) doesn't match "+
+			"number of payment hashes (%v)", len(newInvoices),
+			len(paymentHashes))
+	}
+
+	for _, newInvoice := range newInvoices {
+		if err := validateInvoice(newInvoice); err != nil {
+			return nil, err
 		}
-		addIndex, err := invoices.CreateBucketIfNotExists(
-			addIndexBucket,
-		)
-		if err != nil {
-			return err
+	}
+
+	var invoiceAddIndexes []uint64
+	err := d.Update(func(tx *bolt.Tx) error {
+		invoiceAddIndexes = make([]uint64, 0, len(newInvoices))
+		for i, newInvoice := range newInvoices {
+			invoiceAddIndex, err := addInvoice(
+				tx, newInvoice, paymentHashes[i],
+			)
+			if err != nil {
+				return err
+			}
+
+			invoiceAddIndexes = append(
+				invoiceAddIndexes, invoiceAddIndex,
+			)
 		}
 
-		// Ensure that an invoice an identical payment hash doesn't
-		// already exist within the index.
-		if invoiceIndex.Get(paymentHash[:]) != nil {
-			return ErrDuplicateInvoice
+		return nil
+	})
+	if err != nil {
+		// As the batch was aborted, none of the add indices that were
+		// set on the invoices are valid.
+		for _, newInvoice := range newInvoices {
+			newInvoice.AddIndex = 0
 		}
 
-		// If the current running payment ID counter hasn't yet been
-		// created, then create it now.
-		var invoiceNum uint32
-		invoiceCounter := invoiceIndex.Get(numInvoicesKey)
-		if invoiceCounter == nil {
-			var scratch [4]byte
-			byteOrder.PutUint32(scratch[:], invoiceNum)
-			err := invoiceIndex.Put(numInvoicesKey, scratch[:])
+		return nil, err
+	}
+
+	return invoiceAddIndexes, nil
+}
+
+// addInvoice inserts the invoice paying to the given payment hash within the
+// passed transaction and returns its add index. See AddInvoice for the
+// handling of duplicate payment hashes and idempotency tokens.
+func addInvoice(tx *bolt.Tx, newInvoice *Invoice, paymentHash lntypes.Hash) (
+	uint64, error) {
+
+	invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
+	if err != nil {
+		return 0, err
+	}
+
+	invoiceIndex, err := invoices.CreateBucketIfNotExists(
+		invoiceIndexBucket,
+	)
+	if err != nil {
+		return 0, err
+	}
+	addIndex, err := invoices.CreateBucketIfNotExists(
+		addIndexBucket,
+	)
+	if err != nil {
+		return 0, err
+	}
+	tokenIndex, err := invoices.CreateBucketIfNotExists(
+		invoiceTokenIndexBucket,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// If the client supplied an idempotency token that we already
+	// know of, then this is either a retry of a prior insertion, or
+	// an attempt to reuse the token for another invoice.
+	token := newInvoice.IdempotencyToken
+	if len(token) != 0 {
+		tokenHash := tokenIndex.Get(token)
+		switch {
+		case tokenHash == nil:
+
+		case !bytes.Equal(tokenHash, paymentHash[:]):
+			return 0, ErrDuplicateInvoiceToken
+
+		default:
+			invoiceNum := invoiceIndex.Get(paymentHash[:])
+			invoice, err := fetchInvoice(invoiceNum, invoices)
 			if err != nil {
-				return err
+				return 0, err
 			}
-		} else {
-			invoiceNum = byteOrder.Uint32(invoiceCounter)
+
+			// A canceled invoice that is about to be
+			// replaced isn't a prior insertion of this
+			// one.
+			if newInvoice.ReplaceCanceled &&
+				invoice.Terms.State == ContractCanceled {
+
+				break
+			}
+
+			// A retry must request the same payment as
+			// the prior insertion, otherwise the token is
+			// being reused for a different invoice.
+			if !sameInvoiceRequest(newInvoice, &invoice) {
+				return 0, ErrDuplicateInvoiceToken
+			}
+
+			newInvoice.AddIndex = invoice.AddIndex
+			return invoice.AddIndex, nil
+		}
+	}
+
+	// Ensure that an invoice an identical payment hash doesn't
+	// already exist within the index, unless the caller opted in
+	// to replace a canceled one.
+	oldInvoiceNum := invoiceIndex.Get(paymentHash[:])
+	if oldInvoiceNum != nil {
+		if !newInvoice.ReplaceCanceled {
+			return 0, ErrDuplicateInvoice
 		}
 
-		newIndex, err := putInvoice(
-			invoices, invoiceIndex, addIndex, newInvoice, invoiceNum,
+		err := removeCanceledInvoice(
+			invoices, addIndex, tokenIndex, oldInvoiceNum,
 			paymentHash,
 		)
 		if err != nil {
-			return err
+			return 0, err
 		}
+	}
 
-		invoiceAddIndex = newIndex
-		return nil
-	})
+	// If the current running payment ID counter hasn't yet been
+	// created, then create it now.
+	var invoiceNum uint32
+	invoiceCounter := invoiceIndex.Get(numInvoicesKey)
+	if invoiceCounter == nil {
+		var scratch [4]byte
+		byteOrder.PutUint32(scratch[:], invoiceNum)
+		err := invoiceIndex.Put(numInvoicesKey, scratch[:])
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		invoiceNum = byteOrder.Uint32(invoiceCounter)
+	}
+
+	newIndex, err := putInvoice(
+		invoices, invoiceIndex, addIndex, newInvoice, invoiceNum,
+		paymentHash,
+	)
 	if err != nil {
 		return 0, err
 	}
 
-	return invoiceAddIndex, err
+	// Index the invoice by its idempotency token, if any, so that
+	// a retry of this insertion can be detected.
+	if len(token) != 0 {
+		err := tokenIndex.Put(token, paymentHash[:])
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return newIndex, nil
 }
 
 // InvoicesAddedSince can be used by callers to seek into the event time series
@@ -405,14 +665,34 @@ func (d *DB) AddInvoice(newInvoice *Invoice, paymentHash lntypes.Hash) (
 // NOTE: The index starts from 1, as a result. We enforce that specifying a
 // value below the starting index value is a noop.
 func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
-	var newInvoices []Invoice
-
 	// If an index of zero was specified, then in order to maintain
 	// backwards compat, we won't send out any new invoices.
 	if sinceAddIndex == 0 {
-		return newInvoices, nil
+		return nil, nil
 	}
 
+	newInvoices, err := d.InvoicesAddedSinceStrict(sinceAddIndex)
+	switch {
+	// If no invoices have been created, then we'll return the empty set of
+	// invoices.
+	case err == ErrNoInvoicesCreated:
+
+	case err != nil:
+		return nil, err
+	}
+
+	return newInvoices, nil
+}
+
+// InvoicesAddedSinceStrict behaves like InvoicesAddedSince, but returns
+// ErrNoInvoicesCreated if no invoice has ever been added to the database,
+// allowing callers to distinguish that case from there being no invoices
+// newer than sinceAddIndex. This is checked even if sinceAddIndex is zero.
+func (d *DB) InvoicesAddedSinceStrict(sinceAddIndex uint64) ([]Invoice,
+	error) {
+
+	var newInvoices []Invoice
+
 	var startIndex [8]byte
 	byteOrder.PutUint64(startIndex[:], sinceAddIndex)
 
@@ -427,6 +707,12 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 			return ErrNoInvoicesCreated
 		}
 
+		// An index of zero never yields any invoices, see
+		// InvoicesAddedSince.
+		if sinceAddIndex == 0 {
+			return nil
+		}
+
 		// We'll now run through each entry in the add index starting
 		// at our starting index. We'll continue until we reach the
 		// very end of the current key space.
@@ -451,12 +737,7 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 
 		return nil
 	})
-	switch {
-	// If no invoices have been created, then we'll return the empty set of
-	// invoices.
-	case err == ErrNoInvoicesCreated:
-
-	case err != nil:
+	if err != nil {
 		return nil, err
 	}
 
@@ -549,14 +830,141 @@ func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 	return invoices, nil
 }
 
+// InvoiceStats is a summary of the invoices within the database.
+type InvoiceStats struct {
+	// NumOpen is the number of open invoices.
+	NumOpen uint64
+
+	// NumAccepted is the number of invoices with accepted, but not yet
+	// settled, htlcs.
+	NumAccepted uint64
+
+	// NumSettled is the number of settled invoices.
+	NumSettled uint64
+
+	// NumCanceled is the number of canceled invoices.
+	NumCanceled uint64
+
+	// TotalSettled is the total amount paid to settled invoices.
+	TotalSettled lnwire.MilliAtom
+}
+
+// InvoiceStats returns a summary of the invoices within the database, tallying
+// the number of invoices in each state, and the total amount paid to settled
+// invoices.
+func (d *DB) InvoiceStats() (InvoiceStats, error) {
+	var stats InvoiceStats
+
+	err := d.View(func(tx *bolt.Tx) error {
+		invoiceB := tx.Bucket(invoiceBucket)
+		if invoiceB == nil {
+			return nil
+		}
+
+		return invoiceB.ForEach(func(k, v []byte) error {
+			if v == nil {
+				return nil
+			}
+
+			invoice, err := deserializeInvoice(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			switch invoice.Terms.State {
+			case ContractOpen:
+				stats.NumOpen++
+
+			case ContractAccepted:
+				stats.NumAccepted++
+
+			case ContractSettled:
+				stats.NumSettled++
+				stats.TotalSettled += invoice.AmtPaid
+
+			case ContractCanceled:
+				stats.NumCanceled++
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return InvoiceStats{}, err
+	}
+
+	return stats, nil
+}
+
+// LookupInvoicesByMetadata returns all invoices that have the given value
+// stored under the given metadata key. As the metadata isn't indexed, this
+// scans all invoices in the database.
+func (d *DB) LookupInvoicesByMetadata(key string, value []byte) ([]Invoice,
+	error) {
+
+	var invoices []Invoice
+
+	err := d.View(func(tx *bolt.Tx) error {
+		invoiceB := tx.Bucket(invoiceBucket)
+		if invoiceB == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		return invoiceB.ForEach(func(k, v []byte) error {
+			if v == nil {
+				return nil
+			}
+
+			invoiceReader := bytes.NewReader(v)
+			invoice, err := deserializeInvoice(invoiceReader)
+			if err != nil {
+				return err
+			}
+
+			metaValue, ok := invoice.Metadata[key]
+			if !ok || !bytes.Equal(metaValue, value) {
+				return nil
+			}
+
+			invoices = append(invoices, invoice)
+
+			return nil
+		})
+	})
+	if err != nil && err != ErrNoInvoicesCreated {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+// InvoiceIndexType denotes the index an InvoiceQuery pages through.
+type InvoiceIndexType uint8
+
+const (
+	// InvoiceAddIndex pages through invoices in the order they were
+	// added.
+	InvoiceAddIndex InvoiceIndexType = 0
+
+	// InvoiceSettleIndex pages through settled invoices in the order they
+	// were settled.
+	InvoiceSettleIndex InvoiceIndexType = 1
+)
+
 // InvoiceQuery represents a query to the invoice database. The query allows a
 // caller to retrieve all invoices starting from a particular add index and
 // limit the number of results returned.
 type InvoiceQuery struct {
 	// IndexOffset is the offset within the add indices to start at. This
-	// can be used to start the response at a particular invoice.
+	// can be used to start the response at a particular invoice. If
+	// IndexType is InvoiceSettleIndex, this is an offset within the settle
+	// indices instead.
 	IndexOffset uint64
 
+	// IndexType is the index that the query pages through. By default,
+	// invoices are returned in the order they were added.
+	IndexType InvoiceIndexType
+
 	// NumMaxInvoices is the maximum number of invoices that should be
 	// starting from the add index.
 	NumMaxInvoices uint64
@@ -568,6 +976,13 @@ type InvoiceQuery struct {
 	// Reversed, if set, indicates that the invoices returned should start
 	// from the IndexOffset and go backwards.
 	Reversed bool
+
+	// ScanLimitMultiplier, if non-zero, bounds the number of index entries
+	// examined by the query to this multiple of NumMaxInvoices. This
+	// prevents filtered queries, such as PendingOnly ones, from scanning
+	// the entire index when few invoices match. If the limit is reached,
+	// a partial result is returned along with a ContinuationOffset.
+	ScanLimitMultiplier uint64
 }
 
 // InvoiceSlice is the response to a invoice query. It includes the original
@@ -593,15 +1008,38 @@ type InvoiceSlice struct {
 	// in the event that the slice has too many events to fit into a single
 	// response.
 	LastIndexOffset uint64
+
+	// ScanLimitReached is true if the query stopped early because it
+	// examined the maximum number of index entries allowed by
+	// ScanLimitMultiplier.
+	ScanLimitReached bool
+
+	// ContinuationOffset is the index of the last entry examined by the
+	// query, regardless of whether it matched. If ScanLimitReached is set,
+	// callers can use it as the IndexOffset of a subsequent query to
+	// resume scanning where this one stopped.
+	ContinuationOffset uint64
 }
 
 // QueryInvoices allows a caller to query the invoice database for invoices
-// within the specified add index range.
+// within the specified add or settle index range.
 func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 	resp := InvoiceSlice{
 		InvoiceQuery: q,
 	}
 
+	// Determine which index we'll be paging through.
+	var indexBucket []byte
+	switch q.IndexType {
+	case InvoiceAddIndex:
+		indexBucket = addIndexBucket
+	case InvoiceSettleIndex:
+		indexBucket = settleIndexBucket
+	default:
+		return resp, fmt.Errorf("unknown invoice index type: %v",
+			q.IndexType)
+	}
+
 	err := d.View(func(tx *bolt.Tx) error {
 		// If the bucket wasn't found, then there aren't any invoices
 		// within the database yet, so we can simply exit.
@@ -609,22 +1047,24 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 		if invoices == nil {
 			return ErrNoInvoicesCreated
 		}
-		invoiceAddIndex := invoices.Bucket(addIndexBucket)
-		if invoiceAddIndex == nil {
+		invoiceIndex := invoices.Bucket(indexBucket)
+		if invoiceIndex == nil {
 			return ErrNoInvoicesCreated
 		}
 
-		// keyForIndex is a helper closure that retrieves the invoice
-		// key for the given add index of an invoice.
-		keyForIndex := func(c *bolt.Cursor, index uint64) []byte {
+		// keyForIndex is a helper closure that retrieves the index key
+		// and invoice key for the given add or settle index of an
+		// invoice.
+		keyForIndex := func(c *bolt.Cursor, index uint64) ([]byte,
+			[]byte) {
+
 			var keyIndex [8]byte
 			byteOrder.PutUint64(keyIndex[:], index)
-			_, invoiceKey := c.Seek(keyIndex[:])
-			return invoiceKey
+			return c.Seek(keyIndex[:])
 		}
 
 		// nextKey is a helper closure to determine what the next
-		// invoice key is when iterating over the invoice add index.
+		// invoice key is when iterating over the invoice index.
 		nextKey := func(c *bolt.Cursor) ([]byte, []byte) {
 			if q.Reversed {
 				return c.Prev()
@@ -635,8 +1075,8 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 		// We'll be using a cursor to seek into the database and return
 		// a slice of invoices. We'll need to determine where to start
 		// our cursor depending on the parameters set within the query.
-		c := invoiceAddIndex.Cursor()
-		invoiceKey := keyForIndex(c, q.IndexOffset+1)
+		c := invoiceIndex.Cursor()
+		indexKey, invoiceKey := keyForIndex(c, q.IndexOffset+1)
 
 		// If the query is specifying reverse iteration, then we must
 		// handle a few offset cases.
@@ -647,7 +1087,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 			// specified. In that case we just start from the last
 			// invoice.
 			case 0:
-				_, invoiceKey = c.Last()
+				indexKey, invoiceKey = c.Last()
 
 			// This indicates the offset being set to the very
 			// first invoice. Since there are no invoices before
@@ -659,21 +1099,39 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 			// Otherwise we start iteration at the invoice prior to
 			// the offset.
 			default:
-				invoiceKey = keyForIndex(c, q.IndexOffset-1)
+				indexKey, invoiceKey = keyForIndex(
+					c, q.IndexOffset-1,
+				)
 			}
 		}
 
+		// Determine the maximum number of index entries we're allowed
+		// to examine, if bounded.
+		var scanLimit, numScanned uint64
+		if q.ScanLimitMultiplier != 0 {
+			scanLimit = q.NumMaxInvoices * q.ScanLimitMultiplier
+		}
+
 		// If we know that a set of invoices exists, then we'll begin
 		// our seek through the bucket in order to satisfy the query.
-		// We'll continue until either we reach the end of the range, or
-		// reach our max number of invoices.
-		for ; invoiceKey != nil; _, invoiceKey = nextKey(c) {
+		// We'll continue until either we reach the end of the range,
+		// reach our max number of invoices, or exhaust our scan limit.
+		for ; invoiceKey != nil; indexKey, invoiceKey = nextKey(c) {
 			// If our current return payload exceeds the max number
 			// of invoices, then we'll exit now.
 			if uint64(len(resp.Invoices)) >= q.NumMaxInvoices {
 				break
 			}
 
+			// Likewise if we've already examined as many entries
+			// as we're allowed to.
+			if scanLimit != 0 && numScanned >= scanLimit {
+				resp.ScanLimitReached = true
+				break
+			}
+			numScanned++
+			resp.ContinuationOffset = byteOrder.Uint64(indexKey)
+
 			invoice, err := fetchInvoice(invoiceKey, invoices)
 			if err != nil {
 				return err
@@ -692,7 +1150,7 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 			resp.Invoices = append(resp.Invoices, invoice)
 		}
 
-		// If we iterated through the add index in reverse order, then
+		// If we iterated through the index in reverse order, then
 		// we'll need to reverse the slice of invoices to return them in
 		// forward order.
 		if q.Reversed {
@@ -713,8 +1171,16 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 	// Finally, record the indexes of the first and last invoices returned
 	// so that the caller can resume from this point later on.
 	if len(resp.Invoices) > 0 {
-		resp.FirstIndexOffset = resp.Invoices[0].AddIndex
-		resp.LastIndexOffset = resp.Invoices[len(resp.Invoices)-1].AddIndex
+		first := &resp.Invoices[0]
+		last := &resp.Invoices[len(resp.Invoices)-1]
+
+		if q.IndexType == InvoiceSettleIndex {
+			resp.FirstIndexOffset = first.SettleIndex
+			resp.LastIndexOffset = last.SettleIndex
+		} else {
+			resp.FirstIndexOffset = first.AddIndex
+			resp.LastIndexOffset = last.AddIndex
+		}
 	}
 
 	return resp, nil
@@ -730,6 +1196,33 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 func (d *DB) UpdateInvoice(paymentHash lntypes.Hash,
 	callback InvoiceUpdateCallback) (*Invoice, error) {
 
+	return d.updateInvoiceTx(paymentHash, UnknownPreimage, callback)
+}
+
+// UpdateInvoiceWithResolver is identical to UpdateInvoice, but first fetches
+// the preimage of the invoice through the given resolver. If the update
+// settles the invoice without specifying a preimage, the resolved one is
+// used. The preimage is resolved before the database transaction is started,
+// so a slow external source doesn't hold up other writers.
+func (d *DB) UpdateInvoiceWithResolver(paymentHash lntypes.Hash,
+	resolver PreimageResolver, callback InvoiceUpdateCallback) (*Invoice,
+	error) {
+
+	preimage, err := resolver(paymentHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve preimage: %v", err)
+	}
+
+	return d.updateInvoiceTx(paymentHash, preimage, callback)
+}
+
+// updateInvoiceTx applies the update obtained from the callback to the invoice
+// with the given payment hash in a single database transaction. The resolved
+// preimage is used to settle the invoice if the update doesn't specify one.
+func (d *DB) updateInvoiceTx(paymentHash lntypes.Hash,
+	resolvedPreimage lntypes.Preimage,
+	callback InvoiceUpdateCallback) (*Invoice, error) {
+
 	var updatedInvoice *Invoice
 	err := d.Update(func(tx *bolt.Tx) error {
 		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
@@ -758,7 +1251,7 @@ func (d *DB) UpdateInvoice(paymentHash lntypes.Hash,
 
 		updatedInvoice, err = d.updateInvoice(
 			paymentHash, invoices, settleIndex, invoiceNum,
-			callback,
+			resolvedPreimage, callback,
 		)
 
 		return err
@@ -828,6 +1321,169 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 	return settledInvoices, nil
 }
 
+// FetchSettledInvoicesInRange returns all invoices with a settle index
+// between fromIndex and toIndex, both inclusive, in the order they were
+// settled. This allows callers to page through the settle index.
+func (d *DB) FetchSettledInvoicesInRange(fromIndex, toIndex uint64) ([]Invoice,
+	error) {
+
+	return d.fetchSettledInvoices(fromIndex, toIndex)
+}
+
+// FetchSettledInvoicesInTimeRange returns the invoices that were settled
+// between the start and end time, both inclusive, in the order they were
+// settled. Like QueryInvoices, only invoices with a settle index above
+// indexOffset are returned, and at most numMaxInvoices of them, so callers can
+// page through the range by passing the settle index of the last invoice
+// returned as the next offset. A numMaxInvoices of zero returns all invoices
+// in the range.
+//
+// NOTE: As invoices are settled in order, the settle index is sorted by settle
+// date, which allows the start of the range to be located through a binary
+// search of the index rather than scanning every settled invoice.
+func (d *DB) FetchSettledInvoicesInTimeRange(start, end time.Time,
+	indexOffset, numMaxInvoices uint64) ([]Invoice, error) {
+
+	var settledInvoices []Invoice
+
+	// An offset at the very end of the index leaves nothing to return.
+	if indexOffset == math.MaxUint64 {
+		return settledInvoices, nil
+	}
+
+	err := d.DB.View(func(tx *bolt.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		settleIndex := invoices.Bucket(settleIndexBucket)
+		if settleIndex == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		invoiceCursor := settleIndex.Cursor()
+		lastSeqNo, _ := invoiceCursor.Last()
+		if lastSeqNo == nil {
+			return nil
+		}
+
+		// We'll binary search the settle index past the offset for
+		// the first invoice settled no earlier than the start time.
+		// Should every invoice be settled earlier, the search ends
+		// past the last settle index.
+		low := indexOffset + 1
+		high := byteOrder.Uint64(lastSeqNo) + 1
+		for low < high {
+			mid := low + (high-low)/2
+
+			var midKey [8]byte
+			byteOrder.PutUint64(midKey[:], mid)
+			seqNo, invoiceKey := invoiceCursor.Seek(midKey[:])
+
+			invoice, err := fetchInvoice(invoiceKey, invoices)
+			if err != nil {
+				return err
+			}
+
+			// As the settle index may have gaps, the entry found
+			// can lie beyond mid. If it was settled too early, so
+			// were all entries up to it.
+			if invoice.SettleDate.Before(start) {
+				low = byteOrder.Uint64(seqNo) + 1
+			} else {
+				high = mid
+			}
+		}
+
+		// With the start of the range found, we'll run through the
+		// settle index until we've passed the end time, or returned
+		// as many invoices as requested.
+		var startKey [8]byte
+		byteOrder.PutUint64(startKey[:], low)
+		seqNo, invoiceKey := invoiceCursor.Seek(startKey[:])
+		for ; seqNo != nil; seqNo, invoiceKey = invoiceCursor.Next() {
+			if numMaxInvoices != 0 &&
+				uint64(len(settledInvoices)) >= numMaxInvoices {
+
+				break
+			}
+
+			invoice, err := fetchInvoice(invoiceKey, invoices)
+			if err != nil {
+				return err
+			}
+
+			if invoice.SettleDate.After(end) {
+				break
+			}
+
+			settledInvoices = append(settledInvoices, invoice)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return settledInvoices, nil
+}
+
+// fetchSettledInvoices returns all invoices with a settle index between
+// fromIndex and toIndex, both inclusive.
+func (d *DB) fetchSettledInvoices(fromIndex, toIndex uint64) ([]Invoice,
+	error) {
+
+	var settledInvoices []Invoice
+
+	// The settle index starts from 1, so we'll clamp the start of the
+	// range to it. An empty range can be returned right away.
+	if fromIndex == 0 {
+		fromIndex = 1
+	}
+	if fromIndex > toIndex {
+		return settledInvoices, nil
+	}
+
+	var startIndex, endIndex [8]byte
+	byteOrder.PutUint64(startIndex[:], fromIndex)
+	byteOrder.PutUint64(endIndex[:], toIndex)
+
+	err := d.DB.View(func(tx *bolt.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		settleIndex := invoices.Bucket(settleIndexBucket)
+		if settleIndex == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		// We'll seek to the start of the range, and run through the
+		// settle index until we've passed its end.
+		invoiceCursor := settleIndex.Cursor()
+		seqNo, invoiceKey := invoiceCursor.Seek(startIndex[:])
+		for seqNo != nil && bytes.Compare(seqNo, endIndex[:]) <= 0 {
+			invoice, err := fetchInvoice(invoiceKey, invoices)
+			if err != nil {
+				return err
+			}
+
+			settledInvoices = append(settledInvoices, invoice)
+			seqNo, invoiceKey = invoiceCursor.Next()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return settledInvoices, nil
+}
+
 func putInvoice(invoices, invoiceIndex, addIndex *bolt.Bucket,
 	i *Invoice, invoiceNum uint32, paymentHash lntypes.Hash) (
 	uint64, error) {
@@ -886,6 +1542,42 @@ func putInvoice(invoices, invoiceIndex, addIndex *bolt.Bucket,
 	return nextAddSeqNo, nil
 }
 
+// removeCanceledInvoice removes the invoice with the given invoice number from
+// the invoice bucket and the indexes referring to it, so that its payment hash
+// can be reused. ErrDuplicateInvoice is returned if the invoice isn't
+// canceled. The payment hash index entry is left in place, to be overwritten
+// by the replacing invoice.
+func removeCanceledInvoice(invoices, addIndex, tokenIndex *bolt.Bucket,
+	invoiceNum []byte, paymentHash lntypes.Hash) error {
+
+	invoice, err := fetchInvoice(invoiceNum, invoices)
+	if err != nil {
+		return err
+	}
+
+	if invoice.Terms.State != ContractCanceled {
+		return ErrDuplicateInvoice
+	}
+
+	var seqNoBytes [8]byte
+	byteOrder.PutUint64(seqNoBytes[:], invoice.AddIndex)
+	if err := addIndex.Delete(seqNoBytes[:]); err != nil {
+		return err
+	}
+
+	// Only remove the token if it still refers to this payment hash.
+	token := invoice.IdempotencyToken
+	if len(token) != 0 &&
+		bytes.Equal(tokenIndex.Get(token), paymentHash[:]) {
+
+		if err := tokenIndex.Delete(token); err != nil {
+			return err
+		}
+	}
+
+	return invoices.Delete(invoiceNum)
+}
+
 // serializeInvoice serializes an invoice to a writer.
 //
 // Note: this function is in use for a migration. Before making changes that
@@ -956,9 +1648,146 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 		return err
 	}
 
+	// Terminate the list of htlcs with a zero length stream, which can't
+	// be a valid htlc, and append the optional invoice fields.
+	if err := binary.Write(w, byteOrder, uint64(0)); err != nil {
+		return err
+	}
+
+	return serializeInvoiceTail(w, i)
+}
+
+// serializeInvoiceTail serializes the optional invoice fields as a tlv stream
+// to a writer. Fields that aren't set are omitted from the stream.
+func serializeInvoiceTail(w io.Writer, i *Invoice) error {
+	var records []tlv.Record
+	if len(i.IdempotencyToken) != 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			idempotencyTokenType, &i.IdempotencyToken,
+		))
+	}
+
+	if len(i.Metadata) != 0 {
+		var b bytes.Buffer
+		if err := serializeMetadata(&b, i.Metadata); err != nil {
+			return err
+		}
+		metadata := b.Bytes()
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			metadataType, &metadata,
+		))
+	}
+
+	if i.Keysend {
+		keysend := uint8(1)
+		records = append(records, tlv.MakePrimitiveRecord(
+			keysendType, &keysend,
+		))
+	}
+
+	if i.PayerPubKey != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			payerPubKeyType, &i.PayerPubKey,
+		))
+	}
+
+	tlvStream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// deserializeInvoiceTail reads the optional invoice fields from a tlv stream
+// into the passed invoice.
+func deserializeInvoiceTail(r io.Reader, i *Invoice) error {
+	var (
+		metadata []byte
+		keysend  uint8
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(
+			idempotencyTokenType, &i.IdempotencyToken,
+		),
+		tlv.MakePrimitiveRecord(metadataType, &metadata),
+		tlv.MakePrimitiveRecord(keysendType, &keysend),
+		tlv.MakePrimitiveRecord(payerPubKeyType, &i.PayerPubKey),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return err
+	}
+
+	i.Keysend = keysend != 0
+
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	i.Metadata, err = deserializeMetadata(bytes.NewReader(metadata))
+	return err
+}
+
+// serializeMetadata serializes the invoice metadata as a list of key/value
+// pairs, sorted by key, to a writer.
+func serializeMetadata(w io.Writer, metadata map[string][]byte) error {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	err := wire.WriteVarInt(w, 0, uint64(len(keys)))
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		if err := wire.WriteVarString(w, 0, k); err != nil {
+			return err
+		}
+		if err := wire.WriteVarBytes(w, 0, metadata[k]); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// deserializeMetadata reads the invoice metadata from a reader.
+func deserializeMetadata(r io.Reader) (map[string][]byte, error) {
+	numPairs, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if numPairs > MaxMetadataSize {
+		return nil, fmt.Errorf("invalid number of metadata pairs: %v",
+			numPairs)
+	}
+
+	metadata := make(map[string][]byte, numPairs)
+	for i := uint64(0); i < numPairs; i++ {
+		k, err := wire.ReadVarString(r, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := wire.ReadVarBytes(r, 0, MaxMetadataSize, "metadata")
+		if err != nil {
+			return nil, err
+		}
+
+		metadata[k] = v
+	}
+
+	return metadata, nil
+}
+
 // serializeHtlcs serializes a map containing circuit keys and invoice htlcs to
 // a writer.
 func serializeHtlcs(w io.Writer, htlcs map[CircuitKey]*InvoiceHTLC) error {
@@ -970,7 +1799,7 @@ func serializeHtlcs(w io.Writer, htlcs map[CircuitKey]*InvoiceHTLC) error {
 		resolveTime := uint64(htlc.ResolveTime.UnixNano())
 		state := uint8(htlc.State)
 
-		tlvStream, err := tlv.NewStream(
+		records := []tlv.Record{
 			tlv.MakePrimitiveRecord(chanIDType, &chanID),
 			tlv.MakePrimitiveRecord(htlcIDType, &key.HtlcID),
 			tlv.MakePrimitiveRecord(amtType, &amt),
@@ -981,7 +1810,16 @@ func serializeHtlcs(w io.Writer, htlcs map[CircuitKey]*InvoiceHTLC) error {
 			tlv.MakePrimitiveRecord(resolveTimeType, &resolveTime),
 			tlv.MakePrimitiveRecord(expiryHeightType, &htlc.Expiry),
 			tlv.MakePrimitiveRecord(stateType, &state),
-		)
+		}
+
+		// Only htlcs that are part of an AMP payment carry a set id.
+		if htlc.AMPSetID != ([32]byte{}) {
+			records = append(records, tlv.MakePrimitiveRecord(
+				ampSetIDType, &htlc.AMPSetID,
+			))
+		}
+
+		tlvStream, err := tlv.NewStream(records...)
 		if err != nil {
 			return err
 		}
@@ -1090,11 +1928,18 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 		return Invoice{}, err
 	}
 
+	// Invoices serialized before the optional fields were introduced end
+	// right after the htlcs, in which case decoding the empty remainder
+	// is a noop.
+	if err := deserializeInvoiceTail(r, &invoice); err != nil {
+		return Invoice{}, err
+	}
+
 	return invoice, nil
 }
 
 // deserializeHtlcs reads a list of invoice htlcs from a reader and returns it
-// as a map.
+// as a map. The list ends either at EOF or with a zero length stream.
 func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 	htlcs := make(map[CircuitKey]*InvoiceHTLC)
 
@@ -1109,6 +1954,11 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 			return nil, err
 		}
 
+		// A zero length stream terminates the list of htlcs.
+		if streamLen == 0 {
+			break
+		}
+
 		streamBytes := make([]byte, streamLen)
 		if _, err := r.Read(streamBytes); err != nil {
 			return nil, err
@@ -1135,6 +1985,7 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 			tlv.MakePrimitiveRecord(resolveTimeType, &resolveTime),
 			tlv.MakePrimitiveRecord(expiryHeightType, &htlc.Expiry),
 			tlv.MakePrimitiveRecord(stateType, &state),
+			tlv.MakePrimitiveRecord(ampSetIDType, &htlc.AMPSetID),
 		)
 		if err != nil {
 			return nil, err
@@ -1156,6 +2007,41 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 	return htlcs, nil
 }
 
+// sameInvoiceRequest returns true if both invoices request the same payment,
+// ignoring any state that is assigned when the invoice is added or paid.
+func sameInvoiceRequest(a, b *Invoice) bool {
+	// The preimage of an invoice added without one is filled in once it
+	// is settled, so it can only be compared if both sides know it.
+	// Otherwise the payment hash, which matched already, decides.
+	aPreimage := a.Terms.PaymentPreimage
+	bPreimage := b.Terms.PaymentPreimage
+	if aPreimage != UnknownPreimage && bPreimage != UnknownPreimage &&
+		aPreimage != bPreimage {
+
+		return false
+	}
+
+	if !bytes.Equal(a.Memo, b.Memo) ||
+		!bytes.Equal(a.Receipt, b.Receipt) ||
+		!bytes.Equal(a.PaymentRequest, b.PaymentRequest) ||
+		a.FinalCltvDelta != b.FinalCltvDelta ||
+		a.Expiry != b.Expiry ||
+		a.Terms.Value != b.Terms.Value ||
+		len(a.Metadata) != len(b.Metadata) {
+
+		return false
+	}
+
+	for k, v := range a.Metadata {
+		other, ok := b.Metadata[k]
+		if !ok || !bytes.Equal(v, other) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // copySlice allocates a new slice and copies the source into it.
 func copySlice(src []byte) []byte {
 	dest := make([]byte, len(src))
@@ -1179,19 +2065,34 @@ func copyInvoice(src *Invoice) *Invoice {
 		Htlcs: make(
 			map[CircuitKey]*InvoiceHTLC, len(src.Htlcs),
 		),
+		IdempotencyToken: copySlice(src.IdempotencyToken),
+		Keysend:          src.Keysend,
+	}
+
+	if src.PayerPubKey != nil {
+		payerPubKey := *src.PayerPubKey
+		dest.PayerPubKey = &payerPubKey
 	}
 
 	for k, v := range src.Htlcs {
 		dest.Htlcs[k] = v
 	}
 
+	if src.Metadata != nil {
+		dest.Metadata = make(map[string][]byte, len(src.Metadata))
+		for k, v := range src.Metadata {
+			dest.Metadata[k] = copySlice(v)
+		}
+	}
+
 	return &dest
 }
 
 // updateInvoice fetches the invoice, obtains the update descriptor from the
 // callback and applies the updates in a single db transaction.
 func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bolt.Bucket,
-	invoiceNum []byte, callback InvoiceUpdateCallback) (*Invoice, error) {
+	invoiceNum []byte, resolvedPreimage lntypes.Preimage,
+	callback InvoiceUpdateCallback) (*Invoice, error) {
 
 	invoice, err := fetchInvoice(invoiceNum, invoices)
 	if err != nil {
@@ -1210,6 +2111,22 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bolt.Bucket
 		return &invoice, err
 	}
 
+	// Make sure the new htlcs don't push the invoice over the htlc limit.
+	// We check this before applying any of the updates, so the invoice is
+	// returned untouched.
+	if d.maxInvoiceHtlcs > 0 {
+		numHtlcs := len(invoice.Htlcs)
+		for key, htlcUpdate := range update.Htlcs {
+			if _, ok := invoice.Htlcs[key]; !ok && htlcUpdate != nil {
+				numHtlcs++
+			}
+		}
+
+		if numHtlcs > d.maxInvoiceHtlcs {
+			return &invoice, ErrInvoiceHtlcLimit
+		}
+	}
+
 	// Update invoice state.
 	invoice.Terms.State = update.State
 
@@ -1245,6 +2162,7 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bolt.Bucket
 			Expiry:       htlcUpdate.Expiry,
 			AcceptHeight: uint32(htlcUpdate.AcceptHeight),
 			AcceptTime:   now,
+			AMPSetID:     htlcUpdate.AMPSetID,
 		}
 		if preUpdateState == ContractSettled {
 			htlc.State = HtlcStateSettled
@@ -1262,19 +2180,43 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex *bolt.Bucket
 	if preUpdateState != invoice.Terms.State &&
 		invoice.Terms.State == ContractSettled {
 
-		if update.Preimage.Hash() != hash {
-			return nil, fmt.Errorf("preimage does not match")
+		preimage := update.Preimage
+		if preimage == UnknownPreimage {
+			preimage = resolvedPreimage
 		}
-		invoice.Terms.PaymentPreimage = update.Preimage
 
-		// Settle all accepted htlcs.
-		for _, htlc := range invoice.Htlcs {
+		if preimage.Hash() != hash {
+			return nil, fmt.Errorf("preimage does not match")
+		}
+		invoice.Terms.PaymentPreimage = preimage
+
+		// Settle all accepted htlcs of the set being settled. When
+		// settling a single AMP set, the accepted htlcs of all other
+		// sets are canceled back in the same update, so they no longer
+		// count towards the amount paid.
+		settleSet := invoice.HTLCSet(update.SetID)
+		if update.SetID != nil {
+			var setAmt lnwire.MilliAtom
+			for _, htlc := range settleSet {
+				setAmt += htlc.Amt
+			}
+			if setAmt < invoice.Terms.Value {
+				return nil, ErrAMPSetUnderpaid
+			}
+		}
+		for key, htlc := range invoice.Htlcs {
 			if htlc.State != HtlcStateAccepted {
 				continue
 			}
 
-			htlc.State = HtlcStateSettled
 			htlc.ResolveTime = now
+			if _, ok := settleSet[key]; ok {
+				htlc.State = HtlcStateSettled
+				continue
+			}
+
+			htlc.State = HtlcStateCanceled
+			invoice.AmtPaid -= htlc.Amt
 		}
 
 		err := setSettleFields(settleIndex, invoiceNum, &invoice, now)
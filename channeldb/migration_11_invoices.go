@@ -85,7 +85,8 @@ func migrateInvoices(tx *bolt.Tx) error {
 		// Serialize the invoice in the new format and use it to replace
 		// the old invoice in the database.
 		var buf bytes.Buffer
-		if err := serializeInvoice(&buf, &invoice); err != nil {
+		err = serializeInvoiceMigration11(&buf, &invoice)
+		if err != nil {
 			return err
 		}
 
@@ -217,3 +218,67 @@ func serializeInvoiceLegacy(w io.Writer, i *Invoice) error {
 
 	return nil
 }
+
+// serializeInvoiceMigration11 serializes an invoice in the format introduced
+// by this migration. Invoices of the previous db version don't have any htlcs,
+// so none are written.
+func serializeInvoiceMigration11(w io.Writer, i *Invoice) error {
+	if err := wire.WriteVarBytes(w, 0, i.Memo); err != nil {
+		return err
+	}
+	if err := wire.WriteVarBytes(w, 0, i.Receipt); err != nil {
+		return err
+	}
+	if err := wire.WriteVarBytes(w, 0, i.PaymentRequest); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, i.FinalCltvDelta); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, int64(i.Expiry)); err != nil {
+		return err
+	}
+
+	birthBytes, err := i.CreationDate.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarBytes(w, 0, birthBytes); err != nil {
+		return err
+	}
+
+	settleBytes, err := i.SettleDate.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarBytes(w, 0, settleBytes); err != nil {
+		return err
+	}
+
+	if _, err := w.Write(i.Terms.PaymentPreimage[:]); err != nil {
+		return err
+	}
+
+	var scratch [8]byte
+	byteOrder.PutUint64(scratch[:], uint64(i.Terms.Value))
+	if _, err := w.Write(scratch[:]); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, i.Terms.State); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, i.AddIndex); err != nil {
+		return err
+	}
+	if err := binary.Write(w, byteOrder, i.SettleIndex); err != nil {
+		return err
+	}
+
+	return binary.Write(w, byteOrder, int64(i.AmtPaid))
+}
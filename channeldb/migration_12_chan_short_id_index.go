@@ -0,0 +1,71 @@
+package channeldb
+
+import (
+	bolt "go.etcd.io/bbolt"
+)
+
+// migrateChanShortIDIndex populates the short channel ID index with all
+// channels that were marked as open before the index was introduced.
+func migrateChanShortIDIndex(tx *bolt.Tx) error {
+	log.Infof("Populating new short channel ID index")
+
+	openChanBucket := tx.Bucket(openChannelBucket)
+	if openChanBucket == nil {
+		return nil
+	}
+
+	// As it isn't safe to modify the database while iterating over it
+	// with ForEach, we'll collect all open channels in memory first.
+	var channels []*OpenChannel
+	err := openChanBucket.ForEach(func(nodePub, v []byte) error {
+		// If there's a value, it's not a bucket so ignore it.
+		if v != nil {
+			return nil
+		}
+
+		nodeChanBucket := openChanBucket.Bucket(nodePub)
+		return nodeChanBucket.ForEach(func(chainHash, v []byte) error {
+			if v != nil {
+				return nil
+			}
+
+			chainBucket := nodeChanBucket.Bucket(chainHash)
+			return chainBucket.ForEach(func(k, v []byte) error {
+				if v != nil {
+					return nil
+				}
+
+				chanBucket := chainBucket.Bucket(k)
+
+				var channel OpenChannel
+				err := fetchChanInfo(chanBucket, &channel)
+				if err != nil {
+					return err
+				}
+
+				// Pending channels don't have a final short
+				// channel ID yet, and will be indexed once
+				// they're marked as open.
+				if channel.IsPending {
+					return nil
+				}
+
+				channels = append(channels, &channel)
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range channels {
+		if err := putChanShortIDIndex(tx, channel); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Migration to short channel ID index complete!")
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/rand"
+	"net"
 	"reflect"
 	"testing"
 	"time"
@@ -950,3 +951,93 @@ func TestPaymentRouteSerialization(t *testing.T) {
 		migrateRouteSerialization,
 		false)
 }
+
+// TestMigrateChanShortIDIndex asserts that all channels that were marked as
+// open before the short channel ID index was introduced are added to it.
+func TestMigrateChanShortIDIndex(t *testing.T) {
+	t.Parallel()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	openLoc := lnwire.ShortChannelID{
+		BlockHeight: 5,
+		TxIndex:     10,
+		TxPosition:  15,
+	}
+
+	var openChan, pendingChan *OpenChannel
+	beforeMigration := func(db *DB) {
+		var err error
+		openChan, err = createTestChannelState(db)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := openChan.SyncPending(addr, 101); err != nil {
+			t.Fatalf("unable to sync channel: %v", err)
+		}
+		if err := openChan.MarkAsOpen(openLoc); err != nil {
+			t.Fatalf("unable to mark channel open: %v", err)
+		}
+
+		pendingChan, err = createTestChannelState(db)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := pendingChan.SyncPending(addr, 101); err != nil {
+			t.Fatalf("unable to sync channel: %v", err)
+		}
+
+		// Remove the index, such that the database looks like it was
+		// created before the index existed.
+		err = db.Update(func(tx *bolt.Tx) error {
+			return tx.DeleteBucket(chanShortIDIndexBucket)
+		})
+		if err != nil {
+			t.Fatalf("unable to delete index: %v", err)
+		}
+	}
+
+	afterMigration := func(db *DB) {
+		meta, err := db.FetchMeta(nil)
+		if err != nil {
+			t.Fatalf("unable to fetch db version: %v", err)
+		}
+		if meta.DbVersionNumber != 1 {
+			t.Fatalf("migration should have succeeded but didn't")
+		}
+
+		channel, err := db.FetchChannelByID(openLoc)
+		if err != nil {
+			t.Fatalf("unable to fetch channel by id: %v", err)
+		}
+		if channel.FundingOutpoint != openChan.FundingOutpoint {
+			t.Fatalf("expected channel %v, got %v",
+				openChan.FundingOutpoint,
+				channel.FundingOutpoint)
+		}
+
+		// The pending channel shouldn't have been indexed.
+		err = db.View(func(tx *bolt.Tx) error {
+			indexBucket := tx.Bucket(chanShortIDIndexBucket)
+			if indexBucket == nil {
+				return errors.New("index bucket not found")
+			}
+			if n := indexBucket.Stats().KeyN; n != 1 {
+				return fmt.Errorf("expected 1 index entry, "+
+					"got %v", n)
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	applyMigration(
+		t, beforeMigration, afterMigration,
+		migrateChanShortIDIndex, false,
+	)
+}
@@ -1,5 +1,11 @@
 package channeldb
 
+import (
+	"time"
+
+	"github.com/decred/dcrlnd/clock"
+)
+
 const (
 	// DefaultRejectCacheSize is the default number of rejectCacheEntries to
 	// cache for use in the rejection cache of incoming gossip traffic. This
@@ -10,6 +16,15 @@ const (
 	// in order to reply to gossip queries. This produces a cache size of
 	// around 40MB.
 	DefaultChannelCacheSize = 20000
+
+	// DefaultMaxInvoiceHtlcs is the default maximum number of htlcs that
+	// can be recorded for a single invoice. This is far more than any
+	// honest payment should need.
+	DefaultMaxInvoiceHtlcs = 1000
+
+	// DefaultChannelAcceptLogSize is the default number of channel accept
+	// decisions retained in the channel accept log.
+	DefaultChannelAcceptLogSize = 10000
 )
 
 // Options holds parameters for tuning and customizing a channeldb.DB.
@@ -26,6 +41,37 @@ type Options struct {
 	// freelist to disk, resulting in improved performance at the expense of
 	// increased startup time.
 	NoFreelistSync bool
+
+	// InMemory, if true, causes the database to be backed by a throwaway
+	// store that is discarded once the database is closed. The dbPath
+	// passed to Open is ignored in this mode.
+	InMemory bool
+
+	// BalanceHistorySize is the maximum number of balance snapshots
+	// retained per channel. A snapshot is recorded each time a channel's
+	// local commitment is updated. Zero disables balance history
+	// altogether, which avoids the extra write per state update.
+	BalanceHistorySize int
+
+	// MaxInvoiceHtlcs is the maximum number of htlcs that can be recorded
+	// for a single invoice, including canceled ones. Zero means there is
+	// no limit.
+	MaxInvoiceHtlcs int
+
+	// ChannelAcceptLogSize is the maximum number of channel accept
+	// decisions retained in the channel accept log. Once exceeded, the
+	// oldest decisions are pruned. Zero means there is no limit.
+	ChannelAcceptLogSize int
+
+	// CommitBatchWindow, if non-zero, is the time commitment updates wait
+	// for concurrent updates of other channels, so they can all be written
+	// within a single database transaction. Each update still only returns
+	// once it has been committed to disk.
+	CommitBatchWindow time.Duration
+
+	// Clock is the time source used for the timestamps recorded by the
+	// database, such as the accept and settle times of invoices.
+	Clock clock.Clock
 }
 
 // DefaultOptions returns an Options populated with default values.
@@ -34,6 +80,10 @@ func DefaultOptions() Options {
 		RejectCacheSize:  DefaultRejectCacheSize,
 		ChannelCacheSize: DefaultChannelCacheSize,
 		NoFreelistSync:   true,
+		MaxInvoiceHtlcs:  DefaultMaxInvoiceHtlcs,
+		Clock:            clock.NewDefaultClock(),
+
+		ChannelAcceptLogSize: DefaultChannelAcceptLogSize,
 	}
 }
 
@@ -60,3 +110,52 @@ func OptionSetSyncFreelist(b bool) OptionModifier {
 		o.NoFreelistSync = !b
 	}
 }
+
+// OptionInMemory causes the database to be opened with an ephemeral backend
+// that doesn't persist any data once closed. This is intended for tests and
+// throwaway nodes.
+func OptionInMemory() OptionModifier {
+	return func(o *Options) {
+		o.InMemory = true
+	}
+}
+
+// OptionSetBalanceHistorySize enables recording up to n balance snapshots per
+// channel. Setting n to zero disables balance history.
+func OptionSetBalanceHistorySize(n int) OptionModifier {
+	return func(o *Options) {
+		o.BalanceHistorySize = n
+	}
+}
+
+// OptionSetMaxInvoiceHtlcs sets the MaxInvoiceHtlcs to n. Setting n to zero
+// removes the limit.
+func OptionSetMaxInvoiceHtlcs(n int) OptionModifier {
+	return func(o *Options) {
+		o.MaxInvoiceHtlcs = n
+	}
+}
+
+// OptionSetChannelAcceptLogSize sets the ChannelAcceptLogSize to n. Setting n
+// to zero removes the limit.
+func OptionSetChannelAcceptLogSize(n int) OptionModifier {
+	return func(o *Options) {
+		o.ChannelAcceptLogSize = n
+	}
+}
+
+// OptionSetCommitBatchWindow enables batching of commitment updates within the
+// given window. Setting the window to zero disables batching.
+func OptionSetCommitBatchWindow(window time.Duration) OptionModifier {
+	return func(o *Options) {
+		o.CommitBatchWindow = window
+	}
+}
+
+// OptionClock sets the clock used by the database as its time source. This
+// allows callers, tests in particular, to supply a mock clock.
+func OptionClock(clock clock.Clock) OptionModifier {
+	return func(o *Options) {
+		o.Clock = clock
+	}
+}
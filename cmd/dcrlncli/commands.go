@@ -3007,6 +3007,16 @@ var queryRoutesCommand = cli.Command{
 			Usage: "use mission control probabilities",
 		},
 		cltvLimitFlag,
+		cli.Float64Flag{
+			Name: "time_pref",
+			Usage: "(optional) preference between lower fees (-1) " +
+				"and a lower time lock (1) when selecting the route",
+		},
+		cli.Int64Flag{
+			Name: "min_chan_capacity",
+			Usage: "(optional) only consider channels with at " +
+				"least this capacity in atoms",
+		},
 	},
 	Action: actionDecorator(queryRoutes),
 }
@@ -3052,12 +3062,14 @@ func queryRoutes(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.QueryRoutesRequest{
-		PubKey:            dest,
-		Amt:               amt,
-		FeeLimit:          feeLimit,
-		FinalCltvDelta:    int32(ctx.Int("final_cltv_delta")),
-		UseMissionControl: ctx.Bool("use_mc"),
-		CltvLimit:         uint32(ctx.Uint64(cltvLimitFlag.Name)),
+		PubKey:             dest,
+		Amt:                amt,
+		FeeLimit:           feeLimit,
+		FinalCltvDelta:     int32(ctx.Int("final_cltv_delta")),
+		UseMissionControl:  ctx.Bool("use_mc"),
+		CltvLimit:          uint32(ctx.Uint64(cltvLimitFlag.Name)),
+		TimePref:           ctx.Float64("time_pref"),
+		MinChannelCapacity: ctx.Int64("min_chan_capacity"),
 	}
 
 	route, err := client.QueryRoutes(ctxb, req)
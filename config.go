@@ -53,6 +53,7 @@ const (
 	defaultRESTPort           = 8080
 	defaultPeerPort           = 9735
 	defaultRPCHost            = "localhost"
+	defaultTLSMinVersion      = "1.2"
 
 	// DefaultMaxPendingChannels is the default maximum number of incoming
 	// pending channels permitted per peer.
@@ -180,15 +181,16 @@ type dcrwalletConfig struct {
 }
 
 type autoPilotConfig struct {
-	Active         bool               `long:"active" description:"If the autopilot agent should be active or not."`
-	Heuristic      map[string]float64 `long:"heuristic" description:"Heuristic to activate, and the weight to give it during scoring."`
-	MaxChannels    int                `long:"maxchannels" description:"The maximum number of channels that should be created"`
-	Allocation     float64            `long:"allocation" description:"The percentage of total funds that should be committed to automatic channel establishment"`
-	MinChannelSize int64              `long:"minchansize" description:"The smallest channel that the autopilot agent should create"`
-	MaxChannelSize int64              `long:"maxchansize" description:"The largest channel that the autopilot agent should create"`
-	Private        bool               `long:"private" description:"Whether the channels created by the autopilot agent should be private or not. Private channels won't be announced to the network."`
-	MinConfs       int32              `long:"minconfs" description:"The minimum number of confirmations each of your inputs in funding transactions created by the autopilot agent must have."`
-	ConfTarget     uint32             `long:"conftarget" description:"The confirmation target (in blocks) for channels opened by autopilot."`
+	Active          bool               `long:"active" description:"If the autopilot agent should be active or not."`
+	Heuristic       map[string]float64 `long:"heuristic" description:"Heuristic to activate, and the weight to give it during scoring."`
+	MaxChannels     int                `long:"maxchannels" description:"The maximum number of channels that should be created"`
+	Allocation      float64            `long:"allocation" description:"The percentage of total funds that should be committed to automatic channel establishment"`
+	MinChannelSize  int64              `long:"minchansize" description:"The smallest channel that the autopilot agent should create"`
+	MaxChannelSize  int64              `long:"maxchansize" description:"The largest channel that the autopilot agent should create"`
+	Private         bool               `long:"private" description:"Whether the channels created by the autopilot agent should be private or not. Private channels won't be announced to the network."`
+	MinConfs        int32              `long:"minconfs" description:"The minimum number of confirmations each of your inputs in funding transactions created by the autopilot agent must have."`
+	ConfTarget      uint32             `long:"conftarget" description:"The confirmation target (in blocks) for channels opened by autopilot."`
+	FailureHalfLife time.Duration      `long:"failurehalflife" description:"The time after which the penalty for a node the autopilot failed to open a channel with is halved. Recently failed nodes are skipped until their penalty has decayed."`
 }
 
 type torConfig struct {
@@ -217,6 +219,8 @@ type config struct {
 	TLSKeyPath      string   `long:"tlskeypath" description:"Path to write the TLS private key for lnd's RPC and REST services"`
 	TLSExtraIPs     []string `long:"tlsextraip" description:"Adds an extra ip to the generated certificate"`
 	TLSExtraDomains []string `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate"`
+	TLSMinVersion   string   `long:"tlsminversion" description:"The minimum TLS version accepted by the RPC and REST services (1.2 or 1.3)"`
+	TLSCipherSuites []string `long:"tlsciphersuite" description:"Restricts the cipher suites accepted by the RPC and REST services for TLS 1.2 connections to the given suite, e.g. TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384. May be specified multiple times. Only ECDHE suites using an AEAD cipher are accepted. TLS 1.3 suites are not configurable"`
 	NoMacaroons     bool     `long:"no-macaroons" description:"Disable macaroon authentication"`
 	AdminMacPath    string   `long:"adminmacaroonpath" description:"Path to write the admin macaroon for lnd's RPC and REST services if it doesn't exist"`
 	ReadMacPath     string   `long:"readonlymacaroonpath" description:"Path to write the read-only macaroon for lnd's RPC and REST services if it doesn't exist"`
@@ -249,6 +253,8 @@ type config struct {
 
 	Profile string `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65535"`
 
+	ReadinessListen string `long:"readinesslisten" description:"Enable an HTTP readiness probe on the given host:port that returns 200 once the chain backend is synced and the server has started, and 503 before that"`
+
 	UnsafeDisconnect   bool   `long:"unsafe-disconnect" description:"Allows the rpcserver to intentionally disconnect from peers with open channels. USED FOR TESTING ONLY."`
 	UnsafeReplay       bool   `long:"unsafe-replay" description:"Causes a link to replay the adds on its commitment txn after starting up, this enables testing of the sphinx replay logic."`
 	MaxPendingChannels int    `long:"maxpendingchannels" description:"The maximum number of incoming pending channels permitted per peer."`
@@ -294,8 +300,23 @@ type config struct {
 
 	MaxChannelFeeAllocation float64 `long:"max-channel-fee-allocation" description:"The maximum percentage of total funds that can be allocated to a channel's commitment fee. This only applies for the initiator of the channel. Valid values are within [0.1, 1]."`
 
+	MinCommitFeeRate uint64 `long:"mincommitfeerate" description:"The minimum fee rate (in atoms/KB) the commitment transactions of newly opened channels must pay. Inbound channels proposing a lower rate are rejected, and fee updates below it are refused. 0 disables the floor."`
+
+	ReplayLogRetention uint32 `long:"replaylogretention" description:"The number of blocks past their expiry that entries of the onion replay log are retained before being garbage collected."`
+
+	CoopCloseConfDepth uint32 `long:"coopcloseconfdepth" description:"The number of confirmations the closing transaction of a cooperative close must reach before the channel is considered fully resolved. Values of 0 and 1 resolve the channel as soon as the close is first confirmed."`
+
+	ForceCloseMaxFeeRate uint64 `long:"forceclose-max-feerate" description:"The maximum fee rate estimate (in atoms/KB) at which a user requested force close is broadcast right away. Above it, the broadcast is deferred until the estimate drops. Force closes needed to meet HTLC deadlines are never deferred. 0 disables deferring."`
+
+	DanglingBroadcastDelta uint32 `long:"danglingbroadcastdelta" description:"The number of blocks before the expiry of an outgoing HTLC that only exists on the remote commitment at which the channel is force closed. As such HTLCs can't be timed out on-chain by us, a larger safety margin than for other outgoing HTLCs may be desired. 0 uses the regular outgoing broadcast delta."`
+
 	net tor.Net
 
+	// tlsMinVersion and tlsCipherSuites are the parsed values of
+	// TLSMinVersion and TLSCipherSuites.
+	tlsMinVersion   uint16
+	tlsCipherSuites []uint16
+
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
 
 	Workers *lncfg.Workers `group:"workers" namespace:"workers"`
@@ -327,6 +348,7 @@ func loadConfig() (*config, error) {
 		DebugLevel:     defaultLogLevel,
 		TLSCertPath:    defaultTLSCertPath,
 		TLSKeyPath:     defaultTLSKeyPath,
+		TLSMinVersion:  defaultTLSMinVersion,
 		LogDir:         defaultLogDir,
 		MaxLogFiles:    defaultMaxLogFiles,
 		MaxLogFileSize: defaultMaxLogFileSize,
@@ -352,12 +374,13 @@ func loadConfig() (*config, error) {
 			RouterRPC: routerrpc.DefaultConfig(),
 		},
 		Autopilot: &autoPilotConfig{
-			MaxChannels:    5,
-			Allocation:     0.6,
-			MinChannelSize: int64(minChanFundingSize),
-			MaxChannelSize: int64(MaxFundingAmount),
-			MinConfs:       1,
-			ConfTarget:     autopilot.DefaultConfTarget,
+			MaxChannels:     5,
+			Allocation:      0.6,
+			MinChannelSize:  int64(minChanFundingSize),
+			MaxChannelSize:  int64(MaxFundingAmount),
+			MinConfs:        1,
+			ConfTarget:      autopilot.DefaultConfTarget,
+			FailureHalfLife: autopilot.DefaultFailureHalfLife,
 			Heuristic: map[string]float64{
 				"preferential": 1.0,
 			},
@@ -496,6 +519,25 @@ func loadConfig() (*config, error) {
 	cfg.Watchtower.TowerDir = cleanAndExpandPath(cfg.Watchtower.TowerDir)
 	cfg.Dcrwallet.CertPath = cleanAndExpandPath(cfg.Dcrwallet.CertPath)
 
+	// Parse the TLS options now, so that an unknown version or cipher
+	// suite is reported at startup.
+	tlsMinVersion, err := parseTLSMinVersion(cfg.TLSMinVersion)
+	if err != nil {
+		str := "%s: invalid tlsminversion: %v"
+		err := fmt.Errorf(str, funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	tlsCipherSuites, err := parseTLSCipherSuites(cfg.TLSCipherSuites)
+	if err != nil {
+		str := "%s: invalid tlsciphersuite: %v"
+		err := fmt.Errorf(str, funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	cfg.tlsMinVersion = tlsMinVersion
+	cfg.tlsCipherSuites = tlsCipherSuites
+
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
 	if cfg.Autopilot.MaxChannels < 0 {
@@ -528,6 +570,12 @@ func loadConfig() (*config, error) {
 		fmt.Fprintln(os.Stderr, err)
 		return nil, err
 	}
+	if cfg.Autopilot.FailureHalfLife <= 0 {
+		str := "%s: autopilot.failurehalflife must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
 	if cfg.Autopilot.ConfTarget < 1 {
 		str := "%s: autopilot.conftarget must be positive"
 		err := fmt.Errorf(str, funcName)
@@ -555,6 +603,21 @@ func loadConfig() (*config, error) {
 			cfg.MaxChannelFeeAllocation)
 	}
 
+	// Validate the routing config parameters.
+	routingConfig := routerrpc.GetRoutingConfig(cfg.SubRPCServers.RouterRPC)
+	if routingConfig.AttemptCostPPM < 0 {
+		return nil, fmt.Errorf("invalid attempt cost ppm: %v, must "+
+			"not be negative", routingConfig.AttemptCostPPM)
+	}
+	if uint32(routingConfig.DefaultFinalCltvDelta) >
+		cfg.MaxOutgoingCltvExpiry {
+
+		return nil, fmt.Errorf("invalid default final cltv delta: %v, "+
+			"must not exceed max-cltv-expiry of %v",
+			routingConfig.DefaultFinalCltvDelta,
+			cfg.MaxOutgoingCltvExpiry)
+	}
+
 	// Validate the Tor config parameters.
 	socks, err := lncfg.ParseAddressString(
 		cfg.Tor.SOCKS, strconv.Itoa(defaultTorSOCKSPort),
@@ -743,6 +806,19 @@ func loadConfig() (*config, error) {
 		}
 	}
 
+	// Validate the readiness probe listen address.
+	if cfg.ReadinessListen != "" {
+		_, _, err := net.SplitHostPort(cfg.ReadinessListen)
+		if err != nil {
+			str := "%s: Invalid readiness listen address %q: %v"
+			err := fmt.Errorf(str, funcName, cfg.ReadinessListen,
+				err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+	}
+
 	// We'll now construct the network directory which will be where we
 	// store all the data specific to this chain/network.
 	networkDir = filepath.Join(
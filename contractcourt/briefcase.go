@@ -5,8 +5,10 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/input"
@@ -40,6 +42,70 @@ func (c *ContractResolutions) IsEmpty() bool {
 		len(c.HtlcResolutions.OutgoingHTLCs) == 0
 }
 
+// ResolutionOutcome describes how a contract was resolved on chain.
+type ResolutionOutcome uint8
+
+const (
+	// ResolutionOutcomeTimeout indicates that an outgoing htlc timed out,
+	// and we swept it back to us after failing it back.
+	ResolutionOutcomeTimeout ResolutionOutcome = 1
+
+	// ResolutionOutcomeClaimed indicates that we claimed an incoming htlc
+	// on chain using its preimage.
+	ResolutionOutcomeClaimed ResolutionOutcome = 2
+
+	// ResolutionOutcomeRemoteClaimed indicates that the remote party
+	// claimed an outgoing htlc on chain using its preimage.
+	ResolutionOutcomeRemoteClaimed ResolutionOutcome = 3
+
+	// ResolutionOutcomeAbandoned indicates that an incoming htlc was
+	// canceled back, or timed out before we learned its preimage, so we
+	// left its output to the remote party.
+	ResolutionOutcomeAbandoned ResolutionOutcome = 4
+)
+
+// String returns a human readable string describing the outcome.
+func (r ResolutionOutcome) String() string {
+	switch r {
+	case ResolutionOutcomeTimeout:
+		return "Timeout"
+
+	case ResolutionOutcomeClaimed:
+		return "Claimed"
+
+	case ResolutionOutcomeRemoteClaimed:
+		return "RemoteClaimed"
+
+	case ResolutionOutcomeAbandoned:
+		return "Abandoned"
+
+	default:
+		return "<unknown outcome>"
+	}
+}
+
+// ResolutionReport is a persistent record of how a single contract of a
+// closing channel was resolved on chain.
+type ResolutionReport struct {
+	// OutPoint is the output of the contract on the commitment
+	// transaction.
+	OutPoint wire.OutPoint
+
+	// Amount is the amount of the contract.
+	Amount dcrutil.Amount
+
+	// Outcome describes how the contract was resolved.
+	Outcome ResolutionOutcome
+
+	// SweepTxid is the txid of the last transaction spending the
+	// contract's funds. For an HTLC resolved through a second-level
+	// transaction, this is the sweep of the second-level output, not the
+	// second-level transaction itself. If the remote party claimed the
+	// contract, it's their spending transaction. It is the zero hash if
+	// the contract was abandoned.
+	SweepTxid chainhash.Hash
+}
+
 // ArbitratorLog is the primary source of persistent storage for the
 // ChannelArbitrator. The log stores the current state of the
 // ChannelArbitrator's internal state machine, any items that are required to
@@ -51,8 +117,13 @@ type ArbitratorLog interface {
 	// CurrentState returns the current state of the ChannelArbitrator.
 	CurrentState() (ArbitratorState, error)
 
-	// CommitState persists, the current state of the chain attendant.
-	CommitState(ArbitratorState) error
+	// CommitState persists, the current state of the chain attendant,
+	// along with the reason we decided to go to chain, if any.
+	CommitState(ArbitratorState, channeldb.CloseCause) error
+
+	// CurrentCloseCause returns the close cause that was last persisted
+	// along with the state of the ChannelArbitrator.
+	CurrentCloseCause() (channeldb.CloseCause, error)
 
 	// InsertUnresolvedContracts inserts a set of unresolved contracts into
 	// the log. The log will then persistently store each contract until
@@ -91,6 +162,27 @@ type ArbitratorLog interface {
 	// from the database.
 	FetchConfirmedCommitSet() (*CommitSet, error)
 
+	// BatchConfirmedCommitSet adds the commit set to the passed batch
+	// rather than writing it immediately, allowing the commit sets of
+	// multiple closing channels to be written within a single
+	// transaction. The commit set is only durable, and returned by
+	// FetchConfirmedCommitSet, once the batch has been flushed.
+	//
+	// NOTE: The batch must be flushed before the channel is marked as
+	// closed. If we crash in between, the channel is still open on disk,
+	// so the close is dispatched again by the chain watcher on restart and
+	// the commit set is re-derived from the chain state.
+	BatchConfirmedCommitSet(c *CommitSet, batch *CommitSetBatch) error
+
+	// LogCoopCloseTx stores the closing transaction of a cooperative
+	// close, so we can wait for it to reach the required confirmation
+	// depth after a restart.
+	LogCoopCloseTx(closeTx *wire.MsgTx) error
+
+	// FetchCoopCloseTx returns the closing transaction of a cooperative
+	// close previously stored with LogCoopCloseTx.
+	FetchCoopCloseTx() (*wire.MsgTx, error)
+
 	// FetchChainActions attempts to fetch the set of previously stored
 	// chain actions. We'll use this upon restart to properly advance our
 	// state machine forward.
@@ -100,6 +192,23 @@ type ArbitratorLog interface {
 	// introduced.
 	FetchChainActions() (ChainActionMap, error)
 
+	// HistorySummary returns the current state of the log, along with the
+	// number of stored resolvers and contract resolutions. This allows
+	// callers to inspect what would be deleted by WipeHistory before
+	// calling it.
+	HistorySummary() (ArbitratorState, int, error)
+
+	// LogResolverReport stores a report on the outcome of a contract that
+	// was fully resolved. Unlike the rest of the log, reports aren't
+	// deleted by WipeHistory, so they remain queryable once the channel
+	// is closed.
+	LogResolverReport(report *ResolutionReport) error
+
+	// FetchResolverReports returns all reports that were stored for the
+	// channel with the given chan point, on the same chain as the log.
+	FetchResolverReports(chanPoint wire.OutPoint) ([]*ResolutionReport,
+		error)
+
 	// WipeHistory is to be called ONLY once *all* contracts have been
 	// fully resolved, and the channel closure if finalized. This method
 	// will delete all on-disk state within the persistent log.
@@ -145,6 +254,12 @@ const (
 	// state, then we cannot proceed with manual intervention as a state
 	// transition failed.
 	StateError ArbitratorState = 5
+
+	// StateCoopCloseNegotiation is a state that indicates that we're
+	// negotiating a cooperative close with the remote party, but no
+	// closing transaction has confirmed yet. Persisting this state ensures
+	// that we don't assume the close is done after a restart.
+	StateCoopCloseNegotiation ArbitratorState = 7
 )
 
 // String returns a human readable string describing the ArbitratorState.
@@ -171,6 +286,9 @@ func (a ArbitratorState) String() string {
 	case StateError:
 		return "StateError"
 
+	case StateCoopCloseNegotiation:
+		return "StateCoopCloseNegotiation"
+
 	default:
 		return "unknown state"
 	}
@@ -255,6 +373,11 @@ var (
 	// arbitrator.
 	stateKey = []byte("state")
 
+	// closeCauseKey is the key that we use to store the reason the
+	// arbitrator decided to go to chain. It's written along with the
+	// state.
+	closeCauseKey = []byte("close-cause")
+
 	// contractsBucketKey is the bucket within the logScope that will store
 	// all the active unresolved contracts.
 	contractsBucketKey = []byte("contractkey")
@@ -271,6 +394,17 @@ var (
 	// store the confirmed active HTLC sets once we learn that a channel
 	// has closed out on chain.
 	commitSetKey = []byte("commit-set")
+
+	// coopCloseTxKey is the key under the logScope that we'll use to store
+	// the closing transaction of a cooperative close while we wait for it
+	// to reach the required confirmation depth.
+	coopCloseTxKey = []byte("coop-close-tx")
+
+	// resolverReportsBucket is the top-level bucket that stores the
+	// resolution reports of each channel under its logScope. It is kept
+	// separate from the log scopes themselves, as those are removed once
+	// a channel is fully resolved.
+	resolverReportsBucket = []byte("resolver-reports")
 )
 
 var (
@@ -294,8 +428,83 @@ var (
 	// This can happen if the channel hasn't closed yet, or a client is
 	// running an older version that didn't yet write this state.
 	errNoCommitSet = fmt.Errorf("no commit set exists")
+
+	// errNoCoopCloseTx is returned when the log doesn't contain the
+	// closing transaction of a cooperative close.
+	errNoCoopCloseTx = fmt.Errorf("no cooperative close tx exists")
+
+	// errCommitSetBatchDB is returned when a commit set is added to a
+	// batch that writes to a different database than the log.
+	errCommitSetBatchDB = fmt.Errorf("commit set batch belongs to a " +
+		"different database")
 )
 
+// CommitSetBatch accumulates the confirmed commit sets of multiple arbitrator
+// logs, so they can be written to disk within a single transaction. This
+// amortizes the cost of syncing the database when many channels close at
+// once.
+type CommitSetBatch struct {
+	db *bolt.DB
+
+	// pending maps the scope of each log to its serialized commit set.
+	pending map[logScope][]byte
+	mtx     sync.Mutex
+}
+
+// NewCommitSetBatch returns an empty CommitSetBatch that writes to the given
+// database.
+func NewCommitSetBatch(db *bolt.DB) *CommitSetBatch {
+	return &CommitSetBatch{
+		db:      db,
+		pending: make(map[logScope][]byte),
+	}
+}
+
+// add adds the serialized commit set of the log with the given scope to the
+// batch, replacing any commit set previously added for it.
+func (b *CommitSetBatch) add(scopeKey logScope, commitSet []byte) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.pending[scopeKey] = commitSet
+}
+
+// Flush writes all commit sets added to the batch within a single
+// transaction. The batch is emptied once the write succeeds, so it can be
+// reused afterwards.
+func (b *CommitSetBatch) Flush() error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if len(b.pending) == 0 {
+		return nil
+	}
+
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		for scopeKey, commitSet := range b.pending {
+			scopeBucket, err := tx.CreateBucketIfNotExists(
+				scopeKey[:],
+			)
+			if err != nil {
+				return err
+			}
+
+			err = scopeBucket.Put(commitSetKey, commitSet)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	b.pending = make(map[logScope][]byte)
+	return nil
+}
+
 // boltArbitratorLog is an implementation of the ArbitratorLog interface backed
 // by a bolt DB instance.
 type boltArbitratorLog struct {
@@ -421,20 +630,56 @@ func (b *boltArbitratorLog) CurrentState() (ArbitratorState, error) {
 	return s, nil
 }
 
-// CommitState persists, the current state of the chain attendant.
+// CommitState persists, the current state of the chain attendant, along with
+// the reason we decided to go to chain, if any.
 //
-// NOTE: Part of the ContractResolver interface.
-func (b *boltArbitratorLog) CommitState(s ArbitratorState) error {
+// NOTE: Part of the ArbitratorLog interface.
+func (b *boltArbitratorLog) CommitState(s ArbitratorState,
+	cause channeldb.CloseCause) error {
+
 	return b.db.Batch(func(tx *bolt.Tx) error {
 		scopeBucket, err := tx.CreateBucketIfNotExists(b.scopeKey[:])
 		if err != nil {
 			return err
 		}
 
+		err = scopeBucket.Put(closeCauseKey, []byte{uint8(cause)})
+		if err != nil {
+			return err
+		}
+
 		return scopeBucket.Put(stateKey, []byte{uint8(s)})
 	})
 }
 
+// CurrentCloseCause returns the close cause that was last persisted along
+// with the state of the ChannelArbitrator. Logs written before the close
+// cause was persisted return CloseCauseUnknown.
+//
+// NOTE: Part of the ArbitratorLog interface.
+func (b *boltArbitratorLog) CurrentCloseCause() (channeldb.CloseCause, error) {
+	var cause channeldb.CloseCause
+	err := b.db.View(func(tx *bolt.Tx) error {
+		scopeBucket := tx.Bucket(b.scopeKey[:])
+		if scopeBucket == nil {
+			return errScopeBucketNoExist
+		}
+
+		causeBytes := scopeBucket.Get(closeCauseKey)
+		if causeBytes == nil {
+			return nil
+		}
+
+		cause = channeldb.CloseCause(causeBytes[0])
+		return nil
+	})
+	if err != nil && err != errScopeBucketNoExist {
+		return cause, err
+	}
+
+	return cause, nil
+}
+
 // FetchUnresolvedContracts returns all unresolved contracts that have been
 // previously written to the log.
 //
@@ -802,6 +1047,27 @@ func (b *boltArbitratorLog) InsertConfirmedCommitSet(c *CommitSet) error {
 	})
 }
 
+// BatchConfirmedCommitSet adds the commit set to the passed batch rather than
+// writing it immediately. The commit set is only durable once the batch has
+// been flushed.
+//
+// NOTE: Part of the ContractResolver interface.
+func (b *boltArbitratorLog) BatchConfirmedCommitSet(c *CommitSet,
+	batch *CommitSetBatch) error {
+
+	if batch.db != b.db {
+		return errCommitSetBatchDB
+	}
+
+	var buf bytes.Buffer
+	if err := encodeCommitSet(&buf, c); err != nil {
+		return err
+	}
+
+	batch.add(b.scopeKey, buf.Bytes())
+	return nil
+}
+
 // FetchConfirmedCommitSet fetches the known confirmed active HTLC set from the
 // database.
 //
@@ -834,6 +1100,180 @@ func (b *boltArbitratorLog) FetchConfirmedCommitSet() (*CommitSet, error) {
 	return c, nil
 }
 
+// LogCoopCloseTx stores the closing transaction of a cooperative close, so we
+// can wait for it to reach the required confirmation depth after a restart.
+//
+// NOTE: Part of the ArbitratorLog interface.
+func (b *boltArbitratorLog) LogCoopCloseTx(closeTx *wire.MsgTx) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		scopeBucket, err := tx.CreateBucketIfNotExists(b.scopeKey[:])
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := closeTx.Serialize(&b); err != nil {
+			return err
+		}
+
+		return scopeBucket.Put(coopCloseTxKey, b.Bytes())
+	})
+}
+
+// FetchCoopCloseTx returns the closing transaction of a cooperative close
+// previously stored with LogCoopCloseTx.
+//
+// NOTE: Part of the ArbitratorLog interface.
+func (b *boltArbitratorLog) FetchCoopCloseTx() (*wire.MsgTx, error) {
+	var closeTx *wire.MsgTx
+	err := b.db.View(func(tx *bolt.Tx) error {
+		scopeBucket := tx.Bucket(b.scopeKey[:])
+		if scopeBucket == nil {
+			return errScopeBucketNoExist
+		}
+
+		closeTxBytes := scopeBucket.Get(coopCloseTxKey)
+		if closeTxBytes == nil {
+			return errNoCoopCloseTx
+		}
+
+		closeTx = &wire.MsgTx{}
+		return closeTx.Deserialize(bytes.NewReader(closeTxBytes))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closeTx, nil
+}
+
+// HistorySummary returns the current state of the log, along with the number
+// of stored resolvers and contract resolutions. This is the set of items that
+// would be deleted by a call to WipeHistory.
+//
+// NOTE: Part of the ContractResolver interface.
+func (b *boltArbitratorLog) HistorySummary() (ArbitratorState, int, error) {
+	state, err := b.CurrentState()
+	if err != nil {
+		return state, 0, err
+	}
+
+	var numResolvers int
+	err = b.db.View(func(tx *bolt.Tx) error {
+		contractBucket, err := fetchContractReadBucket(tx, b.scopeKey[:])
+		if err != nil {
+			return err
+		}
+
+		return contractBucket.ForEach(func(resKey, _ []byte) error {
+			if len(resKey) != resolverIDLen {
+				return nil
+			}
+
+			numResolvers++
+			return nil
+		})
+	})
+	if err != nil && err != errScopeBucketNoExist && err != errNoContracts {
+		return state, 0, err
+	}
+
+	var numResolutions int
+	resolutions, err := b.FetchContractResolutions()
+	switch err {
+	case nil:
+		if resolutions.CommitResolution != nil {
+			numResolutions++
+		}
+		numResolutions += len(resolutions.HtlcResolutions.IncomingHTLCs)
+		numResolutions += len(resolutions.HtlcResolutions.OutgoingHTLCs)
+
+	case errScopeBucketNoExist, errNoResolutions:
+
+	default:
+		return state, 0, err
+	}
+
+	return state, numResolvers + numResolutions, nil
+}
+
+// LogResolverReport stores a report on the outcome of a contract that was
+// fully resolved. Reports are keyed by the outpoint of the contract, so
+// logging a report for the same contract again replaces the previous one.
+//
+// NOTE: Part of the ArbitratorLog interface.
+func (b *boltArbitratorLog) LogResolverReport(report *ResolutionReport) error {
+	var buf bytes.Buffer
+	if err := encodeResolutionReport(&buf, report); err != nil {
+		return err
+	}
+
+	return b.db.Update(func(tx *bolt.Tx) error {
+		reportsBucket, err := tx.CreateBucketIfNotExists(
+			resolverReportsBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		chanBucket, err := reportsBucket.CreateBucketIfNotExists(
+			b.scopeKey[:],
+		)
+		if err != nil {
+			return err
+		}
+
+		reportKey := newResolverID(report.OutPoint)
+		return chanBucket.Put(reportKey[:], buf.Bytes())
+	})
+}
+
+// FetchResolverReports returns all reports that were stored for the channel
+// with the given chan point, on the same chain as the log.
+//
+// NOTE: Part of the ArbitratorLog interface.
+func (b *boltArbitratorLog) FetchResolverReports(chanPoint wire.OutPoint) (
+	[]*ResolutionReport, error) {
+
+	var chainHash chainhash.Hash
+	copy(chainHash[:], b.scopeKey[:chainhash.HashSize])
+
+	scope, err := newLogScope(chainHash, chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []*ResolutionReport
+	err = b.db.View(func(tx *bolt.Tx) error {
+		reportsBucket := tx.Bucket(resolverReportsBucket)
+		if reportsBucket == nil {
+			return nil
+		}
+
+		chanBucket := reportsBucket.Bucket(scope[:])
+		if chanBucket == nil {
+			return nil
+		}
+
+		return chanBucket.ForEach(func(_, reportBytes []byte) error {
+			report, err := decodeResolutionReport(
+				bytes.NewReader(reportBytes),
+			)
+			if err != nil {
+				return err
+			}
+
+			reports = append(reports, report)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
 // WipeHistory is to be called ONLY once *all* contracts have been fully
 // resolved, and the channel closure if finalized. This method will delete all
 // on-disk state within the persistent log.
@@ -1063,6 +1503,58 @@ func decodeCommitResolution(r io.Reader,
 	return binary.Read(r, endian, &c.MaturityDelay)
 }
 
+func encodeResolutionReport(w io.Writer, r *ResolutionReport) error {
+	if _, err := w.Write(r.OutPoint.Hash[:]); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, r.OutPoint.Index); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, r.OutPoint.Tree); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, int64(r.Amount)); err != nil {
+		return err
+	}
+	if err := binary.Write(w, endian, r.Outcome); err != nil {
+		return err
+	}
+	_, err := w.Write(r.SweepTxid[:])
+	return err
+}
+
+func decodeResolutionReport(r io.Reader) (*ResolutionReport, error) {
+	report := &ResolutionReport{}
+
+	_, err := io.ReadFull(r, report.OutPoint.Hash[:])
+	if err != nil {
+		return nil, err
+	}
+	err = binary.Read(r, endian, &report.OutPoint.Index)
+	if err != nil {
+		return nil, err
+	}
+	err = binary.Read(r, endian, &report.OutPoint.Tree)
+	if err != nil {
+		return nil, err
+	}
+
+	var amt int64
+	if err := binary.Read(r, endian, &amt); err != nil {
+		return nil, err
+	}
+	report.Amount = dcrutil.Amount(amt)
+
+	if err := binary.Read(r, endian, &report.Outcome); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, report.SweepTxid[:]); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
 func encodeHtlcSetKey(w io.Writer, h *HtlcSetKey) error {
 	err := binary.Write(w, endian, h.IsRemote)
 	if err != nil {
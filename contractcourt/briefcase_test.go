@@ -253,34 +253,41 @@ func TestContractInsertionRetrieval(t *testing.T) {
 	defer cleanUp()
 
 	// The log created, we'll create a series of resolvers, each properly
-	// implementing the ContractResolver interface.
-	timeoutResolver := htlcTimeoutResolver{
-		htlcResolution: lnwallet.OutgoingHtlcResolution{
-			Expiry:          99,
-			SignedTimeoutTx: nil,
-			CsvDelay:        99,
-			ClaimOutpoint:   randOutPoint(),
-			SweepSignDesc:   testSignDesc,
-		},
-		outputIncubating: true,
-		resolved:         true,
-		broadcastHeight:  102,
-		htlcIndex:        12,
-	}
-	successResolver := htlcSuccessResolver{
-		htlcResolution: lnwallet.IncomingHtlcResolution{
-			Preimage:        testPreimage,
-			SignedSuccessTx: nil,
-			CsvDelay:        900,
-			ClaimOutpoint:   randOutPoint(),
-			SweepSignDesc:   testSignDesc,
-		},
-		outputIncubating: true,
-		resolved:         true,
-		broadcastHeight:  109,
-		payHash:          testPreimage,
-		sweepTx:          nil,
+	// implementing the ContractResolver interface. As resolvers hold a
+	// lock, we'll use constructors rather than copying them.
+	newTimeoutResolver := func() htlcTimeoutResolver {
+		return htlcTimeoutResolver{
+			htlcResolution: lnwallet.OutgoingHtlcResolution{
+				Expiry:          99,
+				SignedTimeoutTx: nil,
+				CsvDelay:        99,
+				ClaimOutpoint:   randOutPoint(),
+				SweepSignDesc:   testSignDesc,
+			},
+			outputIncubating: true,
+			resolved:         true,
+			broadcastHeight:  102,
+			htlcIndex:        12,
+		}
+	}
+	newSuccessResolver := func() htlcSuccessResolver {
+		return htlcSuccessResolver{
+			htlcResolution: lnwallet.IncomingHtlcResolution{
+				Preimage:        testPreimage,
+				SignedSuccessTx: nil,
+				CsvDelay:        900,
+				ClaimOutpoint:   randOutPoint(),
+				SweepSignDesc:   testSignDesc,
+			},
+			outputIncubating: true,
+			resolved:         true,
+			broadcastHeight:  109,
+			payHash:          testPreimage,
+			sweepTx:          nil,
+		}
 	}
+	timeoutResolver := newTimeoutResolver()
+	successResolver := newSuccessResolver()
 	resolvers := []ContractResolver{
 		&timeoutResolver,
 		&successResolver,
@@ -296,19 +303,14 @@ func TestContractInsertionRetrieval(t *testing.T) {
 		},
 	}
 
-	// All resolvers require a unique ResolverKey() output. To achieve this
-	// for the composite resolvers, we'll mutate the underlying resolver
-	// with a new outpoint.
-	contestTimeout := timeoutResolver
-	contestTimeout.htlcResolution.ClaimOutpoint = randOutPoint()
+	// All resolvers require a unique ResolverKey() output, which the
+	// constructors achieve by using a new outpoint for each resolver.
 	resolvers = append(resolvers, &htlcOutgoingContestResolver{
-		htlcTimeoutResolver: contestTimeout,
+		htlcTimeoutResolver: newTimeoutResolver(),
 	})
-	contestSuccess := successResolver
-	contestSuccess.htlcResolution.ClaimOutpoint = randOutPoint()
 	resolvers = append(resolvers, &htlcIncomingContestResolver{
 		htlcExpiry:          100,
-		htlcSuccessResolver: contestSuccess,
+		htlcSuccessResolver: newSuccessResolver(),
 	})
 
 	// For quick lookup during the test, we'll create this map which allow
@@ -443,21 +445,25 @@ func TestContractSwapping(t *testing.T) {
 
 	// We'll create two resolvers, a regular timeout resolver, and the
 	// contest resolver that eventually turns into the timeout resolver.
-	timeoutResolver := htlcTimeoutResolver{
-		htlcResolution: lnwallet.OutgoingHtlcResolution{
-			Expiry:          99,
-			SignedTimeoutTx: nil,
-			CsvDelay:        99,
-			ClaimOutpoint:   randOutPoint(),
-			SweepSignDesc:   testSignDesc,
-		},
-		outputIncubating: true,
-		resolved:         true,
-		broadcastHeight:  102,
-		htlcIndex:        12,
+	claimOutpoint := randOutPoint()
+	newTimeoutResolver := func() htlcTimeoutResolver {
+		return htlcTimeoutResolver{
+			htlcResolution: lnwallet.OutgoingHtlcResolution{
+				Expiry:          99,
+				SignedTimeoutTx: nil,
+				CsvDelay:        99,
+				ClaimOutpoint:   claimOutpoint,
+				SweepSignDesc:   testSignDesc,
+			},
+			outputIncubating: true,
+			resolved:         true,
+			broadcastHeight:  102,
+			htlcIndex:        12,
+		}
 	}
+	timeoutResolver := newTimeoutResolver()
 	contestResolver := &htlcOutgoingContestResolver{
-		htlcTimeoutResolver: timeoutResolver,
+		htlcTimeoutResolver: newTimeoutResolver(),
 	}
 
 	// We'll first insert the contest resolver into the log.
@@ -592,7 +598,10 @@ func TestStateMutation(t *testing.T) {
 
 	// We should now be able to mutate the state to an arbitrary one of our
 	// choosing, then read that same state back from disk.
-	if err := testLog.CommitState(StateFullyResolved); err != nil {
+	err = testLog.CommitState(
+		StateFullyResolved, channeldb.CloseCauseUserRequest,
+	)
+	if err != nil {
 		t.Fatalf("unable to write state: %v", err)
 	}
 	arbState, err = testLog.CurrentState()
@@ -604,6 +613,16 @@ func TestStateMutation(t *testing.T) {
 			arbState)
 	}
 
+	// The close cause should have been written along with the state.
+	closeCause, err := testLog.CurrentCloseCause()
+	if err != nil {
+		t.Fatalf("unable to read close cause: %v", err)
+	}
+	if closeCause != channeldb.CloseCauseUserRequest {
+		t.Fatalf("close cause mismatch: expected %v, got %v",
+			channeldb.CloseCauseUserRequest, closeCause)
+	}
+
 	// Next, we'll wipe our state and ensure that if we try to query for
 	// the current state, we get the proper error.
 	err = testLog.WipeHistory()
@@ -621,6 +640,100 @@ func TestStateMutation(t *testing.T) {
 		t.Fatalf("state mismatch: expected %v, got %v", StateDefault,
 			arbState)
 	}
+
+	// The close cause should also be reset.
+	closeCause, err = testLog.CurrentCloseCause()
+	if err != nil {
+		t.Fatalf("unable to read close cause: %v", err)
+	}
+	if closeCause != channeldb.CloseCauseUnknown {
+		t.Fatalf("close cause mismatch: expected %v, got %v",
+			channeldb.CloseCauseUnknown, closeCause)
+	}
+}
+
+// TestHistorySummary tests that the history summary reports the current state
+// along with the number of stored resolvers and resolutions, and that it's
+// empty once the history has been wiped.
+func TestHistorySummary(t *testing.T) {
+	t.Parallel()
+
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	assertSummary := func(expState ArbitratorState, expItems int) {
+		t.Helper()
+
+		state, numItems, err := testLog.HistorySummary()
+		if err != nil {
+			t.Fatalf("unable to fetch history summary: %v", err)
+		}
+		if state != expState {
+			t.Fatalf("state mismatch: expected %v, got %v",
+				expState, state)
+		}
+		if numItems != expItems {
+			t.Fatalf("expected %v items, got %v", expItems,
+				numItems)
+		}
+	}
+
+	// A fresh log should report the default state and no items.
+	assertSummary(StateDefault, 0)
+
+	// We'll now commit a new state, and store a set of resolutions along
+	// with a single resolver.
+	err = testLog.CommitState(
+		StateContractClosed, channeldb.CloseCauseUnknown,
+	)
+	if err != nil {
+		t.Fatalf("unable to write state: %v", err)
+	}
+	res := ContractResolutions{
+		CommitHash: testChainHash,
+		CommitResolution: &lnwallet.CommitOutputResolution{
+			SelfOutPoint:       testChanPoint2,
+			SelfOutputSignDesc: testSignDesc,
+			MaturityDelay:      101,
+		},
+		HtlcResolutions: lnwallet.HtlcResolutions{
+			OutgoingHTLCs: []lnwallet.OutgoingHtlcResolution{
+				{
+					Expiry:        103,
+					CsvDelay:      923923,
+					ClaimOutpoint: randOutPoint(),
+					SweepSignDesc: testSignDesc,
+				},
+			},
+		},
+	}
+	if err := testLog.LogContractResolutions(&res); err != nil {
+		t.Fatalf("unable to insert resolutions into db: %v", err)
+	}
+	timeoutResolver := &htlcTimeoutResolver{
+		htlcResolution:  res.HtlcResolutions.OutgoingHTLCs[0],
+		broadcastHeight: 102,
+		htlcIndex:       12,
+	}
+	err = testLog.InsertUnresolvedContracts(timeoutResolver)
+	if err != nil {
+		t.Fatalf("unable to insert resolvers: %v", err)
+	}
+
+	// The summary should now include the resolver, the commit resolution
+	// and the outgoing HTLC resolution.
+	assertSummary(StateContractClosed, 3)
+
+	// Once the history is wiped, the summary should be empty again.
+	if err := testLog.WipeHistory(); err != nil {
+		t.Fatalf("unable to wipe history: %v", err)
+	}
+	assertSummary(StateDefault, 0)
 }
 
 // TestScopeIsolation tests the two distinct ArbitratorLog instances with two
@@ -648,10 +761,16 @@ func TestScopeIsolation(t *testing.T) {
 
 	// We'll now update the current state of both the logs to a unique
 	// state.
-	if err := testLog1.CommitState(StateWaitingFullResolution); err != nil {
+	err = testLog1.CommitState(
+		StateWaitingFullResolution, channeldb.CloseCauseUnknown,
+	)
+	if err != nil {
 		t.Fatalf("unable to write state: %v", err)
 	}
-	if err := testLog2.CommitState(StateContractClosed); err != nil {
+	err = testLog2.CommitState(
+		StateContractClosed, channeldb.CloseCauseUnknown,
+	)
+	if err != nil {
 		t.Fatalf("unable to write state: %v", err)
 	}
 
@@ -736,8 +855,199 @@ func TestCommitSetStorage(t *testing.T) {
 
 }
 
+// TestCommitSetBatch tests that commit sets added to a batch are only written
+// once the batch is flushed, and can then be read back from each log.
+func TestCommitSetBatch(t *testing.T) {
+	t.Parallel()
+
+	testDB, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to create test db: %v", err)
+	}
+	defer cleanUp()
+
+	testArbCfg := ChannelArbitratorConfig{}
+	log1, err := newBoltArbitratorLog(
+		testDB, testArbCfg, testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	log2, err := newBoltArbitratorLog(
+		testDB, testArbCfg, testChainHash, testChanPoint2,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+
+	activeHTLCs := []channeldb.HTLC{
+		{
+			Amt:       1000,
+			OnionBlob: make([]byte, 0),
+			Signature: make([]byte, 0),
+		},
+	}
+	newCommitSet := func(confType HtlcSetKey) *CommitSet {
+		commitSet := &CommitSet{
+			ConfCommitKey: &confType,
+			HtlcSets:      make(map[HtlcSetKey][]channeldb.HTLC),
+		}
+		commitSet.HtlcSets[LocalHtlcSet] = activeHTLCs
+		commitSet.HtlcSets[RemoteHtlcSet] = activeHTLCs
+		return commitSet
+	}
+	commitSet1 := newCommitSet(LocalHtlcSet)
+	commitSet2 := newCommitSet(RemoteHtlcSet)
+
+	batch := NewCommitSetBatch(testDB)
+	if err := log1.BatchConfirmedCommitSet(commitSet1, batch); err != nil {
+		t.Fatalf("unable to batch commit set: %v", err)
+	}
+	if err := log2.BatchConfirmedCommitSet(commitSet2, batch); err != nil {
+		t.Fatalf("unable to batch commit set: %v", err)
+	}
+
+	// Before the batch is flushed, nothing should have been written.
+	for _, testLog := range []*boltArbitratorLog{log1, log2} {
+		_, err := testLog.FetchConfirmedCommitSet()
+		if err != errScopeBucketNoExist && err != errNoCommitSet {
+			t.Fatalf("expected no commit set, got: %v", err)
+		}
+	}
+
+	if err := batch.Flush(); err != nil {
+		t.Fatalf("unable to flush batch: %v", err)
+	}
+
+	// After flushing, each log should return its own commit set.
+	for i, testLog := range []*boltArbitratorLog{log1, log2} {
+		expected := []*CommitSet{commitSet1, commitSet2}[i]
+
+		diskCommitSet, err := testLog.FetchConfirmedCommitSet()
+		if err != nil {
+			t.Fatalf("unable to read commit set: %v", err)
+		}
+		if !reflect.DeepEqual(expected, diskCommitSet) {
+			t.Fatalf("commit set mismatch: expected %v, got %v",
+				spew.Sdump(expected), spew.Sdump(diskCommitSet))
+		}
+	}
+
+	// The flushed batch is empty, so flushing it again is a noop.
+	if err := batch.Flush(); err != nil {
+		t.Fatalf("unable to flush empty batch: %v", err)
+	}
+
+	// A batch for a different database must be rejected.
+	otherDB, otherCleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to create test db: %v", err)
+	}
+	defer otherCleanUp()
+
+	err = log1.BatchConfirmedCommitSet(
+		commitSet1, NewCommitSetBatch(otherDB),
+	)
+	if err != errCommitSetBatchDB {
+		t.Fatalf("expected errCommitSetBatchDB, got: %v", err)
+	}
+}
+
 func init() {
 	testSignDesc.KeyDesc.PubKey, _ = secp256k1.ParsePubKey(key1)
 
 	prand.Seed(time.Now().Unix())
 }
+
+// TestResolverReports tests that resolver reports are stored and retrieved per
+// channel, and that they survive wiping the history of the log.
+func TestResolverReports(t *testing.T) {
+	t.Parallel()
+
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	// Initially, there shouldn't be any reports.
+	reports, err := testLog.FetchResolverReports(testChanPoint1)
+	if err != nil {
+		t.Fatalf("unable to fetch reports: %v", err)
+	}
+	if len(reports) != 0 {
+		t.Fatalf("expected no reports, got %v", len(reports))
+	}
+
+	timeoutReport := &ResolutionReport{
+		OutPoint:  randOutPoint(),
+		Amount:    1000,
+		Outcome:   ResolutionOutcomeTimeout,
+		SweepTxid: chainhash.Hash{1},
+	}
+	abandonedReport := &ResolutionReport{
+		OutPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{2},
+			Index: 3,
+			Tree:  wire.TxTreeRegular,
+		},
+		Amount:  2000,
+		Outcome: ResolutionOutcomeAbandoned,
+	}
+
+	// Logging a report for the same contract again replaces the previous
+	// one.
+	claimedReport := *timeoutReport
+	claimedReport.Outcome = ResolutionOutcomeRemoteClaimed
+
+	for _, report := range []*ResolutionReport{
+		&claimedReport, timeoutReport, abandonedReport,
+	} {
+		if err := testLog.LogResolverReport(report); err != nil {
+			t.Fatalf("unable to log report: %v", err)
+		}
+	}
+
+	// Once the channel is fully resolved, its history is wiped. The
+	// reports should remain available nonetheless.
+	err = testLog.CommitState(
+		StateFullyResolved, channeldb.CloseCauseUnknown,
+	)
+	if err != nil {
+		t.Fatalf("unable to commit state: %v", err)
+	}
+	if err := testLog.WipeHistory(); err != nil {
+		t.Fatalf("unable to wipe history: %v", err)
+	}
+
+	reports, err = testLog.FetchResolverReports(testChanPoint1)
+	if err != nil {
+		t.Fatalf("unable to fetch reports: %v", err)
+	}
+	if len(reports) != 2 {
+		t.Fatalf("expected 2 reports, got %v", len(reports))
+	}
+
+	expectedReports := map[wire.OutPoint]*ResolutionReport{
+		timeoutReport.OutPoint:   timeoutReport,
+		abandonedReport.OutPoint: abandonedReport,
+	}
+	for _, report := range reports {
+		expectedReport := expectedReports[report.OutPoint]
+		if !reflect.DeepEqual(report, expectedReport) {
+			t.Fatalf("report mismatch: expected %v, got %v",
+				spew.Sdump(expectedReport), spew.Sdump(report))
+		}
+	}
+
+	// The reports of another channel are kept separately.
+	reports, err = testLog.FetchResolverReports(testChanPoint2)
+	if err != nil {
+		t.Fatalf("unable to fetch reports: %v", err)
+	}
+	if len(reports) != 0 {
+		t.Fatalf("expected no reports, got %v", len(reports))
+	}
+}
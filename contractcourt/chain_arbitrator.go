@@ -67,6 +67,20 @@ type ChainArbitratorConfig struct {
 	// htlcs. This value can be lower than the incoming broadcast delta.
 	OutgoingBroadcastDelta uint32
 
+	// DanglingBroadcastDelta is the delta that we'll use to decide when to
+	// broadcast our commitment transaction if there are outgoing htlcs
+	// that only exist on the remote party's commitment. Operators may want
+	// a larger safety margin for these htlcs, as we can't time them out
+	// on-chain ourselves. If zero, OutgoingBroadcastDelta is used.
+	DanglingBroadcastDelta uint32
+
+	// ForceCloseMaxFeeRate is the maximum fee rate estimate at which a
+	// force close requested by the user is broadcast right away. If the
+	// estimate exceeds it, the broadcast is deferred until a later block
+	// where it doesn't. Force closes needed to meet HTLC deadlines are
+	// never deferred. A zero value disables deferring force closes.
+	ForceCloseMaxFeeRate lnwallet.AtomPerKByte
+
 	// NewSweepAddr is a function that returns a new address under control
 	// by the wallet. We'll use this to sweep any no-delay outputs as a
 	// result of unilateral channel closes.
@@ -150,6 +164,12 @@ type ChainArbitratorConfig struct {
 	// NotifyClosedChannel is a function closure that the ChainArbitrator
 	// will use to notify the ChannelNotifier about a newly closed channel.
 	NotifyClosedChannel func(wire.OutPoint)
+
+	// CoopCloseConfDepth is the number of confirmations the closing
+	// transaction of a cooperative close must reach before the channel is
+	// considered fully resolved. Values of zero and one both resolve the
+	// channel as soon as the close is first confirmed.
+	CoopCloseConfDepth uint32
 }
 
 // ChainArbitrator is a sub-system that oversees the on-chain resolution of all
@@ -265,7 +285,7 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 			}
 			return chanMachine.ForceClose()
 		},
-		MarkCommitmentBroadcasted: channel.MarkCommitmentBroadcasted,
+		MarkCommitmentBroadcasted: channel.MarkCommitmentBroadcastedAtHeight,
 		MarkChannelClosed: func(summary *channeldb.ChannelCloseSummary) error {
 			if err := channel.CloseChannel(summary); err != nil {
 				return err
@@ -273,7 +293,10 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 			c.cfg.NotifyClosedChannel(summary.ChanPoint)
 			return nil
 		},
-		IsPendingClose:        false,
+		IsPendingClose: false,
+		CommitFeeRate: lnwallet.AtomPerKByte(
+			channel.LocalCommitment.FeePerKB,
+		),
 		ChainArbitratorConfig: c.cfg,
 		ChainEvents:           chanEvents,
 	}
@@ -327,13 +350,26 @@ func (c *ChainArbitrator) resolveContract(chanPoint wire.OutPoint,
 	log.Infof("Marking ChannelPoint(%v) fully resolved", chanPoint)
 
 	// First, we'll we'll mark the channel as fully closed from the PoV of
-	// the channel source.
-	err := c.chanSource.MarkChanFullyClosed(&chanPoint)
+	// the channel source. If a prior attempt already did so, we'll skip
+	// this step so the closure isn't applied twice.
+	closeSummary, err := c.chanSource.FetchClosedChannel(&chanPoint)
 	if err != nil {
-		log.Errorf("ChainArbitrator: unable to mark ChannelPoint(%v) "+
-			"fully closed: %v", chanPoint, err)
+		log.Errorf("ChainArbitrator: unable to fetch close summary "+
+			"for ChannelPoint(%v): %v", chanPoint, err)
 		return err
 	}
+	if closeSummary.IsPending {
+		err := c.chanSource.MarkChanFullyClosed(&chanPoint)
+		if err != nil {
+			log.Errorf("ChainArbitrator: unable to mark "+
+				"ChannelPoint(%v) fully closed: %v", chanPoint,
+				err)
+			return err
+		}
+	} else {
+		log.Debugf("ChannelPoint(%v) already marked fully closed",
+			chanPoint)
+	}
 
 	if arbLog != nil {
 		// Once this has been marked as resolved, we'll wipe the log
@@ -478,7 +514,16 @@ func (c *ChainArbitrator) Start() error {
 			ChainEvents:           &ChainEventSubscription{},
 			IsPendingClose:        true,
 			ClosingHeight:         closeChanInfo.CloseHeight,
+			ClosingTXID:           closeChanInfo.ClosingTXID,
 			CloseType:             closeChanInfo.CloseType,
+			FetchCommitments: func() (
+				map[HtlcSetKey]*channeldb.ChannelCommitment,
+				error) {
+
+				return fetchClosedChannelCommitments(
+					c.chanSource, chanPoint,
+				)
+			},
 		}
 		chanLog, err := newBoltArbitratorLog(
 			c.chanSource.DB, arbCfg, c.cfg.ChainHash, chanPoint,
@@ -678,18 +723,41 @@ type forceCloseReq struct {
 	// NOTE; This channel MUST be buffered.
 	errResp chan error
 
-	// closeTx is a channel that carries the transaction which ultimately
-	// closed out the channel.
-	closeTx chan *wire.MsgTx
+	// resp is a channel that carries the result of the force close,
+	// including the transaction which ultimately closed out the channel.
+	resp chan *ForceCloseResult
+
+	// cause is the reason the channel is being force closed. It will be
+	// recorded in the channel's close summary.
+	cause channeldb.CloseCause
+}
+
+// ForceCloseResult describes the consequences of a successful force close.
+type ForceCloseResult struct {
+	// CloseTx is the commitment transaction that was broadcast to close
+	// the channel.
+	CloseTx *wire.MsgTx
+
+	// PendingHTLCs is the set of non-dust HTLCs on our commitment that
+	// will need to be resolved on chain once it confirms.
+	PendingHTLCs []channeldb.HTLC
+
+	// BlocksToResolution is the number of blocks after the confirmation
+	// of the commitment within which all contracts are expected to be
+	// resolved. This assumes the worst case, where each HTLC is only
+	// resolved after it expires and its output matured.
+	BlocksToResolution uint32
 }
 
 // ForceCloseContract attempts to force close the channel infield by the passed
 // channel point. A force close will immediately terminate the contract,
 // causing it to enter the resolution phase. If the force close was successful,
-// then the force close transaction itself will be returned.
-//
-// TODO(roasbeef): just return the summary itself?
-func (c *ChainArbitrator) ForceCloseContract(chanPoint wire.OutPoint) (*wire.MsgTx, error) {
+// then a result containing the force close transaction and the contracts that
+// remain to be resolved will be returned. The passed cause is recorded in the
+// close summary of the channel.
+func (c *ChainArbitrator) ForceCloseContract(chanPoint wire.OutPoint,
+	cause channeldb.CloseCause) (*ForceCloseResult, error) {
+
 	c.Lock()
 	arbitrator, ok := c.activeChannels[chanPoint]
 	c.Unlock()
@@ -697,7 +765,8 @@ func (c *ChainArbitrator) ForceCloseContract(chanPoint wire.OutPoint) (*wire.Msg
 		return nil, fmt.Errorf("unable to find arbitrator")
 	}
 
-	log.Infof("Attempting to force close ChannelPoint(%v)", chanPoint)
+	log.Infof("Attempting to force close ChannelPoint(%v), cause=%v",
+		chanPoint, cause)
 
 	// Before closing, we'll attempt to send a disable update for the
 	// channel. We do so before closing the channel as otherwise the current
@@ -708,21 +777,22 @@ func (c *ChainArbitrator) ForceCloseContract(chanPoint wire.OutPoint) (*wire.Msg
 	}
 
 	errChan := make(chan error, 1)
-	respChan := make(chan *wire.MsgTx, 1)
+	respChan := make(chan *ForceCloseResult, 1)
 
 	// With the channel found, and the request crafted, we'll send over a
 	// force close request to the arbitrator that watches this channel.
 	select {
 	case arbitrator.forceCloseReqs <- &forceCloseReq{
 		errResp: errChan,
-		closeTx: respChan,
+		resp:    respChan,
+		cause:   cause,
 	}:
 	case <-c.quit:
 		return nil, ErrChainArbExiting
 	}
 
-	// We'll await two responses: the error response, and the transaction
-	// that closed out the channel.
+	// We'll await two responses: the error response, and the result of
+	// the force close.
 	select {
 	case err := <-errChan:
 		if err != nil {
@@ -732,14 +802,31 @@ func (c *ChainArbitrator) ForceCloseContract(chanPoint wire.OutPoint) (*wire.Msg
 		return nil, ErrChainArbExiting
 	}
 
-	var closeTx *wire.MsgTx
+	var result *ForceCloseResult
 	select {
-	case closeTx = <-respChan:
+	case result = <-respChan:
 	case <-c.quit:
 		return nil, ErrChainArbExiting
 	}
 
-	return closeTx, nil
+	return result, nil
+}
+
+// NotifyCoopCloseNegotiation notifies the arbitrator of the channel with the
+// passed channel point that a cooperative close negotiation was started
+// (active) or aborted (!active). This allows the arbitrator to persist that
+// the channel is being closed, without assuming the close is done.
+func (c *ChainArbitrator) NotifyCoopCloseNegotiation(chanPoint wire.OutPoint,
+	active bool) error {
+
+	c.Lock()
+	arbitrator, ok := c.activeChannels[chanPoint]
+	c.Unlock()
+	if !ok {
+		return fmt.Errorf("unable to find arbitrator")
+	}
+
+	return arbitrator.NotifyCoopCloseNegotiation(active)
 }
 
 // WatchNewChannel sends the ChainArbitrator a message to create a
@@ -822,3 +909,27 @@ func (c *ChainArbitrator) SubscribeChannelEvents(
 
 // TODO(roasbeef): arbitration reports
 //  * types: contested, waiting for success conf, etc
+
+// fetchClosedChannelCommitments returns the commitments that were stored for
+// the target channel at the time it was closed, keyed by the HTLC set they
+// belong to.
+func fetchClosedChannelCommitments(chanSource *channeldb.DB,
+	chanPoint wire.OutPoint) (map[HtlcSetKey]*channeldb.ChannelCommitment,
+	error) {
+
+	commits, err := chanSource.FetchClosedChannelCommitments(&chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	commitments := map[HtlcSetKey]*channeldb.ChannelCommitment{
+		LocalHtlcSet:  &commits.LocalCommitment,
+		RemoteHtlcSet: &commits.RemoteCommitment,
+	}
+	if commits.RemotePendingCommitment != nil {
+		commitments[RemotePendingHtlcSet] =
+			commits.RemotePendingCommitment
+	}
+
+	return commitments, nil
+}
@@ -115,3 +115,98 @@ func TestChainArbitratorRepublishCommitment(t *testing.T) {
 		t.Fatalf("unexpected tx published")
 	}
 }
+
+// TestChainArbitratorReconstructCommitSet tests that the chain arbitrator
+// reconstructs the confirmed commit set of a channel that was closed without
+// one being logged, using the commitments stored when it was closed.
+func TestChainArbitratorReconstructCommitSet(t *testing.T) {
+	t.Parallel()
+
+	tempPath, err := ioutil.TempDir("", "testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempPath)
+
+	db, err := channeldb.Open(tempPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	lChannel, _, cleanup, err := lnwallet.CreateTestChannels(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	channel := lChannel.State()
+	channel.Db = db
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	if err := channel.SyncPending(addr, 101); err != nil {
+		t.Fatal(err)
+	}
+
+	// Close the channel with our commitment, without logging a confirmed
+	// commit set, as older nodes did.
+	chanPoint := channel.FundingOutpoint
+	closeTxid := channel.LocalCommitment.CommitTx.TxHash()
+	err = channel.CloseChannel(&channeldb.ChannelCloseSummary{
+		ChanPoint:   chanPoint,
+		ChainHash:   channel.ChainHash,
+		ClosingTXID: closeTxid,
+		CloseHeight: 110,
+		RemotePub:   channel.IdentityPub,
+		Capacity:    channel.Capacity,
+		CloseType:   channeldb.LocalForceClose,
+		IsPending:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chainArbCfg := ChainArbitratorConfig{
+		ChainIO:  &mockChainIO{},
+		Notifier: &mockNotifier{},
+		PublishTx: func(tx *wire.MsgTx) error {
+			return nil
+		},
+	}
+	chainArb := NewChainArbitrator(chainArbCfg, db)
+
+	if err := chainArb.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := chainArb.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	chainArb.Lock()
+	channelArb, ok := chainArb.activeChannels[chanPoint]
+	chainArb.Unlock()
+	if !ok {
+		t.Fatalf("no arbitrator for closing channel %v", chanPoint)
+	}
+
+	// The commit set should have been reconstructed and logged, pointing
+	// at our commitment.
+	commitSet, err := channelArb.log.FetchConfirmedCommitSet()
+	if err != nil {
+		t.Fatalf("unable to fetch confirmed commit set: %v", err)
+	}
+	if commitSet.ConfCommitKey == nil ||
+		*commitSet.ConfCommitKey != LocalHtlcSet {
+
+		t.Fatalf("expected conf commit key %v, got %v", LocalHtlcSet,
+			commitSet.ConfCommitKey)
+	}
+	if _, ok := commitSet.HtlcSets[RemoteHtlcSet]; !ok {
+		t.Fatalf("expected remote htlc set to be present")
+	}
+}
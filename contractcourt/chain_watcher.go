@@ -46,6 +46,9 @@ type LocalUnilateralCloseInfo struct {
 // cooperative close that gets confirmed.
 type CooperativeCloseInfo struct {
 	*channeldb.ChannelCloseSummary
+
+	// CloseTx is the cooperative close transaction that was confirmed.
+	CloseTx *wire.MsgTx
 }
 
 // RemoteUnilateralCloseInfo wraps the normal UnilateralCloseSummary to couple
@@ -730,6 +733,7 @@ func (c *chainWatcher) dispatchCooperativeClose(commitSpend *chainntnfs.SpendDet
 	// cooperative closure.
 	closeInfo := &CooperativeCloseInfo{
 		ChannelCloseSummary: closeSummary,
+		CloseTx:             broadcastTx,
 	}
 
 	// With the event processed, we'll now notify all subscribers of the
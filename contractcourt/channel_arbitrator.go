@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/chainntnfs"
@@ -22,8 +24,42 @@ var (
 	// close a channel that's already in the process of doing so.
 	errAlreadyForceClosed = errors.New("channel is already in the " +
 		"process of being force closed")
+
+	// ErrForceCloseDeferred is returned when a requested force close was
+	// accepted, but broadcasting the commitment has been deferred as the
+	// current fee rate estimate exceeds ForceCloseMaxFeeRate. The
+	// commitment will be broadcast once the estimate drops below it.
+	ErrForceCloseDeferred = errors.New("force close deferred until the " +
+		"fee rate estimate drops below the configured maximum")
+)
+
+const (
+	// commitFeeConfTarget is the confirmation target we'll use to query
+	// the fee estimator when checking whether a broadcast commitment
+	// still pays an adequate fee rate.
+	commitFeeConfTarget = 6
+
+	// commitFeeBumpFactor is the factor by which the current fee estimate
+	// must exceed our commitment fee rate for the commitment to be
+	// considered underpaying.
+	commitFeeBumpFactor = 2
 )
 
+// commitFeeTooLow returns true if the current fee estimate is more than
+// commitFeeBumpFactor times the fee rate paid by our commitment transaction.
+// The current estimate is returned as well.
+func commitFeeTooLow(estimator lnwallet.FeeEstimator,
+	commitFeeRate lnwallet.AtomPerKByte) (bool, lnwallet.AtomPerKByte,
+	error) {
+
+	feeEstimate, err := estimator.EstimateFeePerKB(commitFeeConfTarget)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return feeEstimate > commitFeeRate*commitFeeBumpFactor, feeEstimate, nil
+}
+
 // WitnessSubscription represents an intent to be notified once new witnesses
 // are discovered by various active contract resolvers. A contract resolver may
 // use this to be notified of when it can satisfy an incoming contract after we
@@ -96,8 +132,9 @@ type ChannelArbitratorConfig struct {
 	ForceCloseChan func() (*lnwallet.LocalForceCloseSummary, error)
 
 	// MarkCommitmentBroadcasted should mark the channel as the commitment
-	// being broadcast, and we are waiting for the commitment to confirm.
-	MarkCommitmentBroadcasted func(*wire.MsgTx) error
+	// being broadcast at the passed height, and we are waiting for the
+	// commitment to confirm.
+	MarkCommitmentBroadcasted func(*wire.MsgTx, uint32) error
 
 	// MarkChannelClosed marks the channel closed in the database, with the
 	// passed close summary. After this method successfully returns we can
@@ -114,15 +151,36 @@ type ChannelArbitratorConfig struct {
 	// that this value is only valid if IsPendingClose is true.
 	ClosingHeight uint32
 
+	// ClosingTXID is the txid of the transaction that closed the channel.
+	// Note that this value is only valid if IsPendingClose is true.
+	ClosingTXID chainhash.Hash
+
 	// CloseType is the type of the close event in case IsPendingClose is
 	// true. Otherwise this value is unset.
 	CloseType channeldb.ClosureType
 
+	// CommitFeeRate is the fee rate paid by our latest local commitment
+	// transaction. On restart, this is compared against the current fee
+	// estimate to decide whether a broadcast commitment is likely to
+	// confirm in time. A zero value disables this check.
+	CommitFeeRate lnwallet.AtomPerKByte
+
+	// FetchCommitments is an optional closure that returns the latest
+	// commitments stored for the channel, keyed by the HTLC set they
+	// belong to. It's used to reconstruct the confirmed commit set of
+	// channels that were closed without one being logged.
+	FetchCommitments func() (map[HtlcSetKey]*channeldb.ChannelCommitment,
+		error)
+
 	// MarkChannelResolved is a function closure that serves to mark a
 	// channel as "fully resolved". A channel itself can be considered
 	// fully resolved once all active contracts have individually been
 	// fully resolved.
 	//
+	// NOTE: The arbitrator won't call this once the log has been wiped,
+	// but a crash part way through may still lead to it being called
+	// again, so it must be idempotent.
+	//
 	// TODO(roasbeef): need RPC's to combine for pendingchannels RPC
 	MarkChannelResolved func() error
 
@@ -280,10 +338,37 @@ type ChannelArbitrator struct {
 	// contract will be sent over.
 	forceCloseReqs chan *forceCloseReq
 
+	// coopCloseNegotiating is the latest cooperative close negotiation
+	// status we were notified of: started (true), or aborted (false). We
+	// only keep the latest one, so notifying us never blocks the caller.
+	coopCloseNegotiating bool
+	coopCloseMtx         sync.Mutex
+
+	// coopCloseSignal is signaled once coopCloseNegotiating is updated.
+	coopCloseSignal chan struct{}
+
 	// state is the current state of the arbitrator. This state is examined
 	// upon start up to decide which actions to take.
 	state ArbitratorState
 
+	// stateMtx guards writes to state, as well as reads of it from outside
+	// of the channelAttendant goroutine.
+	stateMtx sync.RWMutex
+
+	// closeCause is the reason we decided to go to chain. It's persisted
+	// along with the state, and recorded in the close summary once our
+	// commitment confirms.
+	closeCause channeldb.CloseCause
+
+	// forceCloseDeferred is true if we're in StateBroadcastCommit, but
+	// deferred broadcasting our commitment due to a fee rate spike. We'll
+	// reconsider on every new block.
+	forceCloseDeferred bool
+
+	// commitFeeBumpNeeded is set to 1 if on start up we found that our
+	// broadcast commitment pays a fee rate far below the current estimate.
+	commitFeeBumpNeeded int32 // To be used atomically.
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -302,6 +387,8 @@ func NewChannelArbitrator(cfg ChannelArbitratorConfig,
 		activeHTLCs:      htlcSets,
 		cfg:              cfg,
 		quit:             make(chan struct{}),
+
+		coopCloseSignal: make(chan struct{}, 1),
 	}
 }
 
@@ -323,7 +410,19 @@ func (c *ChannelArbitrator) Start() error {
 
 	// First, we'll read our last state from disk, so our internal state
 	// machine can act accordingly.
-	c.state, err = c.log.CurrentState()
+	state, err := c.log.CurrentState()
+	if err != nil {
+		c.cfg.BlockEpochs.Cancel()
+		return err
+	}
+
+	c.stateMtx.Lock()
+	c.state = state
+	c.stateMtx.Unlock()
+
+	// We'll also restore the reason we decided to go to chain, so a
+	// deferred force close is still recognized as user requested.
+	c.closeCause, err = c.log.CurrentCloseCause()
 	if err != nil {
 		c.cfg.BlockEpochs.Cancel()
 		return err
@@ -332,6 +431,18 @@ func (c *ChannelArbitrator) Start() error {
 	log.Infof("ChannelArbitrator(%v): starting state=%v", c.cfg.ChanPoint,
 		c.state)
 
+	// If we restarted after having decided to go to chain, but before the
+	// commitment confirmed, the fee market may have moved since we signed
+	// it. We'll check whether the commitment still pays a sane fee rate.
+	if !c.cfg.IsPendingClose && (c.state == StateBroadcastCommit ||
+		c.state == StateCommitmentBroadcasted) {
+
+		if err := c.checkCommitFeeRate(); err != nil {
+			log.Errorf("ChannelArbitrator(%v): unable to check "+
+				"commitment fee rate: %v", c.cfg.ChanPoint, err)
+		}
+	}
+
 	_, bestHeight, err := c.cfg.ChainIO.GetBestBlock()
 	if err != nil {
 		c.cfg.BlockEpochs.Cancel()
@@ -349,6 +460,8 @@ func (c *ChannelArbitrator) Start() error {
 		switch c.state {
 		case StateDefault:
 			fallthrough
+		case StateCoopCloseNegotiation:
+			fallthrough
 		case StateBroadcastCommit:
 			fallthrough
 		case StateCommitmentBroadcasted:
@@ -374,6 +487,23 @@ func (c *ChannelArbitrator) Start() error {
 		}
 	}
 
+	// If the closing transaction of a cooperative close still needs to
+	// reach the required depth, we'll resume waiting for it rather than
+	// resolving the channel right away.
+	var coopCloseConf *chainntnfs.ConfirmationEvent
+	if trigger == coopCloseTrigger && c.cfg.CoopCloseConfDepth > 1 {
+		coopCloseConf, err = c.resumeCoopCloseConf()
+		if err != nil {
+			c.cfg.BlockEpochs.Cancel()
+			return err
+		}
+	}
+	if coopCloseConf != nil {
+		c.wg.Add(1)
+		go c.channelAttendant(bestHeight, coopCloseConf)
+		return nil
+	}
+
 	// Next we'll fetch our confirmed commitment set. This will only exist
 	// if the channel has been closed out on chain for modern nodes. For
 	// older nodes, this won't be found at all, and will rely on the
@@ -384,6 +514,18 @@ func (c *ChannelArbitrator) Start() error {
 		return err
 	}
 
+	// Channels closed by older nodes may not have their confirmed commit
+	// set logged. In that case, we'll attempt to reconstruct it, as we
+	// otherwise can't resolve the HTLCs that were active at the time of
+	// closure.
+	if commitSet == nil && c.cfg.IsPendingClose {
+		commitSet, err = c.reconstructCommitSet()
+		if err != nil {
+			log.Warnf("ChannelArbitrator(%v): unable to reconstruct "+
+				"confirmed commit set: %v", c.cfg.ChanPoint, err)
+		}
+	}
+
 	// We'll now attempt to advance our state forward based on the current
 	// on-chain state, and our set of active contracts.
 	startingState := c.state
@@ -433,10 +575,64 @@ func (c *ChannelArbitrator) Start() error {
 	}
 
 	c.wg.Add(1)
-	go c.channelAttendant(bestHeight)
+	go c.channelAttendant(bestHeight, nil)
 	return nil
 }
 
+// reconstructCommitSet attempts to re-derive the confirmed commit set of a
+// channel that was closed without one being logged. The closing txid is
+// matched against the commitments stored for the channel at the time it was
+// closed. The reconstructed set is written to the log, so this only needs to
+// happen once.
+func (c *ChannelArbitrator) reconstructCommitSet() (*CommitSet, error) {
+	// Only a force close can leave HTLCs for us to resolve on-chain.
+	switch c.cfg.CloseType {
+	case channeldb.LocalForceClose, channeldb.RemoteForceClose:
+	default:
+		return nil, nil
+	}
+
+	if c.cfg.FetchCommitments == nil {
+		return nil, fmt.Errorf("no stored commitments available")
+	}
+	commitments, err := c.cfg.FetchCommitments()
+	if err != nil {
+		return nil, err
+	}
+
+	closeTxid := c.cfg.ClosingTXID
+
+	commitSet := &CommitSet{
+		HtlcSets: make(map[HtlcSetKey][]channeldb.HTLC),
+	}
+	for htlcSetKey, commitment := range commitments {
+		htlcSetKey := htlcSetKey
+
+		commitSet.HtlcSets[htlcSetKey] = commitment.Htlcs
+
+		if commitment.CommitTx != nil &&
+			commitment.CommitTx.TxHash() == closeTxid {
+
+			commitSet.ConfCommitKey = &htlcSetKey
+		}
+	}
+
+	if commitSet.ConfCommitKey == nil {
+		return nil, fmt.Errorf("closing txid=%v doesn't match any "+
+			"stored commitment", closeTxid)
+	}
+
+	log.Infof("ChannelArbitrator(%v): reconstructed confirmed commit "+
+		"set, closing txid=%v matches %v", c.cfg.ChanPoint, closeTxid,
+		c.cfg.CloseType)
+
+	if err := c.log.InsertConfirmedCommitSet(commitSet); err != nil {
+		return nil, err
+	}
+
+	return commitSet, nil
+}
+
 // relauchResolvers relaunches the set of resolvers for unresolved contracts in
 // order to provide them with information that's not immediately available upon
 // starting the ChannelArbitrator. This information should ideally be stored in
@@ -621,6 +817,125 @@ func (c *ChannelArbitrator) Report() []*ContractReport {
 	return reports
 }
 
+// ResolverKind denotes the kind of contract an active resolver is resolving.
+type ResolverKind uint8
+
+const (
+	// ResolverKindOutgoingHtlc is a resolver for an outgoing HTLC.
+	ResolverKindOutgoingHtlc ResolverKind = iota
+
+	// ResolverKindIncomingHtlc is a resolver for an incoming HTLC.
+	ResolverKindIncomingHtlc
+
+	// ResolverKindCommit is a resolver for our output on the commitment
+	// transaction.
+	ResolverKindCommit
+)
+
+// String returns a human readable string describing a resolver kind.
+func (k ResolverKind) String() string {
+	switch k {
+	case ResolverKindOutgoingHtlc:
+		return "OutgoingHtlc"
+
+	case ResolverKindIncomingHtlc:
+		return "IncomingHtlc"
+
+	case ResolverKindCommit:
+		return "Commit"
+
+	default:
+		return "<unknown kind>"
+	}
+}
+
+// ResolverStage denotes what an active resolver is currently waiting on.
+type ResolverStage uint8
+
+const (
+	// ResolverWaitingTimeout indicates that the resolver is waiting for a
+	// timeout (or a preimage) before it can act.
+	ResolverWaitingTimeout ResolverStage = iota
+
+	// ResolverWaitingConfirmation indicates that the resolver has acted,
+	// and is waiting for its transaction to confirm or its output to
+	// mature.
+	ResolverWaitingConfirmation
+)
+
+// String returns a human readable string describing a resolver stage.
+func (s ResolverStage) String() string {
+	switch s {
+	case ResolverWaitingTimeout:
+		return "WaitingTimeout"
+
+	case ResolverWaitingConfirmation:
+		return "WaitingConfirmation"
+
+	default:
+		return "<unknown stage>"
+	}
+}
+
+// ResolverReport is a snapshot of the progress of a single active resolver.
+type ResolverReport struct {
+	// Kind is the kind of contract the resolver is resolving.
+	Kind ResolverKind
+
+	// Stage is what the resolver is currently waiting on.
+	Stage ResolverStage
+}
+
+// PendingResolutions returns a snapshot of all active resolvers that haven't
+// yet been fully resolved. It is safe to call while the state machine is
+// running.
+func (c *ChannelArbitrator) PendingResolutions() []ResolverReport {
+	c.activeResolversLock.RLock()
+	defer c.activeResolversLock.RUnlock()
+
+	var reports []ResolverReport
+	for _, resolver := range c.activeResolvers {
+		if resolver.IsResolved() {
+			continue
+		}
+
+		var report ResolverReport
+		switch r := resolver.(type) {
+		case *htlcOutgoingContestResolver:
+			report.Kind = ResolverKindOutgoingHtlc
+			report.Stage = ResolverWaitingTimeout
+
+		case *htlcTimeoutResolver:
+			report.Kind = ResolverKindOutgoingHtlc
+			report.Stage = ResolverWaitingTimeout
+			if r.isIncubating() {
+				report.Stage = ResolverWaitingConfirmation
+			}
+
+		case *htlcIncomingContestResolver:
+			report.Kind = ResolverKindIncomingHtlc
+			report.Stage = ResolverWaitingTimeout
+
+		case *htlcSuccessResolver:
+			report.Kind = ResolverKindIncomingHtlc
+			report.Stage = ResolverWaitingConfirmation
+
+		case *commitSweepResolver:
+			report.Kind = ResolverKindCommit
+			report.Stage = ResolverWaitingConfirmation
+
+		default:
+			log.Warnf("ChannelArbitrator(%v): unknown resolver "+
+				"type %T", c.cfg.ChanPoint, resolver)
+			continue
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports
+}
+
 // Stop signals the ChannelArbitrator for a graceful shutdown.
 func (c *ChannelArbitrator) Stop() error {
 	if !atomic.CompareAndSwapInt32(&c.stopped, 0, 1) {
@@ -678,6 +993,15 @@ const (
 	// being confirmed. In this case the channel arbitrator won't have to
 	// do anything, so we'll just clean up and exit gracefully.
 	breachCloseTrigger
+
+	// coopCloseNegotiationTrigger is a transition trigger driven by a
+	// cooperative close negotiation with the remote party being started.
+	coopCloseNegotiationTrigger
+
+	// coopCloseAbortTrigger is a transition trigger driven by a
+	// cooperative close negotiation being aborted before a closing
+	// transaction was agreed upon.
+	coopCloseAbortTrigger
 )
 
 // String returns a human readable string describing the passed
@@ -702,6 +1026,12 @@ func (t transitionTrigger) String() string {
 	case breachCloseTrigger:
 		return "breachCloseTrigger"
 
+	case coopCloseNegotiationTrigger:
+		return "coopCloseNegotiationTrigger"
+
+	case coopCloseAbortTrigger:
+		return "coopCloseAbortTrigger"
+
 	default:
 		return "unknown trigger"
 	}
@@ -710,20 +1040,30 @@ func (t transitionTrigger) String() string {
 // stateStep is a help method that examines our internal state, and attempts
 // the appropriate state transition if necessary. The next state we transition
 // to is returned, Additionally, if the next transition results in a commitment
-// broadcast, the commitment transaction itself is returned.
+// broadcast, a summary of the force close is returned.
 func (c *ChannelArbitrator) stateStep(
 	triggerHeight uint32, trigger transitionTrigger,
-	confCommitSet *CommitSet) (ArbitratorState, *wire.MsgTx, error) {
+	confCommitSet *CommitSet) (ArbitratorState, *ForceCloseResult, error) {
 
 	var (
-		nextState ArbitratorState
-		closeTx   *wire.MsgTx
+		nextState   ArbitratorState
+		closeResult *ForceCloseResult
 	)
 	switch c.state {
 
 	// If we're in the default state, then we'll check our set of actions
 	// to see if while we were down, conditions have changed.
 	case StateDefault:
+		// A cooperative close negotiation doesn't depend on the state
+		// of our HTLCs, so we can move on right away.
+		switch trigger {
+		case coopCloseNegotiationTrigger:
+			return StateCoopCloseNegotiation, closeResult, nil
+
+		case coopCloseAbortTrigger:
+			return StateDefault, closeResult, nil
+		}
+
 		log.Debugf("ChannelArbitrator(%v): new block (height=%v) "+
 			"examining active HTLC's", c.cfg.ChanPoint,
 			triggerHeight)
@@ -754,7 +1094,7 @@ func (c *ChannelArbitrator) stateStep(
 			log.Tracef("ChannelArbitrator(%v): no actions for "+
 				"chain trigger, terminating", c.cfg.ChanPoint)
 
-			return StateDefault, closeTx, nil
+			return StateDefault, closeResult, nil
 		}
 
 		// Otherwise, we'll log that we checked the HTLC actions as the
@@ -772,9 +1112,14 @@ func (c *ChannelArbitrator) stateStep(
 
 		// If this is a chain trigger, then we'll go straight to the
 		// next state, as we still need to broadcast the commitment
-		// transaction.
+		// transaction. We'll note which kind of HTLC forced us to go
+		// to chain.
 		case chainTrigger:
-			fallthrough
+			c.closeCause = chainActionsCloseCause(chainActions)
+			nextState = StateBroadcastCommit
+
+		// For a user trigger, the close cause was set by the
+		// requester.
 		case userTrigger:
 			nextState = StateBroadcastCommit
 
@@ -798,6 +1143,53 @@ func (c *ChannelArbitrator) stateStep(
 			nextState = StateContractClosed
 		}
 
+	// If we're in this state, then we're negotiating a cooperative close
+	// with the remote party. Until the closing transaction confirms, the
+	// channel can still be closed by either commitment, and we still need
+	// to watch our HTLCs.
+	case StateCoopCloseNegotiation:
+		switch trigger {
+		// Once the closing transaction is confirmed, there are no
+		// contracts left to resolve. The same is true in the case of a
+		// breach.
+		case coopCloseTrigger, breachCloseTrigger:
+			nextState = StateFullyResolved
+
+		// If a commitment confirmed instead, we'll inspect the set of
+		// unresolved contracts.
+		case localCloseTrigger, remoteCloseTrigger:
+			nextState = StateContractClosed
+
+		// If the negotiation failed, the channel goes back to normal
+		// operation.
+		case coopCloseAbortTrigger:
+			nextState = StateDefault
+
+		// The user may decide to force close a stalled negotiation.
+		case userTrigger:
+			nextState = StateBroadcastCommit
+
+		// Otherwise, we'll only go to chain if one of our HTLCs
+		// requires it.
+		case chainTrigger:
+			chainActions, err := c.checkLocalChainActions(
+				triggerHeight, trigger, c.activeHTLCs, false,
+			)
+			if err != nil {
+				return StateCoopCloseNegotiation, nil, err
+			}
+			if len(chainActions) == 0 {
+				return StateCoopCloseNegotiation, closeResult,
+					nil
+			}
+
+			c.closeCause = chainActionsCloseCause(chainActions)
+			nextState = StateBroadcastCommit
+
+		default:
+			return StateCoopCloseNegotiation, closeResult, nil
+		}
+
 	// If we're in this state, then we've decided to broadcast the
 	// commitment transaction. We enter this state either due to an outside
 	// sub-system, or because an on-chain action has been triggered.
@@ -816,14 +1208,21 @@ func (c *ChannelArbitrator) stateStep(
 				"close after closing channel, fast-forwarding "+
 				"to %s to resolve contract",
 				c.cfg.ChanPoint, trigger, StateContractClosed)
-			return StateContractClosed, closeTx, nil
+			return StateContractClosed, closeResult, nil
 
 		case coopCloseTrigger, breachCloseTrigger:
 			log.Infof("ChannelArbitrator(%v): detected %s "+
 				"close after closing channel, fast-forwarding "+
 				"to %s to resolve contract",
 				c.cfg.ChanPoint, trigger, StateFullyResolved)
-			return StateFullyResolved, closeTx, nil
+			return StateFullyResolved, closeResult, nil
+		}
+
+		// If this is a manual close and fees are spiking, we'll hold
+		// off broadcasting until the next block.
+		c.forceCloseDeferred = c.shouldDeferForceClose(triggerHeight)
+		if c.forceCloseDeferred {
+			return StateBroadcastCommit, closeResult, nil
 		}
 
 		log.Infof("ChannelArbitrator(%v): force closing "+
@@ -840,18 +1239,19 @@ func (c *ChannelArbitrator) stateStep(
 		if err != nil {
 			log.Errorf("ChannelArbitrator(%v): unable to "+
 				"force close: %v", c.cfg.ChanPoint, err)
-			return StateError, closeTx, err
+			return StateError, closeResult, err
 		}
-		closeTx = closeSummary.CloseTx
+		closeTx := closeSummary.CloseTx
 
 		// Before publishing the transaction, we store it to the
 		// database, such that we can re-publish later in case it
 		// didn't propagate.
-		if err := c.cfg.MarkCommitmentBroadcasted(closeTx); err != nil {
+		err = c.cfg.MarkCommitmentBroadcasted(closeTx, triggerHeight)
+		if err != nil {
 			log.Errorf("ChannelArbitrator(%v): unable to "+
 				"mark commitment broadcasted: %v",
 				c.cfg.ChanPoint, err)
-			return StateError, closeTx, err
+			return StateError, closeResult, err
 		}
 
 		// With the close transaction in hand, broadcast the
@@ -869,10 +1269,14 @@ func (c *ChannelArbitrator) stateStep(
 			log.Errorf("ChannelArbitrator(%v): unable to broadcast "+
 				"close tx: %v", c.cfg.ChanPoint, err)
 			if err != lnwallet.ErrDoubleSpend {
-				return StateError, closeTx, err
+				return StateError, closeResult, err
 			}
 		}
 
+		// Now that the commitment is on its way, we'll summarize the
+		// contracts that will need to be resolved once it confirms.
+		closeResult = c.newForceCloseResult(closeSummary, triggerHeight)
+
 		// We go to the StateCommitmentBroadcasted state, where we'll
 		// be waiting for the commitment to be confirmed.
 		nextState = StateCommitmentBroadcasted
@@ -917,7 +1321,7 @@ func (c *ChannelArbitrator) stateStep(
 		if err != nil {
 			log.Errorf("unable to fetch contract resolutions: %v",
 				err)
-			return StateError, closeTx, err
+			return StateError, closeResult, err
 		}
 
 		// If the resolution is empty, and we have no HTLCs at all to
@@ -948,7 +1352,7 @@ func (c *ChannelArbitrator) stateStep(
 				// TODO(roasbeef): check for AlreadyExists errors
 				log.Errorf("unable to incubate commitment "+
 					"output: %v", err)
-				return StateError, closeTx, err
+				return StateError, closeResult, err
 			}
 		}
 
@@ -962,7 +1366,7 @@ func (c *ChannelArbitrator) stateStep(
 		if err != nil {
 			log.Errorf("ChannelArbitrator(%v): unable to "+
 				"resolve contracts: %v", c.cfg.ChanPoint, err)
-			return StateError, closeTx, err
+			return StateError, closeResult, err
 		}
 
 		log.Debugf("ChannelArbitrator(%v): sending resolution message=%v",
@@ -979,7 +1383,7 @@ func (c *ChannelArbitrator) stateStep(
 				// TODO(roasbeef): make sure packet sends are
 				// idempotent
 				log.Errorf("unable to send pkts: %v", err)
-				return StateError, closeTx, err
+				return StateError, closeResult, err
 			}
 		}
 
@@ -988,7 +1392,7 @@ func (c *ChannelArbitrator) stateStep(
 
 		err = c.log.InsertUnresolvedContracts(htlcResolvers...)
 		if err != nil {
-			return StateError, closeTx, err
+			return StateError, closeResult, err
 		}
 
 		// Finally, we'll launch all the required contract resolvers.
@@ -1005,7 +1409,7 @@ func (c *ChannelArbitrator) stateStep(
 
 		numUnresolved, err := c.log.FetchUnresolvedContracts()
 		if err != nil {
-			return StateError, closeTx, err
+			return StateError, closeResult, err
 		}
 
 		// If we still have unresolved contracts, then we'll stay alive
@@ -1027,16 +1431,32 @@ func (c *ChannelArbitrator) stateStep(
 		log.Infof("ChannelPoint(%v) has been fully resolved "+
 			"on-chain at height=%v", c.cfg.ChanPoint, triggerHeight)
 
+		// Before marking the channel resolved, we'll make sure this
+		// hasn't already been done. Once the channel is marked
+		// resolved, the log is wiped, so if the persisted state no
+		// longer reflects a fully resolved contract, a prior attempt
+		// already went through.
+		persistedState, err := c.log.CurrentState()
+		if err != nil {
+			return StateError, closeResult, err
+		}
+		if persistedState != StateFullyResolved {
+			log.Debugf("ChannelArbitrator(%v): channel already "+
+				"marked resolved, persisted state=%v",
+				c.cfg.ChanPoint, persistedState)
+			break
+		}
+
 		if err := c.cfg.MarkChannelResolved(); err != nil {
 			log.Errorf("unable to mark channel resolved: %v", err)
-			return StateError, closeTx, err
+			return StateError, closeResult, err
 		}
 	}
 
 	log.Tracef("ChannelArbitrator(%v): next_state=%v", c.cfg.ChanPoint,
 		nextState)
 
-	return nextState, closeTx, nil
+	return nextState, closeResult, nil
 }
 
 // launchResolvers updates the activeResolvers list and starts the resolvers.
@@ -1051,6 +1471,75 @@ func (c *ChannelArbitrator) launchResolvers(resolvers []ContractResolver) {
 	}
 }
 
+// newForceCloseResult summarizes the consequences of force closing the channel
+// at the given height with the passed close summary.
+func (c *ChannelArbitrator) newForceCloseResult(
+	closeSummary *lnwallet.LocalForceCloseSummary,
+	height uint32) *ForceCloseResult {
+
+	result := &ForceCloseResult{
+		CloseTx: closeSummary.CloseTx,
+	}
+
+	// All outputs we'll sweep from our commitment, either directly or
+	// from a second-level HTLC transaction, are encumbered by our CSV
+	// delay.
+	var csvDelay uint32
+	switch {
+	case closeSummary.CommitResolution != nil:
+		csvDelay = closeSummary.CommitResolution.MaturityDelay
+
+	case closeSummary.HtlcResolutions != nil &&
+		len(closeSummary.HtlcResolutions.OutgoingHTLCs) > 0:
+
+		csvDelay = closeSummary.HtlcResolutions.OutgoingHTLCs[0].CsvDelay
+
+	case closeSummary.HtlcResolutions != nil &&
+		len(closeSummary.HtlcResolutions.IncomingHTLCs) > 0:
+
+		csvDelay = closeSummary.HtlcResolutions.IncomingHTLCs[0].CsvDelay
+	}
+	result.BlocksToResolution = csvDelay
+
+	// Dust HTLCs don't have an output on the commitment, so only the
+	// remaining HTLCs on our commitment will need to be resolved on
+	// chain. In the worst case, each of them is resolved once it
+	// expires and its second-level output matured.
+	htlcs := c.activeHTLCs[LocalHtlcSet]
+	for _, set := range []map[uint64]channeldb.HTLC{
+		htlcs.incomingHTLCs, htlcs.outgoingHTLCs,
+	} {
+		for _, htlc := range set {
+			if htlc.OutputIndex < 0 {
+				continue
+			}
+
+			result.PendingHTLCs = append(result.PendingHTLCs, htlc)
+
+			var blocks uint32
+			if htlc.RefundTimeout > height {
+				blocks = htlc.RefundTimeout - height
+			}
+			blocks += csvDelay
+
+			if blocks > result.BlocksToResolution {
+				result.BlocksToResolution = blocks
+			}
+		}
+	}
+
+	// Sort the HTLCs so the result doesn't depend on map iteration order.
+	sort.Slice(result.PendingHTLCs, func(i, j int) bool {
+		a, b := result.PendingHTLCs[i], result.PendingHTLCs[j]
+		if a.Incoming != b.Incoming {
+			return a.Incoming
+		}
+		return a.HtlcIndex < b.HtlcIndex
+	})
+
+	return result
+}
+
 // advanceState is the main driver of our state machine. This method is an
 // iterative function which repeatedly attempts to advance the internal state
 // of the channel arbitrator. The state will be advanced until we reach a
@@ -1059,11 +1548,11 @@ func (c *ChannelArbitrator) launchResolvers(resolvers []ContractResolver) {
 // after each state transition.
 func (c *ChannelArbitrator) advanceState(
 	triggerHeight uint32, trigger transitionTrigger,
-	confCommitSet *CommitSet) (ArbitratorState, *wire.MsgTx, error) {
+	confCommitSet *CommitSet) (ArbitratorState, *ForceCloseResult, error) {
 
 	var (
-		priorState   ArbitratorState
-		forceCloseTx *wire.MsgTx
+		priorState       ArbitratorState
+		forceCloseResult *ForceCloseResult
 	)
 
 	// We'll continue to advance our state forward until the state we
@@ -1074,7 +1563,7 @@ func (c *ChannelArbitrator) advanceState(
 			"trigger=%v from state=%v", c.cfg.ChanPoint, trigger,
 			priorState)
 
-		nextState, closeTx, err := c.stateStep(
+		nextState, closeResult, err := c.stateStep(
 			triggerHeight, trigger, confCommitSet,
 		)
 		if err != nil {
@@ -1083,8 +1572,8 @@ func (c *ChannelArbitrator) advanceState(
 			return priorState, nil, err
 		}
 
-		if forceCloseTx == nil && closeTx != nil {
-			forceCloseTx = closeTx
+		if forceCloseResult == nil && closeResult != nil {
+			forceCloseResult = closeResult
 		}
 
 		// Our termination transition is a noop transition. If we get
@@ -1093,20 +1582,138 @@ func (c *ChannelArbitrator) advanceState(
 		if nextState == priorState {
 			log.Tracef("ChannelArbitrator(%v): terminating at "+
 				"state=%v", c.cfg.ChanPoint, nextState)
-			return nextState, forceCloseTx, nil
+			return nextState, forceCloseResult, nil
 		}
 
 		// As the prior state was successfully executed, we can now
 		// commit the next state. This ensures that we will re-execute
 		// the prior state if anything fails.
-		if err := c.log.CommitState(nextState); err != nil {
+		err = c.log.CommitState(nextState, c.closeCause)
+		if err != nil {
 			log.Errorf("ChannelArbitrator(%v): unable to commit "+
 				"next state(%v): %v", c.cfg.ChanPoint,
 				nextState, err)
 			return priorState, nil, err
 		}
+
+		c.stateMtx.Lock()
 		c.state = nextState
+		c.stateMtx.Unlock()
+	}
+}
+
+// checkCommitFeeRate consults the fee estimator to determine whether the fee
+// rate paid by our commitment transaction has fallen too far below the current
+// estimate. If so, the commitment is flagged as needing a fee bump.
+//
+// NOTE: Commitments in this tree have no anchor outputs, so there is no output
+// we can spend to CPFP the commitment. Until anchors are supported, we can only
+// surface the condition.
+func (c *ChannelArbitrator) checkCommitFeeRate() error {
+	if c.cfg.FeeEstimator == nil || c.cfg.CommitFeeRate == 0 {
+		return nil
+	}
+
+	tooLow, feeEstimate, err := commitFeeTooLow(
+		c.cfg.FeeEstimator, c.cfg.CommitFeeRate,
+	)
+	if err != nil {
+		return err
+	}
+	if !tooLow {
+		return nil
+	}
+
+	log.Warnf("ChannelArbitrator(%v): commitment fee rate %v is far "+
+		"below the current estimate of %v, commitment needs a fee bump",
+		c.cfg.ChanPoint, c.cfg.CommitFeeRate, feeEstimate)
+
+	atomic.StoreInt32(&c.commitFeeBumpNeeded, 1)
+
+	return nil
+}
+
+// shouldDeferForceClose returns true if broadcasting our commitment at the
+// given height should be deferred, as the force close was requested by the
+// user and the current fee rate estimate exceeds ForceCloseMaxFeeRate. Force
+// closes that are needed to meet an HTLC deadline are never deferred.
+func (c *ChannelArbitrator) shouldDeferForceClose(height uint32) bool {
+	if c.cfg.FeeEstimator == nil || c.cfg.ForceCloseMaxFeeRate == 0 {
+		return false
 	}
+	if c.closeCause != channeldb.CloseCauseUserRequest {
+		return false
+	}
+
+	// While we wait, an HTLC may have come close enough to its deadline
+	// that we need to go to chain regardless of the fee rate.
+	chainActions, err := c.checkLocalChainActions(
+		height, chainTrigger, c.activeHTLCs, false,
+	)
+	if err != nil {
+		log.Errorf("ChannelArbitrator(%v): unable to check chain "+
+			"actions: %v", c.cfg.ChanPoint, err)
+		return false
+	}
+	if len(chainActions) > 0 {
+		c.closeCause = chainActionsCloseCause(chainActions)
+		return false
+	}
+
+	feeEstimate, err := c.cfg.FeeEstimator.EstimateFeePerKB(
+		commitFeeConfTarget,
+	)
+	if err != nil {
+		log.Errorf("ChannelArbitrator(%v): unable to estimate fee "+
+			"rate: %v", c.cfg.ChanPoint, err)
+		return false
+	}
+	if feeEstimate <= c.cfg.ForceCloseMaxFeeRate {
+		return false
+	}
+
+	log.Infof("ChannelArbitrator(%v): deferring force close at "+
+		"height=%v, fee rate estimate %v exceeds maximum of %v",
+		c.cfg.ChanPoint, height, feeEstimate,
+		c.cfg.ForceCloseMaxFeeRate)
+
+	return true
+}
+
+// forceCloseAllowed returns whether a force close request is accepted by an
+// arbitrator in the given state. Once we've decided to go on chain, any
+// further requests are rejected with errAlreadyForceClosed.
+func forceCloseAllowed(state ArbitratorState) bool {
+	return state == StateDefault || state == StateCoopCloseNegotiation
+}
+
+// CanForceClose returns whether a force close request would currently be
+// accepted by the arbitrator. If not, a human readable reason is returned as
+// well. As the arbitrator may advance its state concurrently, the result is
+// only advisory, e.g. to disable a force close button in a UI.
+func (c *ChannelArbitrator) CanForceClose() (bool, string) {
+	select {
+	case <-c.quit:
+		return false, "channel arbitrator is shutting down"
+	default:
+	}
+
+	c.stateMtx.RLock()
+	state := c.state
+	c.stateMtx.RUnlock()
+
+	if !forceCloseAllowed(state) {
+		return false, fmt.Sprintf("%v (state=%v)",
+			errAlreadyForceClosed, state)
+	}
+
+	return true, ""
+}
+
+// CommitFeeBumpNeeded returns true if the arbitrator found on start up that
+// its broadcast commitment pays too low a fee rate to confirm in time.
+func (c *ChannelArbitrator) CommitFeeBumpNeeded() bool {
+	return atomic.LoadInt32(&c.commitFeeBumpNeeded) == 1
 }
 
 // ChainAction is an enum that encompasses all possible on-chain actions
@@ -1172,6 +1779,20 @@ func (c ChainAction) String() string {
 	}
 }
 
+// chainActionsCloseCause returns the close cause for a set of chain actions
+// that forced us to go to chain. If any outgoing HTLC is timing out (either on
+// our commitment, or dangling on the remote commitment), we're going to chain
+// to time it out. Otherwise, we're going to chain to claim an incoming HTLC.
+func chainActionsCloseCause(actions ChainActionMap) channeldb.CloseCause {
+	if len(actions[HtlcTimeoutAction]) > 0 ||
+		len(actions[HtlcFailNowAction]) > 0 {
+
+		return channeldb.CloseCauseHtlcTimeout
+	}
+
+	return channeldb.CloseCauseHtlcClaim
+}
+
 // ChainActionMap is a map of a chain action, to the set of HTLC's that need to
 // be acted upon for a given action type. The channel
 type ChainActionMap map[ChainAction][]channeldb.HTLC
@@ -1210,6 +1831,17 @@ func (c *ChannelArbitrator) shouldGoOnChain(htlcExpiry, broadcastDelta,
 	return currentHeight >= broadcastCutOff
 }
 
+// danglingBroadcastDelta returns the delta we'll use to decide when to go on
+// chain for outgoing HTLCs that only exist on the remote commitment. If no
+// dedicated delta is configured, the outgoing broadcast delta is used.
+func (c *ChannelArbitrator) danglingBroadcastDelta() uint32 {
+	if c.cfg.DanglingBroadcastDelta == 0 {
+		return c.cfg.OutgoingBroadcastDelta
+	}
+
+	return c.cfg.DanglingBroadcastDelta
+}
+
 // checkCommitChainActions is called for each new block connected to the end of
 // the main chain. Given the new block height, this new method will examine all
 // active HTLC's, and determine if we need to go on-chain to claim any of them.
@@ -1489,8 +2121,7 @@ func (c *ChannelArbitrator) checkRemoteDanglingActions(
 		// We'll now check if we need to go to chain in order to cancel
 		// the incoming HTLC.
 		goToChain := c.shouldGoOnChain(
-			htlc.RefundTimeout, c.cfg.OutgoingBroadcastDelta,
-			height,
+			htlc.RefundTimeout, c.danglingBroadcastDelta(), height,
 		)
 
 		// If we don't need to go to chain, and no commitments have
@@ -1968,6 +2599,11 @@ func (c *ChannelArbitrator) resolveContract(currentContract ContractResolver) {
 					"contract %T fully resolved",
 					c.cfg.ChanPoint, currentContract)
 
+				// Record how the contract was resolved, so
+				// the outcome remains queryable once the
+				// channel is closed.
+				c.logResolverReport(currentContract)
+
 				err := c.log.ResolveContract(currentContract)
 				if err != nil {
 					log.Errorf("unable to resolve contract: %v",
@@ -2000,6 +2636,29 @@ type signalUpdateMsg struct {
 	doneChan chan struct{}
 }
 
+// logResolverReport stores a report on the outcome of the given fully resolved
+// contract in the arbitrator log, if the contract is able to report it.
+func (c *ChannelArbitrator) logResolverReport(resolver ContractResolver) {
+	r, ok := resolver.(outcomeReportingResolver)
+	if !ok {
+		return
+	}
+
+	report := r.resolutionReport()
+	if report == nil {
+		return
+	}
+
+	log.Infof("ChannelArbitrator(%v): contract %v resolved with "+
+		"outcome=%v, sweep_txid=%v", c.cfg.ChanPoint, report.OutPoint,
+		report.Outcome, report.SweepTxid)
+
+	if err := c.log.LogResolverReport(report); err != nil {
+		log.Errorf("ChannelArbitrator(%v): unable to log resolver "+
+			"report: %v", c.cfg.ChanPoint, err)
+	}
+}
+
 // UpdateContractSignals updates the set of signals the ChannelArbitrator needs
 // to receive from a channel in real-time in order to keep in sync with the
 // latest state of the contract.
@@ -2020,6 +2679,71 @@ func (c *ChannelArbitrator) UpdateContractSignals(newSignals *ContractSignals) {
 	}
 }
 
+// NotifyCoopCloseNegotiation notifies the arbitrator that a cooperative close
+// negotiation for its channel was started (active) or aborted (!active). This
+// method doesn't block, as only the latest notification is acted upon.
+func (c *ChannelArbitrator) NotifyCoopCloseNegotiation(active bool) error {
+	select {
+	case <-c.quit:
+		return ErrChainArbExiting
+	default:
+	}
+
+	c.coopCloseMtx.Lock()
+	c.coopCloseNegotiating = active
+	c.coopCloseMtx.Unlock()
+
+	select {
+	case c.coopCloseSignal <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// registerCoopCloseConf registers for a notification once the closing
+// transaction of a cooperative close reaches CoopCloseConfDepth
+// confirmations.
+func (c *ChannelArbitrator) registerCoopCloseConf(closeTx *wire.MsgTx,
+	closeHeight uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	if closeTx == nil || len(closeTx.TxOut) == 0 {
+		return nil, fmt.Errorf("closing transaction of "+
+			"ChannelPoint(%v) unknown", c.cfg.ChanPoint)
+	}
+
+	closeTxid := closeTx.TxHash()
+
+	log.Infof("ChannelArbitrator(%v): waiting for cooperative close "+
+		"txid=%v to reach %v confirmations", c.cfg.ChanPoint,
+		closeTxid, c.cfg.CoopCloseConfDepth)
+
+	return c.cfg.Notifier.RegisterConfirmationsNtfn(
+		&closeTxid, closeTx.TxOut[0].PkScript,
+		c.cfg.CoopCloseConfDepth, closeHeight,
+	)
+}
+
+// resumeCoopCloseConf registers for the confirmation of a cooperative close
+// that was found pending on startup. If the closing transaction wasn't stored,
+// there's nothing to wait for, and nil is returned.
+func (c *ChannelArbitrator) resumeCoopCloseConf() (
+	*chainntnfs.ConfirmationEvent, error) {
+
+	closeTx, err := c.log.FetchCoopCloseTx()
+	switch {
+	case err == errNoCoopCloseTx || err == errScopeBucketNoExist:
+		log.Warnf("ChannelArbitrator(%v): no cooperative close tx "+
+			"stored, resolving channel", c.cfg.ChanPoint)
+		return nil, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	return c.registerCoopCloseConf(closeTx, c.cfg.ClosingHeight)
+}
+
 // channelAttendant is the primary goroutine that acts at the judicial
 // arbitrator between our channel state, the remote channel peer, and the
 // blockchain Our judge). This goroutine will ensure that we faithfully execute
@@ -2032,7 +2756,8 @@ func (c *ChannelArbitrator) UpdateContractSignals(newSignals *ContractSignals) {
 // Nursery for incubation, and ultimate sweeping.
 //
 // NOTE: This MUST be run as a goroutine.
-func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
+func (c *ChannelArbitrator) channelAttendant(bestHeight int32,
+	coopCloseConf *chainntnfs.ConfirmationEvent) {
 
 	// TODO(roasbeef): tell top chain arb we're done
 	defer func() {
@@ -2040,7 +2765,25 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 		c.wg.Done()
 	}()
 
+	// If we need to wait for a cooperative close to reach a certain depth
+	// before resolving the channel, we'll keep track of the pending
+	// confirmation here. It is already set if we resumed waiting for a
+	// cooperative close on startup.
+	coopCloseHeight := c.cfg.ClosingHeight
+	defer func() {
+		if coopCloseConf != nil && coopCloseConf.Cancel != nil {
+			coopCloseConf.Cancel()
+		}
+	}()
+
 	for {
+		// Only select on the confirmation channel if we're actually
+		// waiting on a cooperative close.
+		var coopCloseConfirmed chan *chainntnfs.TxConfirmation
+		if coopCloseConf != nil {
+			coopCloseConfirmed = coopCloseConf.Confirmed
+		}
+
 		select {
 
 		// A new block has arrived, we'll examine all the active HTLC's
@@ -2054,8 +2797,13 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 
 			// If we're not in the default state, then we can
 			// ignore this signal as we're waiting for contract
-			// resolution.
-			if c.state != StateDefault {
+			// resolution, unless we're still negotiating a
+			// cooperative close, or deferred broadcasting our
+			// commitment and should reconsider.
+			if c.state != StateDefault &&
+				c.state != StateCoopCloseNegotiation &&
+				!c.forceCloseDeferred {
+
 				continue
 			}
 
@@ -2116,6 +2864,20 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 			log.Infof("ChannelArbitrator(%v) marking channel "+
 				"cooperatively closed", c.cfg.ChanPoint)
 
+			// If the closing transaction needs to be buried deeper
+			// than a single confirmation, we'll store it before
+			// marking the channel closed, so we can keep waiting
+			// for it after a restart.
+			waitForDepth := c.cfg.CoopCloseConfDepth > 1
+			if waitForDepth && closeInfo.CloseTx != nil {
+				err := c.log.LogCoopCloseTx(closeInfo.CloseTx)
+				if err != nil {
+					log.Errorf("Unable to log close tx: "+
+						"%v", err)
+					return
+				}
+			}
+
 			err := c.cfg.MarkChannelClosed(
 				closeInfo.ChannelCloseSummary,
 			)
@@ -2125,6 +2887,23 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 				return
 			}
 
+			// Hold off on resolving the channel until the closing
+			// transaction reaches the required depth.
+			if waitForDepth {
+				coopCloseConf, err = c.registerCoopCloseConf(
+					closeInfo.CloseTx,
+					closeInfo.CloseHeight,
+				)
+				if err != nil {
+					log.Errorf("Unable to register for "+
+						"close confirmation: %v", err)
+					return
+				}
+				coopCloseHeight = closeInfo.CloseHeight
+
+				continue
+			}
+
 			// We'll now advance our state machine until it reaches
 			// a terminal state, and the channel is marked resolved.
 			_, _, err = c.advanceState(
@@ -2135,6 +2914,26 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 				return
 			}
 
+		// The cooperative close transaction has reached the required
+		// number of confirmations, so the channel can now be resolved.
+		case _, ok := <-coopCloseConfirmed:
+			if !ok {
+				return
+			}
+			coopCloseConf = nil
+
+			log.Infof("ChannelArbitrator(%v): cooperative close "+
+				"reached %v confirmations", c.cfg.ChanPoint,
+				c.cfg.CoopCloseConfDepth)
+
+			_, _, err := c.advanceState(
+				coopCloseHeight, coopCloseTrigger, nil,
+			)
+			if err != nil {
+				log.Errorf("Unable to advance state: %v", err)
+				return
+			}
+
 		// We have broadcasted our commitment, and it is now confirmed
 		// on-chain.
 		case closeInfo := <-c.cfg.ChainEvents.LocalUnilateralClosure:
@@ -2184,7 +2983,9 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 			// we won't be longer getting chain events. In this
 			// case we must manually re-trigger the state
 			// transition into StateContractClosed based on the
-			// close status of the channel.
+			// close status of the channel. We'll also record why
+			// we went to chain in the first place.
+			closeInfo.ChannelCloseSummary.CloseCause = c.closeCause
 			err = c.cfg.MarkChannelClosed(
 				closeInfo.ChannelCloseSummary,
 			)
@@ -2310,12 +3111,42 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 				return
 			}
 
+		// A cooperative close negotiation was either started or
+		// aborted. We'll only act on it if it's consistent with our
+		// current state, as we may already be going to chain.
+		case <-c.coopCloseSignal:
+			c.coopCloseMtx.Lock()
+			active := c.coopCloseNegotiating
+			c.coopCloseMtx.Unlock()
+
+			trigger := coopCloseAbortTrigger
+			expectedState := StateCoopCloseNegotiation
+			if active {
+				trigger = coopCloseNegotiationTrigger
+				expectedState = StateDefault
+			}
+
+			if c.state != expectedState {
+				log.Warnf("ChannelArbitrator(%v): ignoring %v "+
+					"in state=%v", c.cfg.ChanPoint, trigger,
+					c.state)
+				continue
+			}
+
+			_, _, err := c.advanceState(
+				uint32(bestHeight), trigger, nil,
+			)
+			if err != nil {
+				log.Errorf("Unable to advance state: %v", err)
+			}
+
 		// We've just received a request to forcibly close out the
 		// channel. We'll
 		case closeReq := <-c.forceCloseReqs:
-			if c.state != StateDefault {
+			if !forceCloseAllowed(c.state) {
+
 				select {
-				case closeReq.closeTx <- nil:
+				case closeReq.resp <- nil:
 				case <-c.quit:
 				}
 
@@ -2327,15 +3158,22 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 				continue
 			}
 
-			nextState, closeTx, err := c.advanceState(
+			c.closeCause = closeReq.cause
+			nextState, closeResult, err := c.advanceState(
 				uint32(bestHeight), userTrigger, nil,
 			)
 			if err != nil {
 				log.Errorf("Unable to advance state: %v", err)
 			}
 
+			// Let the caller know if the broadcast of our
+			// commitment was deferred.
+			if err == nil && c.forceCloseDeferred {
+				err = ErrForceCloseDeferred
+			}
+
 			select {
-			case closeReq.closeTx <- closeTx:
+			case closeReq.resp <- closeResult:
 			case <-c.quit:
 				return
 			}
@@ -6,10 +6,13 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/davecgh/go-spew/spew"
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/chainntnfs"
@@ -29,8 +32,11 @@ type mockArbitratorLog struct {
 	failCommitState ArbitratorState
 	resolutions     *ContractResolutions
 	resolvers       map[ContractResolver]struct{}
+	reports         []*ResolutionReport
 
-	commitSet *CommitSet
+	closeCause  channeldb.CloseCause
+	commitSet   *CommitSet
+	coopCloseTx *wire.MsgTx
 
 	sync.Mutex
 }
@@ -43,16 +49,25 @@ func (b *mockArbitratorLog) CurrentState() (ArbitratorState, error) {
 	return b.state, nil
 }
 
-func (b *mockArbitratorLog) CommitState(s ArbitratorState) error {
+func (b *mockArbitratorLog) CommitState(s ArbitratorState,
+	cause channeldb.CloseCause) error {
+
 	if b.failCommit && s == b.failCommitState {
 		return fmt.Errorf("intentional commit error at state %v",
 			b.failCommitState)
 	}
 	b.state = s
+	b.closeCause = cause
 	b.newStates <- s
 	return nil
 }
 
+func (b *mockArbitratorLog) CurrentCloseCause() (channeldb.CloseCause,
+	error) {
+
+	return b.closeCause, nil
+}
+
 func (b *mockArbitratorLog) FetchUnresolvedContracts() ([]ContractResolver,
 	error) {
 
@@ -123,10 +138,63 @@ func (b *mockArbitratorLog) InsertConfirmedCommitSet(c *CommitSet) error {
 	return nil
 }
 
+func (b *mockArbitratorLog) BatchConfirmedCommitSet(c *CommitSet,
+	_ *CommitSetBatch) error {
+
+	b.commitSet = c
+	return nil
+}
+
 func (b *mockArbitratorLog) FetchConfirmedCommitSet() (*CommitSet, error) {
 	return b.commitSet, nil
 }
 
+func (b *mockArbitratorLog) LogCoopCloseTx(closeTx *wire.MsgTx) error {
+	b.coopCloseTx = closeTx
+	return nil
+}
+
+func (b *mockArbitratorLog) FetchCoopCloseTx() (*wire.MsgTx, error) {
+	if b.coopCloseTx == nil {
+		return nil, errNoCoopCloseTx
+	}
+
+	return b.coopCloseTx, nil
+}
+
+func (b *mockArbitratorLog) HistorySummary() (ArbitratorState, int, error) {
+	b.Lock()
+	numItems := len(b.resolvers)
+	b.Unlock()
+
+	if b.resolutions != nil {
+		if b.resolutions.CommitResolution != nil {
+			numItems++
+		}
+		numItems += len(b.resolutions.HtlcResolutions.IncomingHTLCs)
+		numItems += len(b.resolutions.HtlcResolutions.OutgoingHTLCs)
+	}
+
+	return b.state, numItems, nil
+}
+
+func (b *mockArbitratorLog) LogResolverReport(report *ResolutionReport) error {
+	b.Lock()
+	b.reports = append(b.reports, report)
+	b.Unlock()
+
+	return nil
+}
+
+func (b *mockArbitratorLog) FetchResolverReports(_ wire.OutPoint) (
+	[]*ResolutionReport, error) {
+
+	b.Lock()
+	defer b.Unlock()
+
+	return b.reports, nil
+}
+
 func (b *mockArbitratorLog) WipeHistory() error {
 	return nil
 }
@@ -140,8 +208,10 @@ type testArbLog struct {
 	newStates chan ArbitratorState
 }
 
-func (t *testArbLog) CommitState(s ArbitratorState) error {
-	if err := t.ArbitratorLog.CommitState(s); err != nil {
+func (t *testArbLog) CommitState(s ArbitratorState,
+	cause channeldb.CloseCause) error {
+
+	if err := t.ArbitratorLog.CommitState(s, cause); err != nil {
 		return err
 	}
 
@@ -171,6 +241,46 @@ func (*mockChainIO) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error)
 	return nil, nil
 }
 
+// defaultTestFeeRate is the fee rate returned by the fee estimator of the test
+// channel arbitrator, unless changed by a test.
+const defaultTestFeeRate = lnwallet.AtomPerKByte(1e4)
+
+// mockFeeEstimator is a fee estimator whose estimate can be changed while it's
+// in use.
+type mockFeeEstimator struct {
+	feeRate int64 // To be used atomically.
+}
+
+var _ lnwallet.FeeEstimator = (*mockFeeEstimator)(nil)
+
+func newMockFeeEstimator(feeRate lnwallet.AtomPerKByte) *mockFeeEstimator {
+	m := &mockFeeEstimator{}
+	m.setFeeRate(feeRate)
+	return m
+}
+
+func (m *mockFeeEstimator) setFeeRate(feeRate lnwallet.AtomPerKByte) {
+	atomic.StoreInt64(&m.feeRate, int64(feeRate))
+}
+
+func (m *mockFeeEstimator) EstimateFeePerKB(uint32) (lnwallet.AtomPerKByte,
+	error) {
+
+	return lnwallet.AtomPerKByte(atomic.LoadInt64(&m.feeRate)), nil
+}
+
+func (m *mockFeeEstimator) RelayFeePerKB() lnwallet.AtomPerKByte {
+	return defaultTestFeeRate
+}
+
+func (m *mockFeeEstimator) Start() error {
+	return nil
+}
+
+func (m *mockFeeEstimator) Stop() error {
+	return nil
+}
+
 type chanArbTestCtx struct {
 	t *testing.T
 
@@ -186,6 +296,8 @@ type chanArbTestCtx struct {
 
 	resolutions chan []ResolutionMsg
 
+	feeEstimator *mockFeeEstimator
+
 	log ArbitratorLog
 }
 
@@ -285,8 +397,10 @@ func createTestChannelArbitrator(t *testing.T, log ArbitratorLog) (*chanArbTestC
 	incubateChan := make(chan struct{})
 
 	chainIO := &mockChainIO{}
+	feeEstimator := newMockFeeEstimator(defaultTestFeeRate)
 	chainArbCfg := ChainArbitratorConfig{
-		ChainIO: chainIO,
+		ChainIO:      chainIO,
+		FeeEstimator: feeEstimator,
 		PublishTx: func(*wire.MsgTx) error {
 			return nil
 		},
@@ -331,7 +445,7 @@ func createTestChannelArbitrator(t *testing.T, log ArbitratorLog) (*chanArbTestC
 			}
 			return summary, nil
 		},
-		MarkCommitmentBroadcasted: func(_ *wire.MsgTx) error {
+		MarkCommitmentBroadcasted: func(_ *wire.MsgTx, _ uint32) error {
 			return nil
 		},
 		MarkChannelClosed: func(*channeldb.ChannelCloseSummary) error {
@@ -384,6 +498,7 @@ func createTestChannelArbitrator(t *testing.T, log ArbitratorLog) (*chanArbTestC
 		blockEpochs:        blockEpochs,
 		log:                log,
 		incubationRequests: incubateChan,
+		feeEstimator:       feeEstimator,
 	}, nil
 }
 
@@ -424,7 +539,7 @@ func TestChannelArbitratorCooperativeClose(t *testing.T) {
 	// Cooperative close should do trigger a MarkChannelClosed +
 	// MarkChannelResolved.
 	closeInfo := &CooperativeCloseInfo{
-		&channeldb.ChannelCloseSummary{},
+		ChannelCloseSummary: &channeldb.ChannelCloseSummary{},
 	}
 	chanArbCtx.chanArb.cfg.ChainEvents.CooperativeClosure <- closeInfo
 
@@ -446,6 +561,323 @@ func TestChannelArbitratorCooperativeClose(t *testing.T) {
 	}
 }
 
+// TestChannelArbitratorCooperativeCloseConfDepth tests that a cooperative close
+// is only resolved once the closing transaction has reached the configured
+// confirmation depth.
+func TestChannelArbitratorCooperativeCloseConfDepth(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+
+	chanArbCtx, err := createTestChannelArbitrator(t, log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	chanArb := chanArbCtx.chanArb
+	chanArb.cfg.CoopCloseConfDepth = 3
+
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer func() {
+		if err := chanArb.Stop(); err != nil {
+			t.Fatalf("unable to stop chan arb: %v", err)
+		}
+	}()
+
+	closeInfos := make(chan *channeldb.ChannelCloseSummary)
+	chanArb.cfg.MarkChannelClosed = func(
+		closeInfo *channeldb.ChannelCloseSummary) error {
+		closeInfos <- closeInfo
+		return nil
+	}
+
+	closeTx := &wire.MsgTx{
+		TxOut: []*wire.TxOut{{PkScript: []byte{0x00}}},
+	}
+	closeInfo := &CooperativeCloseInfo{
+		ChannelCloseSummary: &channeldb.ChannelCloseSummary{
+			ClosingTXID: closeTx.TxHash(),
+			CloseHeight: 10,
+		},
+		CloseTx: closeTx,
+	}
+	chanArb.cfg.ChainEvents.CooperativeClosure <- closeInfo
+
+	// The channel should be marked closed right away, with the closing
+	// transaction stored so we can keep waiting for it after a restart.
+	select {
+	case <-closeInfos:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout waiting for channel close")
+	}
+	if _, err := log.FetchCoopCloseTx(); err != nil {
+		t.Fatalf("closing transaction not stored: %v", err)
+	}
+
+	// However, it shouldn't be resolved as new blocks arrive, as long as
+	// the closing transaction hasn't reached the required depth.
+	notifier := chanArb.cfg.Notifier.(*mockNotifier)
+	for height := int32(11); height < 13; height++ {
+		notifier.epochChan <- &chainntnfs.BlockEpoch{Height: height}
+	}
+
+	select {
+	case <-chanArbCtx.resolvedChan:
+		t.Fatalf("channel resolved before reaching conf depth")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once the closing transaction is sufficiently confirmed, the channel
+	// should be marked resolved.
+	notifier.confChan <- &chainntnfs.TxConfirmation{BlockHeight: 12}
+
+	select {
+	case <-chanArbCtx.resolvedChan:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("contract was not resolved")
+	}
+	chanArbCtx.AssertStateTransitions(StateFullyResolved)
+}
+
+// TestChannelArbitratorCooperativeCloseConfDepthRestart tests that a
+// cooperative close found pending on startup is only resolved once the stored
+// closing transaction has reached the configured confirmation depth.
+func TestChannelArbitratorCooperativeCloseConfDepthRestart(t *testing.T) {
+	closeTx := &wire.MsgTx{
+		TxOut: []*wire.TxOut{{PkScript: []byte{0x00}}},
+	}
+	log := &mockArbitratorLog{
+		state:       StateDefault,
+		newStates:   make(chan ArbitratorState, 5),
+		coopCloseTx: closeTx,
+	}
+
+	chanArbCtx, err := createTestChannelArbitrator(t, log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	chanArb := chanArbCtx.chanArb
+	chanArb.cfg.CoopCloseConfDepth = 3
+	chanArb.cfg.IsPendingClose = true
+	chanArb.cfg.CloseType = channeldb.CooperativeClose
+	chanArb.cfg.ClosingHeight = 10
+
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer func() {
+		if err := chanArb.Stop(); err != nil {
+			t.Fatalf("unable to stop chan arb: %v", err)
+		}
+	}()
+
+	// The channel shouldn't be resolved on startup, as the closing
+	// transaction hasn't reached the required depth yet.
+	select {
+	case <-chanArbCtx.resolvedChan:
+		t.Fatalf("channel resolved before reaching conf depth")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once the closing transaction is sufficiently confirmed, the channel
+	// should be marked resolved.
+	notifier := chanArb.cfg.Notifier.(*mockNotifier)
+	notifier.confChan <- &chainntnfs.TxConfirmation{BlockHeight: 12}
+
+	select {
+	case <-chanArbCtx.resolvedChan:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("contract was not resolved")
+	}
+	chanArbCtx.AssertStateTransitions(StateFullyResolved)
+}
+
+// TestChannelArbitratorCoopCloseNegotiation tests that an in-progress
+// cooperative close negotiation is persisted, such that a restart in the middle
+// of it resumes the negotiation state rather than assuming the close is done.
+func TestChannelArbitratorCoopCloseNegotiation(t *testing.T) {
+	// We use a log backed by a real DB, as we want to test that the
+	// negotiation state survives a restart.
+	chanArbCtx, err := createTestChannelArbitrator(t, nil)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	defer chanArbCtx.cleanUp()
+
+	if err := chanArbCtx.chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+
+	// Starting a negotiation should move us into the negotiation state,
+	// and aborting it should bring us back to the default state.
+	err = chanArbCtx.chanArb.NotifyCoopCloseNegotiation(true)
+	if err != nil {
+		t.Fatalf("unable to notify negotiation: %v", err)
+	}
+	chanArbCtx.AssertStateTransitions(StateCoopCloseNegotiation)
+
+	err = chanArbCtx.chanArb.NotifyCoopCloseNegotiation(false)
+	if err != nil {
+		t.Fatalf("unable to notify negotiation: %v", err)
+	}
+	chanArbCtx.AssertStateTransitions(StateDefault)
+
+	// Start negotiating again, then restart in the middle of it.
+	err = chanArbCtx.chanArb.NotifyCoopCloseNegotiation(true)
+	if err != nil {
+		t.Fatalf("unable to notify negotiation: %v", err)
+	}
+	chanArbCtx.AssertStateTransitions(StateCoopCloseNegotiation)
+
+	chanArbCtx, err = chanArbCtx.Restart(nil)
+	if err != nil {
+		t.Fatalf("unable to restart ChannelArbitrator: %v", err)
+	}
+	defer chanArbCtx.chanArb.Stop()
+
+	// We should resume in the negotiation state, without the channel
+	// being marked as resolved.
+	chanArbCtx.AssertState(StateCoopCloseNegotiation)
+	select {
+	case <-chanArbCtx.resolvedChan:
+		t.Fatalf("channel shouldn't be resolved yet")
+	default:
+	}
+
+	closeInfos := make(chan *channeldb.ChannelCloseSummary, 1)
+	chanArbCtx.chanArb.cfg.MarkChannelClosed = func(
+		closeInfo *channeldb.ChannelCloseSummary) error {
+
+		closeInfos <- closeInfo
+		return nil
+	}
+
+	// Once the closing transaction confirms, the channel should be marked
+	// closed and fully resolved.
+	closeInfo := &CooperativeCloseInfo{
+		ChannelCloseSummary: &channeldb.ChannelCloseSummary{},
+	}
+	chanArbCtx.chanArb.cfg.ChainEvents.CooperativeClosure <- closeInfo
+
+	select {
+	case c := <-closeInfos:
+		if c.CloseType != channeldb.CooperativeClose {
+			t.Fatalf("expected cooperative close, got %v",
+				c.CloseType)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout waiting for channel close")
+	}
+
+	chanArbCtx.AssertStateTransitions(StateFullyResolved)
+
+	select {
+	case <-chanArbCtx.resolvedChan:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("contract was not resolved")
+	}
+}
+
+// TestChannelArbitratorCoopCloseNegotiationNonBlocking tests that notifying
+// the arbitrator of a cooperative close negotiation doesn't block while it's
+// busy, and that only the latest notification is acted upon.
+func TestChannelArbitratorCoopCloseNegotiationNonBlocking(t *testing.T) {
+	chanArbCtx, err := createTestChannelArbitrator(t, nil)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	defer chanArbCtx.cleanUp()
+
+	// The arbitrator isn't started yet, so nothing is consuming the
+	// notifications. None of them should block.
+	for _, active := range []bool{true, false, true} {
+		err := chanArbCtx.chanArb.NotifyCoopCloseNegotiation(active)
+		if err != nil {
+			t.Fatalf("unable to notify negotiation: %v", err)
+		}
+	}
+
+	if err := chanArbCtx.chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer chanArbCtx.chanArb.Stop()
+
+	// Once started, the arbitrator should act on the latest notification
+	// only.
+	chanArbCtx.AssertStateTransitions(StateCoopCloseNegotiation)
+}
+
+// TestChannelArbitratorMarkResolvedOnce tests that the ChannelArbitrator
+// doesn't mark a channel resolved a second time if it's asked to resolve it
+// again after a restart.
+func TestChannelArbitratorMarkResolvedOnce(t *testing.T) {
+	chanArbCtx, err := createTestChannelArbitrator(t, nil)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	defer chanArbCtx.cleanUp()
+
+	// Like the ChainArbitrator, we'll wipe the log once the channel has
+	// been marked resolved.
+	var numResolved int32
+	markResolved := func(log ArbitratorLog) func() error {
+		return func() error {
+			atomic.AddInt32(&numResolved, 1)
+			return log.WipeHistory()
+		}
+	}
+	chanArb := chanArbCtx.chanArb
+	chanArb.cfg.MarkChannelResolved = markResolved(chanArbCtx.log)
+
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+
+	// A confirmed cooperative close should resolve the channel.
+	closeInfo := &CooperativeCloseInfo{
+		ChannelCloseSummary: &channeldb.ChannelCloseSummary{},
+	}
+	chanArb.cfg.ChainEvents.CooperativeClosure <- closeInfo
+	chanArbCtx.AssertStateTransitions(StateFullyResolved)
+
+	if err := chanArb.Stop(); err != nil {
+		t.Fatalf("unable to stop ChannelArbitrator: %v", err)
+	}
+	if n := atomic.LoadInt32(&numResolved); n != 1 {
+		t.Fatalf("expected channel to be resolved once, was "+
+			"resolved %v times", n)
+	}
+
+	// Now we'll simulate a restart where the arbitrator still believes
+	// the contract to be fully resolved, and force it to step through
+	// its final state again.
+	newCtx, err := createTestChannelArbitrator(t, chanArbCtx.log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	newChanArb := newCtx.chanArb
+	newChanArb.cfg.MarkChannelResolved = markResolved(newCtx.log)
+	newChanArb.state = StateFullyResolved
+
+	nextState, _, err := newChanArb.stateStep(0, chainTrigger, nil)
+	if err != nil {
+		t.Fatalf("unable to step state: %v", err)
+	}
+	if nextState != StateFullyResolved {
+		t.Fatalf("expected state %v, got %v", StateFullyResolved,
+			nextState)
+	}
+
+	// As the log was wiped by the first resolution, the channel
+	// shouldn't have been marked resolved again.
+	if n := atomic.LoadInt32(&numResolved); n != 1 {
+		t.Fatalf("expected channel to be resolved once, was "+
+			"resolved %v times", n)
+	}
+}
+
 // TestChannelArbitratorRemoteForceClose checks that the ChannelArbitrator goes
 // through the expected states if a remote force close is observed in the
 // chain.
@@ -539,13 +971,13 @@ func TestChannelArbitratorLocalForceClose(t *testing.T) {
 	}
 
 	errChan := make(chan error, 1)
-	respChan := make(chan *wire.MsgTx, 1)
+	respChan := make(chan *ForceCloseResult, 1)
 
 	// With the channel found, and the request crafted, we'll send over a
 	// force close request to the arbitrator that watches this channel.
 	chanArb.forceCloseReqs <- &forceCloseReq{
 		errResp: errChan,
-		closeTx: respChan,
+		resp:    respChan,
 	}
 
 	// It should transition to StateBroadcastCommit.
@@ -713,13 +1145,13 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	}
 
 	errChan := make(chan error, 1)
-	respChan := make(chan *wire.MsgTx, 1)
+	respChan := make(chan *ForceCloseResult, 1)
 
 	// With the channel found, and the request crafted, we'll send over a
 	// force close request to the arbitrator that watches this channel.
 	chanArb.forceCloseReqs <- &forceCloseReq{
 		errResp: errChan,
-		closeTx: respChan,
+		resp:    respChan,
 	}
 
 	// The force close request should trigger broadcast of the commitment
@@ -728,12 +1160,25 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 		StateBroadcastCommit,
 		StateCommitmentBroadcasted,
 	)
+	var closeResult *ForceCloseResult
 	select {
-	case <-respChan:
+	case closeResult = <-respChan:
 	case <-time.After(5 * time.Second):
 		t.Fatalf("no response received")
 	}
 
+	// The result should report the pending HTLC, but not the dust HTLCs
+	// which don't need to be resolved on chain.
+	if closeResult == nil || closeResult.CloseTx == nil {
+		t.Fatalf("expected force close result with close tx")
+	}
+	if len(closeResult.PendingHTLCs) != 1 ||
+		closeResult.PendingHTLCs[0].HtlcIndex != htlc.HtlcIndex {
+
+		t.Fatalf("expected pending htlc %v, got %v", htlc.HtlcIndex,
+			closeResult.PendingHTLCs)
+	}
+
 	select {
 	case err := <-errChan:
 		if err != nil {
@@ -849,7 +1294,23 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 			len(chanArb.activeResolvers))
 	}
 
-	// We'll now examine the in-memory state of the active resolvers to
+	// The pending resolutions should report the single outgoing HTLC
+	// resolver, which is waiting for the HTLC to time out.
+	pending := chanArb.PendingResolutions()
+	if len(pending) != 1 {
+		t.Fatalf("expected single pending resolution, instead got: %v",
+			len(pending))
+	}
+	if pending[0].Kind != ResolverKindOutgoingHtlc {
+		t.Fatalf("expected outgoing htlc resolver, got %v",
+			pending[0].Kind)
+	}
+	if pending[0].Stage != ResolverWaitingTimeout {
+		t.Fatalf("expected resolver waiting for timeout, got %v",
+			pending[0].Stage)
+	}
+
+	// We'll now examine the in-memory state of the active resolvers to
 	// ensure t hey were populated properly.
 	resolver := chanArb.activeResolvers[0]
 	outgoingResolver, ok := resolver.(*htlcOutgoingContestResolver)
@@ -922,6 +1383,28 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	case <-time.After(5 * time.Second):
 		t.Fatalf("contract was not resolved")
 	}
+
+	// Even though the log was wiped once the channel was fully resolved,
+	// the timeout of the HTLC should have been recorded.
+	reports, err := chanArb.log.FetchResolverReports(chanArb.cfg.ChanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch resolver reports: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected single resolver report, got %v",
+			len(reports))
+	}
+
+	expectedReport := &ResolutionReport{
+		OutPoint:  htlcOp,
+		Amount:    htlc.Amt.ToAtoms(),
+		Outcome:   ResolutionOutcomeTimeout,
+		SweepTxid: closeTx.TxHash(),
+	}
+	if !reflect.DeepEqual(reports[0], expectedReport) {
+		t.Fatalf("expected report %v, got %v",
+			spew.Sdump(expectedReport), spew.Sdump(reports[0]))
+	}
 }
 
 // TestChannelArbitratorLocalForceCloseRemoteConfiremd tests that the
@@ -962,13 +1445,13 @@ func TestChannelArbitratorLocalForceCloseRemoteConfirmed(t *testing.T) {
 	}
 
 	errChan := make(chan error, 1)
-	respChan := make(chan *wire.MsgTx, 1)
+	respChan := make(chan *ForceCloseResult, 1)
 
 	// With the channel found, and the request crafted, we'll send over a
 	// force close request to the arbitrator that watches this channel.
 	chanArb.forceCloseReqs <- &forceCloseReq{
 		errResp: errChan,
-		closeTx: respChan,
+		resp:    respChan,
 	}
 
 	// It should transition to StateBroadcastCommit.
@@ -1070,13 +1553,13 @@ func TestChannelArbitratorLocalForceDoubleSpend(t *testing.T) {
 	}
 
 	errChan := make(chan error, 1)
-	respChan := make(chan *wire.MsgTx, 1)
+	respChan := make(chan *ForceCloseResult, 1)
 
 	// With the channel found, and the request crafted, we'll send over a
 	// force close request to the arbitrator that watches this channel.
 	chanArb.forceCloseReqs <- &forceCloseReq{
 		errResp: errChan,
-		closeTx: respChan,
+		resp:    respChan,
 	}
 
 	// It should transition to StateBroadcastCommit.
@@ -1301,13 +1784,13 @@ func TestChannelArbitratorForceCloseBreachedChannel(t *testing.T) {
 	}
 
 	errChan := make(chan error, 1)
-	respChan := make(chan *wire.MsgTx, 1)
+	respChan := make(chan *ForceCloseResult, 1)
 
 	// With the channel found, and the request crafted, we'll send over a
 	// force close request to the arbitrator that watches this channel.
 	chanArb.forceCloseReqs <- &forceCloseReq{
 		errResp: errChan,
-		closeTx: respChan,
+		resp:    respChan,
 	}
 
 	// It should transition to StateBroadcastCommit.
@@ -1381,8 +1864,9 @@ func TestChannelArbitratorCommitFailure(t *testing.T) {
 		{
 			closeType: channeldb.CooperativeClose,
 			sendEvent: func(chanArb *ChannelArbitrator) {
+				closeSummary := &channeldb.ChannelCloseSummary{}
 				closeInfo := &CooperativeCloseInfo{
-					&channeldb.ChannelCloseSummary{},
+					ChannelCloseSummary: closeSummary,
 				}
 				chanArb.cfg.ChainEvents.CooperativeClosure <- closeInfo
 			},
@@ -1541,6 +2025,91 @@ func TestChannelArbitratorEmptyResolutions(t *testing.T) {
 	chanArb.Stop()
 }
 
+// TestChannelArbitratorReconstructCommitSet tests that a channel closed by an
+// older node without a logged commit set has it reconstructed from its closing
+// txid and stored commitments, such that its HTLCs can still be resolved.
+func TestChannelArbitratorReconstructCommitSet(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:       StateDefault,
+		newStates:   make(chan ArbitratorState, 5),
+		resolutions: &ContractResolutions{},
+		resolvers:   make(map[ContractResolver]struct{}),
+	}
+
+	chanArbCtx, err := createTestChannelArbitrator(t, log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+	chanArb := chanArbCtx.chanArb
+	chanArb.cfg.IsPendingClose = true
+	chanArb.cfg.ClosingHeight = 100
+	chanArb.cfg.CloseType = channeldb.RemoteForceClose
+
+	// The remote party's commitment is the one that confirmed, and it
+	// carries an outgoing dust HTLC.
+	localCommitTx := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{PreviousOutPoint: chanArb.cfg.ChanPoint}},
+		TxOut: []*wire.TxOut{{Value: 1}},
+	}
+	remoteCommitTx := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{PreviousOutPoint: chanArb.cfg.ChanPoint}},
+		TxOut: []*wire.TxOut{{Value: 2}},
+	}
+	outgoingDustHtlc := channeldb.HTLC{
+		Incoming:    false,
+		OutputIndex: -1,
+		HtlcIndex:   1,
+	}
+	chanArb.cfg.ClosingTXID = remoteCommitTx.TxHash()
+	chanArb.cfg.FetchCommitments = func() (
+		map[HtlcSetKey]*channeldb.ChannelCommitment, error) {
+
+		return map[HtlcSetKey]*channeldb.ChannelCommitment{
+			LocalHtlcSet: {
+				CommitTx: localCommitTx,
+				Htlcs:    []channeldb.HTLC{outgoingDustHtlc},
+			},
+			RemoteHtlcSet: {
+				CommitTx: remoteCommitTx,
+				Htlcs:    []channeldb.HTLC{outgoingDustHtlc},
+			},
+		}, nil
+	}
+
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer chanArb.Stop()
+
+	chanArbCtx.AssertStateTransitions(StateContractClosed)
+
+	// The reconstructed commit set should have been logged, pointing at
+	// the remote commitment.
+	commitSet := log.commitSet
+	if commitSet == nil {
+		t.Fatalf("expected commit set to be reconstructed")
+	}
+	if *commitSet.ConfCommitKey != RemoteHtlcSet {
+		t.Fatalf("expected conf commit key %v, got %v",
+			RemoteHtlcSet, *commitSet.ConfCommitKey)
+	}
+
+	// As a result, the dust HTLC should be failed back right away.
+	select {
+	case msgs := <-chanArbCtx.resolutions:
+		if len(msgs) != 1 {
+			t.Fatalf("expected 1 message, instead got %v", len(msgs))
+		}
+
+		if msgs[0].HtlcIndex != outgoingDustHtlc.HtlcIndex {
+			t.Fatalf("wrong htlc index: expected %v, got %v",
+				outgoingDustHtlc.HtlcIndex, msgs[0].HtlcIndex)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("resolution msgs not sent")
+	}
+}
+
 // TestChannelArbitratorAlreadyForceClosed ensures that we cannot force close a
 // channel that is already in the process of doing so.
 func TestChannelArbitratorAlreadyForceClosed(t *testing.T) {
@@ -1564,11 +2133,11 @@ func TestChannelArbitratorAlreadyForceClosed(t *testing.T) {
 	// Then, we'll create a request to signal a force close request to the
 	// channel arbitrator.
 	errChan := make(chan error, 1)
-	respChan := make(chan *wire.MsgTx, 1)
+	respChan := make(chan *ForceCloseResult, 1)
 
 	select {
 	case chanArb.forceCloseReqs <- &forceCloseReq{
-		closeTx: respChan,
+		resp:    respChan,
 		errResp: errChan,
 	}:
 	case <-chanArb.quit:
@@ -1586,6 +2155,434 @@ func TestChannelArbitratorAlreadyForceClosed(t *testing.T) {
 	}
 }
 
+// TestChannelArbitratorCanForceClose asserts that CanForceClose reports
+// whether a force close request would be accepted by the arbitrator.
+func TestChannelArbitratorCanForceClose(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		state       ArbitratorState
+		expectedCan bool
+	}{
+		{
+			state:       StateDefault,
+			expectedCan: true,
+		},
+		{
+			state:       StateCoopCloseNegotiation,
+			expectedCan: true,
+		},
+		{
+			state:       StateCommitmentBroadcasted,
+			expectedCan: false,
+		},
+	}
+
+	for _, test := range testCases {
+		log := &mockArbitratorLog{
+			state: test.state,
+		}
+		chanArbCtx, err := createTestChannelArbitrator(t, log)
+		if err != nil {
+			t.Fatalf("unable to create ChannelArbitrator: %v", err)
+		}
+		chanArb := chanArbCtx.chanArb
+		if err := chanArb.Start(); err != nil {
+			t.Fatalf("unable to start ChannelArbitrator: %v", err)
+		}
+
+		canForceClose, reason := chanArb.CanForceClose()
+		if canForceClose != test.expectedCan {
+			t.Fatalf("state %v: expected CanForceClose=%v, got %v "+
+				"(%v)", test.state, test.expectedCan,
+				canForceClose, reason)
+		}
+		if !canForceClose && reason == "" {
+			t.Fatalf("state %v: expected reason", test.state)
+		}
+
+		// Once stopped, the arbitrator doesn't accept any requests.
+		if err := chanArb.Stop(); err != nil {
+			t.Fatalf("unable to stop ChannelArbitrator: %v", err)
+		}
+		if canForceClose, _ := chanArb.CanForceClose(); canForceClose {
+			t.Fatalf("state %v: expected stopped arbitrator to "+
+				"reject force close", test.state)
+		}
+	}
+}
+
+// TestChannelArbitratorCommitFeeReestimation tests that if we restart in the
+// StateCommitmentBroadcasted state, and the fee estimator reports a fee rate
+// far above the one paid by our commitment, the commitment is flagged as
+// needing a fee bump.
+func TestChannelArbitratorCommitFeeReestimation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		feeEstimate lnwallet.AtomPerKByte
+		bumpNeeded  bool
+	}{
+		{
+			name:        "estimate unchanged",
+			feeEstimate: 1e4,
+			bumpNeeded:  false,
+		},
+		{
+			name:        "estimate within factor",
+			feeEstimate: 2e4,
+			bumpNeeded:  false,
+		},
+		{
+			name:        "estimate far above",
+			feeEstimate: 1e5,
+			bumpNeeded:  true,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			log := &mockArbitratorLog{
+				state: StateCommitmentBroadcasted,
+			}
+			chanArbCtx, err := createTestChannelArbitrator(t, log)
+			if err != nil {
+				t.Fatalf("unable to create "+
+					"ChannelArbitrator: %v", err)
+			}
+			chanArb := chanArbCtx.chanArb
+			chanArb.cfg.CommitFeeRate = 1e4
+			chanArbCtx.feeEstimator.setFeeRate(test.feeEstimate)
+
+			if err := chanArb.Start(); err != nil {
+				t.Fatalf("unable to start "+
+					"ChannelArbitrator: %v", err)
+			}
+			defer chanArb.Stop()
+
+			if chanArb.state != StateCommitmentBroadcasted {
+				t.Fatalf("expected state %v, got %v",
+					StateCommitmentBroadcasted,
+					chanArb.state)
+			}
+
+			bumpNeeded := chanArb.CommitFeeBumpNeeded()
+			if bumpNeeded != test.bumpNeeded {
+				t.Fatalf("expected bump needed=%v, got %v",
+					test.bumpNeeded, bumpNeeded)
+			}
+		})
+	}
+}
+
+// TestChannelArbitratorForceCloseFeeDeferral tests that a force close
+// requested by the user is deferred while the fee rate estimate exceeds the
+// configured maximum, but a force close needed to meet an HTLC deadline isn't.
+func TestChannelArbitratorForceCloseFeeDeferral(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxFeeRate = 1e4
+		htlcExpiry = 20
+	)
+
+	setup := func(t *testing.T) (*chanArbTestCtx, *mockArbitratorLog,
+		*int32) {
+
+		arbLog := &mockArbitratorLog{
+			state:     StateDefault,
+			newStates: make(chan ArbitratorState, 5),
+			resolvers: make(map[ContractResolver]struct{}),
+		}
+
+		chanArbCtx, err := createTestChannelArbitrator(t, arbLog)
+		if err != nil {
+			t.Fatalf("unable to create ChannelArbitrator: %v", err)
+		}
+		chanArb := chanArbCtx.chanArb
+
+		// Start out with an estimate above the maximum.
+		chanArbCtx.feeEstimator.setFeeRate(maxFeeRate * 10)
+		chanArb.cfg.ForceCloseMaxFeeRate = maxFeeRate
+
+		var numPublished int32
+		chanArb.cfg.PublishTx = func(*wire.MsgTx) error {
+			atomic.AddInt32(&numPublished, 1)
+			return nil
+		}
+
+		if err := chanArb.Start(); err != nil {
+			t.Fatalf("unable to start ChannelArbitrator: %v", err)
+		}
+
+		return chanArbCtx, arbLog, &numPublished
+	}
+
+	t.Run("user request", func(t *testing.T) {
+		chanArbCtx, arbLog, numPublished := setup(t)
+		defer chanArbCtx.chanArb.Stop()
+
+		errChan := make(chan error, 1)
+		respChan := make(chan *ForceCloseResult, 1)
+		chanArbCtx.chanArb.forceCloseReqs <- &forceCloseReq{
+			errResp: errChan,
+			resp:    respChan,
+			cause:   channeldb.CloseCauseUserRequest,
+		}
+
+		// We should move to StateBroadcastCommit, but not broadcast
+		// our commitment yet.
+		chanArbCtx.AssertStateTransitions(StateBroadcastCommit)
+		select {
+		case result := <-respChan:
+			if result != nil {
+				t.Fatalf("expected no force close result")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no response received")
+		}
+		select {
+		case err := <-errChan:
+			if err != ErrForceCloseDeferred {
+				t.Fatalf("expected ErrForceCloseDeferred, "+
+					"got: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no response received")
+		}
+		if atomic.LoadInt32(numPublished) != 0 {
+			t.Fatalf("commitment shouldn't have been published")
+		}
+
+		// A new block while the estimate is still too high shouldn't
+		// change anything. We send it twice, so we know the first one
+		// has been fully processed once the second is received.
+		epoch := &chainntnfs.BlockEpoch{Height: 10}
+		chanArbCtx.blockEpochs <- epoch
+		chanArbCtx.blockEpochs <- epoch
+
+		select {
+		case state := <-arbLog.newStates:
+			t.Fatalf("unexpected state transition to %v", state)
+		default:
+		}
+
+		// Once the estimate drops, the next block should trigger the
+		// broadcast.
+		chanArbCtx.feeEstimator.setFeeRate(maxFeeRate)
+		chanArbCtx.blockEpochs <- &chainntnfs.BlockEpoch{Height: 11}
+
+		chanArbCtx.AssertStateTransitions(StateCommitmentBroadcasted)
+		if atomic.LoadInt32(numPublished) != 1 {
+			t.Fatalf("commitment should have been published")
+		}
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		chanArbCtx, arbLog, _ := setup(t)
+
+		errChan := make(chan error, 1)
+		chanArbCtx.chanArb.forceCloseReqs <- &forceCloseReq{
+			errResp: errChan,
+			resp:    make(chan *ForceCloseResult, 1),
+			cause:   channeldb.CloseCauseUserRequest,
+		}
+		chanArbCtx.AssertStateTransitions(StateBroadcastCommit)
+		select {
+		case err := <-errChan:
+			if err != ErrForceCloseDeferred {
+				t.Fatalf("expected ErrForceCloseDeferred, "+
+					"got: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no response received")
+		}
+
+		// The close cause is persisted along with the state, so the
+		// force close should still be deferred after a restart.
+		var numPublished int32
+		chanArbCtx, err := chanArbCtx.Restart(func(c *chanArbTestCtx) {
+			c.feeEstimator.setFeeRate(maxFeeRate * 10)
+			c.chanArb.cfg.ForceCloseMaxFeeRate = maxFeeRate
+			c.chanArb.cfg.PublishTx = func(*wire.MsgTx) error {
+				atomic.AddInt32(&numPublished, 1)
+				return nil
+			}
+		})
+		if err != nil {
+			t.Fatalf("unable to restart ChannelArbitrator: %v", err)
+		}
+		defer chanArbCtx.CleanUp()
+
+		if arbLog.closeCause != channeldb.CloseCauseUserRequest {
+			t.Fatalf("expected close cause %v, got %v",
+				channeldb.CloseCauseUserRequest,
+				arbLog.closeCause)
+		}
+
+		epoch := &chainntnfs.BlockEpoch{Height: 10}
+		chanArbCtx.blockEpochs <- epoch
+		chanArbCtx.blockEpochs <- epoch
+
+		select {
+		case state := <-arbLog.newStates:
+			t.Fatalf("unexpected state transition to %v", state)
+		default:
+		}
+		if atomic.LoadInt32(&numPublished) != 0 {
+			t.Fatalf("commitment shouldn't have been published")
+		}
+
+		chanArbCtx.feeEstimator.setFeeRate(maxFeeRate)
+		chanArbCtx.blockEpochs <- &chainntnfs.BlockEpoch{Height: 11}
+
+		chanArbCtx.AssertStateTransitions(StateCommitmentBroadcasted)
+		if atomic.LoadInt32(&numPublished) != 1 {
+			t.Fatalf("commitment should have been published")
+		}
+	})
+
+	t.Run("htlc deadline", func(t *testing.T) {
+		chanArbCtx, _, numPublished := setup(t)
+		defer chanArbCtx.chanArb.Stop()
+
+		htlcUpdates := make(chan *ContractUpdate)
+		chanArbCtx.chanArb.UpdateContractSignals(&ContractSignals{
+			HtlcUpdates: htlcUpdates,
+			ShortChanID: lnwire.ShortChannelID{},
+		})
+		htlcUpdates <- &ContractUpdate{
+			HtlcKey: LocalHtlcSet,
+			Htlcs: []channeldb.HTLC{
+				{
+					Incoming:      false,
+					Amt:           10000,
+					HtlcIndex:     99,
+					RefundTimeout: htlcExpiry,
+				},
+			},
+		}
+
+		// Once the HTLC is close to timing out, we should go to chain
+		// right away despite the high fee rate estimate.
+		chanArbCtx.blockEpochs <- &chainntnfs.BlockEpoch{
+			Height: htlcExpiry - 5,
+		}
+		chanArbCtx.AssertStateTransitions(
+			StateBroadcastCommit,
+			StateCommitmentBroadcasted,
+		)
+		if atomic.LoadInt32(numPublished) != 1 {
+			t.Fatalf("commitment should have been published")
+		}
+	})
+}
+
+// TestChannelArbitratorDanglingBroadcastDelta tests that the decision to go to
+// chain for an HTLC that only exists on the remote commitment uses the
+// dangling broadcast delta, falling back to the outgoing broadcast delta if it
+// isn't set.
+func TestChannelArbitratorDanglingBroadcastDelta(t *testing.T) {
+	t.Parallel()
+
+	const htlcExpiry = 20
+
+	testCases := []struct {
+		name          string
+		danglingDelta uint32
+		triggerHeight int32
+	}{
+		{
+			name:          "default to outgoing delta",
+			danglingDelta: 0,
+			triggerHeight: htlcExpiry - 5,
+		},
+		{
+			name:          "larger dangling delta",
+			danglingDelta: 10,
+			triggerHeight: htlcExpiry - 10,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			arbLog := &mockArbitratorLog{
+				state:     StateDefault,
+				newStates: make(chan ArbitratorState, 5),
+				resolvers: make(map[ContractResolver]struct{}),
+			}
+
+			chanArbCtx, err := createTestChannelArbitrator(
+				t, arbLog,
+			)
+			if err != nil {
+				t.Fatalf("unable to create "+
+					"ChannelArbitrator: %v", err)
+			}
+			chanArb := chanArbCtx.chanArb
+			chanArb.cfg.DanglingBroadcastDelta = test.danglingDelta
+
+			if err := chanArb.Start(); err != nil {
+				t.Fatalf("unable to start "+
+					"ChannelArbitrator: %v", err)
+			}
+			defer chanArb.Stop()
+
+			htlcUpdates := make(chan *ContractUpdate)
+			chanArb.UpdateContractSignals(&ContractSignals{
+				HtlcUpdates: htlcUpdates,
+				ShortChanID: lnwire.ShortChannelID{},
+			})
+
+			// We'll add an HTLC that only exists on the remote
+			// party's commitment.
+			htlcUpdates <- &ContractUpdate{
+				HtlcKey: RemoteHtlcSet,
+				Htlcs: []channeldb.HTLC{
+					{
+						Incoming:      false,
+						Amt:           10000,
+						HtlcIndex:     99,
+						RefundTimeout: htlcExpiry,
+					},
+				},
+			}
+
+			// A block right before the trigger height shouldn't
+			// cause us to go to chain. We send it twice, so we know
+			// the first one has been fully processed once the
+			// second is received.
+			beforeTrigger := &chainntnfs.BlockEpoch{
+				Height: test.triggerHeight - 1,
+			}
+			chanArbCtx.blockEpochs <- beforeTrigger
+			chanArbCtx.blockEpochs <- beforeTrigger
+
+			select {
+			case state := <-arbLog.newStates:
+				t.Fatalf("unexpected state transition to %v",
+					state)
+			default:
+			}
+
+			// Once the trigger height is reached, we should go to
+			// chain.
+			chanArbCtx.blockEpochs <- &chainntnfs.BlockEpoch{
+				Height: test.triggerHeight,
+			}
+			chanArbCtx.AssertStateTransitions(
+				StateBroadcastCommit,
+				StateCommitmentBroadcasted,
+			)
+		})
+	}
+}
+
 // TestChannelArbitratorDanglingCommitForceClose tests that if there're HTLCs
 // on the remote party's commitment, but not ours, and they're about to time
 // out, then we'll go on chain so we can cancel back the HTLCs on the incoming
@@ -1654,6 +2651,20 @@ func TestChannelArbitratorDanglingCommitForceClose(t *testing.T) {
 				t.Fatalf("unable to create ChannelArbitrator: %v", err)
 			}
 			chanArb := chanArbCtx.chanArb
+
+			// We'll capture the close summary written once the
+			// commitment confirms, so we can check the recorded
+			// close cause.
+			closeSummaries := make(
+				chan *channeldb.ChannelCloseSummary, 1,
+			)
+			chanArb.cfg.MarkChannelClosed = func(
+				summary *channeldb.ChannelCloseSummary) error {
+
+				closeSummaries <- summary
+				return nil
+			}
+
 			if err := chanArb.Start(); err != nil {
 				t.Fatalf("unable to start ChannelArbitrator: %v", err)
 			}
@@ -1695,7 +2706,7 @@ func TestChannelArbitratorDanglingCommitForceClose(t *testing.T) {
 			// that only exists on the commitment transaction of
 			// the remote party.
 			errChan := make(chan error, 1)
-			respChan := make(chan *wire.MsgTx, 1)
+			respChan := make(chan *ForceCloseResult, 1)
 			switch {
 			// If we want an HTLC expiration trigger, then We'll
 			// now mine a block (height 5), which is 5 blocks away
@@ -1708,7 +2719,8 @@ func TestChannelArbitratorDanglingCommitForceClose(t *testing.T) {
 			case !testCase.htlcExpired:
 				chanArb.forceCloseReqs <- &forceCloseReq{
 					errResp: errChan,
-					closeTx: respChan,
+					resp:    respChan,
+					cause:   channeldb.CloseCauseUserRequest,
 				}
 
 			}
@@ -1784,6 +2796,30 @@ func TestChannelArbitratorDanglingCommitForceClose(t *testing.T) {
 				StateWaitingFullResolution,
 			)
 
+			// The close summary should record why we went to
+			// chain: either the dangling HTLC timing out, or the
+			// user's request.
+			expCause := channeldb.CloseCauseUserRequest
+			if testCase.htlcExpired {
+				expCause = channeldb.CloseCauseHtlcTimeout
+			}
+			select {
+			case summary := <-closeSummaries:
+				if summary.CloseCause != expCause {
+					t.Fatalf("expected close cause %v, "+
+						"got %v", expCause,
+						summary.CloseCause)
+				}
+				if testCase.htlcExpired &&
+					summary.CloseCause.String() != "htlc-timeout" {
+
+					t.Fatalf("expected htlc-timeout, got %v",
+						summary.CloseCause)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("channel not marked closed")
+			}
+
 			// Now that we've sent this signal, we should have that
 			// HTLC be canceled back immediately.
 			select {
@@ -3,6 +3,7 @@ package contractcourt
 import (
 	"encoding/binary"
 	"io"
+	"sync"
 
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/input"
@@ -26,7 +27,10 @@ type commitSweepResolver struct {
 	commitResolution lnwallet.CommitOutputResolution
 
 	// resolved reflects if the contract has been fully resolved or not.
-	resolved bool
+	// It's guarded by stateLock, as it's read by the ChannelArbitrator
+	// while the resolver is running.
+	resolved  bool
+	stateLock sync.RWMutex
 
 	// broadcastHeight is the height that the original contract was
 	// broadcast to the main-chain at. We'll use this value to bound any
@@ -142,7 +146,7 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 			return nil, errResolverShuttingDown
 		}
 
-		c.resolved = true
+		c.markResolved()
 		return nil, c.Checkpoint(c)
 	}
 
@@ -212,7 +216,7 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 
 	// Once the transaction has received a sufficient number of
 	// confirmations, we'll mark ourselves as fully resolved and exit.
-	c.resolved = true
+	c.markResolved()
 	return nil, c.Checkpoint(c)
 }
 
@@ -229,9 +233,19 @@ func (c *commitSweepResolver) Stop() {
 //
 // NOTE: Part of the ContractResolver interface.
 func (c *commitSweepResolver) IsResolved() bool {
+	c.stateLock.RLock()
+	defer c.stateLock.RUnlock()
+
 	return c.resolved
 }
 
+// markResolved marks the contract as fully resolved.
+func (c *commitSweepResolver) markResolved() {
+	c.stateLock.Lock()
+	c.resolved = true
+	c.stateLock.Unlock()
+}
+
 // Encode writes an encoded version of the ContractResolver into the passed
 // Writer.
 //
@@ -4,6 +4,9 @@ import (
 	"encoding/binary"
 	"errors"
 	"io"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrlnd/chainntnfs"
 )
 
 var (
@@ -69,6 +72,30 @@ type reportingContractResolver interface {
 	report() *ContractReport
 }
 
+// outcomeReportingResolver is a ContractResolver that is able to report on the
+// outcome of the resolution of its contract.
+type outcomeReportingResolver interface {
+	ContractResolver
+
+	// resolutionReport returns a report on how the contract was resolved,
+	// or nil if it isn't resolved yet or its outcome isn't known.
+	resolutionReport() *ResolutionReport
+}
+
+// spendingTxid returns the txid of the transaction of the given spend.
+func spendingTxid(spend *chainntnfs.SpendDetail) chainhash.Hash {
+	switch {
+	case spend.SpenderTxHash != nil:
+		return *spend.SpenderTxHash
+
+	case spend.SpendingTx != nil:
+		return spend.SpendingTx.TxHash()
+
+	default:
+		return chainhash.Hash{}
+	}
+}
+
 // ResolverKit is meant to be used as a mix-in struct to be embedded within a
 // given ContractResolver implementation. It contains all the items that a
 // resolver requires to carry out its duties.
@@ -88,7 +88,8 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 		log.Infof("%T(%v): HTLC has timed out (expiry=%v, height=%v), "+
 			"abandoning", h, h.htlcResolution.ClaimOutpoint,
 			h.htlcExpiry, currentHeight)
-		h.resolved = true
+		h.outcome = ResolutionOutcomeAbandoned
+		h.markResolved()
 		return nil, h.Checkpoint(h)
 	}
 
@@ -157,7 +158,8 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 				h.htlcResolution.ClaimOutpoint,
 				h.htlcExpiry, currentHeight)
 
-			h.resolved = true
+			h.outcome = ResolutionOutcomeAbandoned
+			h.markResolved()
 			return nil, h.Checkpoint(h)
 		}
 
@@ -244,7 +246,8 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 					"(expiry=%v, height=%v), abandoning", h,
 					h.htlcResolution.ClaimOutpoint,
 					h.htlcExpiry, currentHeight)
-				h.resolved = true
+				h.outcome = ResolutionOutcomeAbandoned
+				h.markResolved()
 				return nil, h.Checkpoint(h)
 			}
 
@@ -288,7 +291,7 @@ func (h *htlcIncomingContestResolver) Stop() {
 //
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcIncomingContestResolver) IsResolved() bool {
-	return h.resolved
+	return h.htlcSuccessResolver.IsResolved()
 }
 
 // Encode writes an encoded version of the ContractResolver into the passed
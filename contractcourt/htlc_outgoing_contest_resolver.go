@@ -191,7 +191,7 @@ func (h *htlcOutgoingContestResolver) Stop() {
 //
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcOutgoingContestResolver) IsResolved() bool {
-	return h.resolved
+	return h.htlcTimeoutResolver.IsResolved()
 }
 
 // Encode writes an encoded version of the ContractResolver into the passed
@@ -3,8 +3,10 @@ package contractcourt
 import (
 	"encoding/binary"
 	"io"
+	"sync"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/wire"
 
 	"github.com/decred/dcrlnd/input"
@@ -34,6 +36,10 @@ type htlcSuccessResolver struct {
 	// resolved reflects if the contract has been fully resolved or not.
 	resolved bool
 
+	// stateLock guards outputIncubating and resolved, as they're read by
+	// the ChannelArbitrator while the resolver is running.
+	stateLock sync.RWMutex
+
 	// broadcastHeight is the height that the original contract was
 	// broadcast to the main-chain at. We'll use this value to bound any
 	// historical queries to the chain for spends/confirmations.
@@ -53,6 +59,12 @@ type htlcSuccessResolver struct {
 	// account any fees that may have to be paid if it goes on chain.
 	htlcAmt lnwire.MilliAtom
 
+	// outcome and sweepTxid describe how the htlc was resolved. They're
+	// only kept in memory, as they're reported to the arbitrator log as
+	// soon as the resolver is fully resolved.
+	outcome   ResolutionOutcome
+	sweepTxid chainhash.Hash
+
 	ResolverKit
 }
 
@@ -62,18 +74,37 @@ type htlcSuccessResolver struct {
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcSuccessResolver) ResolverKey() []byte {
 	// The primary key for this resolver will be the outpoint of the HTLC
-	// on the commitment transaction itself. If this is our commitment,
-	// then the output can be found within the signed success tx,
-	// otherwise, it's just the ClaimOutpoint.
-	var op wire.OutPoint
+	// on the commitment transaction itself.
+	key := newResolverID(h.htlcOutpoint())
+	return key[:]
+}
+
+// htlcOutpoint returns the outpoint of the HTLC on the commitment transaction.
+// If this is our commitment, then the output can be found within the signed
+// success tx, otherwise, it's just the ClaimOutpoint.
+func (h *htlcSuccessResolver) htlcOutpoint() wire.OutPoint {
 	if h.htlcResolution.SignedSuccessTx != nil {
-		op = h.htlcResolution.SignedSuccessTx.TxIn[0].PreviousOutPoint
-	} else {
-		op = h.htlcResolution.ClaimOutpoint
+		return h.htlcResolution.SignedSuccessTx.TxIn[0].PreviousOutPoint
 	}
 
-	key := newResolverID(op)
-	return key[:]
+	return h.htlcResolution.ClaimOutpoint
+}
+
+// resolutionReport returns a report on how the HTLC was resolved, or nil if it
+// isn't resolved yet or its outcome isn't known.
+//
+// NOTE: Part of the outcomeReportingResolver interface.
+func (h *htlcSuccessResolver) resolutionReport() *ResolutionReport {
+	if !h.resolved || h.outcome == 0 {
+		return nil
+	}
+
+	return &ResolutionReport{
+		OutPoint:  h.htlcOutpoint(),
+		Amount:    h.htlcAmt.ToAtoms(),
+		Outcome:   h.outcome,
+		SweepTxid: h.sweepTxid,
+	}
 }
 
 // Resolve attempts to resolve an unresolved incoming HTLC that we know the
@@ -180,7 +211,9 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 
 		// Once the transaction has received a sufficient number of
 		// confirmations, we'll mark ourselves as fully resolved and exit.
-		h.resolved = true
+		h.outcome = ResolutionOutcomeClaimed
+		h.sweepTxid = sweepTXID
+		h.markResolved()
 		return nil, h.Checkpoint(h)
 	}
 
@@ -211,7 +244,7 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 			return nil, err
 		}
 
-		h.outputIncubating = true
+		h.markIncubating()
 
 		if err := h.Checkpoint(h); err != nil {
 			log.Errorf("unable to Checkpoint: %v", err)
@@ -234,16 +267,19 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		"after csv_delay=%v", h, h.payHash[:], h.htlcResolution.CsvDelay)
 
 	select {
-	case _, ok := <-spendNtfn.Spend:
+	case spend, ok := <-spendNtfn.Spend:
 		if !ok {
 			return nil, errResolverShuttingDown
 		}
 
+		h.sweepTxid = spendingTxid(spend)
+
 	case <-h.Quit:
 		return nil, errResolverShuttingDown
 	}
 
-	h.resolved = true
+	h.outcome = ResolutionOutcomeClaimed
+	h.markResolved()
 	return nil, h.Checkpoint(h)
 }
 
@@ -260,9 +296,36 @@ func (h *htlcSuccessResolver) Stop() {
 //
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcSuccessResolver) IsResolved() bool {
+	h.stateLock.RLock()
+	defer h.stateLock.RUnlock()
+
 	return h.resolved
 }
 
+// isIncubating returns true if the output has been sent to the output
+// incubator.
+func (h *htlcSuccessResolver) isIncubating() bool {
+	h.stateLock.RLock()
+	defer h.stateLock.RUnlock()
+
+	return h.outputIncubating
+}
+
+// markIncubating records that the output has been sent to the output
+// incubator.
+func (h *htlcSuccessResolver) markIncubating() {
+	h.stateLock.Lock()
+	h.outputIncubating = true
+	h.stateLock.Unlock()
+}
+
+// markResolved marks the contract as fully resolved.
+func (h *htlcSuccessResolver) markResolved() {
+	h.stateLock.Lock()
+	h.resolved = true
+	h.stateLock.Unlock()
+}
+
 // Encode writes an encoded version of the ContractResolver into the passed
 // Writer.
 //
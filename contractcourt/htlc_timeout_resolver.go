@@ -4,8 +4,10 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/chainntnfs"
 	"github.com/decred/dcrlnd/input"
@@ -33,6 +35,10 @@ type htlcTimeoutResolver struct {
 	// resolved reflects if the contract has been fully resolved or not.
 	resolved bool
 
+	// stateLock guards outputIncubating and resolved, as they're read by
+	// the ChannelArbitrator while the resolver is running.
+	stateLock sync.RWMutex
+
 	// broadcastHeight is the height that the original contract was
 	// broadcast to the main-chain at. We'll use this value to bound any
 	// historical queries to the chain for spends/confirmations.
@@ -48,6 +54,12 @@ type htlcTimeoutResolver struct {
 	// account any fees that may have to be paid if it goes on chain.
 	htlcAmt lnwire.MilliAtom
 
+	// outcome and sweepTxid describe how the htlc was resolved. They're
+	// only kept in memory, as they're reported to the arbitrator log as
+	// soon as the resolver is fully resolved.
+	outcome   ResolutionOutcome
+	sweepTxid chainhash.Hash
+
 	ResolverKit
 }
 
@@ -57,18 +69,37 @@ type htlcTimeoutResolver struct {
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcTimeoutResolver) ResolverKey() []byte {
 	// The primary key for this resolver will be the outpoint of the HTLC
-	// on the commitment transaction itself. If this is our commitment,
-	// then the output can be found within the signed timeout tx,
-	// otherwise, it's just the ClaimOutpoint.
-	var op wire.OutPoint
+	// on the commitment transaction itself.
+	key := newResolverID(h.htlcOutpoint())
+	return key[:]
+}
+
+// htlcOutpoint returns the outpoint of the HTLC on the commitment transaction.
+// If this is our commitment, then the output can be found within the signed
+// timeout tx, otherwise, it's just the ClaimOutpoint.
+func (h *htlcTimeoutResolver) htlcOutpoint() wire.OutPoint {
 	if h.htlcResolution.SignedTimeoutTx != nil {
-		op = h.htlcResolution.SignedTimeoutTx.TxIn[0].PreviousOutPoint
-	} else {
-		op = h.htlcResolution.ClaimOutpoint
+		return h.htlcResolution.SignedTimeoutTx.TxIn[0].PreviousOutPoint
 	}
 
-	key := newResolverID(op)
-	return key[:]
+	return h.htlcResolution.ClaimOutpoint
+}
+
+// resolutionReport returns a report on how the HTLC was resolved, or nil if it
+// isn't resolved yet or its outcome isn't known.
+//
+// NOTE: Part of the outcomeReportingResolver interface.
+func (h *htlcTimeoutResolver) resolutionReport() *ResolutionReport {
+	if !h.resolved || h.outcome == 0 {
+		return nil
+	}
+
+	return &ResolutionReport{
+		OutPoint:  h.htlcOutpoint(),
+		Amount:    h.htlcAmt.ToAtoms(),
+		Outcome:   h.outcome,
+		SweepTxid: h.sweepTxid,
+	}
 }
 
 const (
@@ -159,7 +190,9 @@ func (h *htlcTimeoutResolver) claimCleanUp(
 	}); err != nil {
 		return nil, err
 	}
-	h.resolved = true
+	h.outcome = ResolutionOutcomeRemoteClaimed
+	h.sweepTxid = spendingTxid(commitSpend)
+	h.markResolved()
 	return nil, h.Checkpoint(h)
 }
 
@@ -268,7 +301,7 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 			return nil, err
 		}
 
-		h.outputIncubating = true
+		h.markIncubating()
 
 		if err := h.Checkpoint(h); err != nil {
 			log.Errorf("unable to Checkpoint: %v", err)
@@ -292,11 +325,13 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		}
 
 		select {
-		case _, ok := <-spendNtfn.Spend:
+		case spend, ok := <-spendNtfn.Spend:
 			if !ok {
 				return errResolverShuttingDown
 			}
 
+			h.sweepTxid = spendingTxid(spend)
+
 		case <-h.Quit:
 			return errResolverShuttingDown
 		}
@@ -353,6 +388,8 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 	log.Infof("%T(%v): resolving htlc with incoming fail msg, fully "+
 		"confirmed", h, h.htlcResolution.ClaimOutpoint)
 
+	h.sweepTxid = spendingTxid(spend)
+
 	// At this point, the second-level transaction is sufficiently
 	// confirmed, or a transaction directly spending the output is.
 	// Therefore, we can now send back our clean up message, failing the
@@ -379,7 +416,8 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 
 	// With the clean up message sent, we'll now mark the contract
 	// resolved, and wait.
-	h.resolved = true
+	h.outcome = ResolutionOutcomeTimeout
+	h.markResolved()
 	return nil, h.Checkpoint(h)
 }
 
@@ -396,9 +434,36 @@ func (h *htlcTimeoutResolver) Stop() {
 //
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcTimeoutResolver) IsResolved() bool {
+	h.stateLock.RLock()
+	defer h.stateLock.RUnlock()
+
 	return h.resolved
 }
 
+// isIncubating returns true if the output has been sent to the output
+// incubator.
+func (h *htlcTimeoutResolver) isIncubating() bool {
+	h.stateLock.RLock()
+	defer h.stateLock.RUnlock()
+
+	return h.outputIncubating
+}
+
+// markIncubating records that the output has been sent to the output
+// incubator.
+func (h *htlcTimeoutResolver) markIncubating() {
+	h.stateLock.Lock()
+	h.outputIncubating = true
+	h.stateLock.Unlock()
+}
+
+// markResolved marks the contract as fully resolved.
+func (h *htlcTimeoutResolver) markResolved() {
+	h.stateLock.Lock()
+	h.resolved = true
+	h.stateLock.Unlock()
+}
+
 // Encode writes an encoded version of the ContractResolver into the passed
 // Writer.
 //
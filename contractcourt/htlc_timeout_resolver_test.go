@@ -314,6 +314,10 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 			t.Fatalf("failed to request spend ntfn")
 		}
 
+		// The report of the resolver should reference the last
+		// transaction spending the HTLC's funds.
+		finalSpendTx := spendingTx
+
 		if !testCase.timeout {
 			// If the resolver should settle now, then we'll
 			// extract the pre-image to be extracted and the
@@ -362,9 +366,12 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 			// indicate that it's been swept by the nursery, but
 			// only if this is a local commitment transaction.
 			if !testCase.remoteCommit {
+				finalSpendTx = spendingTx.Copy()
+				finalSpendTx.LockTime++
+
 				select {
 				case notifier.spendChan <- &chainntnfs.SpendDetail{
-					SpendingTx: spendingTx,
+					SpendingTx: finalSpendTx,
 				}:
 				case <-time.After(time.Second * 5):
 					t.Fatalf("failed to request spend ntfn")
@@ -388,5 +395,14 @@ func TestHtlcTimeoutResolver(t *testing.T) {
 		if !resolver.resolved {
 			t.Fatalf("resolver should be marked as resolved")
 		}
+
+		report := resolver.resolutionReport()
+		if report == nil {
+			t.Fatalf("expected resolution report")
+		}
+		if report.SweepTxid != finalSpendTx.TxHash() {
+			t.Fatalf("expected sweep txid %v, got %v",
+				finalSpendTx.TxHash(), report.SweepTxid)
+		}
 	}
 }
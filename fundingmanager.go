@@ -327,6 +327,12 @@ type fundingConfig struct {
 	// incoming channels having a non-zero push amount.
 	RejectPush bool
 
+	// MinCommitFeePerKB is the minimum fee rate the commitment
+	// transactions of newly opened channels must pay. Inbound channels
+	// proposing a lower rate are rejected. A zero value disables the
+	// floor.
+	MinCommitFeePerKB lnwallet.AtomPerKByte
+
 	// NotifyOpenChannelEvent informs the ChannelNotifier when channels
 	// transition from pending open to open.
 	NotifyOpenChannelEvent func(wire.OutPoint)
@@ -1158,6 +1164,19 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// We'd refuse to sign any state of a channel paying less than our
+	// commitment fee rate floor, so there's no point in accepting it.
+	commitFeePerKB := lnwallet.AtomPerKByte(msg.FeePerKiloByte)
+	if commitFeePerKB < f.cfg.MinCommitFeePerKB {
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID,
+			lnwallet.ErrCommitFeeTooLow(
+				commitFeePerKB, f.cfg.MinCommitFeePerKB,
+			),
+		)
+		return
+	}
+
 	// Send the OpenChannel request to the ChannelAcceptor to determine whether
 	// this node will accept the channel.
 	chanReq := &chanacceptor.ChannelAcceptRequest{
@@ -1165,7 +1184,37 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		OpenChanMsg: fmsg.msg,
 	}
 
-	if !f.cfg.OpenChannelPredicate.Accept(chanReq) {
+	// If the predicate is able to tell us why the request was rejected,
+	// we'll record that reason along with the decision.
+	var (
+		accepted bool
+		reason   string
+	)
+	predicate := f.cfg.OpenChannelPredicate
+	if p, ok := predicate.(chanacceptor.ReasonedChannelAcceptor); ok {
+		accepted, reason = p.AcceptWithReason(chanReq)
+	} else {
+		accepted = predicate.Accept(chanReq)
+		if !accepted {
+			reason = "rejected by channel acceptor"
+		}
+	}
+
+	// Record the decision, so operators can audit which channels were
+	// accepted or rejected.
+	decision := &channeldb.ChannelAcceptDecision{
+		NodeKey:       fmsg.peer.IdentityKey(),
+		PendingChanID: fmsg.msg.PendingChannelID,
+		Accepted:      accepted,
+		Reason:        reason,
+	}
+	err = f.cfg.Wallet.Cfg.Database.LogChannelAcceptDecision(decision)
+	if err != nil {
+		fndgLog.Errorf("Unable to log channel accept decision for "+
+			"pendingId=%x: %v", fmsg.msg.PendingChannelID, err)
+	}
+
+	if !accepted {
 		f.failFundingFlow(
 			fmsg.peer, fmsg.msg.PendingChannelID,
 			fmt.Errorf("open channel request rejected"),
@@ -1209,7 +1258,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		NodeAddr:         peerAddr,
 		LocalFundingAmt:  0,
 		RemoteFundingAmt: amt,
-		CommitFeePerKB:   lnwallet.AtomPerKByte(msg.FeePerKiloByte),
+		CommitFeePerKB:   commitFeePerKB,
 		FundingFeePerKB:  0,
 		PushMAtoms:       msg.PushAmount,
 		Flags:            msg.ChannelFlags,
@@ -1564,6 +1613,7 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	// The channel is marked IsPending in the database, and can be removed
 	// from the set of active reservations.
 	f.deleteReservationCtx(peerKey, fmsg.msg.PendingChannelID)
+	f.applyMinCommitFee(completeChan)
 
 	// If something goes wrong before the funding transaction is confirmed,
 	// we use this convenience method to delete the pending OpenChannel
@@ -1727,6 +1777,7 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	// The channel is now marked IsPending in the database, and we can
 	// delete it from our set of active reservations.
 	f.deleteReservationCtx(peerKey, pendingChanID)
+	f.applyMinCommitFee(completeChan)
 
 	// Broadcast the finalized funding transaction to the network.
 	fundingTx := completeChan.FundingTxn
@@ -2752,6 +2803,11 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		return
 	}
 
+	// Never commit to a fee rate below our commitment fee rate floor.
+	if commitFeePerKB < f.cfg.MinCommitFeePerKB {
+		commitFeePerKB = f.cfg.MinCommitFeePerKB
+	}
+
 	// We set the channel flags to indicate whether we want this channel to
 	// be announced to the network.
 	var channelFlags lnwire.FundingFlag
@@ -3040,6 +3096,22 @@ func (f *fundingManager) cancelReservationCtx(peerKey *secp256k1.PublicKey,
 	return ctx, nil
 }
 
+// applyMinCommitFee sets the commitment fee rate floor of a newly funded
+// channel, if one is configured.
+func (f *fundingManager) applyMinCommitFee(channel *channeldb.OpenChannel) {
+	if f.cfg.MinCommitFeePerKB == 0 {
+		return
+	}
+
+	err := channel.SetMinCommitFeePerKB(
+		dcrutil.Amount(f.cfg.MinCommitFeePerKB),
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to set min commit fee rate for "+
+			"ChannelPoint(%v): %v", channel.FundingOutpoint, err)
+	}
+}
+
 // deleteReservationCtx deletes the reservation uniquely identified by the
 // target public key of the peer, and the specified pending channel ID.
 func (f *fundingManager) deleteReservationCtx(peerKey *secp256k1.PublicKey,
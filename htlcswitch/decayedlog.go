@@ -9,6 +9,7 @@ import (
 	"sync/atomic"
 
 	"github.com/decred/dcrlnd/chainntnfs"
+	"github.com/decred/dcrlnd/htlcswitch/hop"
 	sphinx "github.com/decred/lightning-onion/v2"
 	bolt "go.etcd.io/bbolt"
 )
@@ -60,6 +61,10 @@ type DecayedLog struct {
 
 	notifier chainntnfs.ChainNotifier
 
+	// retentionBlocks is the number of blocks past their expiry that
+	// entries are kept in the log before being garbage collected.
+	retentionBlocks uint32
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -170,7 +175,7 @@ func (d *DecayedLog) garbageCollector(epochClient *chainntnfs.BlockEpochEvent) {
 			// Perform a bout of garbage collection using the
 			// epoch's block height.
 			height := uint32(epoch.Height)
-			numExpired, err := d.gcExpiredHashes(height)
+			numExpired, err := d.GarbageCollect(height)
 			if err != nil {
 				log.Errorf("unable to expire hashes at "+
 					"height=%d", height)
@@ -243,6 +248,26 @@ func (d *DecayedLog) gcExpiredHashes(height uint32) (uint32, error) {
 	return numExpiredHashes, nil
 }
 
+// SetRetention sets the number of blocks past their expiry that entries are
+// kept in the log before being garbage collected.
+//
+// NOTE: This must be called before the log is started.
+func (d *DecayedLog) SetRetention(retentionBlocks uint32) {
+	d.retentionBlocks = retentionBlocks
+}
+
+// GarbageCollect purges the decaying log of all entries that have been expired
+// for longer than the retention at the provided height, returning the number
+// of entries removed. It is called by the garbage collector on each new block,
+// and can be used to prune the log on demand.
+func (d *DecayedLog) GarbageCollect(height uint32) (uint32, error) {
+	if height <= d.retentionBlocks {
+		return 0, nil
+	}
+
+	return d.gcExpiredHashes(height - d.retentionBlocks)
+}
+
 // Delete removes a <shared secret hash, CLTV> key-pair from the
 // sharedHashBucket.
 func (d *DecayedLog) Delete(hash *sphinx.HashPrefix) error {
@@ -403,3 +428,7 @@ func (d *DecayedLog) PutBatch(b *sphinx.Batch) (*sphinx.ReplaySet, error) {
 // A compile time check to see if DecayedLog adheres to the PersistLog
 // interface.
 var _ sphinx.ReplayLog = (*DecayedLog)(nil)
+
+// A compile time check to see if DecayedLog can be pruned by the onion
+// processor.
+var _ hop.ReplayLogPruner = (*DecayedLog)(nil)
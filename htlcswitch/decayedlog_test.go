@@ -131,6 +131,58 @@ func TestDecayedLogGarbageCollector(t *testing.T) {
 	}
 }
 
+// TestDecayedLogGarbageCollectorRetention tests that the garbage collector
+// keeps entries for the configured number of blocks past their expiry.
+func TestDecayedLogGarbageCollectorRetention(t *testing.T) {
+	t.Parallel()
+
+	const retentionBlocks = 10
+
+	dbPath := tempDecayedLogPath(t)
+
+	notifier := &mockNotifier{
+		epochChan: make(chan *chainntnfs.BlockEpoch, 1),
+	}
+	d := NewDecayedLog(dbPath, notifier)
+	d.SetRetention(retentionBlocks)
+	if err := d.Start(); err != nil {
+		t.Fatalf("Unable to start up DecayedLog: %v", err)
+	}
+	defer shutdown(dbPath, d)
+
+	var hashedSecret sphinx.HashPrefix
+	if _, err := rand.Read(hashedSecret[:]); err != nil {
+		t.Fatalf("Unable to create hashed secret: %v", err)
+	}
+	if err := d.Put(&hashedSecret, cltv); err != nil {
+		t.Fatalf("Unable to store in channeldb: %v", err)
+	}
+
+	// Past the expiry block, the entry should still be retained.
+	notifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: int32(cltv + retentionBlocks),
+	}
+
+	// Wait for database write (GC is in a goroutine)
+	time.Sleep(500 * time.Millisecond)
+
+	if _, err := d.Get(&hashedSecret); err != nil {
+		t.Fatalf("GC deleted CLTV within retention: %v", err)
+	}
+
+	// Once past the retention, the entry should be removed.
+	notifier.epochChan <- &chainntnfs.BlockEpoch{
+		Height: int32(cltv + retentionBlocks + 1),
+	}
+
+	// Wait for database write (GC is in a goroutine)
+	time.Sleep(500 * time.Millisecond)
+
+	if _, err := d.Get(&hashedSecret); err != sphinx.ErrLogEntryNotFound {
+		t.Fatalf("expected ErrLogEntryNotFound, got %v", err)
+	}
+}
+
 // TestDecayedLogPersistentGarbageCollector tests the persistence property of
 // the garbage collector. The garbage collector will be restarted immediately and
 // a block that expires the stored CLTV value will be sent to the ChainNotifier.
@@ -225,6 +277,51 @@ func TestDecayedLogInsertionAndDeletion(t *testing.T) {
 	}
 }
 
+// TestDecayedLogGarbageCollect tests that the log can be pruned on demand,
+// removing only the entries that expire below the given height.
+func TestDecayedLogGarbageCollect(t *testing.T) {
+	t.Parallel()
+
+	dbPath := tempDecayedLogPath(t)
+
+	d, _, hashedSecret, err := startup(dbPath, false)
+	if err != nil {
+		t.Fatalf("Unable to start up DecayedLog: %v", err)
+	}
+	defer shutdown(dbPath, d)
+
+	err = d.Put(hashedSecret, cltv)
+	if err != nil {
+		t.Fatalf("Unable to store in channeldb: %v", err)
+	}
+
+	decayedLog := d.(*DecayedLog)
+
+	// Nothing should be pruned at the expiry height itself.
+	numPruned, err := decayedLog.GarbageCollect(cltv)
+	if err != nil {
+		t.Fatalf("Unable to garbage collect: %v", err)
+	}
+	if numPruned != 0 {
+		t.Fatalf("expected no entries to be pruned, got %v", numPruned)
+	}
+	if _, err := d.Get(hashedSecret); err != nil {
+		t.Fatalf("Get failed - received an error upon Get: %v", err)
+	}
+
+	// Past the expiry height, the entry should be pruned.
+	numPruned, err = decayedLog.GarbageCollect(cltv + 1)
+	if err != nil {
+		t.Fatalf("Unable to garbage collect: %v", err)
+	}
+	if numPruned != 1 {
+		t.Fatalf("expected 1 entry to be pruned, got %v", numPruned)
+	}
+	if _, err := d.Get(hashedSecret); err != sphinx.ErrLogEntryNotFound {
+		t.Fatalf("expected ErrLogEntryNotFound, got %v", err)
+	}
+}
+
 // TestDecayedLogStartAndStop tests for persistence. The DecayedLog is started,
 // a cltv value is stored in the sharedHashBucket, and then it the DecayedLog
 // is stopped. The DecayedLog is then started up again and we test that the
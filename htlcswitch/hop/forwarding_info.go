@@ -1,6 +1,8 @@
 package hop
 
 import (
+	"fmt"
+
 	"github.com/decred/dcrlnd/lnwire"
 )
 
@@ -27,3 +29,51 @@ type ForwardingInfo struct {
 	// in the outgoing HTLC.
 	OutgoingCTLV uint32
 }
+
+// ErrInvalidForwardingInfo is returned when the forwarding info decoded for an
+// intermediate hop is obviously invalid.
+type ErrInvalidForwardingInfo struct {
+	// Code is the failure code that the htlc should be failed with.
+	Code lnwire.FailCode
+
+	// NextHop is the outgoing channel the htlc was to be forwarded over.
+	// Its latest update is the one to include in the failure.
+	NextHop lnwire.ShortChannelID
+
+	// Reason describes why the forwarding info is invalid.
+	Reason string
+}
+
+// Error returns a human-readable description of the invalid forwarding info
+// error.
+func (e ErrInvalidForwardingInfo) Error() string {
+	return fmt.Sprintf("invalid forwarding info (%v): %v", e.Code,
+		e.Reason)
+}
+
+// Validate checks that the forwarding info of an intermediate hop carries a
+// non-zero amount to forward and outgoing timelock. The forwarding info of the
+// final hop isn't checked, as it's validated against the invoice instead.
+func (f *ForwardingInfo) Validate() error {
+	if f.NextHop == Exit {
+		return nil
+	}
+
+	if f.AmountToForward == 0 {
+		return ErrInvalidForwardingInfo{
+			Code:    lnwire.CodeAmountBelowMinimum,
+			NextHop: f.NextHop,
+			Reason:  "zero amount to forward",
+		}
+	}
+
+	if f.OutgoingCTLV == 0 {
+		return ErrInvalidForwardingInfo{
+			Code:    lnwire.CodeIncorrectCltvExpiry,
+			NextHop: f.NextHop,
+			Reason:  "zero outgoing timelock",
+		}
+	}
+
+	return nil
+}
@@ -2,8 +2,11 @@ package hop
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 	"github.com/decred/dcrlnd/lnwire"
@@ -23,6 +26,14 @@ type Iterator interface {
 	// information given to it by the prior hop.
 	ForwardingInstructions() (ForwardingInfo, error)
 
+	// AmtToForward returns the amount that this hop should forward to the
+	// next hop.
+	AmtToForward() (lnwire.MilliAtom, error)
+
+	// OutgoingCLTV returns the time lock of the htlc that this hop should
+	// offer to the next hop.
+	OutgoingCLTV() (uint32, error)
+
 	// ExtraOnionBlob returns the additional EOB data (if available).
 	ExtraOnionBlob() []byte
 
@@ -48,6 +59,10 @@ type sphinxHopIterator struct {
 	// includes the information required to properly forward the packet to
 	// the next hop.
 	processedPacket *sphinx.ProcessedPacket
+
+	// fwdInfo caches the forwarding information once it has been decoded
+	// from the payload of the processed packet.
+	fwdInfo *ForwardingInfo
 }
 
 // makeSphinxHopIterator converts a processed packet returned from a sphinx
@@ -65,11 +80,41 @@ func makeSphinxHopIterator(ogPacket *sphinx.OnionPacket,
 // interface.
 var _ Iterator = (*sphinxHopIterator)(nil)
 
-// Encode encodes iterator and writes it to the writer.
+// Encode encodes iterator and writes it to the writer. The packet is only
+// written if it serializes to exactly the size of an onion blob, so that a
+// malformed packet can't result in a corrupt onion being forwarded.
 //
 // NOTE: Part of the HopIterator interface.
 func (r *sphinxHopIterator) EncodeNextHop(w io.Writer) error {
-	return r.processedPacket.NextPacket.Encode(w)
+	if r.processedPacket.NextPacket == nil {
+		return errors.New("no next onion packet to encode")
+	}
+
+	return encodeOnionPacket(w, r.processedPacket.NextPacket)
+}
+
+// onionPacketEncoder is an onion packet that can serialize itself.
+type onionPacketEncoder interface {
+	Encode(w io.Writer) error
+}
+
+// encodeOnionPacket serializes the onion packet and writes it to the writer,
+// provided it serializes to exactly the size of an onion blob. Otherwise,
+// nothing is written and an error is returned.
+func encodeOnionPacket(w io.Writer, packet onionPacketEncoder) error {
+	var b bytes.Buffer
+	if err := packet.Encode(&b); err != nil {
+		return err
+	}
+
+	if b.Len() != lnwire.OnionPacketSize {
+		return fmt.Errorf("encoded next onion packet has invalid "+
+			"size: expected %v bytes, got %v",
+			lnwire.OnionPacketSize, b.Len())
+	}
+
+	_, err := w.Write(b.Bytes())
+	return err
 }
 
 // ForwardingInstructions returns the set of fields that detail exactly _how_
@@ -79,6 +124,52 @@ func (r *sphinxHopIterator) EncodeNextHop(w io.Writer) error {
 //
 // NOTE: Part of the HopIterator interface.
 func (r *sphinxHopIterator) ForwardingInstructions() (ForwardingInfo, error) {
+	if r.fwdInfo != nil {
+		return *r.fwdInfo, nil
+	}
+
+	fwdInfo, err := r.decodeForwardingInfo()
+	if err != nil {
+		return ForwardingInfo{}, err
+	}
+
+	if err := fwdInfo.Validate(); err != nil {
+		return ForwardingInfo{}, err
+	}
+	r.fwdInfo = &fwdInfo
+
+	return fwdInfo, nil
+}
+
+// AmtToForward returns the amount that this hop should forward to the next
+// hop.
+//
+// NOTE: Part of the HopIterator interface.
+func (r *sphinxHopIterator) AmtToForward() (lnwire.MilliAtom, error) {
+	fwdInfo, err := r.ForwardingInstructions()
+	if err != nil {
+		return 0, err
+	}
+
+	return fwdInfo.AmountToForward, nil
+}
+
+// OutgoingCLTV returns the time lock of the htlc that this hop should offer
+// to the next hop.
+//
+// NOTE: Part of the HopIterator interface.
+func (r *sphinxHopIterator) OutgoingCLTV() (uint32, error) {
+	fwdInfo, err := r.ForwardingInstructions()
+	if err != nil {
+		return 0, err
+	}
+
+	return fwdInfo.OutgoingCTLV, nil
+}
+
+// decodeForwardingInfo decodes the forwarding information from the payload of
+// the processed packet.
+func (r *sphinxHopIterator) decodeForwardingInfo() (ForwardingInfo, error) {
 	switch r.processedPacket.Payload.Type {
 	// If this is the legacy payload, then we'll extract the information
 	// directly from the pre-populated ForwardingInstructions field.
@@ -139,11 +230,102 @@ func (r *sphinxHopIterator) ExtractErrorEncrypter(
 // tests dependent from the sphinx internal parts.
 type OnionProcessor struct {
 	router *sphinx.Router
+
+	// metrics is an optional set of hooks that is notified about every
+	// batch that is decoded.
+	metrics *BatchMetrics
+
+	// decodeWorkers is the number of goroutines used to parse the onion
+	// packets of a batch. A value of 0 or 1 parses them serially.
+	decodeWorkers int
+
+	// replayLog is the replay log of the sphinx router, used to prune
+	// expired entries on demand. If nil, GarbageCollect is a noop.
+	replayLog ReplayLogPruner
+}
+
+// ReplayLogPruner is implemented by replay logs whose expired entries can be
+// pruned on demand.
+type ReplayLogPruner interface {
+	// SetRetention sets the number of blocks past their expiry that
+	// entries are retained in the log.
+	SetRetention(retentionBlocks uint32)
+
+	// GarbageCollect removes all entries that have been expired for
+	// longer than the retention at the given height, returning the number
+	// of entries removed.
+	GarbageCollect(height uint32) (uint32, error)
+}
+
+// BatchMetrics is a set of optional hooks that are called by the
+// OnionProcessor for every batch of onion packets it decodes. Any of the
+// hooks may be nil.
+type BatchMetrics struct {
+	// BatchSize is called with the number of packets in the batch.
+	BatchSize func(size int)
+
+	// Replays is called with the number of packets in the batch that
+	// were detected as replays.
+	Replays func(count int)
+
+	// CommitLatency is called with the time it took to commit the batch
+	// to the replay log.
+	CommitLatency func(latency time.Duration)
 }
 
 // NewOnionProcessor creates new instance of decoder.
 func NewOnionProcessor(router *sphinx.Router) *OnionProcessor {
-	return &OnionProcessor{router}
+	return &OnionProcessor{router: router}
+}
+
+// SetBatchMetrics sets the hooks that are notified about every decoded batch.
+// Passing nil disables the hooks.
+//
+// NOTE: This must be called before the onion processor is used.
+func (p *OnionProcessor) SetBatchMetrics(metrics *BatchMetrics) {
+	p.metrics = metrics
+}
+
+// SetDecodeWorkers sets the number of goroutines used to parse the onion
+// packets of a batch passed to DecodeHopIterators. A value of 0 or 1 parses
+// them serially, which is the default.
+//
+// NOTE: This must be called before the onion processor is used.
+func (p *OnionProcessor) SetDecodeWorkers(numWorkers int) {
+	p.decodeWorkers = numWorkers
+}
+
+// SetReplayLogRetention sets the replay log of the sphinx router that is
+// pruned by GarbageCollect, along with the number of blocks past their expiry
+// that its entries are retained.
+//
+// NOTE: This must be called before the onion processor is used.
+func (p *OnionProcessor) SetReplayLogRetention(replayLog ReplayLogPruner,
+	retentionBlocks uint32) {
+
+	replayLog.SetRetention(retentionBlocks)
+	p.replayLog = replayLog
+}
+
+// GarbageCollect prunes the entries of the replay log that have been expired
+// for longer than the configured retention at the given height. This is a
+// noop if no replay log was set.
+func (p *OnionProcessor) GarbageCollect(height uint32) error {
+	if p.replayLog == nil {
+		return nil
+	}
+
+	numPruned, err := p.replayLog.GarbageCollect(height)
+	if err != nil {
+		return err
+	}
+
+	if numPruned > 0 {
+		log.Debugf("Pruned %v replay log entries at height=%v",
+			numPruned, height)
+	}
+
+	return nil
 }
 
 // Start spins up the onion processor's sphinx router.
@@ -201,6 +383,54 @@ func (p *OnionProcessor) DecodeHopIterator(r io.Reader, rHash []byte,
 	return makeSphinxHopIterator(onionPkt, sphinxPacket), lnwire.CodeNone
 }
 
+// DecodeHopIteratorDryRun decodes a sphinx packet in the same way as
+// DecodeHopIterator, but without writing its shared secret to the replay log.
+// This allows the forwarding instructions of an onion to be inspected for
+// diagnostic purposes without affecting replay protection. The incoming cltv
+// is only used for logging, as it only matters for the replay log.
+//
+// WARNING: Because no replay check is performed, the returned iterator MUST
+// NOT be used to actually forward an HTLC.
+func (p *OnionProcessor) DecodeHopIteratorDryRun(r io.Reader, rHash []byte,
+	incomingCltv uint32) (Iterator, lnwire.FailCode) {
+
+	onionPkt := &sphinx.OnionPacket{}
+	if err := onionPkt.Decode(r); err != nil {
+		switch err {
+		case sphinx.ErrInvalidOnionVersion:
+			return nil, lnwire.CodeInvalidOnionVersion
+		case sphinx.ErrInvalidOnionKey:
+			return nil, lnwire.CodeInvalidOnionKey
+		default:
+			log.Errorf("unable to decode onion packet: %v", err)
+			return nil, lnwire.CodeInvalidOnionKey
+		}
+	}
+
+	log.Debugf("Performing dry run decode of onion packet with "+
+		"payment_hash=%x, incoming_cltv=%v", rHash, incomingCltv)
+
+	// Reconstruct the processed packet, which performs the same checks as
+	// ProcessOnionPacket apart from the replay check.
+	sphinxPacket, err := p.router.ReconstructOnionPacket(onionPkt, rHash)
+	if err != nil {
+		switch err {
+		case sphinx.ErrInvalidOnionVersion:
+			return nil, lnwire.CodeInvalidOnionVersion
+		case sphinx.ErrInvalidOnionHMAC:
+			return nil, lnwire.CodeInvalidOnionHmac
+		case sphinx.ErrInvalidOnionKey:
+			return nil, lnwire.CodeInvalidOnionKey
+		default:
+			log.Errorf("unable to reconstruct onion packet: %v",
+				err)
+			return nil, lnwire.CodeInvalidOnionKey
+		}
+	}
+
+	return makeSphinxHopIterator(onionPkt, sphinxPacket), lnwire.CodeNone
+}
+
 // DecodeHopIteratorRequest encapsulates all date necessary to process an onion
 // packet, perform sphinx replay detection, and schedule the entry for garbage
 // collection.
@@ -242,32 +472,30 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 		resps     = make([]DecodeHopIteratorResponse, batchSize)
 	)
 
+	if p.metrics != nil && p.metrics.BatchSize != nil {
+		p.metrics.BatchSize(batchSize)
+	}
+
+	// Parse all onion packets up front, possibly concurrently. Any packet
+	// that fails to parse has its fail code set and is skipped below.
+	p.decodeOnionPackets(reqs, onionPkts, resps)
+
+	// The packets are added to the sphinx batch serially and in index
+	// order, as the batch isn't safe for concurrent use, and replays
+	// within the batch are detected in the order the packets are added.
+	// This keeps the results deterministic for a given id.
 	tx := p.router.BeginTxn(id, batchSize)
 
 	for i, req := range reqs {
 		onionPkt := &onionPkts[i]
 		resp := &resps[i]
 
-		err := onionPkt.Decode(req.OnionReader)
-		switch err {
-		case nil:
-			// success
-
-		case sphinx.ErrInvalidOnionVersion:
-			resp.FailCode = lnwire.CodeInvalidOnionVersion
-			continue
-
-		case sphinx.ErrInvalidOnionKey:
-			resp.FailCode = lnwire.CodeInvalidOnionKey
-			continue
-
-		default:
-			log.Errorf("unable to decode onion packet: %v", err)
-			resp.FailCode = lnwire.CodeInvalidOnionKey
+		// Skip any indexes that already failed onion decoding.
+		if resp.FailCode != lnwire.CodeNone {
 			continue
 		}
 
-		err = tx.ProcessOnionPacket(
+		err := tx.ProcessOnionPacket(
 			uint16(i), onionPkt, req.RHash, req.IncomingCltv,
 		)
 		switch err {
@@ -299,7 +527,11 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 	// indices that did not fail the above loop. Only indices that are not
 	// in the replay set should be considered valid, as they are
 	// opportunistically computed.
+	commitStart := time.Now()
 	packets, replays, err := tx.Commit()
+	if p.metrics != nil && p.metrics.CommitLatency != nil {
+		p.metrics.CommitLatency(time.Since(commitStart))
+	}
 	if err != nil {
 		log.Errorf("unable to process onion packet batch %x: %v",
 			id, err)
@@ -327,6 +559,7 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 	// Otherwise, the commit was successful. Now we will post process any
 	// remaining packets, additionally failing any that were included in the
 	// replay set.
+	var numReplays int
 	for i := range resps {
 		resp := &resps[i]
 
@@ -343,6 +576,7 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 			log.Errorf("unable to process onion packet: %v",
 				sphinx.ErrReplayedPacket)
 			resp.FailCode = lnwire.CodeTemporaryChannelFailure
+			numReplays++
 			continue
 		}
 
@@ -351,9 +585,81 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 		resp.HopIterator = makeSphinxHopIterator(&onionPkts[i], &packets[i])
 	}
 
+	if p.metrics != nil && p.metrics.Replays != nil {
+		p.metrics.Replays(numReplays)
+	}
+
 	return resps, nil
 }
 
+// decodeOnionPackets parses the onion packet of each request into the onion
+// packet at the same index, setting the fail code of the matching response if
+// the packet can't be decoded. If the processor is configured with multiple
+// decode workers, the packets are parsed concurrently. Each worker only writes
+// to the indexes it's handed, so no further synchronization is needed.
+func (p *OnionProcessor) decodeOnionPackets(reqs []DecodeHopIteratorRequest,
+	onionPkts []sphinx.OnionPacket, resps []DecodeHopIteratorResponse) {
+
+	decode := func(i int) {
+		resps[i].FailCode = decodeOnionPacket(
+			&onionPkts[i], reqs[i].OnionReader,
+		)
+	}
+
+	numWorkers := p.decodeWorkers
+	if numWorkers > len(reqs) {
+		numWorkers = len(reqs)
+	}
+	if numWorkers <= 1 {
+		for i := range reqs {
+			decode(i)
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+	indexes := make(chan int)
+	wg.Add(numWorkers)
+	for w := 0; w < numWorkers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				decode(i)
+			}
+		}()
+	}
+
+	for i := range reqs {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+}
+
+// decodeOnionPacket decodes an onion packet from the passed reader, returning
+// the failure code that should be sent back if it's malformed.
+func decodeOnionPacket(onionPkt *sphinx.OnionPacket,
+	r io.Reader) lnwire.FailCode {
+
+	err := onionPkt.Decode(r)
+	switch err {
+	case nil:
+		return lnwire.CodeNone
+
+	case sphinx.ErrInvalidOnionVersion:
+		return lnwire.CodeInvalidOnionVersion
+
+	case sphinx.ErrInvalidOnionKey:
+		return lnwire.CodeInvalidOnionKey
+
+	default:
+		log.Errorf("unable to decode onion packet: %v", err)
+		return lnwire.CodeInvalidOnionKey
+	}
+}
+
 // ExtractErrorEncrypter takes an io.Reader which should contain the onion
 // packet as original received by a forwarding node and creates an
 // ErrorEncrypter instance using the derived shared secret. In the case that en
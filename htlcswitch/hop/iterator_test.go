@@ -3,11 +3,17 @@ package hop
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/record"
+	"github.com/decred/dcrlnd/routing/route"
 	"github.com/decred/dcrlnd/tlv"
 	sphinx "github.com/decred/lightning-onion/v2"
 )
@@ -82,9 +88,10 @@ func TestSphinxHopIteratorForwardingInstructions(t *testing.T) {
 
 	// Finally, we'll test that we get the same set of
 	// ForwardingInstructions for each payload type.
-	iterator := sphinxHopIterator{}
 	for i, testCase := range testCases {
-		iterator.processedPacket = testCase.sphinxPacket
+		iterator := sphinxHopIterator{
+			processedPacket: testCase.sphinxPacket,
+		}
 
 		fwdInfo, err := iterator.ForwardingInstructions()
 		if err != nil {
@@ -99,3 +106,647 @@ func TestSphinxHopIteratorForwardingInstructions(t *testing.T) {
 		}
 	}
 }
+
+// TestSphinxHopIteratorInvalidForwardingInfo asserts that forwarding info with
+// a zero amount or timelock is rejected for intermediate hops, with a failure
+// code matching the violation.
+func TestSphinxHopIteratorInvalidForwardingInfo(t *testing.T) {
+	t.Parallel()
+
+	var nextHop [8]byte
+	copy(nextHop[:], bytes.Repeat([]byte("a"), 8))
+
+	tests := []struct {
+		name         string
+		hopData      sphinx.HopData
+		expectedCode lnwire.FailCode
+	}{
+		{
+			name: "valid",
+			hopData: sphinx.HopData{
+				NextAddress:   nextHop,
+				ForwardAmount: 100000,
+				OutgoingCltv:  4343,
+			},
+			expectedCode: lnwire.CodeNone,
+		},
+		{
+			name: "zero amount",
+			hopData: sphinx.HopData{
+				NextAddress:  nextHop,
+				OutgoingCltv: 4343,
+			},
+			expectedCode: lnwire.CodeAmountBelowMinimum,
+		},
+		{
+			name: "zero timelock",
+			hopData: sphinx.HopData{
+				NextAddress:   nextHop,
+				ForwardAmount: 100000,
+			},
+			expectedCode: lnwire.CodeIncorrectCltvExpiry,
+		},
+		{
+			// The final hop is validated against the invoice
+			// instead.
+			name:         "exit hop",
+			hopData:      sphinx.HopData{},
+			expectedCode: lnwire.CodeNone,
+		},
+	}
+
+	for _, test := range tests {
+		hopData := test.hopData
+		iterator := sphinxHopIterator{
+			processedPacket: &sphinx.ProcessedPacket{
+				Payload: sphinx.HopPayload{
+					Type: sphinx.PayloadLegacy,
+				},
+				Action:                 sphinx.MoreHops,
+				ForwardingInstructions: &hopData,
+			},
+		}
+
+		_, err := iterator.ForwardingInstructions()
+		if test.expectedCode == lnwire.CodeNone {
+			if err != nil {
+				t.Fatalf("%v: unable to extract forwarding "+
+					"instructions: %v", test.name, err)
+			}
+			continue
+		}
+
+		fwdErr, ok := err.(ErrInvalidForwardingInfo)
+		if !ok {
+			t.Fatalf("%v: expected ErrInvalidForwardingInfo, "+
+				"got %v", test.name, err)
+		}
+		if fwdErr.Code != test.expectedCode {
+			t.Fatalf("%v: expected code %v, got %v", test.name,
+				test.expectedCode, fwdErr.Code)
+		}
+		if fwdErr.NextHop != lnwire.NewShortChanIDFromInt(
+			binary.BigEndian.Uint64(nextHop[:]),
+		) {
+			t.Fatalf("%v: unexpected next hop %v", test.name,
+				fwdErr.NextHop)
+		}
+	}
+}
+
+// TestSphinxHopIteratorEncodeNextHop asserts that the next onion packet is
+// only encoded if it has the expected onion size.
+func TestSphinxHopIteratorEncodeNextHop(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	// A well formed next packet encodes to exactly the onion size.
+	iterator := sphinxHopIterator{
+		processedPacket: &sphinx.ProcessedPacket{
+			Action: sphinx.MoreHops,
+			NextPacket: &sphinx.OnionPacket{
+				EphemeralKey: privKey.PubKey(),
+			},
+		},
+	}
+
+	var b bytes.Buffer
+	if err := iterator.EncodeNextHop(&b); err != nil {
+		t.Fatalf("unable to encode next hop: %v", err)
+	}
+	if b.Len() != lnwire.OnionPacketSize {
+		t.Fatalf("expected %v bytes, got %v", lnwire.OnionPacketSize,
+			b.Len())
+	}
+
+	// A truncated processed packet without a next packet must be
+	// rejected, without anything being written.
+	iterator.processedPacket.NextPacket = nil
+
+	b.Reset()
+	if err := iterator.EncodeNextHop(&b); err == nil {
+		t.Fatal("expected encoding of missing next packet to fail")
+	}
+	if b.Len() != 0 {
+		t.Fatalf("expected nothing to be written, got %v bytes",
+			b.Len())
+	}
+
+	// A packet that doesn't serialize to exactly the onion size must be
+	// rejected as well, whether it's too short or too long.
+	for _, size := range []int{
+		lnwire.OnionPacketSize - 1, lnwire.OnionPacketSize + 1,
+	} {
+		b.Reset()
+		err := encodeOnionPacket(&b, &mockOnionPacket{size: size})
+		if err == nil {
+			t.Fatalf("expected encoding of %v byte packet to fail",
+				size)
+		}
+		if b.Len() != 0 {
+			t.Fatalf("expected nothing to be written, got %v "+
+				"bytes", b.Len())
+		}
+	}
+}
+
+// mockOnionPacket is an onion packet that serializes to the given number of
+// bytes.
+type mockOnionPacket struct {
+	size int
+}
+
+// Encode writes size zero bytes to the writer.
+func (p *mockOnionPacket) Encode(w io.Writer) error {
+	_, err := w.Write(make([]byte, p.size))
+	return err
+}
+
+// TestSphinxHopIteratorAmtAndCLTV asserts that the outgoing amount and time
+// lock can be read directly from an iterator with a TLV payload.
+func TestSphinxHopIteratorAmtAndCLTV(t *testing.T) {
+	t.Parallel()
+
+	var (
+		amtToFwd     uint64 = 100000
+		outgoingCltv uint32 = 4343
+		nextHop      uint64 = 1
+	)
+
+	var b bytes.Buffer
+	tlvStream, err := tlv.NewStream(
+		record.NewAmtToFwdRecord(&amtToFwd),
+		record.NewLockTimeRecord(&outgoingCltv),
+		record.NewNextHopIDRecord(&nextHop),
+	)
+	if err != nil {
+		t.Fatalf("unable to create stream: %v", err)
+	}
+	if err := tlvStream.Encode(&b); err != nil {
+		t.Fatalf("unable to encode stream: %v", err)
+	}
+
+	iterator := sphinxHopIterator{
+		processedPacket: &sphinx.ProcessedPacket{
+			Payload: sphinx.HopPayload{
+				Type:    sphinx.PayloadTLV,
+				Payload: b.Bytes(),
+			},
+		},
+	}
+
+	amt, err := iterator.AmtToForward()
+	if err != nil {
+		t.Fatalf("unable to get amount to forward: %v", err)
+	}
+	if amt != lnwire.MilliAtom(amtToFwd) {
+		t.Fatalf("expected amount %v, got %v", amtToFwd, amt)
+	}
+
+	cltv, err := iterator.OutgoingCLTV()
+	if err != nil {
+		t.Fatalf("unable to get outgoing cltv: %v", err)
+	}
+	if cltv != outgoingCltv {
+		t.Fatalf("expected cltv %v, got %v", outgoingCltv, cltv)
+	}
+
+	// The payload is only decoded once, after which the cached
+	// forwarding info is used.
+	if iterator.fwdInfo == nil {
+		t.Fatal("expected forwarding info to be cached")
+	}
+}
+
+// TestOnionProcessorBatchMetrics asserts that the batch metrics hooks of the
+// onion processor are called, and that replayed packets are counted.
+func TestOnionProcessorBatchMetrics(t *testing.T) {
+	t.Parallel()
+
+	sphinxRouter, onionBlob, rHash := newTestOnion(t)
+	defer sphinxRouter.Stop()
+
+	var (
+		batchSizes []int
+		replays    []int
+		commits    int
+	)
+	processor := NewOnionProcessor(sphinxRouter)
+	processor.SetBatchMetrics(&BatchMetrics{
+		BatchSize: func(size int) {
+			batchSizes = append(batchSizes, size)
+		},
+		Replays: func(count int) {
+			replays = append(replays, count)
+		},
+		CommitLatency: func(time.Duration) {
+			commits++
+		},
+	})
+
+	decodeBatch := func(id byte) {
+		reqs := []DecodeHopIteratorRequest{{
+			OnionReader:  bytes.NewReader(onionBlob),
+			RHash:        rHash,
+			IncomingCltv: 100,
+		}}
+		_, err := processor.DecodeHopIterators([]byte{id}, reqs)
+		if err != nil {
+			t.Fatalf("unable to decode batch: %v", err)
+		}
+	}
+
+	// Decode the packet in two different batches. The second time, the
+	// packet is a replay.
+	decodeBatch(1)
+	decodeBatch(2)
+
+	if len(batchSizes) != 2 || batchSizes[0] != 1 || batchSizes[1] != 1 {
+		t.Fatalf("unexpected batch sizes: %v", batchSizes)
+	}
+	if commits != 2 {
+		t.Fatalf("expected 2 commits, got %v", commits)
+	}
+	if len(replays) != 2 || replays[0] != 0 || replays[1] != 1 {
+		t.Fatalf("unexpected replay counts: %v", replays)
+	}
+
+	// Removing the hooks must leave the processor functional.
+	processor.SetBatchMetrics(nil)
+	decodeBatch(3)
+}
+
+// newTestOnion creates and starts a sphinx router for a fresh node key, and
+// returns it together with an onion packet for a single hop route to that node
+// and the payment hash used as associated data.
+func newTestOnion(t testing.TB) (*sphinx.Router, []byte, []byte) {
+	t.Helper()
+
+	nodeKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	onionBlob, rHash := newTestOnionPacket(t, nodeKey)
+	return newTestRouter(t, nodeKey), onionBlob, rHash
+}
+
+// newTestOnionPacket returns a fresh onion packet for a single hop route to
+// the node with the given key, together with the payment hash used as
+// associated data.
+func newTestOnionPacket(t testing.TB,
+	nodeKey *secp256k1.PrivateKey) ([]byte, []byte) {
+
+	t.Helper()
+
+	sessionKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	// Create an onion packet for a single hop route to our node.
+	var nodeVertex route.Vertex
+	copy(nodeVertex[:], nodeKey.PubKey().SerializeCompressed())
+	rt, err := route.NewRouteFromHops(
+		1000, 100, route.Vertex{}, []*route.Hop{{
+			PubKeyBytes:      nodeVertex,
+			AmtToForward:     1000,
+			OutgoingTimeLock: 100,
+			LegacyPayload:    true,
+		}},
+	)
+	if err != nil {
+		t.Fatalf("unable to create route: %v", err)
+	}
+	sphinxPath, err := rt.ToSphinxPath()
+	if err != nil {
+		t.Fatalf("unable to create sphinx path: %v", err)
+	}
+
+	rHash := bytes.Repeat([]byte{1}, 32)
+	onionPkt, err := sphinx.NewOnionPacket(sphinxPath, sessionKey, rHash)
+	if err != nil {
+		t.Fatalf("unable to create onion packet: %v", err)
+	}
+	var onionBlob bytes.Buffer
+	if err := onionPkt.Encode(&onionBlob); err != nil {
+		t.Fatalf("unable to encode onion packet: %v", err)
+	}
+
+	return onionBlob.Bytes(), rHash
+}
+
+// newTestRouter creates and starts a sphinx router for the given node key,
+// backed by an in-memory replay log.
+func newTestRouter(t testing.TB, nodeKey *secp256k1.PrivateKey) *sphinx.Router {
+	t.Helper()
+
+	sphinxRouter := sphinx.NewRouter(
+		nodeKey, chaincfg.SimNetParams(), sphinx.NewMemoryReplayLog(),
+	)
+	if err := sphinxRouter.Start(); err != nil {
+		t.Fatalf("unable to start sphinx router: %v", err)
+	}
+
+	return sphinxRouter
+}
+
+// TestDecodeHopIteratorDryRun asserts that a dry run decode returns the same
+// forwarding instructions as a regular decode, without adding the packet to
+// the replay log.
+func TestDecodeHopIteratorDryRun(t *testing.T) {
+	t.Parallel()
+
+	sphinxRouter, onionBlob, rHash := newTestOnion(t)
+	defer sphinxRouter.Stop()
+
+	processor := NewOnionProcessor(sphinxRouter)
+
+	dryRunIterator, failCode := processor.DecodeHopIteratorDryRun(
+		bytes.NewReader(onionBlob), rHash, 100,
+	)
+	if failCode != lnwire.CodeNone {
+		t.Fatalf("unable to dry run decode: %v", failCode)
+	}
+
+	// Decoding the packet for real must still succeed, as the dry run
+	// didn't add the packet to the replay log. Submit it as a batch, so
+	// that a replay would be reported through the fail code.
+	resps, err := processor.DecodeHopIterators(
+		[]byte{1}, []DecodeHopIteratorRequest{{
+			OnionReader:  bytes.NewReader(onionBlob),
+			RHash:        rHash,
+			IncomingCltv: 100,
+		}},
+	)
+	if err != nil {
+		t.Fatalf("unable to decode batch: %v", err)
+	}
+	iterator, failCode := resps[0].Result()
+	if failCode != lnwire.CodeNone {
+		t.Fatalf("unable to decode after dry run: %v", failCode)
+	}
+
+	dryRunFwdInfo, err := dryRunIterator.ForwardingInstructions()
+	if err != nil {
+		t.Fatalf("unable to get dry run forwarding info: %v", err)
+	}
+	fwdInfo, err := iterator.ForwardingInstructions()
+	if err != nil {
+		t.Fatalf("unable to get forwarding info: %v", err)
+	}
+	if dryRunFwdInfo != fwdInfo {
+		t.Fatalf("forwarding info mismatch: expected %v, got %v",
+			spew.Sdump(fwdInfo), spew.Sdump(dryRunFwdInfo))
+	}
+}
+
+// TestDecodeHopIteratorsConcurrent asserts that decoding a batch with
+// multiple decode workers yields the same results as decoding it serially,
+// including for malformed packets and packets replayed within the batch.
+func TestDecodeHopIteratorsConcurrent(t *testing.T) {
+	t.Parallel()
+
+	nodeKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	const numPackets = 16
+	var (
+		onionBlobs [][]byte
+		rHash      []byte
+	)
+	for i := 0; i < numPackets; i++ {
+		var onionBlob []byte
+		onionBlob, rHash = newTestOnionPacket(t, nodeKey)
+		onionBlobs = append(onionBlobs, onionBlob)
+	}
+
+	// Replay the first packet at the end of the batch, and add a packet
+	// with an invalid version and a truncated one.
+	badVersion := append([]byte(nil), onionBlobs[1]...)
+	badVersion[0] = 0xff
+	onionBlobs = append(
+		onionBlobs, onionBlobs[0], badVersion, onionBlobs[2][:10],
+	)
+
+	newReqs := func() []DecodeHopIteratorRequest {
+		reqs := make([]DecodeHopIteratorRequest, len(onionBlobs))
+		for i, onionBlob := range onionBlobs {
+			reqs[i] = DecodeHopIteratorRequest{
+				OnionReader:  bytes.NewReader(onionBlob),
+				RHash:        rHash,
+				IncomingCltv: 100,
+			}
+		}
+		return reqs
+	}
+
+	// Each processor gets its own router, so that both start out with an
+	// empty replay log.
+	decode := func(numWorkers int) []DecodeHopIteratorResponse {
+		sphinxRouter := newTestRouter(t, nodeKey)
+		defer sphinxRouter.Stop()
+
+		processor := NewOnionProcessor(sphinxRouter)
+		processor.SetDecodeWorkers(numWorkers)
+
+		resps, err := processor.DecodeHopIterators([]byte{1}, newReqs())
+		if err != nil {
+			t.Fatalf("unable to decode batch: %v", err)
+		}
+		return resps
+	}
+
+	serialResps := decode(1)
+	concurrentResps := decode(4)
+
+	expectedFailCodes := map[int]lnwire.FailCode{
+		numPackets:     lnwire.CodeTemporaryChannelFailure,
+		numPackets + 1: lnwire.CodeInvalidOnionVersion,
+		numPackets + 2: lnwire.CodeInvalidOnionKey,
+	}
+	for i := range serialResps {
+		serialIterator, serialFailCode := serialResps[i].Result()
+		iterator, failCode := concurrentResps[i].Result()
+
+		if failCode != serialFailCode {
+			t.Fatalf("fail code mismatch for packet %v: serial %v, "+
+				"concurrent %v", i, serialFailCode, failCode)
+		}
+		if failCode != expectedFailCodes[i] {
+			t.Fatalf("expected fail code %v for packet %v, got %v",
+				expectedFailCodes[i], i, failCode)
+		}
+		if failCode != lnwire.CodeNone {
+			continue
+		}
+
+		serialFwdInfo, err := serialIterator.ForwardingInstructions()
+		if err != nil {
+			t.Fatalf("unable to get forwarding info: %v", err)
+		}
+		fwdInfo, err := iterator.ForwardingInstructions()
+		if err != nil {
+			t.Fatalf("unable to get forwarding info: %v", err)
+		}
+		if fwdInfo != serialFwdInfo {
+			t.Fatalf("forwarding info mismatch for packet %v: "+
+				"expected %v, got %v", i, spew.Sdump(serialFwdInfo),
+				spew.Sdump(fwdInfo))
+		}
+	}
+}
+
+// BenchmarkDecodeHopIterators benchmarks decoding a batch of onion packets
+// with a varying number of decode workers.
+func BenchmarkDecodeHopIterators(b *testing.B) {
+	const batchSize = 64
+
+	nodeKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		b.Fatalf("unable to generate key: %v", err)
+	}
+
+	onionBlobs := make([][]byte, batchSize)
+	var rHash []byte
+	for i := range onionBlobs {
+		onionBlobs[i], rHash = newTestOnionPacket(b, nodeKey)
+	}
+
+	for _, numWorkers := range []int{1, 2, 4, 8} {
+		numWorkers := numWorkers
+		b.Run(fmt.Sprintf("workers=%d", numWorkers), func(b *testing.B) {
+			sphinxRouter := newTestRouter(b, nodeKey)
+			defer sphinxRouter.Stop()
+
+			processor := NewOnionProcessor(sphinxRouter)
+			processor.SetDecodeWorkers(numWorkers)
+
+			// Packets are replays after the first batch, which
+			// still requires them to be fully processed.
+			reqs := make([]DecodeHopIteratorRequest, batchSize)
+			var id [8]byte
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j, onionBlob := range onionBlobs {
+					reqs[j] = DecodeHopIteratorRequest{
+						OnionReader:  bytes.NewReader(onionBlob),
+						RHash:        rHash,
+						IncomingCltv: 100,
+					}
+				}
+
+				binary.BigEndian.PutUint64(id[:], uint64(i))
+				_, err := processor.DecodeHopIterators(id[:], reqs)
+				if err != nil {
+					b.Fatalf("unable to decode batch: %v", err)
+				}
+			}
+		})
+	}
+}
+
+// mockReplayLogPruner is an in-memory replay log that maps entries to their
+// expiry height.
+type mockReplayLogPruner struct {
+	entries         map[int]uint32
+	retentionBlocks uint32
+}
+
+func (m *mockReplayLogPruner) SetRetention(retentionBlocks uint32) {
+	m.retentionBlocks = retentionBlocks
+}
+
+func (m *mockReplayLogPruner) GarbageCollect(height uint32) (uint32, error) {
+	var numPruned uint32
+	for id, expiry := range m.entries {
+		if expiry+m.retentionBlocks < height {
+			delete(m.entries, id)
+			numPruned++
+		}
+	}
+
+	return numPruned, nil
+}
+
+// TestOnionProcessorGarbageCollect asserts that the onion processor only
+// prunes replay log entries that have been expired for longer than the
+// configured retention.
+func TestOnionProcessorGarbageCollect(t *testing.T) {
+	t.Parallel()
+
+	const retentionBlocks = 10
+
+	replayLog := &mockReplayLogPruner{
+		entries: map[int]uint32{
+			0: 100,
+			1: 110,
+			2: 120,
+		},
+	}
+
+	p := NewOnionProcessor(nil)
+
+	// Without a replay log, garbage collection is a noop.
+	if err := p.GarbageCollect(1000); err != nil {
+		t.Fatalf("unable to garbage collect: %v", err)
+	}
+
+	p.SetReplayLogRetention(replayLog, retentionBlocks)
+
+	tests := []struct {
+		height    uint32
+		remaining []int
+	}{
+		// Below the retention, nothing is pruned.
+		{
+			height:    retentionBlocks,
+			remaining: []int{0, 1, 2},
+		},
+		// The first entry expired, but is still within the retention.
+		{
+			height:    110,
+			remaining: []int{0, 1, 2},
+		},
+		// The first entry is now past the retention.
+		{
+			height:    111,
+			remaining: []int{1, 2},
+		},
+		// The second entry is past the retention, the third one is
+		// right at its threshold.
+		{
+			height:    130,
+			remaining: []int{2},
+		},
+		{
+			height:    131,
+			remaining: nil,
+		},
+	}
+
+	for _, test := range tests {
+		if err := p.GarbageCollect(test.height); err != nil {
+			t.Fatalf("unable to garbage collect at height %v: %v",
+				test.height, err)
+		}
+
+		if len(replayLog.entries) != len(test.remaining) {
+			t.Fatalf("expected %v entries at height %v, got %v",
+				len(test.remaining), test.height,
+				len(replayLog.entries))
+		}
+		for _, id := range test.remaining {
+			if _, ok := replayLog.entries[id]; !ok {
+				t.Fatalf("entry %v pruned at height %v", id,
+					test.height)
+			}
+		}
+	}
+}
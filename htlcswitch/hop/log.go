@@ -10,7 +10,9 @@ import (
 var log slog.Logger
 
 // UseLogger uses a specified Logger to output package logging info. This
-// function is called from the parent package htlcswitch logger initialization.
+// function is called from the parent package htlcswitch logger initialization,
+// which passes a rate limited logger as the onion processing of this package
+// logs an error for every malformed packet.
 func UseLogger(logger slog.Logger) {
 	log = logger
 }
@@ -1049,6 +1049,19 @@ out:
 			newCommitFee := lnwallet.AtomPerKByte(
 				math.Min(float64(netFee), float64(maxFee)),
 			)
+
+			// We never propose a fee rate below the floor
+			// configured for the channel.
+			minFee, err := l.channel.MinCommitFeeRate()
+			if err != nil {
+				log.Errorf("unable to fetch min commit fee "+
+					"rate: %v", err)
+				continue
+			}
+			if newCommitFee < minFee {
+				newCommitFee = minFee
+			}
+
 			if !shouldAdjustCommitFee(newCommitFee, commitFee) {
 				continue
 			}
@@ -2255,6 +2268,33 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 	return nil
 }
 
+// invalidFwdInfoFailure returns the failure message for an incoming htlc whose
+// forwarding info was rejected. The latest routing policy of the outgoing
+// channel is included, as that's the policy the sender must correct the route
+// against.
+func (l *channelLink) invalidFwdInfoFailure(
+	fwdErr hop.ErrInvalidForwardingInfo,
+	pd *lnwallet.PaymentDescriptor) lnwire.FailureMessage {
+
+	// If we don't know of the outgoing channel, the htlc couldn't have
+	// been forwarded regardless.
+	update, err := l.cfg.FetchLastChannelUpdate(fwdErr.NextHop)
+	if err != nil {
+		return &lnwire.FailUnknownNextPeer{}
+	}
+
+	switch fwdErr.Code {
+	case lnwire.CodeAmountBelowMinimum:
+		return lnwire.NewAmountBelowMinimum(pd.Amount, *update)
+
+	case lnwire.CodeIncorrectCltvExpiry:
+		return lnwire.NewIncorrectCltvExpiry(pd.Timeout, *update)
+
+	default:
+		return &lnwire.FailTemporaryNodeFailure{}
+	}
+}
+
 // HtlcSatifiesPolicyLocal should return a nil error if the passed HTLC details
 // satisfy the current channel policy.  Otherwise, a valid protocol failure
 // message should be returned in order to signal the violation. This call is
@@ -2621,7 +2661,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				onionBlob[:], pd.SourceRef)
 			needUpdate = true
 
-			log.Errorf("unable to decode onion hop "+
+			onionLog.Errorf("unable to decode onion hop "+
 				"iterator: %v", failureCode)
 			continue
 		}
@@ -2640,7 +2680,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			)
 			needUpdate = true
 
-			log.Errorf("unable to decode onion "+
+			onionLog.Errorf("unable to decode onion "+
 				"obfuscator: %v", failureCode)
 			continue
 		}
@@ -2652,11 +2692,19 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion payload, or we
 			// we received malformed TLV stream, then we should
 			// send an error back to the caller so the HTLC can be
-			// canceled.
+			// canceled. If the payload could be decoded, but
+			// carries invalid forwarding info, we'll fail the HTLC
+			// with the failure matching the violation.
+			var failure lnwire.FailureMessage
+			if e, ok := err.(hop.ErrInvalidForwardingInfo); ok {
+				failure = l.invalidFwdInfoFailure(e, pd)
+			} else {
+				failure = lnwire.NewInvalidOnionVersion(
+					onionBlob[:],
+				)
+			}
 			l.sendHTLCError(
-				pd.HtlcIndex,
-				lnwire.NewInvalidOnionVersion(onionBlob[:]),
-				obfuscator, pd.SourceRef,
+				pd.HtlcIndex, failure, obfuscator, pd.SourceRef,
 			)
 			needUpdate = true
 
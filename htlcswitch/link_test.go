@@ -5484,6 +5484,73 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 	})
 }
 
+// TestInvalidFwdInfoFailure asserts that htlcs with invalid forwarding info
+// are failed with the latest update of the outgoing channel, rather than that
+// of the incoming one.
+func TestInvalidFwdInfoFailure(t *testing.T) {
+	t.Parallel()
+
+	incomingChanID := lnwire.NewShortChanIDFromInt(1)
+	outgoingChanID := lnwire.NewShortChanIDFromInt(2)
+	unknownChanID := lnwire.NewShortChanIDFromInt(3)
+
+	fetchLastChannelUpdate := func(chanID lnwire.ShortChannelID) (
+		*lnwire.ChannelUpdate, error) {
+
+		if chanID == unknownChanID {
+			return nil, fmt.Errorf("unknown channel %v", chanID)
+		}
+
+		return &lnwire.ChannelUpdate{ShortChannelID: chanID}, nil
+	}
+
+	link := channelLink{
+		cfg: ChannelLinkConfig{
+			FetchLastChannelUpdate: fetchLastChannelUpdate,
+		},
+		shortChanID: incomingChanID,
+	}
+	pd := &lnwallet.PaymentDescriptor{
+		Amount:  1000,
+		Timeout: 100,
+	}
+
+	failure := link.invalidFwdInfoFailure(hop.ErrInvalidForwardingInfo{
+		Code:    lnwire.CodeAmountBelowMinimum,
+		NextHop: outgoingChanID,
+	}, pd)
+	amtFailure, ok := failure.(*lnwire.FailAmountBelowMinimum)
+	if !ok {
+		t.Fatalf("expected FailAmountBelowMinimum, got %T", failure)
+	}
+	if amtFailure.Update.ShortChannelID != outgoingChanID {
+		t.Fatalf("expected update of channel %v, got %v",
+			outgoingChanID, amtFailure.Update.ShortChannelID)
+	}
+
+	failure = link.invalidFwdInfoFailure(hop.ErrInvalidForwardingInfo{
+		Code:    lnwire.CodeIncorrectCltvExpiry,
+		NextHop: outgoingChanID,
+	}, pd)
+	cltvFailure, ok := failure.(*lnwire.FailIncorrectCltvExpiry)
+	if !ok {
+		t.Fatalf("expected FailIncorrectCltvExpiry, got %T", failure)
+	}
+	if cltvFailure.Update.ShortChannelID != outgoingChanID {
+		t.Fatalf("expected update of channel %v, got %v",
+			outgoingChanID, cltvFailure.Update.ShortChannelID)
+	}
+
+	// If the outgoing channel is unknown, there's no update to include.
+	failure = link.invalidFwdInfoFailure(hop.ErrInvalidForwardingInfo{
+		Code:    lnwire.CodeAmountBelowMinimum,
+		NextHop: unknownChanID,
+	}, pd)
+	if _, ok := failure.(*lnwire.FailUnknownNextPeer); !ok {
+		t.Fatalf("expected FailUnknownNextPeer, got %T", failure)
+	}
+}
+
 // TestChannelLinkCanceledInvoice in this test checks the interaction
 // between Alice and Bob for a canceled invoice.
 func TestChannelLinkCanceledInvoice(t *testing.T) {
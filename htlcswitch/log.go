@@ -1,6 +1,8 @@
 package htlcswitch
 
 import (
+	"time"
+
 	"github.com/decred/dcrlnd/build"
 	"github.com/decred/dcrlnd/htlcswitch/hop"
 	"github.com/decred/slog"
@@ -11,6 +13,15 @@ import (
 // requests it.
 var log slog.Logger
 
+// onionLog is a rate limited version of log, used to log the errors of onion
+// processing. These are logged for every malformed packet, which a peer could
+// otherwise use to flood the log.
+var onionLog slog.Logger
+
+// onionLogRateLimitWindow is the window within which identical warnings and
+// errors logged through onionLog are collapsed.
+const onionLogRateLimitWindow = time.Minute
+
 // The default amount of logging is none.
 func init() {
 	logger := build.NewSubLogger("HSWC", nil)
@@ -29,7 +40,8 @@ func DisableLog() {
 // using slog.
 func UseLogger(logger slog.Logger) {
 	log = logger
-	hop.UseLogger(logger)
+	onionLog = build.NewRateLimitedLogger(logger, onionLogRateLimitWindow)
+	hop.UseLogger(onionLog)
 }
 
 // logClosure is used to provide a closure over expensive logging operations so
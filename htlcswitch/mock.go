@@ -280,6 +280,22 @@ func (r *mockHopIterator) ForwardingInstructions() (
 	return h, nil
 }
 
+func (r *mockHopIterator) AmtToForward() (lnwire.MilliAtom, error) {
+	if len(r.hops) == 0 {
+		return 0, errors.New("no hops left")
+	}
+
+	return r.hops[0].AmountToForward, nil
+}
+
+func (r *mockHopIterator) OutgoingCLTV() (uint32, error) {
+	if len(r.hops) == 0 {
+		return 0, errors.New("no hops left")
+	}
+
+	return r.hops[0].OutgoingCTLV, nil
+}
+
 func (r *mockHopIterator) ExtraOnionBlob() []byte {
 	return nil
 }
@@ -106,6 +106,18 @@ const (
 	// Total: 108 bytes
 	P2PKHSigScriptSize int64 = 1 + 73 + 1 + 33
 
+	// P2PKHUncompressedSigScriptSize is the worst case (largest) serialize
+	// size of a transaction input script that redeems an uncompressed
+	// P2PKH output. It is calculated as:
+	//
+	//      - OP_DATA_73                 1 byte
+	//      - signature+hash_type       73 bytes
+	//      - OP_DATA_65                 1 byte
+	//      - uncompressed pubkey       65 bytes
+	//
+	// Total: 140 bytes
+	P2PKHUncompressedSigScriptSize int64 = 1 + 73 + 1 + 65
+
 	// The following **RedeemScriptSize constants record sizes for LN-specific
 	// redeem scripts that are pushed to SigScripts when redeeming LN-specific
 	// P2SH outputs.
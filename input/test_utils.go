@@ -87,8 +87,14 @@ func (m *MockSigner) ComputeInputScript(tx *wire.MsgTx, signDesc *SignDescriptor
 
 	switch scriptType {
 	case txscript.PubKeyHashTy:
-		privKey := m.findKey(addresses[0].ScriptAddress(), signDesc.SingleTweak,
+		pkHash := addresses[0].ScriptAddress()
+		compress := true
+		privKey := m.findKey(pkHash, signDesc.SingleTweak,
 			signDesc.DoubleTweak)
+		if privKey == nil {
+			privKey = m.findUncompressedKey(pkHash)
+			compress = false
+		}
 		if privKey == nil {
 			return nil, fmt.Errorf("mock signer does not have key for "+
 				"address %v", addresses[0])
@@ -96,7 +102,7 @@ func (m *MockSigner) ComputeInputScript(tx *wire.MsgTx, signDesc *SignDescriptor
 
 		sigScript, err := txscript.SignatureScript(
 			tx, signDesc.InputIndex, signDesc.Output.PkScript,
-			txscript.SigHashAll, privKey, true,
+			txscript.SigHashAll, privKey, compress,
 		)
 		if err != nil {
 			return nil, err
@@ -140,6 +146,20 @@ func (m *MockSigner) findKey(needleHash160 []byte, singleTweak []byte,
 	return nil
 }
 
+// findUncompressedKey searches through all stored private keys and returns
+// the one whose uncompressed public key hashes to needleHash160, if any.
+func (m *MockSigner) findUncompressedKey(
+	needleHash160 []byte) *secp256k1.PrivateKey {
+
+	for _, privkey := range m.Privkeys {
+		pubKey := privkey.PubKey().SerializeUncompressed()
+		if bytes.Equal(dcrutil.Hash160(pubKey), needleHash160) {
+			return privkey
+		}
+	}
+	return nil
+}
+
 // pubkeyFromHex parses a Decred public key from a hex encoded string.
 func pubkeyFromHex(keyHex string) (*secp256k1.PublicKey, error) {
 	bytes, err := hex.DecodeString(keyHex)
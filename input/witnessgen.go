@@ -89,6 +89,15 @@ const (
 	// future new types added to the upstream lnd project.
 	PublicKeyHash WitnessType = 901
 
+	// PublicKeyHashUncompressed is a witness type that allows us to sweep
+	// an output that sends to a standard p2pkh script that pays to the
+	// hash of an uncompressed key solely under the control of the backing
+	// wallet.
+	//
+	// NODE(decred): The value was chosen so that it won't conflict with
+	// future new types added to the upstream lnd project.
+	PublicKeyHashUncompressed WitnessType = 902
+
 	// CommitSpendNoDelayTweakless is similar to the CommitSpendNoDelay
 	// type, but it omits the tweak that randomizes the key we need to
 	// spend with a channel peer supplied set of randomness.
@@ -131,6 +140,12 @@ func (wt WitnessType) String() string {
 	case HtlcSecondLevelRevoke:
 		return "HtlcSecondLevelRevoke"
 
+	case PublicKeyHash:
+		return "PublicKeyHash"
+
+	case PublicKeyHashUncompressed:
+		return "PublicKeyHashUncompressed"
+
 	default:
 		return fmt.Sprintf("Unknown WitnessType: %v", uint32(wt))
 	}
@@ -263,6 +278,9 @@ func (wt WitnessType) GenWitnessFunc(signer Signer,
 			return signer.ComputeInputScript(tx, desc)
 
 		case PublicKeyHash:
+			fallthrough
+
+		case PublicKeyHashUncompressed:
 			return signer.ComputeInputScript(tx, desc)
 
 		default:
@@ -44,6 +44,32 @@ type HodlEvent struct {
 	AcceptHeight int32
 }
 
+// HtlcAcceptedEvent describes the progress of a payment to an invoice. It is
+// sent out each time a new htlc is accepted to an invoice without settling it.
+type HtlcAcceptedEvent struct {
+	// Hash is the payment hash of the invoice.
+	Hash lntypes.Hash
+
+	// CircuitKey is the key of the htlc that was accepted.
+	CircuitKey channeldb.CircuitKey
+
+	// HtlcAmt is the amount of the htlc that was accepted.
+	HtlcAmt lnwire.MilliAtom
+
+	// AmtPaid is the total amount paid to the invoice so far, including
+	// the accepted htlc.
+	AmtPaid lnwire.MilliAtom
+
+	// AmtRemaining is the amount that still needs to be paid before the
+	// full invoice value is covered. It is zero for invoices without a
+	// value and for invoices that are already paid in full.
+	AmtRemaining lnwire.MilliAtom
+}
+
+// SettleCallback is a callback that is invoked with the invoice it was
+// registered for once that invoice is settled.
+type SettleCallback func(invoice channeldb.Invoice)
+
 // InvoiceRegistry is a central registry of all the outstanding invoices
 // created by the daemon. The registry is a thin wrapper around a map in order
 // to ensure that all updates/reads are thread safe.
@@ -56,12 +82,14 @@ type InvoiceRegistry struct {
 	nextClientID              uint32
 	notificationClients       map[uint32]*InvoiceSubscription
 	singleNotificationClients map[uint32]*SingleInvoiceSubscription
+	htlcAcceptedClients       map[uint32]*HtlcAcceptedSubscription
 
 	newSubscriptions    chan *InvoiceSubscription
 	subscriptionCancels chan uint32
 
-	// invoiceEvents is a single channel over which both invoice updates and
-	// new single invoice subscriptions are carried.
+	// invoiceEvents is a single channel over which invoice updates, htlc
+	// accepted events and new single invoice and htlc accepted
+	// subscriptions are carried.
 	invoiceEvents chan interface{}
 
 	// subscriptions is a map from a circuit key to a list of subscribers.
@@ -72,6 +100,12 @@ type InvoiceRegistry struct {
 	// subscriber. This is used to unsubscribe from all hashes efficiently.
 	hodlReverseSubscriptions map[chan<- interface{}]map[channeldb.CircuitKey]struct{}
 
+	// settleCallbacks is a map from a payment hash to the callbacks that
+	// are to be invoked once the invoice with that hash settles. It is
+	// guarded by the registry mutex.
+	settleCallbacks      map[lntypes.Hash]map[uint32]SettleCallback
+	nextSettleCallbackID uint32
+
 	// finalCltvRejectDelta defines the number of blocks before the expiry
 	// of the htlc where we no longer settle it as an exit hop and instead
 	// cancel it back. Normally this value should be lower than the cltv
@@ -93,11 +127,13 @@ func NewRegistry(cdb *channeldb.DB, finalCltvRejectDelta int32) *InvoiceRegistry
 		cdb:                       cdb,
 		notificationClients:       make(map[uint32]*InvoiceSubscription),
 		singleNotificationClients: make(map[uint32]*SingleInvoiceSubscription),
+		htlcAcceptedClients:       make(map[uint32]*HtlcAcceptedSubscription),
 		newSubscriptions:          make(chan *InvoiceSubscription),
 		subscriptionCancels:       make(chan uint32),
 		invoiceEvents:             make(chan interface{}, 100),
 		hodlSubscriptions:         make(map[channeldb.CircuitKey]map[chan<- interface{}]struct{}),
 		hodlReverseSubscriptions:  make(map[chan<- interface{}]map[channeldb.CircuitKey]struct{}),
+		settleCallbacks:           make(map[lntypes.Hash]map[uint32]SettleCallback),
 		finalCltvRejectDelta:      finalCltvRejectDelta,
 		quit:                      make(chan struct{}),
 	}
@@ -164,6 +200,7 @@ func (i *InvoiceRegistry) invoiceEventNotifier() {
 
 			delete(i.notificationClients, clientID)
 			delete(i.singleNotificationClients, clientID)
+			delete(i.htlcAcceptedClients, clientID)
 
 		// An invoice event has come in. This can either be an update to
 		// an invoice or a new single invoice subscriber. Both type of
@@ -197,6 +234,20 @@ func (i *InvoiceRegistry) invoiceEventNotifier() {
 					"client: id=%v, hash=%v", e.id, e.hash)
 
 				i.singleNotificationClients[e.id] = e
+
+			// An htlc was accepted to an invoice without settling
+			// it. Dispatch the progress to the subscribed clients.
+			case *HtlcAcceptedEvent:
+				i.dispatchToHtlcAcceptedClients(e)
+
+			// A new htlc accepted subscription has arrived. Add it
+			// to the set of clients in sequence with any other
+			// invoice events.
+			case *HtlcAcceptedSubscription:
+				log.Infof("New htlc accepted subscription "+
+					"client: id=%v, hash=%v", e.id, e.hash)
+
+				i.htlcAcceptedClients[e.id] = e
 			}
 
 		case <-i.quit:
@@ -218,6 +269,20 @@ func (i *InvoiceRegistry) dispatchToSingleClients(event *invoiceEvent) {
 	}
 }
 
+// dispatchToHtlcAcceptedClients passes the supplied event to all htlc accepted
+// clients that subscribed to the invoice this event applies to.
+func (i *InvoiceRegistry) dispatchToHtlcAcceptedClients(
+	event *HtlcAcceptedEvent) {
+
+	for _, client := range i.htlcAcceptedClients {
+		if client.hash != event.Hash {
+			continue
+		}
+
+		client.notify(event)
+	}
+}
+
 // dispatchToClients passes the supplied event to all notification clients that
 // subscribed to all invoices. Add and settle indices are used to make sure that
 // clients don't receive duplicate or unwanted events.
@@ -539,14 +604,34 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	// one exists). The callback will set the resolution action that is
 	// returned to the link or contract resolver.
 	invoice, err := i.cdb.UpdateInvoice(rHash, updateInvoice)
-	if err != nil && err != errNoUpdate {
+	switch {
+	// If the invoice can't hold any more htlcs, this htlc isn't recorded
+	// and will be canceled back below.
+	case err == channeldb.ErrInvoiceHtlcLimit:
+		debugLog("htlc limit reached")
+		updateSubscribers = false
+
+	case err != nil && err != errNoUpdate:
 		debugLog(err.Error())
 
 		return nil, err
 	}
 
+	// If the htlc was newly accepted to the invoice without settling it,
+	// let the subscribers know about the progress of the payment.
+	if err == nil {
+		htlc, ok := invoice.Htlcs[circuitKey]
+		if ok && htlc.State == channeldb.HtlcStateAccepted {
+			i.notifyHtlcAccepted(rHash, circuitKey, invoice)
+		}
+	}
+
 	if updateSubscribers {
 		i.notifyClients(rHash, invoice, invoice.Terms.State)
+
+		if invoice.Terms.State == channeldb.ContractSettled {
+			i.invokeSettleCallbacks(rHash, invoice)
+		}
 	}
 
 	// Inspect latest htlc state on the invoice.
@@ -640,6 +725,7 @@ func (i *InvoiceRegistry) SettleHodlInvoice(preimage lntypes.Preimage) error {
 		})
 	}
 	i.notifyClients(hash, invoice, invoice.Terms.State)
+	i.invokeSettleCallbacks(hash, invoice)
 
 	return nil
 }
@@ -722,9 +808,90 @@ func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
 	}
 	i.notifyClients(payHash, invoice, channeldb.ContractCanceled)
 
+	// A canceled invoice will never settle, so there is no point in
+	// holding on to its settle callbacks.
+	delete(i.settleCallbacks, payHash)
+
 	return nil
 }
 
+// RegisterSettleCallback registers a callback that is invoked with the settled
+// invoice once the invoice with the given payment hash settles. The callback
+// is invoked synchronously as part of the settle flow, after the update has
+// been committed to the database, but while the registry is still locked. It
+// should therefore return quickly and must not call back into the registry.
+// If the invoice is already settled, the callback is invoked right away. The
+// returned function unregisters the callback if it hasn't been invoked yet.
+func (i *InvoiceRegistry) RegisterSettleCallback(payHash lntypes.Hash,
+	callback SettleCallback) (func(), error) {
+
+	i.Lock()
+	defer i.Unlock()
+
+	invoice, err := i.cdb.LookupInvoice(payHash)
+	if err != nil {
+		return nil, err
+	}
+
+	switch invoice.Terms.State {
+	case channeldb.ContractSettled:
+		callback(invoice)
+		return func() {}, nil
+
+	case channeldb.ContractCanceled:
+		return nil, channeldb.ErrInvoiceAlreadyCanceled
+	}
+
+	id := i.nextSettleCallbackID
+	i.nextSettleCallbackID++
+
+	callbacks, ok := i.settleCallbacks[payHash]
+	if !ok {
+		callbacks = make(map[uint32]SettleCallback)
+		i.settleCallbacks[payHash] = callbacks
+	}
+	callbacks[id] = callback
+
+	log.Debugf("Invoice(%v): registered settle callback %v", payHash, id)
+
+	cancel := func() {
+		i.Lock()
+		defer i.Unlock()
+
+		callbacks, ok := i.settleCallbacks[payHash]
+		if !ok {
+			return
+		}
+
+		delete(callbacks, id)
+		if len(callbacks) == 0 {
+			delete(i.settleCallbacks, payHash)
+		}
+	}
+
+	return cancel, nil
+}
+
+// invokeSettleCallbacks invokes and then removes all settle callbacks that were
+// registered for the given, freshly settled invoice. The caller must hold the
+// registry lock.
+func (i *InvoiceRegistry) invokeSettleCallbacks(hash lntypes.Hash,
+	invoice *channeldb.Invoice) {
+
+	callbacks, ok := i.settleCallbacks[hash]
+	if !ok {
+		return
+	}
+	delete(i.settleCallbacks, hash)
+
+	log.Debugf("Invoice(%v): invoking %v settle callback(s)", hash,
+		len(callbacks))
+
+	for _, callback := range callbacks {
+		callback(*invoice)
+	}
+}
+
 // notifyClients notifies all currently registered invoice notification clients
 // of a newly added/settled invoice.
 func (i *InvoiceRegistry) notifyClients(hash lntypes.Hash,
@@ -742,6 +909,30 @@ func (i *InvoiceRegistry) notifyClients(hash lntypes.Hash,
 	}
 }
 
+// notifyHtlcAccepted notifies all htlc accepted clients subscribed to the
+// invoice of the acceptance of the htlc with the given circuit key.
+func (i *InvoiceRegistry) notifyHtlcAccepted(hash lntypes.Hash,
+	circuitKey channeldb.CircuitKey, invoice *channeldb.Invoice) {
+
+	var amtRemaining lnwire.MilliAtom
+	if invoice.Terms.Value > invoice.AmtPaid {
+		amtRemaining = invoice.Terms.Value - invoice.AmtPaid
+	}
+
+	event := &HtlcAcceptedEvent{
+		Hash:         hash,
+		CircuitKey:   circuitKey,
+		HtlcAmt:      invoice.Htlcs[circuitKey].Amt,
+		AmtPaid:      invoice.AmtPaid,
+		AmtRemaining: amtRemaining,
+	}
+
+	select {
+	case i.invoiceEvents <- event:
+	case <-i.quit:
+	}
+}
+
 // invoiceSubscriptionKit defines that are common to both all invoice
 // subscribers and single invoice subscribers.
 type invoiceSubscriptionKit struct {
@@ -798,6 +989,18 @@ type SingleInvoiceSubscription struct {
 	Updates chan *channeldb.Invoice
 }
 
+// HtlcAcceptedSubscription represents an intent to receive progress updates
+// for the payment of a specific invoice.
+type HtlcAcceptedSubscription struct {
+	invoiceSubscriptionKit
+
+	hash lntypes.Hash
+
+	// HtlcAccepted is a channel that we'll use to send an event each time
+	// an htlc is accepted to the invoice without settling it.
+	HtlcAccepted chan *HtlcAcceptedEvent
+}
+
 // Cancel unregisters the InvoiceSubscription, freeing any previously allocated
 // resources.
 func (i *invoiceSubscriptionKit) Cancel() {
@@ -816,7 +1019,7 @@ func (i *invoiceSubscriptionKit) Cancel() {
 	i.wg.Wait()
 }
 
-func (i *invoiceSubscriptionKit) notify(event *invoiceEvent) error {
+func (i *invoiceSubscriptionKit) notify(event interface{}) error {
 	select {
 	case i.ntfnQueue.ChanIn() <- event:
 	case <-i.inv.quit:
@@ -984,6 +1187,70 @@ func (i *InvoiceRegistry) SubscribeSingleInvoice(
 	return client, nil
 }
 
+// SubscribeHtlcAccepted returns an HtlcAcceptedSubscription which allows the
+// caller to receive async notifications of htlcs that are accepted to a
+// specific invoice without settling it, along with the amount paid so far.
+func (i *InvoiceRegistry) SubscribeHtlcAccepted(
+	hash lntypes.Hash) *HtlcAcceptedSubscription {
+
+	client := &HtlcAcceptedSubscription{
+		HtlcAccepted: make(chan *HtlcAcceptedEvent),
+		invoiceSubscriptionKit: invoiceSubscriptionKit{
+			inv:        i,
+			ntfnQueue:  queue.NewConcurrentQueue(20),
+			cancelChan: make(chan struct{}),
+		},
+		hash: hash,
+	}
+	client.ntfnQueue.Start()
+
+	i.clientMtx.Lock()
+	client.id = i.nextClientID
+	i.nextClientID++
+	i.clientMtx.Unlock()
+
+	// Before we register this new subscription, we'll launch a new
+	// goroutine that will proxy all notifications appended to the end of
+	// the concurrent queue to the client-side channel the caller will feed
+	// off of.
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+
+		for {
+			select {
+			case ntfn := <-client.ntfnQueue.ChanOut():
+				event := ntfn.(*HtlcAcceptedEvent)
+
+				select {
+				case client.HtlcAccepted <- event:
+
+				case <-client.cancelChan:
+					return
+
+				case <-i.quit:
+					return
+				}
+
+			case <-client.cancelChan:
+				return
+
+			case <-i.quit:
+				return
+			}
+		}
+	}()
+
+	// The subscription is passed through the invoiceEvents channel, so
+	// that it is registered in sequence with any other invoice events.
+	select {
+	case i.invoiceEvents <- client:
+	case <-i.quit:
+	}
+
+	return client
+}
+
 // notifyHodlSubscribers sends out the hodl event to all current subscribers.
 func (i *InvoiceRegistry) notifyHodlSubscribers(hodlEvent HodlEvent) {
 	subscribers, ok := i.hodlSubscriptions[hodlEvent.CircuitKey]
@@ -654,3 +654,193 @@ func TestUnknownInvoice(t *testing.T) {
 		t.Fatal("expected invoice not found error")
 	}
 }
+
+// TestSettleCallback tests that a registered settle callback is invoked with the
+// settled invoice, and that unregistered callbacks are not invoked.
+func TestSettleCallback(t *testing.T) {
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	// Registering a callback for an unknown invoice should fail.
+	_, err := registry.RegisterSettleCallback(
+		hash, func(channeldb.Invoice) {},
+	)
+	if err != channeldb.ErrInvoiceNotFound {
+		t.Fatalf("expected invoice not found error, got %v", err)
+	}
+
+	_, err = registry.AddInvoice(testInvoice, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var settled []channeldb.Invoice
+	_, err = registry.RegisterSettleCallback(
+		hash, func(invoice channeldb.Invoice) {
+			settled = append(settled, invoice)
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Register a second callback that is unregistered before the invoice
+	// settles.
+	cancel, err := registry.RegisterSettleCallback(
+		hash, func(channeldb.Invoice) {
+			t.Fatal("unexpected invocation of canceled callback")
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	// Settle the invoice. The callback is invoked synchronously, so it
+	// must have been invoked once the htlc is resolved.
+	hodlChan := make(chan interface{}, 1)
+	amtPaid := lnwire.MilliAtom(100500)
+	event, err := registry.NotifyExitHopHtlc(
+		hash, amtPaid, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(0), hodlChan, nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Preimage == nil {
+		t.Fatal("expected settle event")
+	}
+
+	if len(settled) != 1 {
+		t.Fatalf("expected callback to be invoked once, got %v",
+			len(settled))
+	}
+	if settled[0].Terms.State != channeldb.ContractSettled {
+		t.Fatalf("expected state ContractSettled, but got %v",
+			settled[0].Terms.State)
+	}
+	if settled[0].AmtPaid != amtPaid {
+		t.Fatalf("expected amount paid %v, got %v", amtPaid,
+			settled[0].AmtPaid)
+	}
+	if settled[0].Terms.PaymentPreimage != preimage {
+		t.Fatal("invoice preimage incorrect")
+	}
+
+	// A replayed htlc doesn't settle the invoice again, so the callback
+	// must not be invoked a second time.
+	_, err = registry.NotifyExitHopHtlc(
+		hash, amtPaid, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(0), hodlChan, nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(settled) != 1 {
+		t.Fatalf("expected callback to be invoked once, got %v",
+			len(settled))
+	}
+
+	// Registering a callback for an already settled invoice invokes it
+	// right away.
+	_, err = registry.RegisterSettleCallback(
+		hash, func(invoice channeldb.Invoice) {
+			settled = append(settled, invoice)
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(settled) != 2 {
+		t.Fatalf("expected callback to be invoked right away")
+	}
+}
+
+// TestHtlcAcceptedEvents tests that an event with the running total paid to an
+// invoice is sent out each time an htlc is accepted without settling it.
+func TestHtlcAcceptedEvents(t *testing.T) {
+	defer timeout(t)()
+
+	registry, cleanup := newTestContext(t)
+	defer cleanup()
+
+	subscription := registry.SubscribeHtlcAccepted(hash)
+	defer subscription.Cancel()
+
+	// Add a hold invoice, so that htlcs are accepted without settling the
+	// invoice.
+	invoice := &channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: channeldb.UnknownPreimage,
+			Value:           lnwire.MilliAtom(100000),
+		},
+	}
+	_, err := registry.AddInvoice(invoice, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Send htlcs to the invoice one by one and assert that each of them
+	// is reported with the correct running total.
+	hodlChan := make(chan interface{}, 1)
+	htlcAmts := []lnwire.MilliAtom{100000, 100500, 120000}
+
+	var amtPaid lnwire.MilliAtom
+	for htlcID, htlcAmt := range htlcAmts {
+		circuitKey := getCircuitKey(uint64(htlcID))
+		event, err := registry.NotifyExitHopHtlc(
+			hash, htlcAmt, testHtlcExpiry, testCurrentHeight,
+			circuitKey, hodlChan, nil,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if event != nil {
+			t.Fatal("expected htlc to be held")
+		}
+		amtPaid += htlcAmt
+
+		update := <-subscription.HtlcAccepted
+		if update.Hash != hash {
+			t.Fatalf("expected event for hash %v, got %v", hash,
+				update.Hash)
+		}
+		if update.CircuitKey != circuitKey {
+			t.Fatalf("expected event for htlc %v, got %v",
+				circuitKey, update.CircuitKey)
+		}
+		if update.HtlcAmt != htlcAmt {
+			t.Fatalf("expected htlc amount %v, got %v", htlcAmt,
+				update.HtlcAmt)
+		}
+		if update.AmtPaid != amtPaid {
+			t.Fatalf("expected amount paid %v, got %v", amtPaid,
+				update.AmtPaid)
+		}
+		if update.AmtRemaining != 0 {
+			t.Fatalf("expected no remaining amount, got %v",
+				update.AmtRemaining)
+		}
+	}
+
+	// A replayed htlc isn't accepted again, so no event is expected.
+	_, err = registry.NotifyExitHopHtlc(
+		hash, htlcAmts[0], testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(0), hodlChan, nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Settling the invoice doesn't produce an htlc accepted event either.
+	err = registry.SettleHodlInvoice(preimage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case update := <-subscription.HtlcAccepted:
+		t.Fatalf("unexpected htlc accepted event: %v", update.CircuitKey)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -92,6 +92,18 @@ var (
 		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
 		tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
 	}
+
+	// knownTLSCipherSuites maps the names of the TLS 1.2 cipher suites that
+	// may be configured to their ids. Only AEAD suites with forward
+	// secrecy are included, so weak suites can't be enabled by accident.
+	knownTLSCipherSuites = map[string]uint16{
+		"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256":   tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256": tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384":   tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384": tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		"TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305":    tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+		"TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305":  tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+	}
 )
 
 // ListenerCfg is a wrapper around custom listeners that can be passed to lnd
@@ -166,6 +178,21 @@ func Main(lisCfg ListenerCfg) error {
 		}()
 	}
 
+	// Enable the http readiness probe if requested. It will report the
+	// node as not ready until the chain backend is synced and the server
+	// has been started.
+	var readiness *readinessServer
+	if cfg.ReadinessListen != "" {
+		readiness = newReadinessServer(cfg.ReadinessListen)
+		if err := readiness.Start(); err != nil {
+			err := fmt.Errorf("Unable to start readiness server: %v",
+				err)
+			ltndLog.Error(err)
+			return err
+		}
+		defer readiness.Stop()
+	}
+
 	// Write cpu profile if requested.
 	if cfg.CPUProfile != "" {
 		f, err := os.Create(cfg.CPUProfile)
@@ -207,7 +234,8 @@ func Main(lisCfg ListenerCfg) error {
 
 	tlsCfg, restCreds, restProxyDest, err := getTLSConfig(
 		cfg.TLSCertPath, cfg.TLSKeyPath, cfg.TLSExtraIPs,
-		cfg.TLSExtraDomains, cfg.RPCListeners,
+		cfg.TLSExtraDomains, cfg.RPCListeners, cfg.tlsCipherSuites,
+		cfg.tlsMinVersion,
 	)
 	if err != nil {
 		err := fmt.Errorf("Unable to load TLS credentials: %v", err)
@@ -319,6 +347,7 @@ func Main(lisCfg ListenerCfg) error {
 		// Create the macaroon authentication/authorization service.
 		macaroonService, err = macaroons.NewService(
 			networkDir, macaroons.IPLockChecker,
+			macaroons.IPRangeChecker,
 		)
 		if err != nil {
 			err := fmt.Errorf("Unable to set up macaroon "+
@@ -397,6 +426,9 @@ func Main(lisCfg ListenerCfg) error {
 
 	ltndLog.Infof("Chain backend is fully synced (end_height=%v)!",
 		bestHeight)
+	if readiness != nil {
+		readiness.SetSynced()
+	}
 
 	// Finally before we start the server, we'll register the "holy
 	// trinity" of interface for our current "home chain" with the active
@@ -588,6 +620,9 @@ func Main(lisCfg ListenerCfg) error {
 		return err
 	}
 	defer server.Stop()
+	if readiness != nil {
+		readiness.SetStarted()
+	}
 
 	// Now that the server has started, if the autopilot mode is currently
 	// active, then we'll start the autopilot agent immediately. It will be
@@ -616,12 +651,56 @@ func Main(lisCfg ListenerCfg) error {
 	return nil
 }
 
+// parseTLSMinVersion parses the minimum TLS version from its string
+// representation. Only TLS 1.2 and 1.3 are supported.
+func parseTLSMinVersion(version string) (uint16, error) {
+	switch version {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("unsupported TLS version %q, must be "+
+			"1.2 or 1.3", version)
+	}
+}
+
+// parseTLSCipherSuites parses a list of cipher suite names into their ids. An
+// empty list results in the default cipher suites.
+func parseTLSCipherSuites(names []string) ([]uint16, error) {
+	if len(names) == 0 {
+		return tlsCipherSuites, nil
+	}
+
+	suites := make([]uint16, 0, len(names))
+	for _, name := range names {
+		suite, ok := knownTLSCipherSuites[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown or insecure TLS "+
+				"cipher suite %q", name)
+		}
+		suites = append(suites, suite)
+	}
+
+	return suites, nil
+}
+
 // getTLSConfig returns a TLS configuration for the gRPC server and credentials
-// and a proxy destination for the REST reverse proxy.
+// and a proxy destination for the REST reverse proxy. If no cipher suites are
+// given, the default suites are used. A zero minimum version defaults to TLS
+// 1.2.
 func getTLSConfig(tlsCertPath string, tlsKeyPath string, tlsExtraIPs,
-	tlsExtraDomains []string, rpcListeners []net.Addr) (*tls.Config,
+	tlsExtraDomains []string, rpcListeners []net.Addr,
+	cipherSuites []uint16, minVersion uint16) (*tls.Config,
 	*credentials.TransportCredentials, string, error) {
 
+	if len(cipherSuites) == 0 {
+		cipherSuites = tlsCipherSuites
+	}
+	if minVersion == 0 {
+		minVersion = tls.VersionTLS12
+	}
+
 	// Ensure we create TLS key and certificate if they don't exist
 	if !fileExists(tlsCertPath) && !fileExists(tlsKeyPath) {
 		err := genCertPair(
@@ -642,10 +721,19 @@ func getTLSConfig(tlsCertPath string, tlsKeyPath string, tlsExtraIPs,
 		return nil, nil, "", err
 	}
 
-	// If the certificate expired, delete it and the TLS key and generate a
-	// new pair
-	if time.Now().After(cert.NotAfter) {
-		ltndLog.Info("TLS certificate is expired, generating a new one")
+	// If the certificate expired, or it no longer covers all of the extra
+	// IPs and domains we were configured with, delete it and the TLS key
+	// and generate a new pair.
+	expired := time.Now().After(cert.NotAfter)
+	missingSANs := !certCoversSANs(cert, tlsExtraIPs, tlsExtraDomains)
+	if expired || missingSANs {
+		if expired {
+			ltndLog.Info("TLS certificate is expired, generating a " +
+				"new one")
+		} else {
+			ltndLog.Info("TLS certificate is missing configured " +
+				"extra IPs or domains, generating a new one")
+		}
 
 		err := os.Remove(tlsCertPath)
 		if err != nil {
@@ -663,12 +751,19 @@ func getTLSConfig(tlsCertPath string, tlsKeyPath string, tlsExtraIPs,
 		if err != nil {
 			return nil, nil, "", err
 		}
+
+		// Reload the freshly generated pair so we don't serve the
+		// stale certificate.
+		certData, err = tls.LoadX509KeyPair(tlsCertPath, tlsKeyPath)
+		if err != nil {
+			return nil, nil, "", err
+		}
 	}
 
 	tlsCfg := &tls.Config{
 		Certificates: []tls.Certificate{certData},
-		CipherSuites: tlsCipherSuites,
-		MinVersion:   tls.VersionTLS12,
+		CipherSuites: cipherSuites,
+		MinVersion:   minVersion,
 	}
 
 	restCreds, err := credentials.NewClientTLSFromFile(tlsCertPath, "")
@@ -703,6 +798,51 @@ func fileExists(name string) bool {
 	return true
 }
 
+// certCoversSANs returns true if every valid IP in tlsExtraIPs and every
+// domain in tlsExtraDomains is present in the certificate's subject
+// alternative names. Entries the certificate carries beyond the configured
+// ones (loopback, interface addresses, the hostname) are not considered a
+// difference, so the pair is only regenerated when a configured entry would
+// otherwise fail client verification.
+func certCoversSANs(cert *x509.Certificate, tlsExtraIPs,
+	tlsExtraDomains []string) bool {
+
+	for _, ip := range tlsExtraIPs {
+		ipAddr := net.ParseIP(ip)
+		if ipAddr == nil {
+			// genCertPair skips unparseable IPs, so they can never
+			// be part of the certificate.
+			continue
+		}
+
+		found := false
+		for _, certIP := range cert.IPAddresses {
+			if certIP.Equal(ipAddr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for _, domain := range tlsExtraDomains {
+		found := false
+		for _, certDomain := range cert.DNSNames {
+			if certDomain == domain {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
 // genCertPair generates a key/cert pair to the paths provided. The
 // auto-generated certificates should *not* be used in production for public
 // access as they're self-signed and don't necessarily contain all of the
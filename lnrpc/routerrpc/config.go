@@ -6,6 +6,16 @@ import (
 	"github.com/decred/dcrd/dcrutil/v2"
 )
 
+const (
+	// DefaultMaxRouteHints is the default maximum number of route hints a
+	// payment may carry.
+	DefaultMaxRouteHints = 100
+
+	// DefaultMaxRouteHintHops is the default maximum number of hops a
+	// single route hint may contain.
+	DefaultMaxRouteHintHops = 20
+)
+
 // RoutingConfig contains the configurable parameters that control routing.
 type RoutingConfig struct {
 	// MinRouteProbability is the minimum required route success
@@ -25,7 +35,28 @@ type RoutingConfig struct {
 	// potentially better routes against their probability of succeeding.
 	AttemptCost dcrutil.Amount `long:"attemptcost" description:"The (virtual) cost in sats of a failed payment attempt"`
 
+	// AttemptCostPPM is the proportional virtual cost of a failed payment
+	// attempt, in parts per million of the payment amount. It is added to
+	// AttemptCost when ranking routes by their expected cost.
+	AttemptCostPPM int64 `long:"attemptcostppm" description:"The proportional (virtual) cost in parts per million of the amount of a failed payment attempt, used when ranking routes by expected cost"`
+
 	// MaxMcHistory defines the maximum number of payment results that
 	// are held on disk by mission control.
 	MaxMcHistory int `long:"maxmchistory" description:"the maximum number of payment results that are held on disk by mission control"`
+
+	// MaxRouteHints is the maximum number of route hints a payment may
+	// carry, including those encoded in its payment request.
+	MaxRouteHints int `long:"maxroutehints" description:"The maximum number of route hints a payment may carry, including those of its payment request. 0 disables the limit"`
+
+	// MaxRouteHintHops is the maximum number of hops a single route hint
+	// may contain.
+	MaxRouteHintHops int `long:"maxroutehinthops" description:"The maximum number of hops a single route hint may contain. 0 disables the limit"`
+
+	// AllowCircularRoute indicates whether manually crafted routes that
+	// pay back to ourselves are accepted.
+	AllowCircularRoute bool `long:"allowcircularroute" description:"Allow routes passed to SendToRoute to end at our own node, as used for circular rebalances"`
+
+	// DefaultFinalCltvDelta is the final CLTV delta used for route
+	// queries and payments that don't specify one.
+	DefaultFinalCltvDelta uint16 `long:"defaultfinalcltvdelta" description:"The final CLTV delta to use for route queries and payments to a destination that don't specify one. It must not exceed max-cltv-expiry. 0 uses the payment request default"`
 }
@@ -49,7 +49,9 @@ func DefaultConfig() *Config {
 		PenaltyHalfLife:       routing.DefaultPenaltyHalfLife,
 		AttemptCost: routing.DefaultPaymentAttemptPenalty.
 			ToAtoms(),
-		MaxMcHistory: routing.DefaultMaxMcHistory,
+		MaxMcHistory:     routing.DefaultMaxMcHistory,
+		MaxRouteHints:    DefaultMaxRouteHints,
+		MaxRouteHintHops: DefaultMaxRouteHintHops,
 	}
 
 	return &Config{
@@ -63,7 +65,12 @@ func GetRoutingConfig(cfg *Config) *RoutingConfig {
 		AprioriHopProbability: cfg.AprioriHopProbability,
 		MinRouteProbability:   cfg.MinRouteProbability,
 		AttemptCost:           cfg.AttemptCost,
+		AttemptCostPPM:        cfg.AttemptCostPPM,
 		PenaltyHalfLife:       cfg.PenaltyHalfLife,
 		MaxMcHistory:          cfg.MaxMcHistory,
+		MaxRouteHints:         cfg.MaxRouteHints,
+		MaxRouteHintHops:      cfg.MaxRouteHintHops,
+		AllowCircularRoute:    cfg.AllowCircularRoute,
+		DefaultFinalCltvDelta: cfg.DefaultFinalCltvDelta,
 	}
 }
@@ -21,7 +21,9 @@ func GetRoutingConfig(cfg *Config) *RoutingConfig {
 		MinRouteProbability:   routing.DefaultMinRouteProbability,
 		AttemptCost: routing.DefaultPaymentAttemptPenalty.
 			ToAtoms(),
-		PenaltyHalfLife: routing.DefaultPenaltyHalfLife,
-		MaxMcHistory:    routing.DefaultMaxMcHistory,
+		PenaltyHalfLife:  routing.DefaultPenaltyHalfLife,
+		MaxMcHistory:     routing.DefaultMaxMcHistory,
+		MaxRouteHints:    DefaultMaxRouteHints,
+		MaxRouteHintHops: DefaultMaxRouteHintHops,
 	}
 }
@@ -220,10 +220,31 @@ type SendPaymentRequest struct {
 	// An optional field that can be used to pass an arbitrary set of TLV records
 	// to a peer which understands the new records. This can be used to pass
 	// application specific data during the payment attempt.
-	DestTlv              map[uint64][]byte `protobuf:"bytes,11,rep,name=dest_tlv,json=destTlv,proto3" json:"dest_tlv,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	DestTlv map[uint64][]byte `protobuf:"bytes,11,rep,name=dest_tlv,json=destTlv,proto3" json:"dest_tlv,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// *
+	// The channel point of the channel that must be taken to the first hop. This
+	// allows pinning the payment to a specific local channel without looking up
+	// its short channel id. The channel must be confirmed, pending channels are
+	// rejected. If set, it takes precedence over outgoing_chan_id.
+	OutgoingChanPoint *lnrpc.ChannelPoint `protobuf:"bytes,12,opt,name=outgoing_chan_point,json=outgoingChanPoint,proto3" json:"outgoing_chan_point,omitempty"`
+	// *
+	// Number of milli-atoms to send. This allows paying amounts that aren't a
+	// whole number of atoms. If both amt and amt_m_atoms are set, they must
+	// specify the same amount.
+	AmtMAtoms int64 `protobuf:"varint,13,opt,name=amt_m_atoms,json=amtMAtoms,proto3" json:"amt_m_atoms,omitempty"`
+	// *
+	// If set and no cltv_limit is specified when paying a payment request, a CLTV
+	// limit is derived from the payment request's min final CLTV expiry instead
+	// of using the maximum allowed by `--max-cltv-expiry`.
+	DeriveCltvLimit bool `protobuf:"varint,14,opt,name=derive_cltv_limit,json=deriveCltvLimit,proto3" json:"derive_cltv_limit,omitempty"`
+	// *
+	// The pubkey of the last hop of the route. If set, the route must enter the
+	// destination through a channel with this node. Required when paying to
+	// self, in which case it selects the channel the payment returns through.
+	LastHopPubkey        []byte   `protobuf:"bytes,15,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SendPaymentRequest) Reset()         { *m = SendPaymentRequest{} }
@@ -327,6 +348,34 @@ func (m *SendPaymentRequest) GetDestTlv() map[uint64][]byte {
 	return nil
 }
 
+func (m *SendPaymentRequest) GetOutgoingChanPoint() *lnrpc.ChannelPoint {
+	if m != nil {
+		return m.OutgoingChanPoint
+	}
+	return nil
+}
+
+func (m *SendPaymentRequest) GetAmtMAtoms() int64 {
+	if m != nil {
+		return m.AmtMAtoms
+	}
+	return 0
+}
+
+func (m *SendPaymentRequest) GetDeriveCltvLimit() bool {
+	if m != nil {
+		return m.DeriveCltvLimit
+	}
+	return false
+}
+
+func (m *SendPaymentRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
 type TrackPaymentRequest struct {
 	// / The hash of the payment to look up.
 	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
@@ -1696,122 +1745,125 @@ var _Router_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_router_bf5805918396094e) }
 
 var fileDescriptor_router_bf5805918396094e = []byte{
-	// 1868 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xdd, 0x72, 0x1a, 0xc9,
-	0x15, 0x5e, 0x04, 0x08, 0x38, 0x80, 0x34, 0x6a, 0x69, 0x65, 0x8c, 0x2d, 0x5b, 0xcb, 0x6e, 0xbc,
-	0x94, 0x6b, 0x23, 0x6d, 0x29, 0xb5, 0x2e, 0xd7, 0xde, 0xa4, 0x10, 0x34, 0xab, 0xb1, 0x61, 0xd0,
-	0x36, 0xe0, 0x5d, 0x27, 0x17, 0x5d, 0x2d, 0xa6, 0x25, 0xa6, 0x3c, 0x3f, 0xec, 0x4c, 0xa3, 0xb2,
-	0x72, 0x91, 0xaa, 0x54, 0xae, 0xf3, 0x1e, 0xb9, 0xc8, 0x0b, 0xe4, 0x2d, 0xf2, 0x14, 0xc9, 0x33,
-	0x24, 0x57, 0xa9, 0xee, 0x9e, 0x81, 0x01, 0x21, 0x27, 0x57, 0xa2, 0xbf, 0x73, 0xba, 0xfb, 0xf4,
-	0xf9, 0xf9, 0xce, 0x19, 0xc1, 0x61, 0x18, 0xcc, 0x05, 0x0f, 0xc3, 0xd9, 0xe4, 0x54, 0xff, 0x3a,
-	0x99, 0x85, 0x81, 0x08, 0x50, 0x69, 0x81, 0xd7, 0x4b, 0xe1, 0x6c, 0xa2, 0xd1, 0xc6, 0x9f, 0x72,
-	0x80, 0x86, 0xdc, 0xb7, 0x2f, 0xd9, 0x9d, 0xc7, 0x7d, 0x41, 0xf8, 0x2f, 0x73, 0x1e, 0x09, 0x84,
-	0x20, 0x67, 0xf3, 0x48, 0xd4, 0x32, 0xc7, 0x99, 0x66, 0x85, 0xa8, 0xdf, 0xc8, 0x80, 0x2c, 0xf3,
-	0x44, 0x6d, 0xeb, 0x38, 0xd3, 0xcc, 0x12, 0xf9, 0x13, 0x7d, 0x01, 0x95, 0x99, 0xde, 0x47, 0xa7,
-	0x2c, 0x9a, 0xd6, 0xb2, 0x4a, 0xbb, 0x1c, 0x63, 0x17, 0x2c, 0x9a, 0xa2, 0x26, 0x18, 0xd7, 0x8e,
-	0xcf, 0x5c, 0x3a, 0x71, 0xc5, 0x2d, 0xb5, 0xb9, 0x2b, 0x58, 0x2d, 0x77, 0x9c, 0x69, 0xe6, 0xc9,
-	0x8e, 0xc2, 0xdb, 0xae, 0xb8, 0xed, 0x48, 0x14, 0x7d, 0x0d, 0xbb, 0xc9, 0x61, 0xa1, 0xb6, 0xa2,
-	0x96, 0x3f, 0xce, 0x34, 0x4b, 0x64, 0x67, 0xb6, 0x6a, 0xdb, 0xd7, 0xb0, 0x2b, 0x1c, 0x8f, 0x07,
-	0x73, 0x41, 0x23, 0x3e, 0x09, 0x7c, 0x3b, 0xaa, 0x6d, 0xeb, 0x13, 0x63, 0x78, 0xa8, 0x51, 0xf4,
-	0x02, 0x76, 0xaf, 0x39, 0xa7, 0xae, 0xe3, 0x39, 0x82, 0x32, 0x11, 0x78, 0x51, 0xad, 0xa0, 0x8c,
-	0xaf, 0x5e, 0x73, 0xde, 0x93, 0x68, 0x4b, 0x82, 0xd2, 0xc6, 0x60, 0x2e, 0x6e, 0x02, 0xc7, 0xbf,
-	0xa1, 0x93, 0x29, 0xf3, 0xa9, 0x63, 0xd7, 0x8a, 0xc7, 0x99, 0x66, 0x8e, 0xec, 0x24, 0x78, 0x7b,
-	0xca, 0x7c, 0xd3, 0x46, 0x47, 0x00, 0xea, 0x1d, 0xea, 0xc8, 0x5a, 0x49, 0xdd, 0x5a, 0x92, 0x88,
-	0x3a, 0x0d, 0x9d, 0x41, 0x59, 0x39, 0x99, 0x4e, 0x1d, 0x5f, 0x44, 0x35, 0x38, 0xce, 0x36, 0xcb,
-	0x67, 0xc6, 0x89, 0xeb, 0x4b, 0x7f, 0x13, 0x29, 0xb9, 0x70, 0x7c, 0x41, 0xd2, 0x4a, 0x08, 0x43,
-	0x51, 0x7a, 0x97, 0x0a, 0xf7, 0xb6, 0x56, 0x56, 0x1b, 0x5e, 0x9e, 0x2c, 0x22, 0x75, 0x72, 0x3f,
-	0x34, 0x27, 0x1d, 0x1e, 0x89, 0x91, 0x7b, 0x8b, 0x7d, 0x11, 0xde, 0x91, 0x82, 0xad, 0x57, 0xf5,
-	0xef, 0xa1, 0x92, 0x16, 0xc8, 0x60, 0x7d, 0xe0, 0x77, 0x2a, 0x7e, 0x39, 0x22, 0x7f, 0xa2, 0x03,
-	0xc8, 0xdf, 0x32, 0x77, 0xce, 0x55, 0x00, 0x2b, 0x44, 0x2f, 0xbe, 0xdf, 0x7a, 0x9d, 0x69, 0xbc,
-	0x86, 0xfd, 0x51, 0xc8, 0x26, 0x1f, 0xd6, 0x72, 0x60, 0x3d, 0xba, 0x99, 0x7b, 0xd1, 0x6d, 0xfc,
-	0x11, 0xaa, 0xf1, 0xa6, 0xa1, 0x60, 0x62, 0x1e, 0xa1, 0x5f, 0x43, 0x3e, 0x12, 0x4c, 0x70, 0xa5,
-	0xbc, 0x73, 0xf6, 0x28, 0xf5, 0x94, 0x94, 0x22, 0x27, 0x5a, 0x0b, 0xd5, 0xa1, 0x38, 0x0b, 0xb9,
-	0xe3, 0xb1, 0x9b, 0xc4, 0xac, 0xc5, 0x1a, 0x35, 0x20, 0xaf, 0x36, 0xab, 0xac, 0x2a, 0x9f, 0x55,
-	0xd2, 0x6e, 0x24, 0x5a, 0xd4, 0x38, 0x87, 0x5d, 0xb5, 0xee, 0x72, 0xfe, 0xa9, 0xcc, 0x7d, 0x02,
-	0x25, 0xe6, 0x25, 0x29, 0xa0, 0xf3, 0xb7, 0xc8, 0x3c, 0x1d, 0xfd, 0xc6, 0x14, 0x8c, 0xe5, 0x19,
-	0xd1, 0x2c, 0xf0, 0x23, 0x8e, 0xbe, 0x01, 0x24, 0x2f, 0x90, 0x09, 0x21, 0x33, 0xc8, 0xd3, 0x3b,
-	0x33, 0x6a, 0xa7, 0x11, 0x4b, 0xba, 0x9c, 0xf7, 0x15, 0x2e, 0xf3, 0x4c, 0x66, 0x1e, 0x75, 0x83,
-	0xc9, 0x07, 0x99, 0xe2, 0xec, 0x2e, 0xbe, 0xa4, 0x2a, 0xe1, 0x5e, 0x30, 0xf9, 0xd0, 0x91, 0x60,
-	0xe3, 0xf7, 0xba, 0xd4, 0x46, 0x81, 0x7e, 0xc3, 0xff, 0xed, 0xe6, 0xa5, 0x2b, 0xb6, 0x1e, 0x76,
-	0x05, 0x85, 0xfd, 0x95, 0xc3, 0xe3, 0x97, 0xa4, 0x3d, 0x9c, 0x59, 0xf3, 0xf0, 0x37, 0x50, 0xb8,
-	0x66, 0x8e, 0x3b, 0x0f, 0x93, 0x83, 0x51, 0x2a, 0x5c, 0x5d, 0x2d, 0x21, 0x89, 0x4a, 0xe3, 0x3f,
-	0x05, 0x28, 0xc4, 0x20, 0x3a, 0x83, 0xdc, 0x24, 0xb0, 0x93, 0x28, 0x3f, 0xbb, 0xbf, 0x2d, 0xf9,
-	0xdb, 0x0e, 0x6c, 0x4e, 0x94, 0x2e, 0xfa, 0x2d, 0xec, 0xc8, 0xe2, 0xf2, 0xb9, 0x4b, 0xe7, 0x33,
-	0x9b, 0x2d, 0x02, 0x5b, 0x4b, 0xed, 0x6e, 0x6b, 0x85, 0xb1, 0x92, 0x93, 0xea, 0x24, 0xbd, 0x44,
-	0xc7, 0x50, 0x99, 0x0a, 0x77, 0x42, 0xbd, 0x38, 0x90, 0x39, 0x95, 0xdb, 0x20, 0xb1, 0xbe, 0x2e,
-	0xe4, 0x06, 0x54, 0x03, 0xdf, 0x09, 0x7c, 0x1a, 0x4d, 0x19, 0x3d, 0xfb, 0xee, 0x95, 0x22, 0x90,
-	0x0a, 0x29, 0x2b, 0x70, 0x38, 0x65, 0x67, 0xdf, 0xbd, 0x42, 0xcf, 0xa1, 0xac, 0x4a, 0x98, 0x7f,
-	0x9c, 0x39, 0xe1, 0x9d, 0x62, 0x8e, 0x2a, 0x51, 0x55, 0x8d, 0x15, 0x22, 0xeb, 0xe4, 0xda, 0x65,
-	0x37, 0x9a, 0x2b, 0xaa, 0x44, 0x2f, 0xd0, 0xb7, 0x70, 0x10, 0x3b, 0x82, 0x46, 0xc1, 0x3c, 0x9c,
-	0x70, 0xea, 0xf8, 0x36, 0xff, 0xa8, 0x78, 0xa2, 0x4a, 0x50, 0x2c, 0x1b, 0x2a, 0x91, 0x29, 0x25,
-	0xe8, 0x10, 0xb6, 0xa7, 0xdc, 0xb9, 0x99, 0x6a, 0x9e, 0xa8, 0x92, 0x78, 0xd5, 0xf8, 0x5b, 0x1e,
-	0xca, 0x29, 0xef, 0xa0, 0x0a, 0x14, 0x09, 0x1e, 0x62, 0xf2, 0x0e, 0x77, 0x8c, 0xcf, 0x50, 0x13,
-	0xbe, 0x32, 0xad, 0xf6, 0x80, 0x10, 0xdc, 0x1e, 0xd1, 0x01, 0xa1, 0x63, 0xeb, 0xad, 0x35, 0xf8,
-	0xc9, 0xa2, 0x97, 0xad, 0xf7, 0x7d, 0x6c, 0x8d, 0x68, 0x07, 0x8f, 0x5a, 0x66, 0x6f, 0x68, 0x64,
-	0xd0, 0x53, 0xa8, 0x2d, 0x35, 0x13, 0x71, 0xab, 0x3f, 0x18, 0x5b, 0x23, 0x63, 0x0b, 0x3d, 0x87,
-	0x27, 0x5d, 0xd3, 0x6a, 0xf5, 0xe8, 0x52, 0xa7, 0xdd, 0x1b, 0xbd, 0xa3, 0xf8, 0xe7, 0x4b, 0x93,
-	0xbc, 0x37, 0xb2, 0x9b, 0x14, 0x2e, 0x46, 0xbd, 0x76, 0x72, 0x42, 0x0e, 0x3d, 0x86, 0xcf, 0xb5,
-	0x82, 0xde, 0x42, 0x47, 0x83, 0x01, 0x1d, 0x0e, 0x06, 0x96, 0x91, 0x47, 0x7b, 0x50, 0x35, 0xad,
-	0x77, 0xad, 0x9e, 0xd9, 0xa1, 0x04, 0xb7, 0x7a, 0x7d, 0x63, 0x1b, 0xed, 0xc3, 0xee, 0xba, 0x5e,
-	0x41, 0x1e, 0x91, 0xe8, 0x0d, 0x2c, 0x73, 0x60, 0xd1, 0x77, 0x98, 0x0c, 0xcd, 0x81, 0x65, 0x14,
-	0xd1, 0x21, 0xa0, 0x55, 0xd1, 0x45, 0xbf, 0xd5, 0x36, 0x4a, 0xe8, 0x73, 0xd8, 0x5b, 0xc5, 0xdf,
-	0xe2, 0xf7, 0x06, 0xa0, 0x1a, 0x1c, 0x68, 0xc3, 0xe8, 0x39, 0xee, 0x0d, 0x7e, 0xa2, 0x7d, 0xd3,
-	0x32, 0xfb, 0xe3, 0xbe, 0x51, 0x46, 0x07, 0x60, 0x74, 0x31, 0xa6, 0xa6, 0x35, 0x1c, 0x77, 0xbb,
-	0x66, 0xdb, 0xc4, 0xd6, 0xc8, 0xa8, 0xe8, 0x9b, 0x37, 0x3d, 0xbc, 0x2a, 0x37, 0xb4, 0x2f, 0x5a,
-	0x96, 0x85, 0x7b, 0xb4, 0x63, 0x0e, 0x5b, 0xe7, 0x3d, 0xdc, 0x31, 0x76, 0xd0, 0x11, 0x3c, 0x1e,
-	0xe1, 0xfe, 0xe5, 0x80, 0xb4, 0xc8, 0x7b, 0x9a, 0xc8, 0xbb, 0x2d, 0xb3, 0x37, 0x26, 0xd8, 0xd8,
-	0x45, 0x5f, 0xc0, 0x11, 0xc1, 0x3f, 0x8e, 0x4d, 0x82, 0x3b, 0xd4, 0x1a, 0x74, 0x30, 0xed, 0xe2,
-	0xd6, 0x68, 0x4c, 0x30, 0xed, 0x9b, 0xc3, 0xa1, 0x69, 0xfd, 0x60, 0x18, 0xe8, 0x2b, 0x38, 0x5e,
-	0xa8, 0x2c, 0x0e, 0x58, 0xd3, 0xda, 0x93, 0xef, 0x4b, 0x42, 0x6a, 0xe1, 0x9f, 0x47, 0xf4, 0x12,
-	0x63, 0x62, 0x20, 0x54, 0x87, 0xc3, 0xe5, 0xf5, 0xfa, 0x82, 0xf8, 0xee, 0x7d, 0x29, 0xbb, 0xc4,
-	0xa4, 0xdf, 0xb2, 0x64, 0x80, 0x57, 0x64, 0x07, 0xd2, 0xec, 0xa5, 0x6c, 0xdd, 0xec, 0xcf, 0x11,
-	0x82, 0x9d, 0x54, 0x54, 0xba, 0x2d, 0x62, 0x1c, 0xa2, 0x03, 0xd8, 0x4d, 0x2c, 0x48, 0x14, 0xff,
-	0x59, 0x40, 0x8f, 0x00, 0x8d, 0x2d, 0x82, 0x5b, 0x1d, 0xe9, 0x90, 0x85, 0xe0, 0x5f, 0x85, 0x37,
-	0xb9, 0xe2, 0x96, 0x91, 0x6d, 0xfc, 0x3d, 0x0b, 0xd5, 0x95, 0xe2, 0x44, 0x4f, 0xa1, 0x14, 0x39,
-	0x37, 0x3e, 0x13, 0x92, 0x3e, 0x34, 0xb3, 0x2c, 0x01, 0xd5, 0x28, 0xa7, 0xcc, 0xf1, 0x35, 0xa5,
-	0x69, 0x6a, 0x2f, 0x29, 0x44, 0x11, 0xda, 0x23, 0x28, 0x24, 0x8d, 0x36, 0xab, 0xaa, 0x78, 0x7b,
-	0xa2, 0x1b, 0xec, 0x53, 0x28, 0x49, 0xce, 0x8c, 0x04, 0xf3, 0x66, 0xaa, 0xc0, 0xab, 0x64, 0x09,
-	0xa0, 0x2f, 0xa1, 0xea, 0xf1, 0x28, 0x62, 0x37, 0x9c, 0xea, 0x12, 0x05, 0xa5, 0x51, 0x89, 0xc1,
-	0xae, 0xaa, 0xd4, 0x2f, 0x21, 0xe1, 0x8d, 0x58, 0x29, 0xaf, 0x95, 0x62, 0x50, 0x2b, 0xad, 0x53,
-	0xb6, 0x60, 0x31, 0x13, 0xa4, 0x29, 0x5b, 0x30, 0x74, 0x0a, 0x07, 0x9a, 0x73, 0x1c, 0xdf, 0xf1,
-	0xe6, 0xde, 0x82, 0x7b, 0x0a, 0xca, 0xea, 0x3d, 0xc5, 0x3d, 0x5a, 0x14, 0x53, 0xd0, 0x63, 0x28,
-	0x5e, 0xb1, 0x88, 0xcb, 0xb6, 0x11, 0x73, 0x43, 0x41, 0xae, 0xbb, 0x9c, 0x4b, 0x91, 0x6c, 0x26,
-	0xa1, 0xa4, 0x3e, 0x4d, 0x09, 0x85, 0x6b, 0xce, 0x89, 0x74, 0xe6, 0xe2, 0x1a, 0xf6, 0x71, 0xe5,
-	0x9a, 0x72, 0xea, 0x1a, 0x2d, 0x8a, 0xaf, 0x79, 0x09, 0x7b, 0xfc, 0xa3, 0x08, 0x19, 0x0d, 0x66,
-	0xec, 0x97, 0x39, 0xa7, 0x36, 0x13, 0xac, 0x56, 0x51, 0x6e, 0xde, 0x55, 0x82, 0x81, 0xc2, 0x3b,
-	0x4c, 0xb0, 0xc6, 0x53, 0xa8, 0x13, 0x1e, 0x71, 0xd1, 0x77, 0xa2, 0xc8, 0x09, 0xfc, 0x76, 0xe0,
-	0x8b, 0x30, 0x70, 0xe3, 0xf6, 0xd3, 0x38, 0x82, 0x27, 0x1b, 0xa5, 0xba, 0x7f, 0xc8, 0xcd, 0x3f,
-	0xce, 0x79, 0x78, 0xb7, 0x79, 0xf3, 0x1d, 0x3c, 0xd9, 0x28, 0x5d, 0xb4, 0xd1, 0xbc, 0x1f, 0xd8,
-	0x5c, 0x76, 0x4e, 0x39, 0xd8, 0x1c, 0xa6, 0x98, 0xde, 0x0a, 0x6c, 0x7e, 0xe1, 0x44, 0x22, 0x08,
-	0xef, 0x88, 0x56, 0x92, 0xda, 0x33, 0xe6, 0x84, 0xb2, 0x43, 0xaf, 0x6b, 0x5f, 0x32, 0x27, 0x5c,
-	0x68, 0x2b, 0xa5, 0xc6, 0x9f, 0x33, 0x50, 0x4e, 0x1d, 0x22, 0xe9, 0x76, 0x36, 0xbf, 0x4a, 0x66,
-	0x9e, 0x0a, 0x89, 0x57, 0xe8, 0x05, 0xec, 0xb8, 0x2c, 0x12, 0x54, 0x32, 0x34, 0x95, 0xc1, 0x8d,
-	0x7b, 0xf3, 0x1a, 0x8a, 0x4e, 0x00, 0x05, 0x62, 0xca, 0x43, 0x1a, 0xcd, 0x27, 0x13, 0x1e, 0x45,
-	0x74, 0x16, 0x06, 0x57, 0x2a, 0x3b, 0xb7, 0xc8, 0x06, 0xc9, 0x9b, 0x5c, 0x31, 0x67, 0xe4, 0x1b,
-	0xff, 0xce, 0x40, 0x39, 0x65, 0x9c, 0xcc, 0x5f, 0xf9, 0x18, 0x7a, 0x1d, 0x06, 0x5e, 0x52, 0x15,
-	0x0b, 0x00, 0xd5, 0xa0, 0xa0, 0x16, 0x22, 0x88, 0x4b, 0x22, 0x59, 0xae, 0xe6, 0x7d, 0x56, 0x19,
-	0x98, 0xca, 0xfb, 0x57, 0x70, 0xe8, 0x39, 0x3e, 0x9d, 0x71, 0x9f, 0xb9, 0xce, 0x1f, 0x38, 0x5d,
-	0x0e, 0x33, 0x39, 0xa5, 0xfa, 0x80, 0x14, 0x35, 0xa0, 0xb2, 0xf2, 0x9a, 0xbc, 0x7a, 0xcd, 0x0a,
-	0x86, 0x5e, 0xc3, 0x23, 0xe5, 0x09, 0x26, 0x04, 0xf7, 0x66, 0x22, 0x79, 0xe4, 0xf5, 0xdc, 0x55,
-	0x15, 0x51, 0x24, 0x0f, 0x89, 0x1b, 0x7f, 0xcd, 0xc0, 0xde, 0xf9, 0xdc, 0x71, 0xed, 0x95, 0x71,
-	0xe6, 0x19, 0x94, 0xa5, 0x01, 0x49, 0x06, 0xeb, 0x99, 0x49, 0x8e, 0x5f, 0xfd, 0xc5, 0xb0, 0x7d,
-	0xef, 0x83, 0x60, 0x6b, 0xe3, 0x07, 0xc1, 0xa6, 0xb1, 0x3c, 0xbb, 0x71, 0x2c, 0x7f, 0x0e, 0xe5,
-	0x69, 0x30, 0xa3, 0x3a, 0xe2, 0xd2, 0x29, 0xd9, 0x66, 0x85, 0xc0, 0x34, 0x98, 0x5d, 0x6a, 0xa4,
-	0xf1, 0x1a, 0x50, 0xda, 0xd2, 0x38, 0x3d, 0x17, 0x63, 0x55, 0xe6, 0xc1, 0xb1, 0xea, 0xe5, 0x5f,
-	0x32, 0x50, 0x49, 0x4f, 0xae, 0xa8, 0x0a, 0x25, 0xd3, 0xa2, 0xdd, 0x9e, 0xf9, 0xc3, 0xc5, 0xc8,
-	0xf8, 0x4c, 0x2e, 0x87, 0xe3, 0x76, 0x1b, 0xe3, 0x0e, 0xee, 0x18, 0x19, 0x49, 0xb8, 0x92, 0x3b,
-	0x71, 0x87, 0x8e, 0xcc, 0x3e, 0x1e, 0x8c, 0x65, 0x2b, 0xde, 0x87, 0xdd, 0x18, 0xb3, 0x06, 0x94,
-	0x0c, 0xc6, 0x23, 0x6c, 0x64, 0x91, 0x01, 0x95, 0x18, 0xc4, 0x84, 0x0c, 0x88, 0x91, 0x93, 0xfd,
-	0x23, 0x46, 0xee, 0xb7, 0xf5, 0xa4, 0xeb, 0xe7, 0xcf, 0xfe, 0x91, 0x83, 0x6d, 0x65, 0x60, 0x88,
-	0x2e, 0xa0, 0x9c, 0xfa, 0x3c, 0x40, 0x47, 0x9f, 0xfc, 0x6c, 0xa8, 0xd7, 0x36, 0x8f, 0xe2, 0xf3,
-	0xe8, 0xdb, 0x0c, 0x7a, 0x03, 0x95, 0xf4, 0x07, 0x00, 0x4a, 0x0f, 0x74, 0x1b, 0xbe, 0x0c, 0x3e,
-	0x79, 0xd6, 0x5b, 0x30, 0x70, 0x24, 0x1c, 0x4f, 0x0e, 0x70, 0xf1, 0x58, 0x8d, 0xea, 0x29, 0xfd,
-	0xb5, 0x79, 0xbd, 0xfe, 0x64, 0xa3, 0x2c, 0x8e, 0x50, 0x4f, 0x3f, 0x31, 0x1e, 0x6a, 0xef, 0x3d,
-	0x71, 0x75, 0x92, 0xae, 0x3f, 0x7b, 0x48, 0x1c, 0x9f, 0x66, 0xc3, 0xfe, 0x06, 0xaa, 0x43, 0xbf,
-	0x4a, 0x5b, 0xf0, 0x20, 0x51, 0xd6, 0x5f, 0xfc, 0x2f, 0xb5, 0xe5, 0x2d, 0x1b, 0x38, 0x71, 0xe5,
-	0x96, 0x87, 0x19, 0x75, 0xe5, 0x96, 0x4f, 0x51, 0xab, 0x09, 0xb0, 0xcc, 0x68, 0xf4, 0x34, 0xb5,
-	0xeb, 0x5e, 0x49, 0xd6, 0x8f, 0x1e, 0x90, 0xea, 0xa3, 0xce, 0x5f, 0xfe, 0xae, 0x79, 0xe3, 0x88,
-	0xe9, 0xfc, 0xea, 0x64, 0x12, 0x78, 0xa7, 0x36, 0x9f, 0x84, 0xdc, 0x3e, 0xb5, 0x27, 0xa1, 0xeb,
-	0xdb, 0xa7, 0xaa, 0x22, 0x4e, 0x17, 0xdb, 0xaf, 0xb6, 0xd5, 0x7f, 0x0d, 0x7e, 0xf3, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x11, 0xf7, 0x48, 0x9d, 0x65, 0x10, 0x00, 0x00,
+	// 1909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8d, 0x58, 0xcd, 0x72, 0x22, 0xc9,
+	0x11, 0x36, 0x02, 0x04, 0x24, 0x7f, 0xad, 0x92, 0x46, 0xc3, 0xa0, 0xd1, 0xec, 0x18, 0xaf, 0xd7,
+	0x13, 0x13, 0x6b, 0x69, 0x43, 0x0e, 0x6f, 0x4c, 0xec, 0xc5, 0x81, 0xa0, 0x59, 0x7a, 0x07, 0x1a,
+	0x6d, 0x01, 0xb3, 0x3b, 0xf6, 0xa1, 0xa2, 0x05, 0x25, 0xd1, 0x31, 0x4d, 0x37, 0xee, 0x6e, 0x14,
+	0x23, 0x1f, 0x7c, 0xf1, 0xd9, 0xef, 0xe1, 0x83, 0x5f, 0xc0, 0x7e, 0x0a, 0x3f, 0x85, 0xfd, 0x0c,
+	0xf6, 0xc9, 0x59, 0x55, 0xdd, 0xd0, 0x20, 0x34, 0xf6, 0x45, 0x74, 0x7d, 0x99, 0xf5, 0x93, 0x99,
+	0x95, 0x5f, 0x66, 0x09, 0x8e, 0x7d, 0x6f, 0x19, 0x72, 0xdf, 0x5f, 0x4c, 0xce, 0xd5, 0xd7, 0xd9,
+	0xc2, 0xf7, 0x42, 0x8f, 0x14, 0x56, 0x78, 0xbd, 0x80, 0x7f, 0x14, 0xda, 0xf8, 0x7b, 0x16, 0xc8,
+	0x90, 0xbb, 0xd3, 0x2b, 0xeb, 0x7e, 0xce, 0xdd, 0x90, 0xf2, 0xdf, 0x2f, 0x79, 0x10, 0x12, 0x02,
+	0x99, 0x29, 0xfe, 0xd6, 0x52, 0x2f, 0x53, 0xaf, 0x4a, 0x54, 0x7e, 0x13, 0x0d, 0xd2, 0xd6, 0x3c,
+	0xac, 0xed, 0x21, 0x94, 0xa6, 0xe2, 0x93, 0xfc, 0x14, 0x4a, 0x0b, 0x35, 0x8f, 0xcd, 0xac, 0x60,
+	0x56, 0x4b, 0x4b, 0xed, 0x62, 0x84, 0x75, 0x11, 0x22, 0xaf, 0x40, 0xbb, 0xb1, 0x5d, 0xcb, 0x61,
+	0x13, 0x27, 0xbc, 0x63, 0x53, 0xee, 0x84, 0x56, 0x2d, 0x83, 0x6a, 0x59, 0x5a, 0x91, 0x78, 0x0b,
+	0xe1, 0xb6, 0x40, 0xc9, 0x2f, 0xa0, 0x1a, 0x2f, 0xe6, 0xab, 0x53, 0xd4, 0xb2, 0xa8, 0x58, 0xa0,
+	0x95, 0xc5, 0xe6, 0xd9, 0x50, 0x31, 0xb4, 0xe7, 0x1c, 0xad, 0x61, 0x01, 0x9f, 0x78, 0xee, 0x34,
+	0xa8, 0xed, 0xab, 0x15, 0x23, 0x78, 0xa8, 0x50, 0xf2, 0x05, 0x54, 0x6f, 0x38, 0x67, 0x8e, 0x3d,
+	0xb7, 0x43, 0x66, 0x85, 0xde, 0x3c, 0xa8, 0xe5, 0xe4, 0xe1, 0xcb, 0x08, 0xf7, 0x04, 0xda, 0x14,
+	0xa0, 0x38, 0x23, 0xce, 0xba, 0xf5, 0x6c, 0xf7, 0x96, 0x4d, 0x66, 0x96, 0xcb, 0xec, 0x69, 0x2d,
+	0x8f, 0x8a, 0x19, 0x5a, 0x89, 0xf1, 0x16, 0xc2, 0xc6, 0x94, 0x9c, 0x02, 0x48, 0x3b, 0xe4, 0x92,
+	0xb5, 0x82, 0xdc, 0xb5, 0x20, 0x10, 0xb9, 0x1a, 0xb9, 0x80, 0xa2, 0x74, 0x32, 0x9b, 0xd9, 0x6e,
+	0x18, 0xd4, 0xe0, 0x65, 0xfa, 0x55, 0xf1, 0x42, 0x3b, 0x73, 0x5c, 0xe1, 0x6f, 0x2a, 0x24, 0x5d,
+	0x14, 0xd0, 0xa4, 0x12, 0xd1, 0x21, 0x2f, 0xbc, 0xcb, 0x42, 0xe7, 0xae, 0x56, 0x94, 0x13, 0x5e,
+	0x9f, 0xad, 0x22, 0x75, 0xf6, 0x30, 0x34, 0x67, 0x6d, 0xfc, 0x33, 0x72, 0xee, 0x74, 0x37, 0xf4,
+	0xef, 0x69, 0x6e, 0xaa, 0x46, 0xa4, 0x05, 0x87, 0x9b, 0x36, 0x2c, 0xf0, 0x33, 0xac, 0x95, 0xf0,
+	0x88, 0xc5, 0x8b, 0xc3, 0xe8, 0x08, 0xc2, 0x0a, 0x97, 0x3b, 0x57, 0x42, 0x44, 0x0f, 0x92, 0xb6,
+	0x49, 0x88, 0xbc, 0x80, 0x22, 0x86, 0x95, 0xcd, 0x23, 0x67, 0x95, 0xa5, 0xb3, 0x0a, 0x08, 0xf5,
+	0x95, 0xa3, 0x5e, 0xc3, 0xc1, 0x94, 0xfb, 0xf6, 0x1d, 0x67, 0x09, 0x2f, 0x54, 0x50, 0x2b, 0x4f,
+	0xab, 0x4a, 0xd0, 0x5a, 0xf9, 0x02, 0x9d, 0xef, 0x58, 0x68, 0xd7, 0xcc, 0x5b, 0xb0, 0xc5, 0xf2,
+	0xfa, 0x03, 0xbf, 0xaf, 0x55, 0xe5, 0xf5, 0x28, 0x0b, 0xb8, 0xeb, 0x2d, 0xae, 0x24, 0x58, 0xff,
+	0x06, 0x4a, 0x49, 0x8b, 0xc4, 0x2d, 0x13, 0xba, 0x29, 0xe9, 0x7f, 0xf1, 0x49, 0x8e, 0x20, 0x7b,
+	0x67, 0x39, 0x4b, 0x2e, 0x6f, 0x5e, 0x89, 0xaa, 0xc1, 0x37, 0x7b, 0x6f, 0x52, 0x8d, 0x37, 0x70,
+	0x38, 0xf2, 0xad, 0xc9, 0x87, 0xad, 0xcb, 0xbb, 0x7d, 0x2d, 0x53, 0x0f, 0xae, 0x65, 0xe3, 0x8f,
+	0x50, 0x8e, 0x26, 0x0d, 0x43, 0x2b, 0x5c, 0x06, 0xe4, 0x97, 0x90, 0x0d, 0xf0, 0x8b, 0x4b, 0xe5,
+	0xca, 0xc5, 0xd3, 0x44, 0x0c, 0x12, 0x8a, 0x9c, 0x2a, 0x2d, 0x52, 0x87, 0xfc, 0xc2, 0xe7, 0xf6,
+	0xdc, 0xba, 0x8d, 0x8f, 0xb5, 0x1a, 0x93, 0x06, 0x64, 0xe5, 0x64, 0x99, 0x0e, 0xc5, 0x8b, 0x52,
+	0x32, 0xfe, 0x54, 0x89, 0x1a, 0x97, 0x50, 0x95, 0xe3, 0x0e, 0xe7, 0x9f, 0x4a, 0xb9, 0x13, 0x10,
+	0xde, 0x8f, 0xc2, 0xa1, 0x12, 0x2f, 0x8f, 0x80, 0x8c, 0x46, 0x63, 0x06, 0xda, 0x7a, 0x8d, 0x60,
+	0xe1, 0xb9, 0x01, 0x27, 0x5f, 0x02, 0x11, 0x1b, 0x88, 0x5b, 0x20, 0xae, 0xfe, 0x5c, 0xcd, 0x4c,
+	0xc9, 0x99, 0x5a, 0x24, 0x41, 0xfd, 0xbe, 0xc4, 0x45, 0x8c, 0x44, 0xca, 0x30, 0xc7, 0x9b, 0x7c,
+	0x10, 0xb9, 0x69, 0xdd, 0x47, 0x9b, 0x94, 0x05, 0xdc, 0x43, 0xb4, 0x2d, 0xc0, 0xc6, 0xef, 0x14,
+	0x47, 0x8c, 0x3c, 0x65, 0xc3, 0xff, 0xed, 0xe6, 0xb5, 0x2b, 0xf6, 0x1e, 0x77, 0x05, 0x83, 0xc3,
+	0x8d, 0xc5, 0x23, 0x4b, 0x92, 0x1e, 0x4e, 0x6d, 0x79, 0xf8, 0x4b, 0xc8, 0xdd, 0x58, 0xb6, 0xb3,
+	0xf4, 0xe3, 0x85, 0x49, 0x22, 0x5c, 0x1d, 0x25, 0xa1, 0xb1, 0x4a, 0xe3, 0x3f, 0x39, 0xc8, 0x45,
+	0x20, 0x66, 0x68, 0x66, 0xe2, 0x4d, 0xe3, 0x28, 0xbf, 0x78, 0x38, 0x2d, 0xfe, 0x6d, 0xa1, 0x16,
+	0x95, 0xba, 0xe4, 0x37, 0x50, 0x99, 0xa8, 0xc4, 0x61, 0xcb, 0xc5, 0xd4, 0x5a, 0x05, 0xb6, 0x96,
+	0x98, 0x1d, 0x65, 0xd6, 0x58, 0xca, 0x69, 0x79, 0x92, 0x1c, 0x92, 0x97, 0x50, 0x9a, 0x85, 0xce,
+	0x64, 0x95, 0x57, 0x19, 0x79, 0xb7, 0x41, 0x60, 0x51, 0x62, 0x35, 0xa0, 0xec, 0xb9, 0xb6, 0xe7,
+	0xb2, 0x60, 0x66, 0xb1, 0x8b, 0x5f, 0x7f, 0x2d, 0x99, 0x0f, 0x7d, 0x29, 0xc1, 0xe1, 0xcc, 0x42,
+	0x88, 0x7c, 0x06, 0x45, 0x99, 0x75, 0xfc, 0xe3, 0xc2, 0xf6, 0xef, 0x25, 0xe5, 0x95, 0xa9, 0xa4,
+	0x23, 0x5d, 0x22, 0x22, 0x4f, 0x6e, 0x1c, 0xeb, 0x56, 0x91, 0x5c, 0x99, 0xaa, 0x01, 0xf9, 0x0a,
+	0x8e, 0x22, 0x47, 0xb0, 0xc0, 0x5b, 0xfa, 0x13, 0xce, 0x6c, 0x77, 0xca, 0x3f, 0x4a, 0x82, 0x2b,
+	0x53, 0x12, 0xc9, 0x86, 0x52, 0x64, 0x08, 0x09, 0x39, 0x86, 0xfd, 0x19, 0xb7, 0x6f, 0x67, 0x8a,
+	0xe0, 0xca, 0x34, 0x1a, 0x35, 0xfe, 0x9a, 0x85, 0x62, 0xc2, 0x3b, 0xa4, 0x04, 0x79, 0xaa, 0x0f,
+	0x75, 0xfa, 0x4e, 0x6f, 0x6b, 0x3f, 0x41, 0x12, 0xfd, 0xdc, 0x30, 0x5b, 0x03, 0x4a, 0xf5, 0xd6,
+	0x88, 0x0d, 0x28, 0x1b, 0x9b, 0x6f, 0xcd, 0xc1, 0x0f, 0x26, 0xbb, 0x6a, 0xbe, 0xef, 0xeb, 0xe6,
+	0x88, 0xb5, 0xf5, 0x51, 0xd3, 0xe8, 0x0d, 0xb5, 0x14, 0x79, 0x0e, 0xb5, 0xb5, 0x66, 0x2c, 0x6e,
+	0xf6, 0x07, 0x63, 0x73, 0xa4, 0xed, 0xa1, 0x99, 0x27, 0x1d, 0xc3, 0x6c, 0xf6, 0xd8, 0x5a, 0xa7,
+	0xd5, 0x1b, 0xbd, 0x63, 0xfa, 0x8f, 0x57, 0x06, 0x7d, 0xaf, 0xa5, 0x77, 0x29, 0x74, 0x47, 0xbd,
+	0x56, 0xbc, 0x42, 0x86, 0x3c, 0x83, 0x27, 0x4a, 0x41, 0x4d, 0x61, 0xa3, 0xc1, 0x80, 0x0d, 0x07,
+	0x03, 0x53, 0xcb, 0x92, 0x03, 0x28, 0x1b, 0xe6, 0xbb, 0x66, 0xcf, 0x68, 0x33, 0xaa, 0x37, 0x7b,
+	0x7d, 0x6d, 0x9f, 0x1c, 0x42, 0x75, 0x5b, 0x2f, 0x27, 0x96, 0x88, 0xf5, 0x06, 0xa6, 0x31, 0x30,
+	0xd9, 0x3b, 0x9d, 0x0e, 0xf1, 0x57, 0xcb, 0xa3, 0x77, 0xc8, 0xa6, 0xa8, 0xdb, 0x6f, 0xb6, 0xb4,
+	0x02, 0x79, 0x02, 0x07, 0x9b, 0xf8, 0x5b, 0xfd, 0xbd, 0x06, 0xa4, 0x06, 0x47, 0xea, 0x60, 0xec,
+	0x52, 0xef, 0x0d, 0x7e, 0x60, 0x7d, 0xc3, 0x34, 0xfa, 0xe3, 0xbe, 0x56, 0xc4, 0x70, 0x69, 0x1d,
+	0x5d, 0x47, 0x2b, 0x86, 0xe3, 0x4e, 0xc7, 0x68, 0x19, 0xe8, 0x05, 0xad, 0xa4, 0x76, 0xde, 0x65,
+	0x78, 0x59, 0x4c, 0x68, 0x75, 0x9b, 0xa6, 0xa9, 0xf7, 0x58, 0xdb, 0x18, 0x36, 0x2f, 0x7b, 0xe8,
+	0xf7, 0x0a, 0x96, 0xa4, 0x67, 0x23, 0xbd, 0x7f, 0x35, 0xa0, 0x4d, 0x34, 0x21, 0x96, 0x77, 0xd0,
+	0xd5, 0x63, 0xaa, 0x6b, 0x55, 0x4c, 0xd2, 0x53, 0xaa, 0x7f, 0x3f, 0x36, 0xa8, 0xde, 0x66, 0xe6,
+	0xa0, 0xad, 0xb3, 0x8e, 0xde, 0x1c, 0xa1, 0x08, 0x0f, 0x32, 0x1c, 0x1a, 0xe6, 0xb7, 0x9a, 0x46,
+	0x3e, 0x87, 0x97, 0x2b, 0x95, 0xd5, 0x02, 0x5b, 0x5a, 0x07, 0xc2, 0xbe, 0x38, 0xa4, 0xa6, 0xfe,
+	0x23, 0x06, 0x4e, 0xd7, 0xa9, 0x46, 0x30, 0x4d, 0x8f, 0xd7, 0xdb, 0xab, 0x0d, 0xa2, 0xbd, 0x0f,
+	0x85, 0xec, 0x4a, 0xa7, 0xfd, 0xa6, 0x29, 0x02, 0xbc, 0x21, 0x3b, 0x12, 0xc7, 0x5e, 0xcb, 0xb6,
+	0x8f, 0xfd, 0x04, 0xc9, 0xb0, 0x92, 0x88, 0x4a, 0xa7, 0x49, 0xb5, 0x63, 0xb4, 0xbf, 0x1a, 0x9f,
+	0x20, 0x56, 0xfc, 0x67, 0x8e, 0x3c, 0x05, 0x32, 0x36, 0x31, 0x98, 0x6d, 0xe1, 0x90, 0x95, 0xe0,
+	0x5f, 0xb9, 0xef, 0x32, 0xf9, 0x3d, 0x2d, 0xdd, 0xf8, 0x5b, 0x1a, 0xca, 0x1b, 0xc9, 0x89, 0xd7,
+	0xaf, 0x10, 0xd8, 0xb7, 0x2e, 0xb2, 0xbe, 0x1f, 0x33, 0xcb, 0x1a, 0x90, 0x15, 0x7e, 0x66, 0xd9,
+	0xae, 0xa2, 0x34, 0x45, 0xed, 0x05, 0x89, 0x48, 0x42, 0x7b, 0x0a, 0xb9, 0xb8, 0x43, 0x48, 0xcb,
+	0x2c, 0xde, 0x9f, 0xa8, 0xce, 0x00, 0x57, 0x15, 0x9c, 0x89, 0xd5, 0x61, 0xbe, 0x90, 0x09, 0x5e,
+	0xa6, 0x6b, 0x80, 0xfc, 0x0c, 0xca, 0xf8, 0x19, 0x20, 0x77, 0x31, 0x95, 0xa2, 0x20, 0x35, 0x4a,
+	0x11, 0xd8, 0x91, 0x99, 0x8a, 0x4a, 0x31, 0xcf, 0x28, 0xa5, 0xac, 0x52, 0x8a, 0x40, 0xa5, 0xb4,
+	0x4d, 0xd9, 0xd8, 0x4e, 0x29, 0x26, 0x48, 0x52, 0x36, 0x76, 0x53, 0xe7, 0x70, 0xa4, 0x38, 0xc7,
+	0x76, 0xed, 0xf9, 0x72, 0xbe, 0xe2, 0x9e, 0x9c, 0x3c, 0xf5, 0x81, 0xe4, 0x1e, 0x25, 0x8a, 0x28,
+	0xe8, 0x19, 0xe4, 0xaf, 0xad, 0x80, 0x8b, 0xb2, 0x11, 0x71, 0x43, 0x4e, 0x8c, 0xb1, 0x58, 0x08,
+	0x91, 0x28, 0x26, 0xbe, 0xa0, 0x3e, 0x45, 0x09, 0x39, 0x1c, 0x53, 0xe1, 0xcc, 0xd5, 0x36, 0xd6,
+	0xc7, 0x8d, 0x6d, 0x8a, 0x89, 0x6d, 0x94, 0x68, 0xdd, 0x42, 0xf0, 0x8f, 0xa1, 0x6f, 0x31, 0x6f,
+	0x61, 0x61, 0x19, 0x61, 0x18, 0x12, 0x4b, 0x76, 0x29, 0x25, 0x5a, 0x95, 0x82, 0x81, 0xc4, 0xdb,
+	0x08, 0x37, 0x9e, 0x43, 0x1d, 0xcb, 0x01, 0x0f, 0xfb, 0x76, 0x10, 0x20, 0x0f, 0xb6, 0x3c, 0x6c,
+	0x10, 0x3c, 0x27, 0x2a, 0x3f, 0x8d, 0x53, 0x38, 0xd9, 0x29, 0x55, 0xf5, 0x43, 0x4c, 0xfe, 0x7e,
+	0xc9, 0xfd, 0xfb, 0xdd, 0x93, 0xef, 0xe1, 0x64, 0xa7, 0x74, 0x55, 0x46, 0xb3, 0x2e, 0x52, 0x9c,
+	0xa8, 0x9c, 0xa2, 0x23, 0x3b, 0x4e, 0x30, 0xbd, 0x89, 0x78, 0xd7, 0x0e, 0x42, 0x0f, 0xbb, 0x2f,
+	0xa5, 0x24, 0xb4, 0x17, 0x96, 0xed, 0x8b, 0x0a, 0xbd, 0xad, 0x7d, 0x85, 0xf8, 0x4a, 0x5b, 0x2a,
+	0x35, 0xfe, 0x94, 0x82, 0x62, 0x62, 0x11, 0x41, 0xb7, 0x51, 0x7f, 0xa4, 0x2e, 0x63, 0x34, 0xc2,
+	0x48, 0x57, 0x64, 0x03, 0x25, 0x18, 0x9a, 0x89, 0xe0, 0x46, 0xb5, 0x79, 0x0b, 0x25, 0x67, 0x40,
+	0xbc, 0x70, 0xc6, 0x7d, 0x16, 0x2c, 0x27, 0x13, 0xbc, 0x4f, 0x0c, 0x1b, 0xfb, 0x6b, 0x79, 0x3b,
+	0xf7, 0xe8, 0x0e, 0x09, 0xe6, 0x45, 0x46, 0xcb, 0x36, 0xfe, 0x8d, 0xa7, 0x48, 0x1c, 0x4e, 0xdc,
+	0x5f, 0x61, 0x0c, 0xbb, 0xf1, 0xbd, 0x79, 0x9c, 0x15, 0x2b, 0x00, 0x59, 0x2c, 0x27, 0x07, 0xa1,
+	0x17, 0xa5, 0x44, 0x3c, 0xdc, 0xbc, 0xf7, 0x69, 0xd5, 0x30, 0xae, 0xef, 0xfd, 0xd7, 0x70, 0x8c,
+	0x17, 0x90, 0x2d, 0x38, 0x76, 0xfa, 0xf6, 0x1f, 0x38, 0x5b, 0x37, 0x33, 0x19, 0xa9, 0xfa, 0x88,
+	0x14, 0xeb, 0x61, 0x69, 0xc3, 0x9a, 0xac, 0xb4, 0x66, 0x03, 0x23, 0x6f, 0xe0, 0xa9, 0xf4, 0x84,
+	0x15, 0x86, 0x7c, 0xbe, 0x08, 0x63, 0x23, 0x6f, 0x96, 0x8e, 0xcc, 0x88, 0x3c, 0x7d, 0x4c, 0xdc,
+	0xf8, 0x4b, 0x0a, 0x0e, 0x2e, 0x97, 0xb6, 0x33, 0xdd, 0x68, 0x67, 0xb6, 0x9a, 0xdf, 0xd4, 0x76,
+	0xf3, 0xbb, 0xeb, 0x25, 0xb3, 0xb7, 0xf3, 0x25, 0xb3, 0xeb, 0x3d, 0x91, 0xde, 0xf9, 0x9e, 0xc0,
+	0x9a, 0xbe, 0xee, 0x8f, 0x85, 0x53, 0xd2, 0xe8, 0x5b, 0x98, 0xc5, 0xcd, 0x71, 0x80, 0x1d, 0x2e,
+	0x49, 0x9e, 0x34, 0xba, 0x9e, 0xab, 0xb6, 0x2a, 0xf5, 0x68, 0x5b, 0xf5, 0xfa, 0xcf, 0x29, 0x28,
+	0x25, 0x3b, 0x57, 0x52, 0x86, 0x82, 0x81, 0xcc, 0xd9, 0x33, 0xbe, 0xed, 0x8e, 0xb0, 0x5e, 0xe3,
+	0x70, 0x38, 0x6e, 0xb5, 0x74, 0xbd, 0x8d, 0x65, 0x24, 0x25, 0x08, 0x57, 0x70, 0x27, 0x96, 0x80,
+	0x91, 0xd1, 0xd7, 0x07, 0x63, 0x51, 0x8a, 0xb1, 0x34, 0x46, 0x98, 0x39, 0x60, 0x14, 0x31, 0x1d,
+	0xcb, 0xaf, 0x06, 0xa5, 0x08, 0xd4, 0x29, 0x1d, 0x50, 0xac, 0xb7, 0x58, 0x3f, 0x22, 0xe4, 0x61,
+	0x59, 0x8f, 0xab, 0x7e, 0xf6, 0xe2, 0x1f, 0x19, 0xd8, 0x97, 0x07, 0xf4, 0x49, 0x17, 0x8a, 0x89,
+	0x77, 0x0d, 0x39, 0xfd, 0xe4, 0x7b, 0xa7, 0x5e, 0xdb, 0xdd, 0x8a, 0x2f, 0x83, 0xaf, 0x52, 0xe4,
+	0x3b, 0x28, 0x25, 0x1f, 0x00, 0x24, 0xd9, 0xd0, 0xed, 0x78, 0x19, 0x7c, 0x72, 0xad, 0xb7, 0xa0,
+	0xe9, 0x01, 0x5e, 0x5d, 0xd1, 0xc0, 0x45, 0x6d, 0x35, 0xa9, 0x27, 0xf4, 0xb7, 0xfa, 0xf5, 0xfa,
+	0xc9, 0x4e, 0x59, 0x14, 0xa1, 0x9e, 0x32, 0x31, 0x6a, 0x6a, 0x1f, 0x98, 0xb8, 0xd9, 0x49, 0xd7,
+	0x5f, 0x3c, 0x26, 0x8e, 0x56, 0x9b, 0xc2, 0xe1, 0x0e, 0xaa, 0x23, 0x3f, 0x4f, 0x9e, 0xe0, 0x51,
+	0xa2, 0xac, 0x7f, 0xf1, 0xbf, 0xd4, 0xd6, 0xbb, 0xec, 0xe0, 0xc4, 0x8d, 0x5d, 0x1e, 0x67, 0xd4,
+	0x8d, 0x5d, 0x3e, 0x45, 0xad, 0x06, 0xc0, 0xfa, 0x46, 0x93, 0xe7, 0x89, 0x59, 0x0f, 0x52, 0xb2,
+	0x7e, 0xfa, 0x88, 0x54, 0x2d, 0x75, 0xf9, 0xfa, 0xb7, 0xaf, 0x6e, 0xed, 0x70, 0xb6, 0xbc, 0x3e,
+	0x9b, 0x78, 0xf3, 0xf3, 0x29, 0x9f, 0xf8, 0x7c, 0x7a, 0x3e, 0x9d, 0xf8, 0x8e, 0x3b, 0x3d, 0x97,
+	0x19, 0x71, 0xbe, 0x9a, 0x7e, 0xbd, 0x2f, 0xff, 0xdd, 0xf1, 0xab, 0xff, 0x02, 0xe6, 0x93, 0xf8,
+	0xb1, 0x1e, 0x11, 0x00, 0x00,
 }
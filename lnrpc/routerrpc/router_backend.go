@@ -1,7 +1,9 @@
 package routerrpc
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -10,9 +12,13 @@ import (
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/chaincfg/v2"
 	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/routing"
 	"github.com/decred/dcrlnd/routing/route"
@@ -33,6 +39,17 @@ type RouterBackend struct {
 	// capacity of a channel to populate in responses.
 	FetchChannelCapacity func(chanID uint64) (dcrutil.Amount, error)
 
+	// FetchChannelTimeLockDelta returns the time lock delta that the given
+	// node advertised for forwarding over the given channel. An error is
+	// returned if the policy isn't known.
+	FetchChannelTimeLockDelta func(chanID uint64,
+		node route.Vertex) (uint16, error)
+
+	// FetchLocalChannelID returns the short channel id of the local
+	// channel identified by the given channel point. It returns an error
+	// if the channel is still pending.
+	FetchLocalChannelID func(chanPoint wire.OutPoint) (uint64, error)
+
 	// FetchChannelEndpoints returns the pubkeys of both endpoints of the
 	// given channel id.
 	FetchChannelEndpoints func(chanID uint64) (route.Vertex,
@@ -60,6 +77,48 @@ type RouterBackend struct {
 	// MaxTotalTimelock is the maximum total time lock a route is allowed to
 	// have.
 	MaxTotalTimelock uint32
+
+	// DefaultFinalCLTVDelta is the final CLTV delta used for route
+	// queries and payments that don't specify one. Payment requests
+	// without an explicit delta keep the default implied by the
+	// specification, as that is what the payee expects. If zero,
+	// zpay32.DefaultFinalCLTVDelta is used.
+	DefaultFinalCLTVDelta uint16
+
+	// HopTimeLockDelta is the per-hop time lock delta assumed when
+	// deriving a CLTV limit from a payment request's final CLTV delta.
+	// It is expected to be the default forwarding time lock delta. If
+	// zero, no tighter limit is derived and the maximum is used.
+	HopTimeLockDelta uint32
+
+	// AttemptCost is the fixed part of the virtual cost of a failed
+	// payment attempt. Together with AttemptCostPPM, it is combined with
+	// the success probability of a route into an expected cost score.
+	AttemptCost lnwire.MilliAtom
+
+	// AttemptCostPPM is the proportional part of the virtual cost of a
+	// failed payment attempt, expressed in parts per million of the
+	// payment amount.
+	AttemptCostPPM int64
+
+	// MaxRouteHints is the maximum number of route hints a payment may
+	// carry, counting both those of the payment request and those passed
+	// along with the rpc request. Zero means no limit.
+	MaxRouteHints int
+
+	// MaxRouteHintHops is the maximum number of hops a single route hint
+	// may contain. Zero means no limit.
+	MaxRouteHintHops int
+
+	// AllowCircularRoute indicates whether user supplied routes are
+	// allowed to end at our own node, as is done for circular
+	// rebalances.
+	AllowCircularRoute bool
+
+	// SendOnChain sends the given amount to an on-chain address using the
+	// wallet and returns the hash of the published transaction.
+	SendOnChain func(addr dcrutil.Address,
+		amt dcrutil.Amount) (*chainhash.Hash, error)
 }
 
 // MissionControl defines the mission control dependencies of routerrpc.
@@ -76,6 +135,29 @@ type MissionControl interface {
 	// GetHistorySnapshot takes a snapshot from the current mission control
 	// state and actual probability estimates.
 	GetHistorySnapshot() *routing.MissionControlSnapshot
+
+	// ImportHistory merges a previously obtained history snapshot into the
+	// mission control state. More recent data is preferred per node and
+	// pair, unless force is set.
+	ImportHistory(snapshot *routing.MissionControlSnapshot,
+		force bool) error
+}
+
+// defaultFinalCLTVDelta returns the final CLTV delta to use when none is
+// specified. A configured default must leave room within the maximum total
+// time lock.
+func (r *RouterBackend) defaultFinalCLTVDelta() (uint16, error) {
+	if r.DefaultFinalCLTVDelta == 0 {
+		return zpay32.DefaultFinalCLTVDelta, nil
+	}
+
+	if uint32(r.DefaultFinalCLTVDelta) > r.MaxTotalTimelock {
+		return 0, fmt.Errorf("default final cltv delta %v exceeds "+
+			"max total timelock %v", r.DefaultFinalCLTVDelta,
+			r.MaxTotalTimelock)
+	}
+
+	return r.DefaultFinalCLTVDelta, nil
 }
 
 // QueryRoutes attempts to query the daemons' Channel Router for a possible
@@ -170,6 +252,15 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		ignoredPairs[pair] = struct{}{}
 	}
 
+	if in.TimePref < -1 || in.TimePref > 1 {
+		return nil, fmt.Errorf("time_pref %v out of range [-1, 1]",
+			in.TimePref)
+	}
+
+	if in.MinChannelCapacity < 0 {
+		return nil, errors.New("min_channel_capacity cannot be negative")
+	}
+
 	// Since QueryRoutes allows having a different source other than
 	// ourselves, we'll only apply our max time lock if we are the source.
 	maxTotalTimelock := r.MaxTotalTimelock
@@ -184,13 +275,20 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	// We need to subtract the final delta before passing it into path
 	// finding. The optimal path is independent of the final cltv delta and
 	// the path finding algorithm is unaware of this value.
-	finalCLTVDelta := uint16(zpay32.DefaultFinalCLTVDelta)
+	var finalCLTVDelta uint16
 	if in.FinalCltvDelta != 0 {
 		finalCLTVDelta = uint16(in.FinalCltvDelta)
+	} else {
+		finalCLTVDelta, err = r.defaultFinalCLTVDelta()
+		if err != nil {
+			return nil, err
+		}
 	}
 	cltvLimit -= uint32(finalCLTVDelta)
 
-	var destTLV map[uint64][]byte
+	// Any TLV records destined for the final hop require the destination
+	// to understand TLV payloads.
+	destTLV := in.DestTlv
 	restrictions := &routing.RestrictParams{
 		FeeLimit: feeLimit,
 		ProbabilitySource: func(fromNode, toNode route.Vertex,
@@ -218,6 +316,27 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		},
 		DestPayloadTLV: len(destTLV) != 0,
 		CltvLimit:      cltvLimit,
+		TimePreference: in.TimePref,
+		MinChannelCapacity: dcrutil.Amount(
+			in.MinChannelCapacity,
+		),
+		Cancel: ctx.Done(),
+	}
+
+	// Pass along a last hop restriction if specified.
+	if len(in.LastHopPubkey) > 0 {
+		lastHop, err := route.NewVertexFromBytes(in.LastHopPubkey)
+		if err != nil {
+			return nil, err
+		}
+		restrictions.LastHop = &lastHop
+	}
+
+	// If requested, let path finding trade off fees against probability
+	// using our configured attempt cost for this amount.
+	if in.RankByExpectedCost {
+		attemptCost := r.attemptCost(amtMSat)
+		restrictions.PaymentAttemptPenalty = &attemptCost
 	}
 
 	// If we have any TLV records destined for the final hop, then we'll
@@ -236,9 +355,28 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		destTlvRecords, finalCLTVDelta,
 	)
 	if err != nil {
+		// If path finding was aborted because the client went away
+		// or its deadline passed, report that instead.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		return nil, err
 	}
 
+	// If a last hop was requested, make sure the route that was found
+	// actually enters the destination through it.
+	if restrictions.LastHop != nil {
+		lastHop := route.SourcePubKey
+		if len(route.Hops) > 1 {
+			lastHop = route.Hops[len(route.Hops)-2].PubKeyBytes
+		}
+		if lastHop != *restrictions.LastHop {
+			return nil, fmt.Errorf("route last hop %v doesn't match "+
+				"requested last hop %v", lastHop,
+				*restrictions.LastHop)
+		}
+	}
+
 	// For each valid route, we'll convert the result into the format
 	// required by the RPC system.
 	rpcRoute, err := r.MarshallRoute(route)
@@ -256,9 +394,54 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		SuccessProb: successProb,
 	}
 
+	if in.RankByExpectedCost {
+		expectedCost := r.expectedCost(route, successProb)
+		if !math.IsInf(expectedCost, 1) {
+			routeResp.ExpectedCostMAtoms = int64(expectedCost)
+		}
+	}
+
 	return routeResp, nil
 }
 
+// EstimateRouteFeeAndProbability finds a single route from our node to the
+// target that is able to carry the given amount, and returns the fees paid
+// along that route together with its success probability according to mission
+// control.
+func (r *RouterBackend) EstimateRouteFeeAndProbability(target route.Vertex,
+	amt lnwire.MilliAtom) (lnwire.MilliAtom, float64, error) {
+
+	if amt == 0 {
+		return 0, 0, errors.New("amount must be specified")
+	}
+
+	finalCLTVDelta, err := r.defaultFinalCLTVDelta()
+	if err != nil {
+		return 0, 0, err
+	}
+	if uint32(finalCLTVDelta) > r.MaxTotalTimelock {
+		return 0, 0, fmt.Errorf("final cltv delta %v exceeds max "+
+			"total timelock %v", finalCLTVDelta,
+			r.MaxTotalTimelock)
+	}
+
+	restrictions := &routing.RestrictParams{
+		FeeLimit:          calculateFeeLimit(nil, amt),
+		ProbabilitySource: r.MissionControl.GetProbability,
+		CltvLimit:         r.MaxTotalTimelock - uint32(finalCLTVDelta),
+	}
+
+	route, err := r.FindRoute(
+		r.SelfNode, target, amt, restrictions, nil, finalCLTVDelta,
+	)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to find route to %v: %v",
+			target, err)
+	}
+
+	return route.TotalFees(), r.getSuccessProbability(route), nil
+}
+
 // getSuccessProbability returns the success probability for the given route
 // based on the current state of mission control.
 func (r *RouterBackend) getSuccessProbability(rt *route.Route) float64 {
@@ -281,6 +464,29 @@ func (r *RouterBackend) getSuccessProbability(rt *route.Route) float64 {
 	return successProb
 }
 
+// attemptCost returns the virtual cost of a failed payment attempt for the
+// given amount.
+func (r *RouterBackend) attemptCost(amt lnwire.MilliAtom) lnwire.MilliAtom {
+	return r.AttemptCost + amt*lnwire.MilliAtom(r.AttemptCostPPM)/1e6
+}
+
+// expectedCost returns the expected cost in milli-atoms of paying along the
+// given route. On average 1/successProb attempts are required to complete the
+// payment, each of which incurs the attempt cost, on top of the route fees
+// that are paid once. A route with a zero success probability has an infinite
+// expected cost.
+func (r *RouterBackend) expectedCost(rt *route.Route,
+	successProb float64) float64 {
+
+	if successProb <= 0 {
+		return math.Inf(1)
+	}
+
+	attemptCost := r.attemptCost(rt.TotalAmount - rt.TotalFees())
+
+	return float64(rt.TotalFees()) + float64(attemptCost)/successProb
+}
+
 // rpcEdgeToPair looks up the provided channel and returns the channel endpoints
 // as a directed pair.
 func (r *RouterBackend) rpcEdgeToPair(e *lnrpc.EdgeLocator) (
@@ -324,6 +530,26 @@ func calculateFeeLimit(feeLimit *lnrpc.FeeLimit,
 
 // MarshallRoute marshalls an internal route to an rpc route struct.
 func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error) {
+	return r.marshallRoute(route, false)
+}
+
+// MarshallRouteLite marshalls an internal route to an rpc route struct
+// without querying the channel graph for the capacity of each hop's channel.
+// The capacity of every hop is reported as zero, with CapacityUnknown set.
+// This saves a graph lookup per hop for callers that don't need the capacity
+// information.
+func (r *RouterBackend) MarshallRouteLite(route *route.Route) (*lnrpc.Route,
+	error) {
+
+	return r.marshallRoute(route, true)
+}
+
+// marshallRoute marshalls an internal route to an rpc route struct. If
+// skipCapacity is true, the channel capacity of the hops won't be looked up in
+// the graph and is reported as unknown.
+func (r *RouterBackend) marshallRoute(route *route.Route,
+	skipCapacity bool) (*lnrpc.Route, error) {
+
 	resp := &lnrpc.Route{
 		TotalTimeLock:   route.TotalTimeLock,
 		TotalFees:       int64(route.TotalFees().ToAtoms()),
@@ -338,13 +564,26 @@ func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error)
 
 		// Channel capacity is not a defining property of a route. For
 		// backwards RPC compatibility, we retrieve it here from the
-		// graph.
-		chanCapacity, err := r.FetchChannelCapacity(hop.ChannelID)
-		if err != nil {
-			// If capacity cannot be retrieved, this may be a
-			// not-yet-received or private channel. Then report
-			// amount that is sent through the channel as capacity.
-			chanCapacity = incomingAmt.ToAtoms()
+		// graph unless the caller requested to skip the lookup.
+		var (
+			chanCapacity    dcrutil.Amount
+			capacityUnknown = skipCapacity
+		)
+		if !skipCapacity {
+			var err error
+			chanCapacity, err = r.FetchChannelCapacity(
+				hop.ChannelID,
+			)
+			if err != nil {
+				// If capacity cannot be retrieved, this may be
+				// a not-yet-received or private channel taken
+				// from a route hint. Then report amount that
+				// is sent through the channel as capacity and
+				// flag it so clients don't mistake it for the
+				// real capacity.
+				chanCapacity = incomingAmt.ToAtoms()
+				capacityUnknown = true
+			}
 		}
 
 		resp.Hops[i] = &lnrpc.Hop{
@@ -358,7 +597,8 @@ func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error)
 			PubKey: hex.EncodeToString(
 				hop.PubKeyBytes[:],
 			),
-			TlvPayload: !hop.LegacyPayload,
+			TlvPayload:      !hop.LegacyPayload,
+			CapacityUnknown: capacityUnknown,
 		}
 		incomingAmt = hop.AmtToForward
 	}
@@ -366,6 +606,109 @@ func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error)
 	return resp, nil
 }
 
+// FetchPaymentRoute returns the route over which the payment with the given
+// hash succeeded. The route is retrieved from the attempt that was recorded by
+// the control tower, so it is available for any payment that completed
+// successfully, also across restarts.
+func (r *RouterBackend) FetchPaymentRoute(hash lntypes.Hash) (*lnrpc.Route,
+	error) {
+
+	inFlight, resultChan, err := r.Tower.SubscribePayment(hash)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Tower.UnsubscribePayment(hash, resultChan)
+
+	// If the payment is still in flight, the route that will eventually
+	// succeed isn't known yet.
+	if inFlight {
+		return nil, fmt.Errorf("payment %v is still in flight", hash)
+	}
+
+	// For completed payments, the result is available right away.
+	result := <-resultChan
+	if !result.Success {
+		return nil, fmt.Errorf("payment %v failed: %v", hash,
+			result.FailureReason)
+	}
+
+	return r.MarshallRoute(result.Route)
+}
+
+// FallbackOnChainSend pays the given payment request on-chain, to the first
+// of its fallback addresses. It is meant to be used once paying the request
+// over Lightning repeatedly failed, and returns the hash of the transaction
+// that was published.
+func (r *RouterBackend) FallbackOnChainSend(payReq string) (*chainhash.Hash,
+	error) {
+
+	decoded, err := zpay32.Decode(payReq, r.ActiveNetParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// Don't pay an invoice that already expired, as the payee may no
+	// longer be watching the fallback address.
+	if err := ValidatePayReqExpiry(decoded); err != nil {
+		return nil, err
+	}
+
+	fallbackAddrs := decoded.FallbackAddrs()
+	if len(fallbackAddrs) == 0 {
+		return nil, errors.New("payment request has no fallback " +
+			"address")
+	}
+
+	// On-chain payments can't carry sub-atom amounts, so they are only
+	// made if the full amount can be paid.
+	if decoded.MilliAt == nil || *decoded.MilliAt == 0 {
+		return nil, errors.New("amount must be specified to pay a " +
+			"fallback address")
+	}
+	amt := *decoded.MilliAt
+	if amt != lnwire.NewMAtomsFromAtoms(amt.ToAtoms()) {
+		return nil, fmt.Errorf("amount %v can't be paid on-chain", amt)
+	}
+
+	if r.SendOnChain == nil {
+		return nil, errors.New("on-chain sends not supported")
+	}
+
+	// Make sure we never pay twice. The request may only be paid
+	// on-chain if no payment over Lightning was attempted for it, or if
+	// that payment terminally failed.
+	if err := r.checkNoLightningPayment(*decoded.PaymentHash); err != nil {
+		return nil, err
+	}
+
+	return r.SendOnChain(fallbackAddrs[0], amt.ToAtoms())
+}
+
+// checkNoLightningPayment returns an error if the control tower knows of a
+// payment for the given hash that is either still in flight or succeeded.
+func (r *RouterBackend) checkNoLightningPayment(hash lntypes.Hash) error {
+	inFlight, resultChan, err := r.Tower.SubscribePayment(hash)
+	switch {
+	case err == channeldb.ErrPaymentNotInitiated:
+		return nil
+
+	case err != nil:
+		return err
+	}
+	defer r.Tower.UnsubscribePayment(hash, resultChan)
+
+	if inFlight {
+		return fmt.Errorf("payment %v is still in flight", hash)
+	}
+
+	result := <-resultChan
+	if result.Success {
+		return fmt.Errorf("payment %v already succeeded", hash)
+	}
+
+	return nil
+}
+
 // UnmarshallHopByChannelLookup unmarshalls an rpc hop for which the pub key is
 // not known. This function will query the channel graph with channel id to
 // retrieve both endpoints and determine the hop pubkey using the previous hop
@@ -459,6 +802,16 @@ func (r *RouterBackend) UnmarshallRoute(rpcroute *lnrpc.Route) (
 		prevNodePubKey = routeHop.PubKeyBytes
 	}
 
+	err := validateRouteLoops(r.SelfNode, hops, r.AllowCircularRoute)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.validateRouteTimeLocks(rpcroute.TotalTimeLock, hops)
+	if err != nil {
+		return nil, err
+	}
+
 	route, err := route.NewRouteFromHops(
 		lnwire.MilliAtom(rpcroute.TotalAmtMAtoms),
 		rpcroute.TotalTimeLock,
@@ -472,6 +825,112 @@ func (r *RouterBackend) UnmarshallRoute(rpcroute *lnrpc.Route) (
 	return route, nil
 }
 
+// validateRouteLoops checks that a user supplied route doesn't visit the same
+// node more than once, which would result in an invalid onion. The only
+// exception is a route that ends at the source node itself, which is allowed
+// if allowCircular is set.
+func validateRouteLoops(source route.Vertex, hops []*route.Hop,
+	allowCircular bool) error {
+
+	visited := map[route.Vertex]int{
+		source: -1,
+	}
+	for i, hop := range hops {
+		prevIdx, ok := visited[hop.PubKeyBytes]
+		if !ok {
+			visited[hop.PubKeyBytes] = i
+			continue
+		}
+
+		// A route back to ourselves is a circular rebalance as long as
+		// we're the final hop.
+		isLast := i == len(hops)-1
+		if isLast && hop.PubKeyBytes == source {
+			if allowCircular {
+				continue
+			}
+
+			return fmt.Errorf("route ends at source node %v, but "+
+				"circular routes are not allowed", source)
+		}
+
+		if prevIdx == -1 {
+			return fmt.Errorf("route loops back to source node "+
+				"%v at hop %v", source, i)
+		}
+
+		return fmt.Errorf("route visits node %v twice, at hop %v and "+
+			"hop %v", hop.PubKeyBytes, prevIdx, i)
+	}
+
+	return nil
+}
+
+// validateRouteTimeLocks checks that the time locks of a user supplied route
+// are consistent. Every forwarding hop must have an outgoing time lock below
+// the time lock of the htlc it receives, by at least the time lock delta it
+// advertised for its outgoing channel if that policy is known. The time lock
+// delta of the route as a whole must not exceed MaxTotalTimelock, if set.
+func (r *RouterBackend) validateRouteTimeLocks(totalTimeLock uint32,
+	hops []*route.Hop) error {
+
+	if len(hops) == 0 {
+		return nil
+	}
+
+	incomingTimeLock := totalTimeLock
+	for i, hop := range hops {
+		// The final hop doesn't forward the htlc. Its outgoing time
+		// lock is the final cltv expiry, which may not exceed the
+		// time lock of the htlc it receives.
+		if i == len(hops)-1 {
+			if hop.OutgoingTimeLock > incomingTimeLock {
+				return fmt.Errorf("invalid time lock at final "+
+					"hop: outgoing time lock %v exceeds "+
+					"incoming time lock %v",
+					hop.OutgoingTimeLock, incomingTimeLock)
+			}
+			break
+		}
+
+		if hop.OutgoingTimeLock >= incomingTimeLock {
+			return fmt.Errorf("invalid time lock at hop %v: "+
+				"outgoing time lock %v isn't below incoming "+
+				"time lock %v", i, hop.OutgoingTimeLock,
+				incomingTimeLock)
+		}
+
+		// The delta must also satisfy the policy of the channel the
+		// hop forwards over. Channels that aren't in the graph, such
+		// as private channels from route hints, can't be checked.
+		delta := incomingTimeLock - hop.OutgoingTimeLock
+		if r.FetchChannelTimeLockDelta != nil {
+			minDelta, err := r.FetchChannelTimeLockDelta(
+				hops[i+1].ChannelID, hop.PubKeyBytes,
+			)
+			if err == nil && delta < uint32(minDelta) {
+				return fmt.Errorf("invalid time lock at hop "+
+					"%v: time lock delta %v is below the "+
+					"channel's time lock delta %v", i,
+					delta, minDelta)
+			}
+		}
+
+		incomingTimeLock = hop.OutgoingTimeLock
+	}
+
+	// The outgoing time lock of the final hop is the final cltv expiry,
+	// so the difference with the total time lock is the part of the time
+	// lock that is accumulated along the route.
+	routeDelta := totalTimeLock - hops[len(hops)-1].OutgoingTimeLock
+	if r.MaxTotalTimelock != 0 && routeDelta > r.MaxTotalTimelock {
+		return fmt.Errorf("route time lock delta of %v exceeds "+
+			"maximum of %v", routeDelta, r.MaxTotalTimelock)
+	}
+
+	return nil
+}
+
 // extractIntentFromSendRequest attempts to parse the SendRequest details
 // required to dispatch a client from the information presented by an RPC
 // client.
@@ -480,8 +939,29 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 
 	payIntent := &routing.LightningPayment{}
 
-	// Pass along an outgoing channel restriction if specified.
-	if rpcPayReq.OutgoingChanId != 0 {
+	// Pass along an outgoing channel restriction if specified. A channel
+	// point takes precedence over a channel id, as it unambiguously
+	// identifies the local channel.
+	switch {
+	case rpcPayReq.OutgoingChanPoint != nil:
+		chanID, err := r.resolveOutgoingChanPoint(
+			rpcPayReq.OutgoingChanPoint,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if rpcPayReq.OutgoingChanId != 0 &&
+			rpcPayReq.OutgoingChanId != chanID {
+
+			return nil, errors.New("outgoing_chan_id and " +
+				"outgoing_chan_point refer to different " +
+				"channels")
+		}
+
+		payIntent.OutgoingChannelID = &chanID
+
+	case rpcPayReq.OutgoingChanId != 0:
 		payIntent.OutgoingChannelID = &rpcPayReq.OutgoingChanId
 	}
 
@@ -516,6 +996,13 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	payIntent.PayAttemptTimeout = time.Second *
 		time.Duration(rpcPayReq.TimeoutSeconds)
 
+	// Unmarshall the requested amount, which may be specified either in
+	// atoms or in milli-atoms.
+	reqAmt, err := unmarshallAmt(rpcPayReq.Amt, rpcPayReq.AmtMAtoms)
+	if err != nil {
+		return nil, err
+	}
+
 	// Route hints.
 	routeHints, err := unmarshallRouteHints(
 		rpcPayReq.RouteHints,
@@ -544,6 +1031,9 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 				"cannot appear together")
 		}
 
+		// A payment request for another network is rejected with a
+		// zpay32.ErrWrongNetwork, which is passed on as is so the
+		// caller learns about the mismatch.
 		payReq, err := zpay32.Decode(
 			rpcPayReq.PaymentRequest, r.ActiveNetParams,
 		)
@@ -562,17 +1052,15 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		// We override the amount to pay with the amount provided from
 		// the payment request.
 		if payReq.MilliAt == nil {
-			if rpcPayReq.Amt == 0 {
+			if reqAmt == 0 {
 				return nil, errors.New("amount must be " +
 					"specified when paying a zero amount " +
 					"invoice")
 			}
 
-			payIntent.Amount = lnwire.NewMAtomsFromAtoms(
-				dcrutil.Amount(rpcPayReq.Amt),
-			)
+			payIntent.Amount = reqAmt
 		} else {
-			if rpcPayReq.Amt != 0 {
+			if reqAmt != 0 {
 				return nil, errors.New("amount must not be " +
 					"specified when paying a non-zero " +
 					" amount invoice")
@@ -586,6 +1074,15 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		copy(payIntent.Target[:], destKey)
 
 		payIntent.FinalCLTVDelta = uint16(payReq.MinFinalCLTVExpiry())
+
+		// If requested, derive a tighter CLTV limit from the final
+		// CLTV delta when the caller didn't specify one.
+		if rpcPayReq.DeriveCltvLimit && rpcPayReq.CltvLimit == 0 {
+			payIntent.CltvLimit = deriveCLTVLimit(
+				payIntent.FinalCLTVDelta, r.HopTimeLockDelta,
+				r.MaxTotalTimelock,
+			)
+		}
 		payIntent.RouteHints = append(
 			payIntent.RouteHints, payReq.RouteHints...,
 		)
@@ -606,22 +1103,54 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 			payIntent.FinalCLTVDelta =
 				uint16(rpcPayReq.FinalCltvDelta)
 		} else {
-			payIntent.FinalCLTVDelta = zpay32.DefaultFinalCLTVDelta
+			payIntent.FinalCLTVDelta, err =
+				r.defaultFinalCLTVDelta()
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		// Amount.
-		if rpcPayReq.Amt == 0 {
+		if reqAmt == 0 {
 			return nil, errors.New("amount must be specified")
 		}
 
-		payIntent.Amount = lnwire.NewMAtomsFromAtoms(
-			dcrutil.Amount(rpcPayReq.Amt),
-		)
+		payIntent.Amount = reqAmt
 
 		// Payment hash.
 		copy(payIntent.PaymentHash[:], rpcPayReq.PaymentHash)
 	}
 
+	// Bound the route hints we'll hand to path finding, as each of them
+	// adds edges to the graph it operates on.
+	if err := r.checkRouteHintLimits(payIntent.RouteHints); err != nil {
+		return nil, err
+	}
+
+	// Pass along a last hop restriction if specified.
+	if len(rpcPayReq.LastHopPubkey) > 0 {
+		lastHop, err := route.NewVertexFromBytes(
+			rpcPayReq.LastHopPubkey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if lastHop == payIntent.Target {
+			return nil, errors.New("last hop cannot be the " +
+				"payment destination")
+		}
+
+		payIntent.LastHop = &lastHop
+	}
+
+	// A payment to ourselves is a circular rebalance, which only makes
+	// sense when the channel the route comes back in through is pinned.
+	if payIntent.Target == r.SelfNode && payIntent.LastHop == nil {
+		return nil, errors.New("last_hop_pubkey must be specified " +
+			"when paying to self")
+	}
+
 	// Currently, within the bootstrap phase of the network, we limit the
 	// largest payment size allotted to (2^32) - 1 mSAT or 4.29 million
 	// satoshis.
@@ -637,23 +1166,183 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	return payIntent, nil
 }
 
-// unmarshallRouteHints unmarshalls a list of route hints.
+// checkRouteHintLimits returns an error if the passed route hints exceed the
+// number of route hints or hops per route hint allowed by the backend.
+func (r *RouterBackend) checkRouteHintLimits(
+	routeHints [][]zpay32.HopHint) error {
+
+	if r.MaxRouteHints > 0 && len(routeHints) > r.MaxRouteHints {
+		return fmt.Errorf("too many route hints: %d, max allowed is %d",
+			len(routeHints), r.MaxRouteHints)
+	}
+
+	if r.MaxRouteHintHops <= 0 {
+		return nil
+	}
+	for i, routeHint := range routeHints {
+		if len(routeHint) > r.MaxRouteHintHops {
+			return fmt.Errorf("route hint %d has too many hops: "+
+				"%d, max allowed is %d", i, len(routeHint),
+				r.MaxRouteHintHops)
+		}
+	}
+
+	return nil
+}
+
+// unmarshallAmt returns the payment amount specified either in atoms or in
+// milli-atoms. If both are set, they must specify the same amount.
+func unmarshallAmt(amtAtoms, amtMAtoms int64) (lnwire.MilliAtom, error) {
+	if amtAtoms < 0 || amtMAtoms < 0 {
+		return 0, errors.New("amount cannot be negative")
+	}
+
+	amtFromAtoms := lnwire.NewMAtomsFromAtoms(dcrutil.Amount(amtAtoms))
+
+	switch {
+	case amtMAtoms == 0:
+		return amtFromAtoms, nil
+
+	case amtAtoms != 0 && amtFromAtoms != lnwire.MilliAtom(amtMAtoms):
+		return 0, errors.New("amt and amt_m_atoms specify different " +
+			"amounts")
+
+	default:
+		return lnwire.MilliAtom(amtMAtoms), nil
+	}
+}
+
+// resolveOutgoingChanPoint returns the short channel id of the local channel
+// identified by the given rpc channel point.
+func (r *RouterBackend) resolveOutgoingChanPoint(
+	rpcChanPoint *lnrpc.ChannelPoint) (uint64, error) {
+
+	chanPoint, err := unmarshallChanPoint(rpcChanPoint)
+	if err != nil {
+		return 0, err
+	}
+
+	if r.FetchLocalChannelID == nil {
+		return 0, errors.New("outgoing channel point resolution " +
+			"not supported")
+	}
+
+	chanID, err := r.FetchLocalChannelID(*chanPoint)
+	if err != nil {
+		return 0, fmt.Errorf("unable to find outgoing channel %v: %v",
+			chanPoint, err)
+	}
+
+	// Without a confirmed funding transaction, the channel has no short
+	// channel id the router could restrict the first hop to.
+	if chanID == 0 {
+		return 0, fmt.Errorf("outgoing channel %v has no short "+
+			"channel id", chanPoint)
+	}
+
+	return chanID, nil
+}
+
+// unmarshallChanPoint unmarshalls an rpc channel point into an outpoint.
+func unmarshallChanPoint(rpcChanPoint *lnrpc.ChannelPoint) (*wire.OutPoint,
+	error) {
+
+	var txid []byte
+
+	// A channel point's funding txid can be set as a byte slice or a
+	// string. In the case it is a string, decode it.
+	switch rpcChanPoint.GetFundingTxid().(type) {
+	case *lnrpc.ChannelPoint_FundingTxidBytes:
+		txid = rpcChanPoint.GetFundingTxidBytes()
+	case *lnrpc.ChannelPoint_FundingTxidStr:
+		h, err := chainhash.NewHashFromStr(
+			rpcChanPoint.GetFundingTxidStr(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		txid = h[:]
+	}
+
+	hash, err := chainhash.NewHash(txid)
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewOutPoint(
+		hash, rpcChanPoint.OutputIndex, wire.TxTreeRegular,
+	), nil
+}
+
+// hopHintKey uniquely identifies a hop hint by the node it originates from
+// and the channel it describes.
+type hopHintKey struct {
+	nodeID    [33]byte
+	channelID uint64
+}
+
+// unmarshallRouteHints unmarshalls a list of route hints. Hop hints repeated
+// within the same route hint are dropped, as are route hints which are
+// identical to a previous one. A route hint in which the same channel is
+// attributed to different nodes is rejected, as it can't describe a valid
+// path.
 func unmarshallRouteHints(rpcRouteHints []*lnrpc.RouteHint) (
 	[][]zpay32.HopHint, error) {
 
 	routeHints := make([][]zpay32.HopHint, 0, len(rpcRouteHints))
-	for _, rpcRouteHint := range rpcRouteHints {
+	seenRoutes := make(map[string]struct{}, len(rpcRouteHints))
+	for i, rpcRouteHint := range rpcRouteHints {
 		routeHint := make(
 			[]zpay32.HopHint, 0, len(rpcRouteHint.HopHints),
 		)
-		for _, rpcHint := range rpcRouteHint.HopHints {
+
+		var routeKey bytes.Buffer
+		seenHops := make(map[hopHintKey]struct{})
+		chanNodes := make(map[uint64][33]byte)
+		for j, rpcHint := range rpcRouteHint.HopHints {
 			hint, err := unmarshallHopHint(rpcHint)
 			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("invalid hop hint %d of "+
+					"route hint %d: %v", j, i, err)
+			}
+
+			var key hopHintKey
+			copy(key.nodeID[:], hint.NodeID.SerializeCompressed())
+			key.channelID = hint.ChannelID
+
+			// The same channel can't originate from two different
+			// nodes within a single route.
+			nodeID, ok := chanNodes[hint.ChannelID]
+			if ok && nodeID != key.nodeID {
+				return nil, fmt.Errorf("route hint %d has "+
+					"conflicting hop hints for channel %v: "+
+					"node %x vs %x", i,
+					lnwire.NewShortChanIDFromInt(
+						hint.ChannelID,
+					), nodeID, key.nodeID)
+			}
+			chanNodes[hint.ChannelID] = key.nodeID
+
+			if _, ok := seenHops[key]; ok {
+				continue
 			}
+			seenHops[key] = struct{}{}
+
+			var chanID [8]byte
+			binary.BigEndian.PutUint64(chanID[:], key.channelID)
+			routeKey.Write(key.nodeID[:])
+			routeKey.Write(chanID[:])
 
 			routeHint = append(routeHint, hint)
 		}
+
+		// Skip route hints we've already added.
+		if _, ok := seenRoutes[routeKey.String()]; ok {
+			continue
+		}
+		seenRoutes[routeKey.String()] = struct{}{}
+
 		routeHints = append(routeHints, routeHint)
 	}
 
@@ -664,12 +1353,21 @@ func unmarshallRouteHints(rpcRouteHints []*lnrpc.RouteHint) (
 func unmarshallHopHint(rpcHint *lnrpc.HopHint) (zpay32.HopHint, error) {
 	pubBytes, err := hex.DecodeString(rpcHint.NodeId)
 	if err != nil {
-		return zpay32.HopHint{}, err
+		return zpay32.HopHint{}, fmt.Errorf("node id %q is not "+
+			"valid hex: %v", rpcHint.NodeId, err)
+	}
+
+	if len(pubBytes) != secp256k1.PubKeyBytesLenCompressed {
+		return zpay32.HopHint{}, fmt.Errorf("node id %q must be a "+
+			"%d byte compressed public key, got %d bytes",
+			rpcHint.NodeId, secp256k1.PubKeyBytesLenCompressed,
+			len(pubBytes))
 	}
 
 	pubkey, err := secp256k1.ParsePubKey(pubBytes)
 	if err != nil {
-		return zpay32.HopHint{}, err
+		return zpay32.HopHint{}, fmt.Errorf("node id %q is not a "+
+			"valid public key: %v", rpcHint.NodeId, err)
 	}
 
 	return zpay32.HopHint{
@@ -684,15 +1382,32 @@ func unmarshallHopHint(rpcHint *lnrpc.HopHint) (zpay32.HopHint, error) {
 // ValidatePayReqExpiry checks if the passed payment request has expired. In
 // the case it has expired, an error will be returned.
 func ValidatePayReqExpiry(payReq *zpay32.Invoice) error {
-	expiry := payReq.Expiry()
-	validUntil := payReq.Timestamp.Add(expiry)
-	if time.Now().After(validUntil) {
-		return fmt.Errorf("invoice expired. Valid until %v", validUntil)
+	summary := payReq.Summary()
+	if summary.Expired {
+		return fmt.Errorf("invoice expired. Valid until %v",
+			summary.ExpiresAt)
 	}
 
 	return nil
 }
 
+// deriveCLTVLimit returns a CLTV limit that leaves room for the final CLTV
+// delta and a maximum length route in which every hop requires the given time
+// lock delta. The result is capped by the given maximum, which is also
+// returned if the hop delta is unknown.
+func deriveCLTVLimit(finalCLTVDelta uint16, hopDelta, max uint32) uint32 {
+	if hopDelta == 0 {
+		return max
+	}
+
+	limit := uint32(finalCLTVDelta) + routing.HopLimit*hopDelta
+	if limit > max {
+		return max
+	}
+
+	return limit
+}
+
 // ValidateCLTVLimit returns a valid CLTV limit given a value and a maximum. If
 // the value exceeds the maximum, then an error is returned. If the value is 0,
 // then the maximum is used.
@@ -4,13 +4,27 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"math"
+	"os"
 	"testing"
+	"time"
 
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
 	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/routing"
 	"github.com/decred/dcrlnd/routing/route"
 	"github.com/decred/dcrlnd/tlv"
+	"github.com/decred/dcrlnd/zpay32"
+	"github.com/golang/protobuf/proto"
 
 	"github.com/decred/dcrlnd/lnrpc"
 )
@@ -74,6 +88,8 @@ func testQueryRoutes(t *testing.T, useMissionControl bool) {
 			To:   node2[:],
 		}},
 		UseMissionControl: useMissionControl,
+		LastHopPubkey:     node1[:],
+		TimePref:          0.5,
 	}
 
 	findRoute := func(source, target route.Vertex,
@@ -97,6 +113,14 @@ func testQueryRoutes(t *testing.T, useMissionControl bool) {
 			t.Fatal("unexpected fee limit")
 		}
 
+		if restrictions.LastHop == nil || *restrictions.LastHop != node1 {
+			t.Fatal("unexpected last hop")
+		}
+
+		if restrictions.TimePreference != 0.5 {
+			t.Fatal("unexpected time preference")
+		}
+
 		if restrictions.ProbabilitySource(route.Vertex{2},
 			route.Vertex{1}, 0,
 		) != 0 {
@@ -123,7 +147,10 @@ func testQueryRoutes(t *testing.T, useMissionControl bool) {
 			t.Fatal("expecting 100% probability")
 		}
 
-		hops := []*route.Hop{{}}
+		hops := []*route.Hop{
+			{PubKeyBytes: node1},
+			{PubKeyBytes: target},
+		}
 		return route.NewRouteFromHops(amt, 144, source, hops)
 	}
 
@@ -174,3 +201,1506 @@ func (m *mockMissionControl) ResetHistory() error {
 func (m *mockMissionControl) GetHistorySnapshot() *routing.MissionControlSnapshot {
 	return nil
 }
+
+func (m *mockMissionControl) ImportHistory(
+	snapshot *routing.MissionControlSnapshot, force bool) error {
+
+	return nil
+}
+
+// TestMarshallRouteLite asserts that marshalling a route in lite mode doesn't
+// query the graph for channel capacities, while the default mode does.
+func TestMarshallRouteLite(t *testing.T) {
+	var capacityCalls int
+	backend := &RouterBackend{
+		FetchChannelCapacity: func(chanID uint64) (
+			dcrutil.Amount, error) {
+
+			capacityCalls++
+			return 1000, nil
+		},
+	}
+
+	hops := []*route.Hop{
+		{ChannelID: 1, PubKeyBytes: node1, AmtToForward: 2000},
+		{ChannelID: 2, PubKeyBytes: node2, AmtToForward: 1000},
+	}
+	rt, err := route.NewRouteFromHops(3000, 144, sourceKey, hops)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rpcRoute, err := backend.MarshallRouteLite(rt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if capacityCalls != 0 {
+		t.Fatalf("expected no capacity lookups, got %v", capacityCalls)
+	}
+	for i, hop := range rpcRoute.Hops {
+		if hop.ChanCapacity != 0 || !hop.CapacityUnknown {
+			t.Fatalf("expected unknown capacity for hop %v, got %v",
+				i, hop.ChanCapacity)
+		}
+	}
+
+	rpcRoute, err = backend.MarshallRoute(rt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if capacityCalls != len(hops) {
+		t.Fatalf("expected %v capacity lookups, got %v", len(hops),
+			capacityCalls)
+	}
+	for i, hop := range rpcRoute.Hops {
+		if hop.ChanCapacity != 1000 || hop.CapacityUnknown {
+			t.Fatalf("expected capacity 1000 for hop %v, got %v",
+				i, hop.ChanCapacity)
+		}
+	}
+}
+
+// TestMarshallRouteCapacityUnknown asserts that hops over channels that can't
+// be found in the graph, such as private channels from route hints, are
+// flagged as having an unknown capacity.
+func TestMarshallRouteCapacityUnknown(t *testing.T) {
+	backend := &RouterBackend{
+		FetchChannelCapacity: func(chanID uint64) (
+			dcrutil.Amount, error) {
+
+			if chanID == 2 {
+				return 0, errors.New("channel not found")
+			}
+			return 1000, nil
+		},
+	}
+
+	hops := []*route.Hop{
+		{ChannelID: 1, PubKeyBytes: node1, AmtToForward: 2000000},
+		{ChannelID: 2, PubKeyBytes: node2, AmtToForward: 1000000},
+	}
+	rt, err := route.NewRouteFromHops(3000000, 144, sourceKey, hops)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rpcRoute, err := backend.MarshallRoute(rt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rpcRoute.Hops[0].CapacityUnknown {
+		t.Fatal("expected known capacity for public channel")
+	}
+	if rpcRoute.Hops[0].ChanCapacity != 1000 {
+		t.Fatalf("expected capacity 1000, got %v",
+			rpcRoute.Hops[0].ChanCapacity)
+	}
+
+	// The private hop reports the amount sent through it as capacity.
+	if !rpcRoute.Hops[1].CapacityUnknown {
+		t.Fatal("expected unknown capacity for private channel")
+	}
+	if rpcRoute.Hops[1].ChanCapacity != 2000 {
+		t.Fatalf("expected capacity 2000, got %v",
+			rpcRoute.Hops[1].ChanCapacity)
+	}
+}
+
+// TestExtractIntentOutgoingChanPoint asserts that an outgoing channel point is
+// resolved into the outgoing channel id restriction of the payment.
+func TestExtractIntentOutgoingChanPoint(t *testing.T) {
+	const chanID = 12345
+
+	chanPoint := &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: bytes.Repeat([]byte{1}, 32),
+		},
+		OutputIndex: 1,
+	}
+
+	localChanID := uint64(chanID)
+	backend := &RouterBackend{
+		MaxPaymentMAtoms: lnwire.NewMAtomsFromAtoms(1000000),
+		MaxTotalTimelock: 1000,
+		FetchLocalChannelID: func(op wire.OutPoint) (uint64, error) {
+			if op.Index != chanPoint.OutputIndex {
+				t.Fatalf("unexpected outpoint %v", op)
+			}
+			return localChanID, nil
+		},
+	}
+
+	destNodeBytes, err := hex.DecodeString(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &SendPaymentRequest{
+		Dest:              destNodeBytes,
+		Amt:               1000,
+		PaymentHash:       make([]byte, 32),
+		TimeoutSeconds:    60,
+		OutgoingChanPoint: chanPoint,
+	}
+
+	payIntent, err := backend.extractIntentFromSendRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payIntent.OutgoingChannelID == nil ||
+		*payIntent.OutgoingChannelID != chanID {
+
+		t.Fatalf("expected outgoing channel %v", chanID)
+	}
+
+	// A channel without a short channel id must be rejected.
+	localChanID = 0
+	if _, err := backend.extractIntentFromSendRequest(req); err == nil {
+		t.Fatal("expected channel without short channel id to fail")
+	}
+	localChanID = chanID
+
+	// A conflicting channel id must be rejected.
+	req.OutgoingChanId = chanID + 1
+	if _, err := backend.extractIntentFromSendRequest(req); err == nil {
+		t.Fatal("expected conflicting outgoing channel to fail")
+	}
+
+	// Without a channel point, the channel id is used as is.
+	req.OutgoingChanPoint = nil
+	payIntent, err = backend.extractIntentFromSendRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payIntent.OutgoingChannelID == nil ||
+		*payIntent.OutgoingChannelID != chanID+1 {
+
+		t.Fatalf("expected outgoing channel %v", chanID+1)
+	}
+}
+
+// TestExtractIntentRouteHintLimits asserts that payments carrying more route
+// hints, or longer route hints, than allowed are rejected.
+func TestExtractIntentRouteHintLimits(t *testing.T) {
+	backend := &RouterBackend{
+		MaxPaymentMAtoms: lnwire.NewMAtomsFromAtoms(1000000),
+		MaxTotalTimelock: 1000,
+		MaxRouteHints:    2,
+		MaxRouteHintHops: 2,
+	}
+
+	destNodeBytes, err := hex.DecodeString(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// newRouteHints creates numHints distinct route hints of numHops hops
+	// each.
+	newRouteHints := func(numHints, numHops int) []*lnrpc.RouteHint {
+		var routeHints []*lnrpc.RouteHint
+		chanID := uint64(1)
+		for i := 0; i < numHints; i++ {
+			routeHint := &lnrpc.RouteHint{}
+			for j := 0; j < numHops; j++ {
+				routeHint.HopHints = append(
+					routeHint.HopHints, &lnrpc.HopHint{
+						NodeId: ignoreNodeKey,
+						ChanId: chanID,
+					},
+				)
+				chanID++
+			}
+			routeHints = append(routeHints, routeHint)
+		}
+		return routeHints
+	}
+
+	newRequest := func(routeHints []*lnrpc.RouteHint) *SendPaymentRequest {
+		return &SendPaymentRequest{
+			Dest:           destNodeBytes,
+			Amt:            1000,
+			PaymentHash:    make([]byte, 32),
+			TimeoutSeconds: 60,
+			RouteHints:     routeHints,
+		}
+	}
+
+	// Route hints within the limits are accepted.
+	payIntent, err := backend.extractIntentFromSendRequest(
+		newRequest(newRouteHints(2, 2)),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error for route hints within limits: %v",
+			err)
+	}
+	if len(payIntent.RouteHints) != 2 {
+		t.Fatalf("expected 2 route hints, got %d",
+			len(payIntent.RouteHints))
+	}
+
+	// Too many route hints are rejected.
+	_, err = backend.extractIntentFromSendRequest(
+		newRequest(newRouteHints(3, 1)),
+	)
+	if err == nil {
+		t.Fatal("expected too many route hints to be rejected")
+	}
+
+	// A route hint with too many hops is rejected.
+	_, err = backend.extractIntentFromSendRequest(
+		newRequest(newRouteHints(1, 3)),
+	)
+	if err == nil {
+		t.Fatal("expected too long route hint to be rejected")
+	}
+}
+
+// TestExtractIntentAmtMAtoms asserts that payment amounts can be specified
+// with milli-atom precision and that inconsistent amounts are rejected.
+func TestExtractIntentAmtMAtoms(t *testing.T) {
+	backend := &RouterBackend{
+		MaxPaymentMAtoms: lnwire.NewMAtomsFromAtoms(1000000),
+		MaxTotalTimelock: 1000,
+	}
+
+	destNodeBytes, err := hex.DecodeString(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		amt       int64
+		amtMAtoms int64
+		expAmt    lnwire.MilliAtom
+		expErr    bool
+	}{
+		{
+			name:   "atoms only",
+			amt:    1000,
+			expAmt: 1000000,
+		},
+		{
+			name:      "milli-atoms only",
+			amtMAtoms: 1000500,
+			expAmt:    1000500,
+		},
+		{
+			name:      "consistent amounts",
+			amt:       1000,
+			amtMAtoms: 1000000,
+			expAmt:    1000000,
+		},
+		{
+			name:      "inconsistent amounts",
+			amt:       1000,
+			amtMAtoms: 1000500,
+			expErr:    true,
+		},
+		{
+			name:   "no amount",
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			req := &SendPaymentRequest{
+				Dest:           destNodeBytes,
+				Amt:            test.amt,
+				AmtMAtoms:      test.amtMAtoms,
+				PaymentHash:    make([]byte, 32),
+				TimeoutSeconds: 60,
+			}
+
+			payIntent, err := backend.extractIntentFromSendRequest(
+				req,
+			)
+			if test.expErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if payIntent.Amount != test.expAmt {
+				t.Fatalf("expected amount %v, got %v",
+					test.expAmt, payIntent.Amount)
+			}
+		})
+	}
+}
+
+// newTestPayReq returns an encoded payment request for the given amount that
+// requires the given final CLTV delta. Any additional options are applied to
+// the invoice.
+func newTestPayReq(t *testing.T, amt lnwire.MilliAtom,
+	finalCLTVDelta uint64, opts ...func(*zpay32.Invoice)) string {
+
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts = append([]func(*zpay32.Invoice){
+		zpay32.Amount(amt), zpay32.Description("test"),
+		zpay32.CLTVExpiry(finalCLTVDelta),
+	}, opts...)
+	invoice, err := zpay32.NewInvoice(
+		chaincfg.RegNetParams(), [32]byte{1}, time.Now(), opts...,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	payReq, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(hash []byte) ([]byte, error) {
+			return secp256k1.SignCompact(privKey, hash, true)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return payReq
+}
+
+// TestExtractIntentDeriveCLTVLimit asserts that the CLTV limit is only derived
+// from the payment request's final CLTV delta when requested.
+func TestExtractIntentDeriveCLTVLimit(t *testing.T) {
+	const (
+		maxTotalTimelock = 5000
+		finalCLTVDelta   = 40
+		hopDelta         = 60
+	)
+
+	backend := &RouterBackend{
+		MaxPaymentMAtoms: lnwire.NewMAtomsFromAtoms(1000000),
+		MaxTotalTimelock: maxTotalTimelock,
+		ActiveNetParams:  chaincfg.RegNetParams(),
+		HopTimeLockDelta: hopDelta,
+	}
+
+	req := &SendPaymentRequest{
+		PaymentRequest: newTestPayReq(t, 1000, finalCLTVDelta),
+		TimeoutSeconds: 60,
+	}
+
+	// Without the flag, the maximum is used.
+	payIntent, err := backend.extractIntentFromSendRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payIntent.CltvLimit != maxTotalTimelock {
+		t.Fatalf("expected cltv limit %v, got %v", maxTotalTimelock,
+			payIntent.CltvLimit)
+	}
+
+	// With the flag, the limit is derived from the final CLTV delta.
+	req.DeriveCltvLimit = true
+	payIntent, err = backend.extractIntentFromSendRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expLimit := uint32(finalCLTVDelta + routing.HopLimit*hopDelta)
+	if payIntent.CltvLimit != expLimit {
+		t.Fatalf("expected cltv limit %v, got %v", expLimit,
+			payIntent.CltvLimit)
+	}
+
+	// The derived limit is capped by the maximum.
+	backend.MaxTotalTimelock = 100
+	payIntent, err = backend.extractIntentFromSendRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payIntent.CltvLimit != 100 {
+		t.Fatalf("expected cltv limit %v, got %v", 100,
+			payIntent.CltvLimit)
+	}
+}
+
+// TestDeriveCLTVLimit asserts that the derived CLTV limit leaves room for a
+// maximum length route using the given hop delta, and is capped by the
+// maximum.
+func TestDeriveCLTVLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		hopDelta uint32
+		max      uint32
+		expLimit uint32
+	}{
+		{
+			name:     "derived",
+			hopDelta: 80,
+			max:      5000,
+			expLimit: 40 + routing.HopLimit*80,
+		},
+		{
+			name:     "capped",
+			hopDelta: 80,
+			max:      100,
+			expLimit: 100,
+		},
+		{
+			name:     "unknown hop delta",
+			hopDelta: 0,
+			max:      5000,
+			expLimit: 5000,
+		},
+	}
+
+	for _, test := range tests {
+		limit := deriveCLTVLimit(40, test.hopDelta, test.max)
+		if limit != test.expLimit {
+			t.Fatalf("%v: expected cltv limit %v, got %v",
+				test.name, test.expLimit, limit)
+		}
+	}
+}
+
+// TestDefaultFinalCLTVDelta asserts that the configured default final CLTV
+// delta is used by route queries and payments that don't specify one, and that
+// zpay32's default applies when it isn't configured.
+func TestDefaultFinalCLTVDelta(t *testing.T) {
+	var finalDelta uint16
+	findRoute := func(source, target route.Vertex,
+		amt lnwire.MilliAtom, restrictions *routing.RestrictParams,
+		_ []tlv.Record,
+		finalExpiry ...uint16) (*route.Route, error) {
+
+		if len(finalExpiry) != 1 {
+			t.Fatal("expected final expiry")
+		}
+		finalDelta = finalExpiry[0]
+
+		hops := []*route.Hop{{PubKeyBytes: target}}
+		return route.NewRouteFromHops(amt, 144, source, hops)
+	}
+
+	backend := &RouterBackend{
+		MaxPaymentMAtoms: lnwire.NewMAtomsFromAtoms(1000000),
+		MaxTotalTimelock: 1000,
+		FindRoute:        findRoute,
+		SelfNode:         sourceKey,
+		FetchChannelCapacity: func(chanID uint64) (
+			dcrutil.Amount, error) {
+
+			return 1, nil
+		},
+		MissionControl: &mockMissionControl{},
+	}
+
+	queryReq := &lnrpc.QueryRoutesRequest{
+		PubKey: destKey,
+		Amt:    1000,
+	}
+	destBytes, err := hex.DecodeString(destKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payReq := &SendPaymentRequest{
+		Dest:           destBytes,
+		Amt:            1000,
+		PaymentHash:    make([]byte, 32),
+		TimeoutSeconds: 60,
+	}
+
+	assertDelta := func(expected uint16) {
+		t.Helper()
+
+		_, err := backend.QueryRoutes(context.Background(), queryReq)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if finalDelta != expected {
+			t.Fatalf("expected query final delta %v, got %v",
+				expected, finalDelta)
+		}
+
+		payIntent, err := backend.extractIntentFromSendRequest(payReq)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if payIntent.FinalCLTVDelta != expected {
+			t.Fatalf("expected payment final delta %v, got %v",
+				expected, payIntent.FinalCLTVDelta)
+		}
+	}
+
+	// Without a configured default, zpay32's default is used.
+	assertDelta(zpay32.DefaultFinalCLTVDelta)
+
+	// A configured default overrides it.
+	backend.DefaultFinalCLTVDelta = 80
+	assertDelta(80)
+
+	// A delta specified in the request takes precedence.
+	queryReq.FinalCltvDelta = 60
+	payReq.FinalCltvDelta = 60
+	assertDelta(60)
+
+	// A default that exceeds the max total timelock is rejected.
+	queryReq.FinalCltvDelta = 0
+	payReq.FinalCltvDelta = 0
+	backend.DefaultFinalCLTVDelta = 1001
+	_, err = backend.QueryRoutes(context.Background(), queryReq)
+	if err == nil {
+		t.Fatal("expected query with too large default delta to fail")
+	}
+	_, err = backend.extractIntentFromSendRequest(payReq)
+	if err == nil {
+		t.Fatal("expected payment with too large default delta to fail")
+	}
+}
+
+// TestExtractIntentSelfPayment asserts that a payment to self requires a last
+// hop to be specified, and that the last hop is passed on to the payment.
+func TestExtractIntentSelfPayment(t *testing.T) {
+	selfNode := route.Vertex{1, 2, 3}
+	lastHop := route.Vertex{4, 5, 6}
+
+	backend := &RouterBackend{
+		MaxPaymentMAtoms: lnwire.NewMAtomsFromAtoms(1000000),
+		MaxTotalTimelock: 1000,
+		SelfNode:         selfNode,
+	}
+
+	req := &SendPaymentRequest{
+		Dest:           selfNode[:],
+		Amt:            1000,
+		PaymentHash:    make([]byte, 32),
+		TimeoutSeconds: 60,
+	}
+
+	// Paying to self without a last hop isn't allowed.
+	if _, err := backend.extractIntentFromSendRequest(req); err == nil {
+		t.Fatal("expected self payment without last hop to fail")
+	}
+
+	// The last hop can't be the destination itself.
+	req.LastHopPubkey = selfNode[:]
+	if _, err := backend.extractIntentFromSendRequest(req); err == nil {
+		t.Fatal("expected last hop equal to destination to fail")
+	}
+
+	req.LastHopPubkey = lastHop[:]
+	payIntent, err := backend.extractIntentFromSendRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payIntent.Target != selfNode {
+		t.Fatalf("expected target %v, got %v", selfNode,
+			payIntent.Target)
+	}
+	if payIntent.LastHop == nil || *payIntent.LastHop != lastHop {
+		t.Fatalf("expected last hop %v, got %v", lastHop,
+			payIntent.LastHop)
+	}
+}
+
+// TestEstimateRouteFeeAndProbability asserts that the fee and success
+// probability of the route found to a reachable target are returned, and that
+// an unreachable target results in an error.
+func TestEstimateRouteFeeAndProbability(t *testing.T) {
+	const (
+		amt = lnwire.MilliAtom(100000)
+		fee = lnwire.MilliAtom(1000)
+	)
+
+	findRoute := func(source, target route.Vertex,
+		amt lnwire.MilliAtom, restrictions *routing.RestrictParams,
+		_ []tlv.Record,
+		finalExpiry ...uint16) (*route.Route, error) {
+
+		if source != sourceKey {
+			t.Fatal("unexpected source key")
+		}
+
+		if target != node2 {
+			return nil, errors.New("no path found")
+		}
+
+		hops := []*route.Hop{
+			{PubKeyBytes: node1, AmtToForward: amt},
+			{PubKeyBytes: node2, AmtToForward: amt},
+		}
+		return route.NewRouteFromHops(amt+fee, 144, source, hops)
+	}
+
+	backend := &RouterBackend{
+		MaxTotalTimelock: 1000,
+		FindRoute:        findRoute,
+		SelfNode:         sourceKey,
+		MissionControl:   &mockMissionControl{},
+	}
+
+	routeFee, prob, err := backend.EstimateRouteFeeAndProbability(
+		node2, amt,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if routeFee != fee {
+		t.Fatalf("expected fee %v, got %v", fee, routeFee)
+	}
+
+	// Both hops of the route have the mission control probability.
+	expectedProb := testMissionControlProb * testMissionControlProb
+	if prob != expectedProb {
+		t.Fatalf("expected probability %v, got %v", expectedProb, prob)
+	}
+
+	unreachable := route.Vertex{7}
+	_, _, err = backend.EstimateRouteFeeAndProbability(unreachable, amt)
+	if err == nil {
+		t.Fatal("expected unreachable target to fail")
+	}
+}
+
+// TestExpectedCost asserts that a route's expected cost combines its fees with
+// the attempt cost weighed by the success probability, so that a cheaper but
+// slightly less likely route can outrank an expensive certain one.
+func TestExpectedCost(t *testing.T) {
+	backend := &RouterBackend{
+		AttemptCost:    10000,
+		AttemptCostPPM: 1000,
+	}
+
+	// Both routes deliver 1,000,000 milli-atoms. The attempt cost is
+	// therefore 10,000 + 1,000 = 11,000 milli-atoms.
+	newTestRoute := func(fee lnwire.MilliAtom) *route.Route {
+		hops := []*route.Hop{
+			{PubKeyBytes: node1, AmtToForward: 1000000},
+			{PubKeyBytes: node2, AmtToForward: 1000000},
+		}
+		rt, err := route.NewRouteFromHops(
+			1000000+fee, 144, sourceKey, hops,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rt
+	}
+
+	cheapRoute := newTestRoute(1000)
+	expensiveRoute := newTestRoute(20000)
+
+	cheapCost := backend.expectedCost(cheapRoute, 0.9)
+	expensiveCost := backend.expectedCost(expensiveRoute, 1)
+
+	// 1,000 + 11,000/0.9 ~= 13,222 versus 20,000 + 11,000 = 31,000.
+	if cheapCost >= expensiveCost {
+		t.Fatalf("expected cheap route (%v) to outrank expensive "+
+			"route (%v)", cheapCost, expensiveCost)
+	}
+	if expensiveCost != 31000 {
+		t.Fatalf("expected cost 31000, got %v", expensiveCost)
+	}
+
+	// A route that can't succeed has an infinite expected cost.
+	if !math.IsInf(backend.expectedCost(cheapRoute, 0), 1) {
+		t.Fatal("expected infinite cost for zero probability")
+	}
+}
+
+// TestUnmarshallRouteTimeLocks asserts that routes with inconsistent time locks
+// are rejected when unmarshalled.
+func TestUnmarshallRouteTimeLocks(t *testing.T) {
+	backend := &RouterBackend{
+		SelfNode:         sourceKey,
+		MaxTotalTimelock: 1000,
+	}
+
+	newRPCRoute := func(totalTimeLock uint32,
+		expiries ...uint32) *lnrpc.Route {
+
+		rpcRoute := &lnrpc.Route{
+			TotalTimeLock:  totalTimeLock,
+			TotalAmtMAtoms: 1000,
+		}
+		// Use distinct nodes for each hop, so the route doesn't
+		// contain a loop.
+		pubKeys := []string{ignoreNodeKey, destKey}
+		for i, expiry := range expiries {
+			rpcRoute.Hops = append(rpcRoute.Hops, &lnrpc.Hop{
+				ChanId:             1,
+				PubKey:             pubKeys[i],
+				Expiry:             expiry,
+				AmtToForwardMAtoms: 1000,
+			})
+		}
+		return rpcRoute
+	}
+
+	// A route with decreasing time locks is accepted.
+	_, err := backend.UnmarshallRoute(newRPCRoute(340, 240, 140))
+	if err != nil {
+		t.Fatalf("unexpected error for valid route: %v", err)
+	}
+
+	// A route where the time locks are inverted is rejected.
+	_, err = backend.UnmarshallRoute(newRPCRoute(340, 140, 240))
+	if err == nil {
+		t.Fatal("expected error for inverted time locks")
+	}
+
+	// A first hop time lock exceeding the total time lock is rejected.
+	_, err = backend.UnmarshallRoute(newRPCRoute(340, 440, 140))
+	if err == nil {
+		t.Fatal("expected error for time lock exceeding total")
+	}
+
+	// A forwarding hop that doesn't decrease the time lock is rejected.
+	_, err = backend.UnmarshallRoute(newRPCRoute(340, 340, 340))
+	if err == nil {
+		t.Fatal("expected error for forwarding hop without delta")
+	}
+
+	// A route that accumulates more than the maximum time lock is
+	// rejected.
+	_, err = backend.UnmarshallRoute(newRPCRoute(1240, 640, 140))
+	if err == nil {
+		t.Fatal("expected error for route exceeding max time lock")
+	}
+
+	// Once the policy of the forwarding hop is known, its time lock
+	// delta must be respected.
+	ignoreNodeVertex, err := route.NewVertexFromStr(ignoreNodeKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policyKnown := true
+	backend.FetchChannelTimeLockDelta = func(chanID uint64,
+		node route.Vertex) (uint16, error) {
+
+		if node != ignoreNodeVertex {
+			t.Fatalf("unexpected forwarding node %v", node)
+		}
+		if !policyKnown {
+			return 0, errors.New("policy not found")
+		}
+		return 150, nil
+	}
+
+	_, err = backend.UnmarshallRoute(newRPCRoute(340, 240, 240))
+	if err == nil {
+		t.Fatal("expected error for delta below channel policy")
+	}
+
+	_, err = backend.UnmarshallRoute(newRPCRoute(390, 240, 240))
+	if err != nil {
+		t.Fatalf("unexpected error for route meeting policy: %v", err)
+	}
+
+	// Without a known policy, only the time locks themselves are
+	// checked.
+	policyKnown = false
+	_, err = backend.UnmarshallRoute(newRPCRoute(340, 240, 240))
+	if err != nil {
+		t.Fatalf("unexpected error for unknown policy: %v", err)
+	}
+}
+
+// TestUnmarshallRouteLoops asserts that routes visiting the same node twice are
+// rejected when unmarshalled, unless they are circular routes back to
+// ourselves and those are allowed.
+func TestUnmarshallRouteLoops(t *testing.T) {
+	selfKey := hex.EncodeToString(sourceKey[:])
+
+	newRPCRoute := func(pubKeys ...string) *lnrpc.Route {
+		rpcRoute := &lnrpc.Route{
+			TotalTimeLock:  500,
+			TotalAmtMAtoms: 1000,
+		}
+		for i, pubKey := range pubKeys {
+			rpcRoute.Hops = append(rpcRoute.Hops, &lnrpc.Hop{
+				ChanId:             uint64(i + 1),
+				PubKey:             pubKey,
+				Expiry:             uint32(400 - i*40),
+				AmtToForwardMAtoms: 1000,
+			})
+		}
+		return rpcRoute
+	}
+
+	tests := []struct {
+		name          string
+		pubKeys       []string
+		allowCircular bool
+		expectErr     bool
+	}{
+		{
+			name:    "linear route",
+			pubKeys: []string{ignoreNodeKey, destKey},
+		},
+		{
+			name: "loop",
+			pubKeys: []string{
+				ignoreNodeKey, destKey, ignoreNodeKey,
+			},
+			expectErr: true,
+		},
+		{
+			name:      "loop through source",
+			pubKeys:   []string{ignoreNodeKey, selfKey, destKey},
+			expectErr: true,
+		},
+		{
+			name:      "circular route not allowed",
+			pubKeys:   []string{ignoreNodeKey, destKey, selfKey},
+			expectErr: true,
+		},
+		{
+			name:          "circular route allowed",
+			pubKeys:       []string{ignoreNodeKey, destKey, selfKey},
+			allowCircular: true,
+		},
+		{
+			name: "circular route with loop",
+			pubKeys: []string{
+				ignoreNodeKey, destKey, ignoreNodeKey, selfKey,
+			},
+			allowCircular: true,
+			expectErr:     true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			backend := &RouterBackend{
+				SelfNode:           sourceKey,
+				AllowCircularRoute: test.allowCircular,
+			}
+
+			_, err := backend.UnmarshallRoute(
+				newRPCRoute(test.pubKeys...),
+			)
+			if test.expectErr && err == nil {
+				t.Fatal("expected route to be rejected")
+			}
+			if !test.expectErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestQueryRoutesDestTLV asserts that custom records for the final hop are
+// passed on to path finding and end up in the final hop of the route.
+func TestQueryRoutesDestTLV(t *testing.T) {
+	const customRecordType = 65536
+
+	request := &lnrpc.QueryRoutesRequest{
+		PubKey: destKey,
+		Amt:    1000,
+		DestTlv: map[uint64][]byte{
+			customRecordType: {1, 2, 3},
+		},
+	}
+
+	var finalHop *route.Hop
+	findRoute := func(source, target route.Vertex,
+		amt lnwire.MilliAtom, restrictions *routing.RestrictParams,
+		destTlvRecords []tlv.Record,
+		finalExpiry ...uint16) (*route.Route, error) {
+
+		if !restrictions.DestPayloadTLV {
+			t.Fatal("expected dest payload tlv to be required")
+		}
+
+		if len(destTlvRecords) != 1 ||
+			destTlvRecords[0].Type() != customRecordType {
+
+			t.Fatalf("unexpected dest tlv records: %v",
+				destTlvRecords)
+		}
+
+		finalHop = &route.Hop{
+			PubKeyBytes: target,
+			TLVRecords:  destTlvRecords,
+		}
+		return route.NewRouteFromHops(
+			amt, 144, source, []*route.Hop{finalHop},
+		)
+	}
+
+	backend := &RouterBackend{
+		MaxPaymentMAtoms: lnwire.NewMAtomsFromAtoms(1000000),
+		FindRoute:        findRoute,
+		SelfNode:         sourceKey,
+		FetchChannelCapacity: func(chanID uint64) (
+			dcrutil.Amount, error) {
+
+			return 1, nil
+		},
+		MissionControl: &mockMissionControl{},
+	}
+
+	resp, err := backend.QueryRoutes(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Routes) != 1 {
+		t.Fatal("expected a single route response")
+	}
+	if finalHop == nil || len(finalHop.TLVRecords) != 1 {
+		t.Fatal("expected custom record in final hop")
+	}
+}
+
+// TestQueryRoutesRankByExpectedCost asserts that ranking routes by their
+// expected cost passes the attempt cost for the amount on to path finding, and
+// reports the expected cost of the returned route.
+func TestQueryRoutesRankByExpectedCost(t *testing.T) {
+	request := &lnrpc.QueryRoutesRequest{
+		PubKey:             destKey,
+		Amt:                100000,
+		RankByExpectedCost: true,
+	}
+
+	findRoute := func(source, target route.Vertex,
+		amt lnwire.MilliAtom, restrictions *routing.RestrictParams,
+		_ []tlv.Record,
+		finalExpiry ...uint16) (*route.Route, error) {
+
+		// The attempt cost is 1,000 + 10 ppm of 100,000,000.
+		if restrictions.PaymentAttemptPenalty == nil ||
+			*restrictions.PaymentAttemptPenalty != 2000 {
+
+			t.Fatal("unexpected payment attempt penalty")
+		}
+
+		hops := []*route.Hop{{PubKeyBytes: target, AmtToForward: amt}}
+		return route.NewRouteFromHops(amt, 144, source, hops)
+	}
+
+	backend := &RouterBackend{
+		MaxPaymentMAtoms: lnwire.NewMAtomsFromAtoms(1000000),
+		FindRoute:        findRoute,
+		SelfNode:         sourceKey,
+		FetchChannelCapacity: func(chanID uint64) (
+			dcrutil.Amount, error) {
+
+			return 1, nil
+		},
+		MissionControl: &mockMissionControl{},
+		AttemptCost:    1000,
+		AttemptCostPPM: 10,
+	}
+
+	resp, err := backend.QueryRoutes(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The route pays no fees and mission control reports a 50% success
+	// probability, so two attempts are expected.
+	if resp.ExpectedCostMAtoms != 4000 {
+		t.Fatalf("expected cost 4000, got %v", resp.ExpectedCostMAtoms)
+	}
+}
+
+// TestQueryRoutesMinChannelCapacity asserts that the minimum channel capacity
+// of a query routes request is passed on to path finding.
+func TestQueryRoutesMinChannelCapacity(t *testing.T) {
+	request := &lnrpc.QueryRoutesRequest{
+		PubKey:             destKey,
+		Amt:                1000,
+		MinChannelCapacity: 50000,
+	}
+
+	findRoute := func(source, target route.Vertex,
+		amt lnwire.MilliAtom, restrictions *routing.RestrictParams,
+		_ []tlv.Record,
+		finalExpiry ...uint16) (*route.Route, error) {
+
+		if restrictions.MinChannelCapacity != 50000 {
+			t.Fatalf("unexpected min channel capacity: %v",
+				restrictions.MinChannelCapacity)
+		}
+
+		hops := []*route.Hop{{PubKeyBytes: target}}
+		return route.NewRouteFromHops(amt, 144, source, hops)
+	}
+
+	backend := &RouterBackend{
+		MaxPaymentMAtoms: lnwire.NewMAtomsFromAtoms(1000000),
+		FindRoute:        findRoute,
+		SelfNode:         sourceKey,
+		FetchChannelCapacity: func(chanID uint64) (
+			dcrutil.Amount, error) {
+
+			return 1, nil
+		},
+		MissionControl: &mockMissionControl{},
+	}
+
+	_, err := backend.QueryRoutes(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestQueryRoutesCanceled asserts that path finding is aborted along with the
+// context of the request, and that the context error is returned.
+func TestQueryRoutesCanceled(t *testing.T) {
+	findRoute := func(source, target route.Vertex,
+		amt lnwire.MilliAtom, restrictions *routing.RestrictParams,
+		_ []tlv.Record,
+		finalExpiry ...uint16) (*route.Route, error) {
+
+		// Block like a slow path finding attempt until it is
+		// canceled.
+		select {
+		case <-restrictions.Cancel:
+			return nil, errors.New("path finding canceled")
+		case <-time.After(5 * time.Second):
+			t.Fatal("path finding not canceled")
+			return nil, nil
+		}
+	}
+
+	backend := &RouterBackend{
+		MaxPaymentMAtoms: lnwire.NewMAtomsFromAtoms(1000000),
+		FindRoute:        findRoute,
+		SelfNode:         sourceKey,
+		MissionControl:   &mockMissionControl{},
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 50*time.Millisecond,
+	)
+	defer cancel()
+
+	_, err := backend.QueryRoutes(ctx, &lnrpc.QueryRoutesRequest{
+		PubKey: destKey,
+		Amt:    1000,
+	})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+// TestUnmarshallRouteHints asserts that duplicate hop hints and route hints
+// are dropped, and that malformed or conflicting hints are rejected.
+func TestUnmarshallRouteHints(t *testing.T) {
+	hint := func(nodeID string, chanID uint64) *lnrpc.HopHint {
+		return &lnrpc.HopHint{
+			NodeId:          nodeID,
+			ChanId:          chanID,
+			CltvExpiryDelta: 40,
+		}
+	}
+
+	// A route hint repeating the same hop, followed by an identical
+	// route hint, should be collapsed into a single route hint with two
+	// hops.
+	routeHints, err := unmarshallRouteHints([]*lnrpc.RouteHint{
+		{
+			HopHints: []*lnrpc.HopHint{
+				hint(destKey, 1),
+				hint(destKey, 1),
+				hint(ignoreNodeKey, 2),
+			},
+		},
+		{
+			HopHints: []*lnrpc.HopHint{
+				hint(destKey, 1),
+				hint(ignoreNodeKey, 2),
+			},
+		},
+		{
+			HopHints: []*lnrpc.HopHint{
+				hint(ignoreNodeKey, 2),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to unmarshall route hints: %v", err)
+	}
+	if len(routeHints) != 2 {
+		t.Fatalf("expected 2 route hints, got %d", len(routeHints))
+	}
+	if len(routeHints[0]) != 2 {
+		t.Fatalf("expected 2 hop hints, got %d", len(routeHints[0]))
+	}
+	if routeHints[0][0].ChannelID != 1 || routeHints[0][1].ChannelID != 2 {
+		t.Fatalf("unexpected hop hints: %v", routeHints[0])
+	}
+	if len(routeHints[1]) != 1 || routeHints[1][0].ChannelID != 2 {
+		t.Fatalf("unexpected hop hints: %v", routeHints[1])
+	}
+
+	// Malformed node ids should be rejected.
+	malformed := []string{
+		"not hex",
+		destKey[:20],
+		"04" + destKey[2:],
+	}
+	for _, nodeID := range malformed {
+		_, err := unmarshallRouteHints([]*lnrpc.RouteHint{
+			{
+				HopHints: []*lnrpc.HopHint{
+					hint(destKey, 1),
+					hint(nodeID, 2),
+				},
+			},
+		})
+		if err == nil {
+			t.Fatalf("expected node id %q to be rejected", nodeID)
+		}
+	}
+
+	// The same channel originating from two different nodes within a
+	// route hint should be rejected.
+	_, err = unmarshallRouteHints([]*lnrpc.RouteHint{
+		{
+			HopHints: []*lnrpc.HopHint{
+				hint(destKey, 1),
+				hint(ignoreNodeKey, 1),
+			},
+		},
+	})
+	if err == nil {
+		t.Fatalf("expected conflicting hop hints to be rejected")
+	}
+}
+
+// TestFetchPaymentRoute asserts that the route of a successful payment can be
+// retrieved from the control tower after the payment completed.
+func TestFetchPaymentRoute(t *testing.T) {
+	tempPath, err := ioutil.TempDir("", "routerrpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempPath)
+
+	db, err := channeldb.Open(tempPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	backend := &RouterBackend{
+		FetchChannelCapacity: func(chanID uint64) (
+			dcrutil.Amount, error) {
+
+			return 1000, nil
+		},
+		Tower: routing.NewControlTower(
+			channeldb.NewPaymentControl(db),
+		),
+	}
+
+	sessionKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hops := []*route.Hop{
+		{
+			ChannelID:        1,
+			PubKeyBytes:      node1,
+			AmtToForward:     2000,
+			OutgoingTimeLock: 100,
+			LegacyPayload:    true,
+		},
+		{
+			ChannelID:        2,
+			PubKeyBytes:      node2,
+			AmtToForward:     1000,
+			OutgoingTimeLock: 60,
+			LegacyPayload:    true,
+		},
+	}
+	rt, err := route.NewRouteFromHops(3000, 144, sourceKey, hops)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	preimage := lntypes.Preimage{1, 2, 3}
+	hash := preimage.Hash()
+
+	// The route of an unknown payment can't be fetched.
+	if _, err := backend.FetchPaymentRoute(hash); err == nil {
+		t.Fatal("expected error for unknown payment")
+	}
+
+	err = backend.Tower.InitPayment(hash, &channeldb.PaymentCreationInfo{
+		PaymentHash:    hash,
+		Value:          1000,
+		CreationDate:   time.Unix(time.Now().Unix(), 0),
+		PaymentRequest: []byte{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = backend.Tower.RegisterAttempt(hash, &channeldb.PaymentAttemptInfo{
+		PaymentID:  1,
+		SessionKey: sessionKey,
+		Route:      *rt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// While the payment is in flight, no route is returned yet.
+	if _, err := backend.FetchPaymentRoute(hash); err == nil {
+		t.Fatal("expected error for in flight payment")
+	}
+
+	if err := backend.Tower.Success(hash, preimage); err != nil {
+		t.Fatal(err)
+	}
+
+	rpcRoute, err := backend.FetchPaymentRoute(hash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment route: %v", err)
+	}
+
+	expectedRoute, err := backend.MarshallRoute(rt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(rpcRoute, expectedRoute) {
+		t.Fatalf("unexpected route: got %v, want %v", rpcRoute,
+			expectedRoute)
+	}
+}
+
+// TestFallbackOnChainSend asserts that a payment request is paid on-chain to
+// its first fallback address, and only if it has one.
+func TestFallbackOnChainSend(t *testing.T) {
+	tempPath, err := ioutil.TempDir("", "routerrpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempPath)
+
+	db, err := channeldb.Open(tempPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	netParams := chaincfg.RegNetParams()
+
+	fallbackAddr, err := dcrutil.NewAddressPubKeyHash(
+		make([]byte, 20), netParams, dcrec.STEcdsaSecp256k1,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherAddr, err := dcrutil.NewAddressScriptHashFromHash(
+		make([]byte, 20), netParams,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		sentAddr dcrutil.Address
+		sentAmt  dcrutil.Amount
+	)
+	txHash := chainhash.Hash{1}
+	backend := &RouterBackend{
+		ActiveNetParams: netParams,
+		Tower: routing.NewControlTower(
+			channeldb.NewPaymentControl(db),
+		),
+		SendOnChain: func(addr dcrutil.Address,
+			amt dcrutil.Amount) (*chainhash.Hash, error) {
+
+			sentAddr = addr
+			sentAmt = amt
+			return &txHash, nil
+		},
+	}
+
+	// A payment request without fallback address can't be paid on-chain.
+	_, err = backend.FallbackOnChainSend(newTestPayReq(t, 5000000, 40))
+	if err == nil {
+		t.Fatal("expected error for missing fallback address")
+	}
+
+	// Neither can an amount that isn't a whole number of atoms.
+	payReq := newTestPayReq(
+		t, 5000001, 40, zpay32.FallbackAddr(fallbackAddr),
+	)
+	if _, err := backend.FallbackOnChainSend(payReq); err == nil {
+		t.Fatal("expected error for sub-atom amount")
+	}
+
+	payReq = newTestPayReq(
+		t, 5000000, 40,
+		zpay32.FallbackAddrs(fallbackAddr, otherAddr),
+	)
+	hash, err := backend.FallbackOnChainSend(payReq)
+	if err != nil {
+		t.Fatalf("unable to send on-chain: %v", err)
+	}
+	if *hash != txHash {
+		t.Fatalf("expected tx hash %v, got %v", txHash, hash)
+	}
+	if sentAddr.String() != fallbackAddr.String() {
+		t.Fatalf("expected payment to %v, got %v", fallbackAddr,
+			sentAddr)
+	}
+	if sentAmt != 5000 {
+		t.Fatalf("expected amount 5000, got %v", sentAmt)
+	}
+}
+
+// TestFallbackOnChainSendPaymentStatus asserts that a payment request is only
+// paid on-chain if no Lightning payment for it is in flight or succeeded.
+func TestFallbackOnChainSendPaymentStatus(t *testing.T) {
+	tempPath, err := ioutil.TempDir("", "routerrpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempPath)
+
+	db, err := channeldb.Open(tempPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	netParams := chaincfg.RegNetParams()
+	fallbackAddr, err := dcrutil.NewAddressPubKeyHash(
+		make([]byte, 20), netParams, dcrec.STEcdsaSecp256k1,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var numSent int
+	backend := &RouterBackend{
+		ActiveNetParams: netParams,
+		Tower: routing.NewControlTower(
+			channeldb.NewPaymentControl(db),
+		),
+		SendOnChain: func(addr dcrutil.Address,
+			amt dcrutil.Amount) (*chainhash.Hash, error) {
+
+			numSent++
+			return &chainhash.Hash{1}, nil
+		},
+	}
+
+	sessionKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The test payment requests all commit to the same payment hash.
+	hash := lntypes.Hash{1}
+	initPayment := func() {
+		err := backend.Tower.InitPayment(
+			hash, &channeldb.PaymentCreationInfo{
+				PaymentHash:    hash,
+				Value:          5000000,
+				CreationDate:   time.Unix(time.Now().Unix(), 0),
+				PaymentRequest: []byte{},
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = backend.Tower.RegisterAttempt(
+			hash, &channeldb.PaymentAttemptInfo{
+				PaymentID:  1,
+				SessionKey: sessionKey,
+				Route:      route.Route{},
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	payReq := newTestPayReq(
+		t, 5000000, 40, zpay32.FallbackAddr(fallbackAddr),
+	)
+
+	// While a Lightning payment is in flight, the request must not be
+	// paid on-chain.
+	initPayment()
+	if _, err := backend.FallbackOnChainSend(payReq); err == nil {
+		t.Fatal("expected error for in flight payment")
+	}
+
+	// Once the Lightning payment failed, the fallback can be used.
+	err = backend.Tower.Fail(hash, channeldb.FailureReasonNoRoute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := backend.FallbackOnChainSend(payReq); err != nil {
+		t.Fatalf("unable to send on-chain: %v", err)
+	}
+	if numSent != 1 {
+		t.Fatalf("expected 1 on-chain send, got %v", numSent)
+	}
+
+	// A request that was already paid over Lightning must not be paid
+	// again on-chain.
+	initPayment()
+	if err := backend.Tower.Success(hash, lntypes.Preimage{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := backend.FallbackOnChainSend(payReq); err == nil {
+		t.Fatal("expected error for succeeded payment")
+	}
+	if numSent != 1 {
+		t.Fatalf("expected 1 on-chain send, got %v", numSent)
+	}
+}
+
+// TestExtractIntentWrongNetwork asserts that paying a payment request created
+// for a different network fails with an explicit network mismatch error.
+func TestExtractIntentWrongNetwork(t *testing.T) {
+	privKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invoice, err := zpay32.NewInvoice(
+		chaincfg.MainNetParams(), [32]byte{1}, time.Now(),
+		zpay32.Amount(1000), zpay32.Description("test"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payReq, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(hash []byte) ([]byte, error) {
+			return secp256k1.SignCompact(privKey, hash, true)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &RouterBackend{
+		MaxPaymentMAtoms: lnwire.NewMAtomsFromAtoms(1000000),
+		ActiveNetParams:  chaincfg.TestNet3Params(),
+	}
+
+	_, err = backend.extractIntentFromSendRequest(&SendPaymentRequest{
+		PaymentRequest: payReq,
+		TimeoutSeconds: 60,
+	})
+	netErr, ok := err.(zpay32.ErrWrongNetwork)
+	if !ok {
+		t.Fatalf("expected ErrWrongNetwork, got %v", err)
+	}
+	if netErr.Network != chaincfg.MainNetParams().Name {
+		t.Fatalf("expected mainnet invoice, got %v", netErr.Network)
+	}
+}
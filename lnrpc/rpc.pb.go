@@ -5381,7 +5381,31 @@ type QueryRoutesRequest struct {
 	// An optional maximum total time lock for the route. If the source is empty or
 	// ourselves, this should not exceed lnd's `--max-cltv-expiry` setting. If
 	// zero, then the value of `--max-cltv-expiry` is used as the limit.
-	CltvLimit            uint32   `protobuf:"varint,11,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
+	CltvLimit uint32 `protobuf:"varint,11,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
+	// *
+	// If set, the route is selected by its expected cost, which combines the
+	// route fees with the configured cost of a failed payment attempt weighed by
+	// the route's success probability.
+	RankByExpectedCost bool `protobuf:"varint,12,opt,name=rank_by_expected_cost,json=rankByExpectedCost,proto3" json:"rank_by_expected_cost,omitempty"`
+	// *
+	// An optional field that can be used to pass an arbitrary set of TLV records
+	// to the final hop. If set, only routes to a destination that understands
+	// TLV payloads are returned, and the final hop payload accounts for these
+	// records.
+	DestTlv map[uint64][]byte `protobuf:"bytes,13,rep,name=dest_tlv,json=destTlv,proto3" json:"dest_tlv,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// *
+	// The pubkey of the last hop of the route. If set, the route must arrive at
+	// the destination through a channel with this node.
+	LastHopPubkey []byte `protobuf:"bytes,15,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	// *
+	// The preference between lower fees and a lower cumulative time lock when
+	// selecting the route, ranging from -1 to 1. -1 only optimizes for fees, 1
+	// only optimizes for time lock. The default of 0 weighs both equally.
+	TimePref float64 `protobuf:"fixed64,16,opt,name=time_pref,json=timePref,proto3" json:"time_pref,omitempty"`
+	// *
+	// An optional minimum channel capacity in atoms. If set, only channels with
+	// at least this capacity are considered during path finding.
+	MinChannelCapacity   int64    `protobuf:"varint,14,opt,name=min_channel_capacity,json=minChannelCapacity,proto3" json:"min_channel_capacity,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5482,6 +5506,41 @@ func (m *QueryRoutesRequest) GetCltvLimit() uint32 {
 	return 0
 }
 
+func (m *QueryRoutesRequest) GetRankByExpectedCost() bool {
+	if m != nil {
+		return m.RankByExpectedCost
+	}
+	return false
+}
+
+func (m *QueryRoutesRequest) GetDestTlv() map[uint64][]byte {
+	if m != nil {
+		return m.DestTlv
+	}
+	return nil
+}
+
+func (m *QueryRoutesRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
+func (m *QueryRoutesRequest) GetTimePref() float64 {
+	if m != nil {
+		return m.TimePref
+	}
+	return 0
+}
+
+func (m *QueryRoutesRequest) GetMinChannelCapacity() int64 {
+	if m != nil {
+		return m.MinChannelCapacity
+	}
+	return 0
+}
+
 type NodePair struct {
 	// / The sending node of the pair.
 	From []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
@@ -5590,7 +5649,11 @@ type QueryRoutesResponse struct {
 	// *
 	// The success probability of the returned route based on the current mission
 	// control state. [EXPERIMENTAL]
-	SuccessProb          float64  `protobuf:"fixed64,2,opt,name=success_prob,proto3" json:"success_prob,omitempty"`
+	SuccessProb float64 `protobuf:"fixed64,2,opt,name=success_prob,proto3" json:"success_prob,omitempty"`
+	// *
+	// The expected cost of the returned route in milli-atoms. Only set if
+	// rank_by_expected_cost was requested. [EXPERIMENTAL]
+	ExpectedCostMAtoms   int64    `protobuf:"varint,3,opt,name=expected_cost_m_atoms,proto3" json:"expected_cost_m_atoms,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5634,6 +5697,13 @@ func (m *QueryRoutesResponse) GetSuccessProb() float64 {
 	return 0
 }
 
+func (m *QueryRoutesResponse) GetExpectedCostMAtoms() int64 {
+	if m != nil {
+		return m.ExpectedCostMAtoms
+	}
+	return 0
+}
+
 type Hop struct {
 	// *
 	// The unique channel ID for the channel. The first 3 bytes are the block
@@ -5653,7 +5723,14 @@ type Hop struct {
 	// *
 	// If set to true, then this hop will be encoded using the new variable length
 	// TLV format.
-	TlvPayload           bool     `protobuf:"varint,9,opt,name=tlv_payload,proto3" json:"tlv_payload,omitempty"`
+	TlvPayload bool `protobuf:"varint,9,opt,name=tlv_payload,proto3" json:"tlv_payload,omitempty"`
+	// *
+	// If set to true, the capacity of this hop's channel could not be found in
+	// the channel graph. This is usually the case for private channels learned
+	// from route hints, in which case chan_capacity reports the amount sent
+	// through the channel instead of its actual capacity.
+	// If the capacity wasn't looked up at all, chan_capacity is zero.
+	CapacityUnknown      bool     `protobuf:"varint,10,opt,name=capacity_unknown,proto3" json:"capacity_unknown,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5748,6 +5825,13 @@ func (m *Hop) GetTlvPayload() bool {
 	return false
 }
 
+func (m *Hop) GetCapacityUnknown() bool {
+	if m != nil {
+		return m.CapacityUnknown
+	}
+	return false
+}
+
 // *
 // A path through the channel graph which runs over one or more channels in
 // succession. This struct carries all the information required to craft the
@@ -9472,6 +9556,7 @@ func init() {
 	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
 	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
 	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "lnrpc.QueryRoutesRequest.DestTlvEntry")
 	proto.RegisterType((*NodePair)(nil), "lnrpc.NodePair")
 	proto.RegisterType((*EdgeLocator)(nil), "lnrpc.EdgeLocator")
 	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
@@ -12252,540 +12337,544 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_121e03430f9ed6eb) }
 
 var fileDescriptor_rpc_121e03430f9ed6eb = []byte{
-	// 8555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x4d, 0x6c, 0x24, 0xc9,
-	0x95, 0x5e, 0x67, 0xfd, 0x90, 0x55, 0xaf, 0x8a, 0x64, 0x31, 0xc8, 0x26, 0xab, 0x6b, 0x7a, 0x7a,
-	0x7a, 0x52, 0xed, 0x9e, 0x16, 0x35, 0x62, 0xf7, 0xb4, 0xa4, 0xd9, 0xd9, 0x99, 0x5d, 0xaf, 0xd9,
-	0x24, 0xbb, 0xd9, 0x1a, 0x0e, 0x9b, 0x4a, 0x76, 0xab, 0x2d, 0x69, 0x8d, 0x52, 0xb2, 0x2a, 0x48,
-	0xa6, 0xba, 0x2a, 0xb3, 0x94, 0x99, 0xc5, 0x26, 0x35, 0x1e, 0x1f, 0x16, 0x86, 0x61, 0x18, 0x58,
-	0x18, 0xb2, 0x2f, 0x86, 0x01, 0x63, 0x17, 0x92, 0x2f, 0xbb, 0xf6, 0xc1, 0x80, 0xb1, 0x86, 0x0d,
-	0x18, 0xd8, 0x8b, 0x01, 0xfb, 0x62, 0xf8, 0xe0, 0x83, 0x61, 0x1f, 0x7c, 0x30, 0x6c, 0xc8, 0x82,
-	0x7d, 0x31, 0x0c, 0xdf, 0x8d, 0xf7, 0xe2, 0x27, 0x23, 0x32, 0xb3, 0xba, 0x7b, 0x24, 0xd9, 0x27,
-	0x56, 0x7c, 0x11, 0x19, 0xbf, 0xef, 0xbd, 0x78, 0xef, 0xc5, 0x8b, 0x20, 0x34, 0xe3, 0xc9, 0x60,
-	0x73, 0x12, 0x47, 0x69, 0xc4, 0xea, 0xa3, 0x30, 0x9e, 0x0c, 0x7a, 0xd7, 0x4f, 0xa3, 0xe8, 0x74,
-	0xc4, 0xef, 0xfa, 0x93, 0xe0, 0xae, 0x1f, 0x86, 0x51, 0xea, 0xa7, 0x41, 0x14, 0x26, 0xa2, 0x90,
-	0xfb, 0x43, 0x58, 0x7c, 0xc4, 0xc3, 0x23, 0xce, 0x87, 0x1e, 0xff, 0xf1, 0x94, 0x27, 0x29, 0xfb,
-	0x1a, 0x2c, 0xfb, 0xfc, 0x27, 0x9c, 0x0f, 0xfb, 0x13, 0x3f, 0x49, 0x26, 0x67, 0xb1, 0x9f, 0xf0,
-	0xae, 0x73, 0xd3, 0xb9, 0xd3, 0xf6, 0x3a, 0x22, 0xe3, 0x50, 0xe3, 0xec, 0x5d, 0x68, 0x27, 0x58,
-	0x94, 0x87, 0x69, 0x1c, 0x4d, 0x2e, 0xbb, 0x15, 0x2a, 0xd7, 0x42, 0x6c, 0x57, 0x40, 0xee, 0x08,
-	0x96, 0x74, 0x0b, 0xc9, 0x24, 0x0a, 0x13, 0xce, 0xee, 0xc1, 0xea, 0x20, 0x98, 0x9c, 0xf1, 0xb8,
-	0x4f, 0x1f, 0x8f, 0x43, 0x3e, 0x8e, 0xc2, 0x60, 0xd0, 0x75, 0x6e, 0x56, 0xef, 0x34, 0x3d, 0x26,
-	0xf2, 0xf0, 0x8b, 0xcf, 0x64, 0x0e, 0x7b, 0x0f, 0x96, 0x78, 0x28, 0x70, 0x3e, 0xa4, 0xaf, 0x64,
-	0x53, 0x8b, 0x19, 0x8c, 0x1f, 0xb8, 0x7f, 0xb3, 0x02, 0xcb, 0x8f, 0xc3, 0x20, 0x7d, 0xee, 0x8f,
-	0x46, 0x3c, 0x55, 0x63, 0x7a, 0x0f, 0x96, 0x5e, 0x12, 0x40, 0x63, 0x7a, 0x19, 0xc5, 0x43, 0x39,
-	0xa2, 0x45, 0x01, 0x1f, 0x4a, 0x74, 0x66, 0xcf, 0x2a, 0x33, 0x7b, 0x56, 0x3a, 0x5d, 0xd5, 0x19,
-	0xd3, 0xf5, 0x1e, 0x2c, 0xc5, 0x7c, 0x10, 0x9d, 0xf3, 0xf8, 0xb2, 0xff, 0x32, 0x08, 0x87, 0xd1,
-	0xcb, 0x6e, 0xed, 0xa6, 0x73, 0xa7, 0xee, 0x2d, 0x2a, 0xf8, 0x39, 0xa1, 0xec, 0x01, 0x2c, 0x0d,
-	0xce, 0xfc, 0x30, 0xe4, 0xa3, 0xfe, 0xb1, 0x3f, 0x78, 0x31, 0x9d, 0x24, 0xdd, 0xfa, 0x4d, 0xe7,
-	0x4e, 0xeb, 0xfe, 0xb5, 0x4d, 0x5a, 0xd5, 0xcd, 0xed, 0x33, 0x3f, 0x7c, 0x40, 0x39, 0x47, 0xa1,
-	0x3f, 0x49, 0xce, 0xa2, 0xd4, 0x5b, 0x94, 0x5f, 0x08, 0x38, 0x71, 0x57, 0x81, 0x99, 0x33, 0x21,
-	0xe6, 0xde, 0xfd, 0x47, 0x0e, 0xac, 0x3c, 0x0b, 0x47, 0xd1, 0xe0, 0xc5, 0xaf, 0x38, 0x45, 0x25,
-	0x63, 0xa8, 0xbc, 0xe9, 0x18, 0xaa, 0x5f, 0x76, 0x0c, 0x6b, 0xb0, 0x6a, 0x77, 0x56, 0x8e, 0x82,
-	0xc3, 0x55, 0xfc, 0xfa, 0x94, 0xab, 0x6e, 0xa9, 0x61, 0x7c, 0x15, 0x3a, 0x83, 0x69, 0x1c, 0xf3,
-	0xb0, 0x30, 0x8e, 0x25, 0x89, 0xeb, 0x81, 0xbc, 0x0b, 0xed, 0x90, 0xbf, 0xcc, 0x8a, 0x49, 0xda,
-	0x0d, 0xf9, 0x4b, 0x55, 0xc4, 0xed, 0xc2, 0x5a, 0xbe, 0x19, 0xd9, 0x81, 0xff, 0xe6, 0x40, 0xed,
-	0x59, 0x7a, 0x11, 0xb1, 0x4d, 0xa8, 0xa5, 0x97, 0x13, 0xc1, 0x21, 0x8b, 0xf7, 0x99, 0x1c, 0xda,
-	0xd6, 0x70, 0x18, 0xf3, 0x24, 0x79, 0x7a, 0x39, 0xe1, 0x5e, 0xdb, 0x17, 0x89, 0x3e, 0x96, 0x63,
-	0x5d, 0x98, 0x97, 0x69, 0x6a, 0xb0, 0xe9, 0xa9, 0x24, 0x73, 0xa1, 0xed, 0x8f, 0xa3, 0x69, 0x98,
-	0xf6, 0xfd, 0x34, 0x1a, 0x8b, 0xc9, 0xaa, 0x7a, 0x16, 0xc6, 0xae, 0x43, 0x73, 0xf2, 0xa2, 0x9f,
-	0x0c, 0xe2, 0x60, 0x92, 0x12, 0xe9, 0x34, 0xbd, 0x0c, 0x60, 0x5f, 0x83, 0x46, 0x34, 0x4d, 0x27,
-	0x51, 0x10, 0xa6, 0x92, 0x5c, 0x96, 0x64, 0x7f, 0x9e, 0x4c, 0xd3, 0x43, 0x84, 0x3d, 0x5d, 0x80,
-	0xdd, 0x82, 0x85, 0x41, 0x14, 0x9e, 0x04, 0xf1, 0x58, 0x08, 0x84, 0xee, 0x1c, 0xb5, 0x67, 0x83,
-	0xee, 0xbf, 0xa8, 0x40, 0xeb, 0x69, 0xec, 0x87, 0x89, 0x3f, 0x40, 0x00, 0xbb, 0x9f, 0x5e, 0xf4,
-	0xcf, 0xfc, 0xe4, 0x8c, 0x46, 0xdc, 0xf4, 0x54, 0x92, 0xad, 0xc1, 0x9c, 0xe8, 0x2a, 0x8d, 0xab,
-	0xea, 0xc9, 0x14, 0x7b, 0x1f, 0x96, 0xc3, 0xe9, 0xb8, 0x6f, 0xb7, 0x55, 0x25, 0x8a, 0x29, 0x66,
-	0xb0, 0x1b, 0x00, 0xc7, 0xb8, 0xde, 0xa2, 0x09, 0x31, 0x42, 0x03, 0xc1, 0x49, 0x92, 0x29, 0x1e,
-	0x9c, 0x9e, 0x89, 0x61, 0xd6, 0x3d, 0x0b, 0xc3, 0x3a, 0xd2, 0x60, 0xcc, 0xfb, 0x49, 0xea, 0x8f,
-	0x27, 0x72, 0x58, 0x06, 0x42, 0xf9, 0x51, 0xea, 0x8f, 0xfa, 0x27, 0x9c, 0x27, 0xdd, 0x79, 0x99,
-	0xaf, 0x11, 0x76, 0x1b, 0x16, 0x87, 0x3c, 0x49, 0xfb, 0x72, 0x61, 0x78, 0xd2, 0x6d, 0x10, 0xfb,
-	0xe7, 0x50, 0xac, 0x27, 0xf6, 0x5f, 0xf6, 0x71, 0x02, 0xf8, 0x45, 0xb7, 0x29, 0xfa, 0x9a, 0x21,
-	0x48, 0x3d, 0x8f, 0x78, 0x6a, 0xcc, 0x5e, 0x22, 0xa9, 0xd4, 0xdd, 0x07, 0x66, 0xc0, 0x3b, 0x3c,
-	0xf5, 0x83, 0x51, 0xc2, 0x3e, 0x84, 0x76, 0x6a, 0x14, 0x26, 0x71, 0xd8, 0xd2, 0x24, 0x65, 0x7c,
-	0xe0, 0x59, 0xe5, 0xdc, 0x47, 0xd0, 0x78, 0xc8, 0xf9, 0x7e, 0x30, 0x0e, 0x52, 0xb6, 0x06, 0xf5,
-	0x93, 0xe0, 0x82, 0x0b, 0xa2, 0xaf, 0xee, 0x5d, 0xf1, 0x44, 0x92, 0xf5, 0x60, 0x7e, 0xc2, 0xe3,
-	0x01, 0x57, 0xcb, 0xb3, 0x77, 0xc5, 0x53, 0xc0, 0x83, 0x79, 0xa8, 0x8f, 0xf0, 0x63, 0xf7, 0x8f,
-	0x6b, 0xd0, 0x3a, 0xe2, 0xa1, 0x66, 0x26, 0x06, 0x35, 0x1c, 0xb2, 0x64, 0x20, 0xfa, 0xcd, 0xde,
-	0x81, 0x16, 0x4d, 0x43, 0x92, 0xc6, 0x41, 0x78, 0x2a, 0x69, 0x18, 0x10, 0x3a, 0x22, 0x84, 0x75,
-	0xa0, 0xea, 0x8f, 0x53, 0x49, 0xbd, 0xf8, 0x13, 0x19, 0x6d, 0xe2, 0x5f, 0x8e, 0x91, 0x27, 0xf5,
-	0xaa, 0xb6, 0xbd, 0x96, 0xc4, 0xf6, 0x70, 0x59, 0x37, 0x61, 0xc5, 0x2c, 0xa2, 0x6a, 0xaf, 0x53,
-	0xed, 0xcb, 0x46, 0x49, 0xd9, 0xc8, 0x7b, 0xb0, 0xa4, 0xca, 0xc7, 0xa2, 0xb3, 0xb4, 0xce, 0x4d,
-	0x6f, 0x51, 0xc2, 0x6a, 0x08, 0x77, 0xa0, 0x73, 0x12, 0x84, 0xfe, 0xa8, 0x3f, 0x18, 0xa5, 0xe7,
-	0xfd, 0x21, 0x1f, 0xa5, 0x3e, 0xad, 0x78, 0xdd, 0x5b, 0x24, 0x7c, 0x7b, 0x94, 0x9e, 0xef, 0x20,
-	0xca, 0xde, 0x87, 0xe6, 0x09, 0xe7, 0x7d, 0x9a, 0x89, 0x6e, 0xc3, 0xe2, 0x1e, 0x35, 0xbb, 0x5e,
-	0xe3, 0x44, 0xcd, 0xf3, 0x1d, 0xe8, 0x44, 0xd3, 0xf4, 0x34, 0x0a, 0xc2, 0xd3, 0x3e, 0xca, 0xac,
-	0x7e, 0x30, 0xec, 0xc2, 0x4d, 0xe7, 0x4e, 0xcd, 0x5b, 0x54, 0x38, 0x4a, 0x8e, 0xc7, 0x43, 0xf6,
-	0x2d, 0x58, 0x0f, 0x4e, 0xc3, 0x28, 0xe6, 0xfd, 0xb1, 0x7f, 0xd1, 0x8f, 0xa6, 0xe9, 0x71, 0x34,
-	0x0d, 0x87, 0x7d, 0x9c, 0x23, 0x24, 0x99, 0x86, 0xb7, 0x2a, 0xb2, 0x3f, 0xf3, 0x2f, 0x9e, 0xc8,
-	0xcc, 0xad, 0x71, 0xca, 0xde, 0x06, 0xa0, 0x2e, 0x8b, 0xfe, 0xb4, 0x6e, 0x3a, 0x77, 0x16, 0xbc,
-	0x26, 0x22, 0xa2, 0xfd, 0x8f, 0xa1, 0x41, 0xcb, 0x90, 0x8e, 0xce, 0xbb, 0x6d, 0xa2, 0x93, 0x77,
-	0x64, 0x67, 0x8d, 0x05, 0xdc, 0xdc, 0xe1, 0x49, 0xfa, 0x74, 0x74, 0x8e, 0x5b, 0xf1, 0xa5, 0x37,
-	0x3f, 0x14, 0xa9, 0xde, 0xc7, 0xd0, 0x36, 0x33, 0x70, 0xc5, 0x5e, 0xf0, 0x4b, 0x5a, 0xe5, 0x9a,
-	0x87, 0x3f, 0xd9, 0x2a, 0xd4, 0xcf, 0xfd, 0xd1, 0x94, 0x4b, 0x99, 0x28, 0x12, 0x1f, 0x57, 0x3e,
-	0x72, 0xdc, 0x7f, 0xee, 0x40, 0x5b, 0xb4, 0x20, 0xf7, 0xf2, 0x5b, 0xb0, 0xa0, 0x56, 0x82, 0xc7,
-	0x71, 0x14, 0x4b, 0xb1, 0x60, 0x83, 0x6c, 0x03, 0x3a, 0x0a, 0x98, 0xc4, 0x3c, 0x18, 0xfb, 0xa7,
-	0xaa, 0xee, 0x02, 0xce, 0xee, 0x67, 0x35, 0xc6, 0xd1, 0x34, 0xe5, 0x72, 0xd7, 0x68, 0xcb, 0xf1,
-	0x79, 0x88, 0x79, 0x76, 0x11, 0x14, 0x0b, 0x25, 0x24, 0x66, 0x61, 0xee, 0x4f, 0x1d, 0x60, 0xd8,
-	0xf5, 0xa7, 0x91, 0xa8, 0x42, 0x52, 0x48, 0x9e, 0x3a, 0x9d, 0x37, 0xa6, 0xce, 0xca, 0x2c, 0xea,
-	0x74, 0xa1, 0x2e, 0x7a, 0x5e, 0x2b, 0xe9, 0xb9, 0xc8, 0xfa, 0x76, 0xad, 0x51, 0xed, 0xd4, 0xdc,
-	0xff, 0x54, 0x85, 0xd5, 0x6d, 0xb1, 0xe5, 0x6d, 0x0d, 0x06, 0x7c, 0xa2, 0xe9, 0xf6, 0x1d, 0x68,
-	0x85, 0xd1, 0x90, 0xf7, 0x27, 0xd3, 0x63, 0xb5, 0x36, 0x6d, 0x0f, 0x10, 0x3a, 0x24, 0x84, 0xe8,
-	0xe3, 0xcc, 0x0f, 0x42, 0xd1, 0x69, 0x31, 0x97, 0x4d, 0x42, 0xa8, 0xcb, 0xb7, 0x61, 0x69, 0xc2,
-	0xc3, 0xa1, 0x49, 0x9e, 0x42, 0x29, 0x59, 0x90, 0xb0, 0xa4, 0xce, 0x77, 0xa0, 0x75, 0x32, 0x15,
-	0xe5, 0x90, 0x22, 0x6b, 0x44, 0x03, 0x20, 0x21, 0xa4, 0xc3, 0x6b, 0xd0, 0x98, 0x4c, 0x93, 0x33,
-	0xca, 0xad, 0x53, 0xee, 0x3c, 0xa6, 0x25, 0x89, 0x0e, 0xa7, 0x49, 0x2a, 0x49, 0x74, 0x8e, 0x32,
-	0x9b, 0x88, 0x08, 0x12, 0xfd, 0x3a, 0xac, 0x20, 0xc5, 0x13, 0xed, 0xf4, 0x83, 0xb0, 0x7f, 0x32,
-	0x22, 0x89, 0x3d, 0x4f, 0xe5, 0x3a, 0x63, 0xff, 0xe2, 0xbb, 0x98, 0xf3, 0x38, 0x7c, 0x48, 0x38,
-	0xb2, 0xb4, 0x52, 0x17, 0x62, 0x9e, 0xf0, 0xf8, 0x9c, 0x13, 0x17, 0xd6, 0xb4, 0x4e, 0xe0, 0x09,
-	0x14, 0x7b, 0x34, 0xc6, 0x71, 0xa7, 0xa3, 0x01, 0x71, 0x50, 0xcd, 0x9b, 0x1f, 0x07, 0xe1, 0x5e,
-	0x3a, 0x1a, 0xb0, 0xeb, 0x00, 0xc8, 0xc3, 0x13, 0x1e, 0xf7, 0x5f, 0x1c, 0x4b, 0x7e, 0x44, 0x9e,
-	0x3d, 0xe4, 0xf1, 0xa7, 0xc7, 0xec, 0x2d, 0x68, 0x0e, 0x12, 0x12, 0x02, 0xfe, 0xa5, 0xe4, 0xa8,
-	0xc6, 0x20, 0x41, 0xf6, 0xf7, 0x2f, 0xd9, 0xfb, 0xc0, 0xb0, 0xb7, 0x3e, 0xad, 0x02, 0x1f, 0x52,
-	0xf5, 0x49, 0xb7, 0x4d, 0xa5, 0xb0, 0xb3, 0x5b, 0x32, 0x03, 0xdb, 0x49, 0xd8, 0x57, 0x60, 0x41,
-	0x75, 0xf6, 0x64, 0xe4, 0x9f, 0x26, 0xdd, 0x05, 0x2a, 0xd8, 0x96, 0xe0, 0x43, 0xc4, 0xdc, 0xe7,
-	0x42, 0x49, 0x31, 0xd6, 0x56, 0xf2, 0x0c, 0x6e, 0x95, 0x84, 0xd0, 0xba, 0x36, 0x3c, 0x99, 0x2a,
-	0x5b, 0xb4, 0x4a, 0xc9, 0xa2, 0xb9, 0x3f, 0x73, 0xa0, 0x2d, 0x6b, 0xa6, 0x5d, 0x9d, 0xdd, 0x03,
-	0xa6, 0x56, 0x31, 0xbd, 0x08, 0x86, 0xfd, 0xe3, 0xcb, 0x94, 0x27, 0x82, 0x68, 0xf6, 0xae, 0x78,
-	0x25, 0x79, 0xec, 0x7d, 0xe8, 0x58, 0x68, 0x92, 0xc6, 0x82, 0x9e, 0xf7, 0xae, 0x78, 0x85, 0x1c,
-	0x64, 0x2f, 0xd4, 0x1b, 0xa6, 0x69, 0x3f, 0x08, 0x87, 0xfc, 0x82, 0x48, 0x69, 0xc1, 0xb3, 0xb0,
-	0x07, 0x8b, 0xd0, 0x36, 0xbf, 0x73, 0x7f, 0x04, 0x0d, 0xa5, 0x75, 0xd0, 0x8e, 0x9b, 0xeb, 0x97,
-	0x67, 0x20, 0xac, 0x07, 0x0d, 0xbb, 0x17, 0x5e, 0xe3, 0xcb, 0xb4, 0xed, 0xfe, 0x45, 0xe8, 0xec,
-	0x23, 0x11, 0x85, 0x48, 0xb4, 0x52, 0x9d, 0x5a, 0x83, 0x39, 0x83, 0x79, 0x9a, 0x9e, 0x4c, 0xe1,
-	0xa6, 0x76, 0x16, 0x25, 0xa9, 0x6c, 0x87, 0x7e, 0xbb, 0xff, 0xda, 0x01, 0xb6, 0x9b, 0xa4, 0xc1,
-	0xd8, 0x4f, 0xf9, 0x43, 0xae, 0x45, 0xc3, 0x13, 0x68, 0x63, 0x6d, 0x4f, 0xa3, 0x2d, 0xa1, 0xd8,
-	0x88, 0x0d, 0xf9, 0x6b, 0x92, 0x9d, 0x8b, 0x1f, 0x6c, 0x9a, 0xa5, 0x85, 0xd0, 0xb5, 0x2a, 0x40,
-	0x6e, 0x4b, 0xfd, 0xf8, 0x94, 0xa7, 0xa4, 0xf5, 0x48, 0xbd, 0x19, 0x04, 0xb4, 0x1d, 0x85, 0x27,
-	0xbd, 0xdf, 0x83, 0xe5, 0x42, 0x1d, 0xa6, 0x7c, 0x6e, 0x96, 0xc8, 0xe7, 0xaa, 0x29, 0x9f, 0x5f,
-	0xc0, 0x8a, 0xd5, 0x2f, 0x49, 0x71, 0xd7, 0xc5, 0xe6, 0x26, 0x14, 0x4b, 0x52, 0x0d, 0xbc, 0x0c,
-	0x60, 0x1f, 0xc2, 0xda, 0x09, 0xe7, 0xb1, 0x9f, 0x4a, 0x80, 0x18, 0x08, 0x57, 0x46, 0xd6, 0x3f,
-	0x23, 0xd7, 0xfd, 0x85, 0x03, 0x4b, 0x28, 0x51, 0x3f, 0xf3, 0xc3, 0x4b, 0x35, 0x67, 0xfb, 0xa5,
-	0x73, 0x76, 0xc7, 0xd8, 0x9c, 0x8c, 0xd2, 0x5f, 0x76, 0xc2, 0xaa, 0xf9, 0x09, 0x63, 0xb7, 0x60,
-	0x31, 0xd7, 0xe5, 0xba, 0x54, 0x9b, 0x11, 0x3d, 0xe4, 0xf1, 0x83, 0xcb, 0x94, 0xff, 0xfa, 0xd3,
-	0x7a, 0x1b, 0x3a, 0x59, 0xd7, 0xe5, 0x9c, 0x32, 0xa8, 0x21, 0x91, 0xca, 0x0a, 0xe8, 0xb7, 0xfb,
-	0xc7, 0x8e, 0x28, 0xb8, 0x1d, 0x05, 0x5a, 0xdb, 0xc3, 0x82, 0xa8, 0x34, 0xaa, 0x82, 0xf8, 0x7b,
-	0xa6, 0xb6, 0xfc, 0x9b, 0x19, 0x30, 0xca, 0xc8, 0x84, 0xa3, 0x96, 0x31, 0x1a, 0x91, 0x60, 0x6e,
-	0x78, 0xf3, 0x98, 0xde, 0x1a, 0x8d, 0xdc, 0xf7, 0x60, 0xd9, 0xe8, 0xe1, 0x2b, 0xc6, 0x72, 0x00,
-	0x6c, 0x3f, 0x48, 0xd2, 0x67, 0x61, 0x32, 0x31, 0x14, 0xaa, 0xb7, 0xa0, 0x89, 0xd2, 0x17, 0x7b,
-	0x27, 0x28, 0xa9, 0xee, 0xa1, 0x38, 0xc6, 0xbe, 0x25, 0x94, 0xe9, 0x5f, 0xc8, 0xcc, 0x8a, 0xcc,
-	0xf4, 0x2f, 0x28, 0xd3, 0xfd, 0x08, 0x56, 0xac, 0xfa, 0x64, 0xd3, 0xef, 0x42, 0x7d, 0x9a, 0x5e,
-	0x44, 0x4a, 0xdd, 0x6d, 0x49, 0x4a, 0x41, 0xe3, 0xca, 0x13, 0x39, 0xee, 0x27, 0xb0, 0x7c, 0xc0,
-	0x5f, 0x4a, 0xc6, 0x56, 0x1d, 0xb9, 0xfd, 0x5a, 0xc3, 0x8b, 0xf2, 0xdd, 0x4d, 0x60, 0xe6, 0xc7,
-	0xb2, 0x55, 0xc3, 0x0c, 0x73, 0x2c, 0x33, 0xcc, 0xbd, 0x0d, 0xec, 0x28, 0x38, 0x0d, 0x3f, 0xe3,
-	0x49, 0xe2, 0x9f, 0x6a, 0x51, 0xd0, 0x81, 0xea, 0x38, 0x39, 0x95, 0xa2, 0x0b, 0x7f, 0xba, 0xdf,
-	0x80, 0x15, 0xab, 0x5c, 0xc6, 0x69, 0x49, 0x70, 0x1a, 0xfa, 0xe9, 0x34, 0xe6, 0xb2, 0xea, 0x0c,
-	0x70, 0x1f, 0xc2, 0xea, 0x77, 0x79, 0x1c, 0x9c, 0x5c, 0xbe, 0xae, 0x7a, 0xbb, 0x9e, 0x4a, 0xbe,
-	0x9e, 0x5d, 0xb8, 0x9a, 0xab, 0x47, 0x36, 0x2f, 0x48, 0x58, 0xae, 0x64, 0xc3, 0x13, 0x09, 0x43,
-	0x16, 0x56, 0x4c, 0x59, 0xe8, 0x3e, 0x03, 0xb6, 0x1d, 0x85, 0x21, 0x1f, 0xa4, 0x87, 0x9c, 0xc7,
-	0x99, 0x07, 0x28, 0xa3, 0xd7, 0xd6, 0xfd, 0x75, 0x39, 0xb3, 0x79, 0x01, 0x2b, 0x09, 0x99, 0x41,
-	0x6d, 0xc2, 0xe3, 0x31, 0x55, 0xdc, 0xf0, 0xe8, 0xb7, 0x7b, 0x15, 0x56, 0xac, 0x6a, 0xa5, 0xcd,
-	0xfc, 0x01, 0x5c, 0xdd, 0x09, 0x92, 0x41, 0xb1, 0xc1, 0x2e, 0xcc, 0x4f, 0xa6, 0xc7, 0xfd, 0x8c,
-	0x1b, 0x55, 0x12, 0x4d, 0xa8, 0xfc, 0x27, 0xb2, 0xb2, 0xbf, 0xe1, 0x40, 0x6d, 0xef, 0xe9, 0xfe,
-	0x36, 0xee, 0x1d, 0x41, 0x38, 0x88, 0xc6, 0xa8, 0x91, 0x89, 0x41, 0xeb, 0xf4, 0x4c, 0x2e, 0xbb,
-	0x0e, 0x4d, 0x52, 0xe4, 0xd0, 0x6a, 0x94, 0x7a, 0x51, 0x06, 0xa0, 0xc5, 0xca, 0x2f, 0x26, 0x41,
-	0x4c, 0x26, 0xa9, 0x32, 0x34, 0x6b, 0xb4, 0xed, 0x14, 0x33, 0xdc, 0xff, 0x32, 0x07, 0xf3, 0x72,
-	0x33, 0x16, 0x1b, 0x7b, 0x1a, 0x9c, 0xf3, 0x6c, 0x63, 0xc7, 0x14, 0x2a, 0xc9, 0x31, 0x1f, 0x47,
-	0xa9, 0xd6, 0xe7, 0xc4, 0x32, 0xd8, 0x20, 0x59, 0xe4, 0x52, 0xa9, 0x10, 0x36, 0x7c, 0x55, 0x94,
-	0xb2, 0x40, 0x9c, 0x2c, 0xa5, 0x1c, 0x08, 0x6d, 0x4d, 0x25, 0x71, 0x26, 0x06, 0xfe, 0xc4, 0x1f,
-	0x04, 0xe9, 0xa5, 0x14, 0x0a, 0x3a, 0x8d, 0x75, 0x8f, 0xa2, 0x81, 0x3f, 0xea, 0x1f, 0xfb, 0x23,
-	0x3f, 0x1c, 0x70, 0x65, 0xed, 0x5b, 0x20, 0x5a, 0xbe, 0xb2, 0x4b, 0xaa, 0x98, 0xb0, 0x8e, 0x73,
-	0x28, 0xee, 0xe7, 0x83, 0x68, 0x3c, 0x0e, 0x52, 0x34, 0x98, 0x49, 0x4d, 0xab, 0x7a, 0x06, 0xc2,
-	0x6e, 0x42, 0x4b, 0xa6, 0x92, 0xe0, 0x27, 0x9c, 0xb4, 0xb4, 0xaa, 0x67, 0x42, 0x58, 0x43, 0x4e,
-	0x53, 0xab, 0x7a, 0x06, 0x82, 0x6b, 0x30, 0x0d, 0x13, 0x9e, 0xa6, 0x23, 0x3e, 0xd4, 0x9d, 0x69,
-	0x51, 0xb1, 0x62, 0x06, 0x9a, 0x17, 0xc2, 0x7e, 0x17, 0xa2, 0x31, 0x41, 0x33, 0xb7, 0x4d, 0x85,
-	0x0b, 0x38, 0xbb, 0x0f, 0xab, 0x26, 0x16, 0xf3, 0x01, 0x0f, 0xce, 0xf9, 0x90, 0x34, 0xb8, 0xaa,
-	0x57, 0x9a, 0x87, 0xe3, 0x09, 0xa7, 0xe3, 0xfe, 0x74, 0x32, 0xf4, 0x51, 0x81, 0x59, 0xa4, 0x79,
-	0x37, 0x21, 0xf6, 0x01, 0x28, 0x1d, 0x4d, 0x6a, 0x8e, 0x4b, 0x96, 0x34, 0x43, 0x4a, 0xf5, 0xec,
-	0x12, 0x48, 0x84, 0x99, 0x3a, 0xda, 0x91, 0x06, 0x9e, 0x02, 0x88, 0x27, 0xe2, 0xe0, 0xdc, 0x4f,
-	0x79, 0x77, 0x59, 0x08, 0x70, 0x99, 0xc4, 0xef, 0x82, 0x30, 0x48, 0x03, 0x3f, 0x8d, 0xe2, 0x2e,
-	0xa3, 0xbc, 0x0c, 0xc0, 0x89, 0x23, 0x7a, 0x48, 0x52, 0x3f, 0x9d, 0x26, 0x52, 0x3b, 0x5d, 0x11,
-	0x96, 0x4a, 0x21, 0x83, 0x7d, 0x0c, 0x5d, 0x41, 0x01, 0x94, 0x25, 0xf5, 0x6e, 0xa9, 0x26, 0xac,
-	0xd2, 0x84, 0xcc, 0xcc, 0x67, 0xbf, 0x03, 0xd7, 0x24, 0x59, 0x94, 0x7c, 0x7c, 0x95, 0x3e, 0x9e,
-	0x5d, 0x00, 0xfb, 0x89, 0x3d, 0x09, 0x06, 0x7d, 0x59, 0x06, 0xd9, 0x62, 0x8d, 0x46, 0x53, 0xcc,
-	0x70, 0xff, 0xc8, 0x11, 0x9b, 0x87, 0x64, 0xb4, 0xc4, 0x30, 0x93, 0x04, 0x8b, 0xf5, 0xa3, 0x70,
-	0x74, 0x29, 0xb9, 0x0e, 0x04, 0xf4, 0x24, 0x1c, 0x5d, 0xa2, 0xa2, 0x1e, 0x84, 0x66, 0x11, 0x21,
-	0xa7, 0xda, 0x0a, 0xa4, 0x42, 0xef, 0x40, 0x6b, 0x32, 0x3d, 0x1e, 0x05, 0x03, 0x51, 0xa4, 0x2a,
-	0x6a, 0x11, 0x10, 0x15, 0x40, 0x1b, 0x51, 0xcc, 0xbe, 0x28, 0x51, 0xa3, 0x12, 0x2d, 0x89, 0x61,
-	0x11, 0xf7, 0x01, 0xac, 0xda, 0x1d, 0x94, 0x02, 0x79, 0x03, 0x1a, 0x92, 0x7f, 0x93, 0x6e, 0x8b,
-	0x68, 0x62, 0xd1, 0x70, 0x7f, 0xa2, 0x59, 0xa3, 0xf3, 0xdd, 0x7f, 0x56, 0x83, 0x15, 0x89, 0x6e,
-	0x8f, 0xa2, 0x84, 0x1f, 0x4d, 0xc7, 0x63, 0x3f, 0x2e, 0x11, 0x0c, 0xce, 0x6b, 0x04, 0x43, 0xc5,
-	0x16, 0x0c, 0x37, 0x2c, 0x5b, 0x51, 0x48, 0x15, 0x03, 0x61, 0x77, 0x60, 0x69, 0x30, 0x8a, 0x12,
-	0xa1, 0xba, 0x9b, 0x9e, 0xb7, 0x3c, 0x5c, 0x14, 0x64, 0xf5, 0x32, 0x41, 0x66, 0x0a, 0xa2, 0xb9,
-	0x9c, 0x20, 0x72, 0xa1, 0x8d, 0x95, 0x72, 0x25, 0x57, 0xe7, 0xa5, 0xe1, 0x64, 0x60, 0xd8, 0x9f,
-	0x3c, 0xeb, 0x0b, 0x19, 0x93, 0x87, 0xd9, 0x3d, 0x58, 0x21, 0xc7, 0x1e, 0xca, 0x6d, 0xa3, 0xb4,
-	0x10, 0x38, 0x65, 0x59, 0xec, 0x21, 0x80, 0x68, 0x8b, 0x94, 0x07, 0x20, 0xe5, 0xe1, 0xb6, 0xbd,
-	0x22, 0xe6, 0xdc, 0x6f, 0x62, 0x62, 0x1a, 0x73, 0x52, 0x28, 0x8c, 0x2f, 0xdd, 0xbf, 0xe5, 0x40,
-	0xcb, 0xc8, 0x63, 0x57, 0x61, 0x79, 0xfb, 0xc9, 0x93, 0xc3, 0x5d, 0x6f, 0xeb, 0xe9, 0xe3, 0xef,
-	0xee, 0xf6, 0xb7, 0xf7, 0x9f, 0x1c, 0xed, 0x76, 0xae, 0x20, 0xbc, 0xff, 0x64, 0x7b, 0x6b, 0xbf,
-	0xff, 0xf0, 0x89, 0xb7, 0xad, 0x60, 0x87, 0xad, 0x01, 0xf3, 0x76, 0x3f, 0x7b, 0xf2, 0x74, 0xd7,
-	0xc2, 0x2b, 0xac, 0x03, 0xed, 0x07, 0xde, 0xee, 0xd6, 0xf6, 0x9e, 0x44, 0xaa, 0x6c, 0x15, 0x3a,
-	0x0f, 0x9f, 0x1d, 0xec, 0x3c, 0x3e, 0x78, 0xd4, 0xdf, 0xde, 0x3a, 0xd8, 0xde, 0xdd, 0xdf, 0xdd,
-	0xe9, 0xd4, 0xd8, 0x02, 0x34, 0xb7, 0x1e, 0x6c, 0x1d, 0xec, 0x3c, 0x39, 0xd8, 0xdd, 0xe9, 0xd4,
-	0xdd, 0xff, 0xec, 0xc0, 0x55, 0xea, 0xf5, 0x30, 0xcf, 0x20, 0x24, 0x89, 0xa3, 0x09, 0xaa, 0xef,
-	0xd9, 0xb6, 0x64, 0x42, 0x48, 0xfc, 0x82, 0xc5, 0x4f, 0xa2, 0x78, 0xc0, 0x25, 0x7f, 0x00, 0x41,
-	0x0f, 0x11, 0x41, 0xe2, 0x97, 0xcb, 0x2b, 0x4a, 0x08, 0xf6, 0x68, 0x09, 0x4c, 0x14, 0x59, 0x83,
-	0xb9, 0xe3, 0x98, 0xfb, 0x83, 0x33, 0xc9, 0x19, 0x32, 0xc5, 0xbe, 0x9a, 0x59, 0x99, 0x03, 0x9c,
-	0xfd, 0x11, 0x1f, 0x12, 0xc5, 0x34, 0xbc, 0x25, 0x89, 0x6f, 0x4b, 0x18, 0xa5, 0x9a, 0x7f, 0xec,
-	0x87, 0xc3, 0x28, 0xe4, 0x43, 0xa9, 0xb2, 0x66, 0x80, 0x7b, 0x08, 0x6b, 0xf9, 0xf1, 0x49, 0xfe,
-	0xfa, 0xd0, 0xe0, 0x2f, 0xa1, 0x41, 0xf6, 0x66, 0xaf, 0xa6, 0xc1, 0x6b, 0xbf, 0xa8, 0x40, 0x0d,
-	0x15, 0x8a, 0xd9, 0xca, 0x87, 0xa9, 0x23, 0x56, 0x6d, 0x57, 0xfd, 0x0d, 0x00, 0x32, 0x5c, 0xc5,
-	0x4e, 0x23, 0x9d, 0x26, 0x19, 0x92, 0xe5, 0xc7, 0x7c, 0x70, 0x2e, 0xdd, 0x26, 0x06, 0x82, 0xf9,
-	0xc6, 0x4e, 0x25, 0x3d, 0xd4, 0xc6, 0x1e, 0xa5, 0xf3, 0xe9, 0xfb, 0x79, 0x33, 0x9f, 0xbe, 0xef,
-	0xc2, 0x7c, 0x10, 0x92, 0xab, 0x90, 0x18, 0xa3, 0xe1, 0xa9, 0x24, 0x1d, 0x10, 0x10, 0xc3, 0x06,
-	0x63, 0xc5, 0x06, 0x19, 0xc0, 0xee, 0x43, 0x33, 0xb9, 0x0c, 0x07, 0x26, 0xed, 0xaf, 0xca, 0xd9,
-	0xc2, 0xb9, 0xd8, 0x3c, 0xba, 0x0c, 0x07, 0x44, 0xe9, 0x59, 0x31, 0xf7, 0xf7, 0xa0, 0xa1, 0x60,
-	0x24, 0xcf, 0x67, 0x07, 0x9f, 0x1e, 0x3c, 0x79, 0x7e, 0xd0, 0x3f, 0xfa, 0xde, 0xc1, 0x76, 0xe7,
-	0x0a, 0x5b, 0x82, 0xd6, 0xd6, 0x36, 0x51, 0x3c, 0x01, 0x0e, 0x16, 0x39, 0xdc, 0x3a, 0x3a, 0xd2,
-	0x48, 0xc5, 0x65, 0x68, 0x9c, 0x27, 0xa4, 0xbd, 0x69, 0x07, 0xf8, 0x87, 0xb0, 0x6c, 0x60, 0x99,
-	0x25, 0x30, 0x41, 0x20, 0x67, 0x09, 0x90, 0xda, 0x27, 0x72, 0xdc, 0x0e, 0x2c, 0x3e, 0xe2, 0xe9,
-	0xe3, 0xf0, 0x24, 0x52, 0x35, 0xfd, 0xa2, 0x06, 0x4b, 0x1a, 0x92, 0x15, 0xdd, 0x81, 0xa5, 0x60,
-	0xc8, 0xc3, 0x34, 0x48, 0x2f, 0xfb, 0x96, 0x0f, 0x20, 0x0f, 0xa3, 0xba, 0xec, 0x8f, 0x02, 0x5f,
-	0x9d, 0xc5, 0x88, 0x04, 0xaa, 0x08, 0xb8, 0xb7, 0x9b, 0xbe, 0x18, 0xa2, 0x2f, 0xe1, 0x7a, 0x28,
-	0xcd, 0x43, 0x49, 0x84, 0xb8, 0xdc, 0x6a, 0xf4, 0x27, 0x42, 0x6d, 0x2c, 0xcb, 0xc2, 0xa5, 0x12,
-	0x35, 0xe1, 0x90, 0xeb, 0x62, 0xff, 0xd7, 0x40, 0xe1, 0xa0, 0x63, 0x4e, 0xc8, 0xc9, 0xfc, 0x41,
-	0x87, 0x71, 0x58, 0xd2, 0x28, 0x1c, 0x96, 0xa0, 0x1c, 0xbd, 0x0c, 0x07, 0x7c, 0xd8, 0x4f, 0xa3,
-	0x3e, 0xc9, 0x7b, 0xe9, 0x72, 0xce, 0xc3, 0xec, 0x3a, 0xcc, 0xa7, 0x3c, 0x49, 0x43, 0x9e, 0x12,
-	0x59, 0x34, 0x1e, 0x54, 0xba, 0x8e, 0xa7, 0x20, 0xd4, 0xf1, 0xa7, 0x71, 0x90, 0x90, 0xa3, 0xb9,
-	0xe9, 0xd1, 0x6f, 0xf6, 0x4d, 0xb8, 0x7a, 0xcc, 0x93, 0xb4, 0x7f, 0xc6, 0xfd, 0x21, 0x8f, 0x89,
-	0xbc, 0xc4, 0x79, 0x8b, 0xd0, 0xa3, 0xca, 0x33, 0x91, 0x70, 0xcf, 0x79, 0x9c, 0x04, 0x51, 0x48,
-	0x4a, 0x54, 0xd3, 0x53, 0x49, 0xac, 0x0f, 0x07, 0xaf, 0x37, 0x6b, 0x3d, 0x83, 0x4b, 0x34, 0xf0,
-	0xf2, 0x4c, 0x76, 0x0b, 0xe6, 0x68, 0x00, 0x49, 0xb7, 0x43, 0x34, 0xd3, 0xce, 0x78, 0x3f, 0x08,
-	0x3d, 0x99, 0x87, 0xab, 0x3c, 0x88, 0x46, 0x51, 0x4c, 0x9a, 0x54, 0xd3, 0x13, 0x09, 0x7b, 0x76,
-	0x4e, 0x63, 0x7f, 0x72, 0x26, 0xb5, 0xa9, 0x3c, 0xfc, 0xed, 0x5a, 0xa3, 0xd5, 0x69, 0xbb, 0xbf,
-	0x05, 0x75, 0xaa, 0x96, 0xaa, 0xa3, 0xc9, 0x74, 0x64, 0x75, 0x84, 0x76, 0x61, 0x3e, 0xe4, 0xe9,
-	0xcb, 0x28, 0x7e, 0xa1, 0x0e, 0xf6, 0x64, 0xd2, 0xfd, 0x09, 0x59, 0x59, 0xfa, 0x90, 0xeb, 0x19,
-	0xa9, 0x8c, 0x68, 0x2b, 0x8b, 0xa5, 0x4a, 0xce, 0x7c, 0x69, 0xf8, 0x35, 0x08, 0x38, 0x3a, 0xf3,
-	0x51, 0xe6, 0x5a, 0xab, 0x2f, 0x6c, 0xe9, 0x16, 0x61, 0x7b, 0x62, 0xf1, 0x6f, 0xc1, 0xa2, 0x3a,
-	0x3e, 0x4b, 0xfa, 0x23, 0x7e, 0x92, 0x2a, 0xcf, 0x58, 0x38, 0x1d, 0x93, 0xc1, 0xbd, 0xcf, 0x4f,
-	0x52, 0xf7, 0x00, 0x96, 0xa5, 0x1c, 0x7c, 0x32, 0xe1, 0xaa, 0xe9, 0xdf, 0x2e, 0xd3, 0x27, 0x5a,
-	0xf7, 0x57, 0x6c, 0xc1, 0x29, 0x0e, 0x0c, 0xed, 0x92, 0xae, 0x07, 0xcc, 0x94, 0xab, 0xb2, 0x42,
-	0xb9, 0xa9, 0x2b, 0xdf, 0x9f, 0x1c, 0x8e, 0x85, 0xe1, 0xfc, 0x24, 0xd3, 0xc1, 0x40, 0x1d, 0x7c,
-	0x36, 0x3c, 0x95, 0x74, 0xff, 0xb1, 0x03, 0x2b, 0x54, 0x9b, 0xd2, 0x88, 0xe4, 0xde, 0xf5, 0xd1,
-	0x97, 0xe8, 0xa6, 0xf2, 0xbc, 0x0a, 0x7f, 0xe3, 0x2a, 0xd4, 0xcd, 0xdd, 0x4c, 0x24, 0x7e, 0x15,
-	0xdf, 0x4a, 0xad, 0xe8, 0x5b, 0x71, 0xff, 0x9e, 0x03, 0xcb, 0x62, 0x53, 0x21, 0x4d, 0x5a, 0x4e,
-	0xc1, 0xef, 0xc0, 0x82, 0xd0, 0x0e, 0xa4, 0x64, 0x90, 0x9d, 0xcd, 0xc4, 0x2b, 0xa1, 0xa2, 0xf0,
-	0xde, 0x15, 0xcf, 0x2e, 0xcc, 0x3e, 0x21, 0x0d, 0x2d, 0xec, 0x13, 0x5a, 0x72, 0x4c, 0x6e, 0xcf,
-	0xf7, 0xde, 0x15, 0xcf, 0x28, 0xfe, 0xa0, 0x01, 0x73, 0xc2, 0x0c, 0x71, 0x1f, 0xc1, 0x82, 0xd5,
-	0x90, 0xe5, 0xd7, 0x69, 0x0b, 0xbf, 0x4e, 0xc1, 0xa1, 0x5a, 0x29, 0x71, 0xa8, 0xfe, 0x79, 0x15,
-	0x18, 0x12, 0x4c, 0x6e, 0x45, 0x6e, 0xda, 0xa7, 0x12, 0xea, 0xc4, 0x3c, 0x83, 0xd8, 0x26, 0x30,
-	0x23, 0xa9, 0x4e, 0x4a, 0xc4, 0xf6, 0x59, 0x92, 0x83, 0xa2, 0x56, 0x6a, 0x1f, 0xfa, 0x14, 0x82,
-	0xec, 0x75, 0x31, 0xf1, 0xa5, 0x79, 0x28, 0xf6, 0xc4, 0x91, 0x04, 0x59, 0x1a, 0xc2, 0xd2, 0x35,
-	0x90, 0xfc, 0x3a, 0xcf, 0xbd, 0xc1, 0x3a, 0xcf, 0x97, 0xf8, 0xd0, 0x0c, 0x0b, 0xac, 0x61, 0x5b,
-	0x60, 0x1b, 0xd0, 0x51, 0x27, 0x10, 0xfd, 0xb1, 0xec, 0x86, 0xd8, 0x6b, 0x0b, 0x38, 0x96, 0x55,
-	0x46, 0x90, 0x36, 0xf6, 0x40, 0x9c, 0x2a, 0xe4, 0x71, 0xdc, 0x11, 0x32, 0xdf, 0x5a, 0x8b, 0xba,
-	0x9d, 0x01, 0x64, 0x31, 0x21, 0xbd, 0xf4, 0xa7, 0xa1, 0x3c, 0x33, 0xe7, 0x43, 0xb2, 0x72, 0xd1,
-	0x62, 0xca, 0x67, 0xb8, 0x7f, 0xc7, 0x81, 0x0e, 0xae, 0xa0, 0x45, 0xa4, 0x1f, 0x03, 0xf1, 0xc9,
-	0x1b, 0xd2, 0xa8, 0x55, 0x96, 0x7d, 0x04, 0x4d, 0x4a, 0x47, 0x13, 0x1e, 0x4a, 0x0a, 0xed, 0xda,
-	0x14, 0x9a, 0x49, 0x98, 0xbd, 0x2b, 0x5e, 0x56, 0xd8, 0xa0, 0xcf, 0x7f, 0xe7, 0x40, 0x4b, 0xb6,
-	0xf2, 0x2b, 0xfb, 0x6e, 0x7a, 0x46, 0x90, 0x83, 0xa0, 0xab, 0x2c, 0xa6, 0xe1, 0x0e, 0x2c, 0x8d,
-	0xfd, 0x74, 0x1a, 0xe3, 0x0e, 0x6f, 0xf9, 0x6d, 0xf2, 0x30, 0x6e, 0xd7, 0x24, 0x4c, 0x93, 0x7e,
-	0x1a, 0x8c, 0xfa, 0x2a, 0x57, 0x86, 0x13, 0x94, 0x65, 0xa1, 0x4c, 0x49, 0x52, 0xff, 0x94, 0xcb,
-	0x9d, 0x58, 0x24, 0xdc, 0x2e, 0xac, 0x1d, 0x66, 0x67, 0x33, 0x86, 0xe6, 0xed, 0xfe, 0xd3, 0x05,
-	0x58, 0x2f, 0x64, 0xe9, 0x00, 0xa8, 0x15, 0xe1, 0x67, 0x18, 0x05, 0xe3, 0xe3, 0x48, 0x9b, 0x2d,
-	0x8e, 0x34, 0x5b, 0x8a, 0x59, 0xec, 0x14, 0xae, 0x2a, 0x95, 0x03, 0xe7, 0x34, 0xdb, 0x1e, 0x2b,
-	0xb4, 0xef, 0x7d, 0x60, 0x2f, 0x61, 0xbe, 0x41, 0x85, 0x9b, 0x2c, 0x5d, 0x5e, 0x1f, 0x3b, 0x83,
-	0xae, 0xd6, 0x6d, 0xa4, 0xf8, 0x36, 0xf4, 0x1f, 0x6c, 0xeb, 0xfd, 0xd7, 0xb4, 0x65, 0x29, 0xea,
-	0xde, 0xcc, 0xda, 0xd8, 0x25, 0xdc, 0x50, 0x79, 0x24, 0x9f, 0x8b, 0xed, 0xd5, 0xde, 0x68, 0x6c,
-	0x64, 0x82, 0xd8, 0x8d, 0xbe, 0xa6, 0x62, 0xf6, 0x23, 0x58, 0x7b, 0xe9, 0x07, 0xa9, 0xea, 0x96,
-	0xa1, 0x6d, 0xd4, 0xa9, 0xc9, 0xfb, 0xaf, 0x69, 0xf2, 0xb9, 0xf8, 0xd8, 0xda, 0xb4, 0x66, 0xd4,
-	0xd8, 0xfb, 0x57, 0x15, 0x58, 0xb4, 0xeb, 0x41, 0x32, 0x95, 0xbc, 0xaf, 0x24, 0xa2, 0xd2, 0x4f,
-	0x73, 0x70, 0xd1, 0xf2, 0xaf, 0x94, 0x59, 0xfe, 0xa6, 0xbd, 0x5d, 0x7d, 0x9d, 0xe3, 0xaf, 0xf6,
-	0x66, 0x8e, 0xbf, 0x7a, 0xa9, 0xe3, 0xef, 0x55, 0xfe, 0xa2, 0xb9, 0x5f, 0xc7, 0x5f, 0x34, 0xff,
-	0x1a, 0x7f, 0x51, 0xef, 0x7f, 0x39, 0xc0, 0x8a, 0x54, 0xcc, 0x1e, 0x09, 0xa7, 0x47, 0xc8, 0x47,
-	0x52, 0x98, 0x7d, 0xfd, 0xcd, 0x38, 0x41, 0xad, 0x9a, 0xfa, 0x1a, 0x59, 0xd2, 0x8c, 0x44, 0x32,
-	0x15, 0xaf, 0x05, 0xaf, 0x2c, 0x2b, 0xe7, 0x04, 0xad, 0xbd, 0xce, 0x09, 0x5a, 0x7f, 0x9d, 0x13,
-	0x74, 0x2e, 0xef, 0x04, 0xed, 0xfd, 0x75, 0x07, 0x56, 0x4a, 0x48, 0xed, 0x37, 0x37, 0x68, 0x24,
-	0x0e, 0x4b, 0x02, 0x55, 0x24, 0x71, 0x98, 0x60, 0xef, 0xaf, 0xc2, 0x82, 0xc5, 0x5e, 0xbf, 0xb9,
-	0xf6, 0xf3, 0x7a, 0xa3, 0xa0, 0x6e, 0x0b, 0xeb, 0xfd, 0x8f, 0x0a, 0xb0, 0x22, 0x8b, 0xff, 0x7f,
-	0xed, 0x43, 0x71, 0x9e, 0xaa, 0x25, 0xf3, 0xf4, 0xff, 0x74, 0xf7, 0x79, 0x1f, 0x96, 0x65, 0x78,
-	0xa5, 0xe1, 0xe6, 0x12, 0x14, 0x53, 0xcc, 0x40, 0xcd, 0xd9, 0xf6, 0x46, 0x37, 0xac, 0x50, 0x32,
-	0x63, 0x0b, 0xce, 0x39, 0xa5, 0xdd, 0x1e, 0x74, 0xe5, 0x0c, 0xed, 0x9e, 0xf3, 0x30, 0x3d, 0x9a,
-	0x1e, 0x8b, 0xd8, 0xc2, 0x20, 0x0a, 0xdd, 0x3f, 0xab, 0x6a, 0xe5, 0x9f, 0x32, 0xa5, 0x52, 0xf1,
-	0x4d, 0x68, 0x9b, 0x5b, 0x88, 0x5c, 0x8e, 0x9c, 0x97, 0x13, 0xd5, 0x09, 0xb3, 0x14, 0xdb, 0x81,
-	0x45, 0x12, 0x94, 0x43, 0xfd, 0x5d, 0x85, 0xbe, 0x7b, 0x85, 0xf7, 0x66, 0xef, 0x8a, 0x97, 0xfb,
-	0x86, 0xfd, 0x2e, 0x2c, 0xda, 0x26, 0xa1, 0xd4, 0x4c, 0xca, 0x6c, 0x04, 0xfc, 0xdc, 0x2e, 0xcc,
-	0xb6, 0xa0, 0x93, 0xb7, 0x29, 0x65, 0xcc, 0xce, 0x8c, 0x0a, 0x0a, 0xc5, 0xd9, 0x47, 0xf2, 0x18,
-	0xb2, 0x4e, 0xde, 0x94, 0x5b, 0xf6, 0x67, 0xc6, 0x34, 0x6d, 0x8a, 0x3f, 0xc6, 0xc1, 0xe4, 0xef,
-	0x03, 0x64, 0x18, 0xeb, 0x40, 0xfb, 0xc9, 0xe1, 0xee, 0x41, 0x7f, 0x7b, 0x6f, 0xeb, 0xe0, 0x60,
-	0x77, 0xbf, 0x73, 0x85, 0x31, 0x58, 0x24, 0x27, 0xe0, 0x8e, 0xc6, 0x1c, 0xc4, 0xa4, 0xbb, 0x45,
-	0x61, 0x15, 0xb6, 0x0a, 0x9d, 0xc7, 0x07, 0x39, 0xb4, 0xfa, 0xa0, 0xa9, 0xf9, 0xc3, 0x5d, 0x83,
-	0x55, 0x11, 0x3e, 0xfb, 0x40, 0x90, 0x87, 0xd2, 0x50, 0xfe, 0x81, 0x03, 0x57, 0x73, 0x19, 0x59,
-	0x50, 0x97, 0x50, 0x42, 0x6c, 0xcd, 0xc4, 0x06, 0xe9, 0xa8, 0x41, 0xe9, 0x9b, 0x39, 0x09, 0x52,
-	0xcc, 0x40, 0x9a, 0x37, 0xf4, 0xd3, 0x1c, 0x27, 0x95, 0x65, 0xb9, 0xeb, 0x3a, 0x7e, 0x26, 0xd7,
-	0xf1, 0x7f, 0xe3, 0x88, 0xb8, 0x5c, 0x33, 0x27, 0x3b, 0xd7, 0xb5, 0xfb, 0xac, 0x92, 0x68, 0x69,
-	0x58, 0x1a, 0x8f, 0xdd, 0xe1, 0xd2, 0x3c, 0xb4, 0x66, 0xc6, 0xfe, 0x45, 0x5f, 0x3a, 0xd7, 0x94,
-	0x6d, 0x22, 0xba, 0x5c, 0x92, 0x83, 0x63, 0xcc, 0x05, 0xf9, 0x19, 0xc6, 0x4c, 0x59, 0x96, 0xfb,
-	0xa7, 0x55, 0x60, 0xdf, 0x99, 0xf2, 0xf8, 0x92, 0x82, 0xc3, 0xb4, 0xdb, 0x76, 0x3d, 0xef, 0x94,
-	0x9c, 0x9b, 0x4c, 0x8f, 0x3f, 0xe5, 0x97, 0x2a, 0xba, 0xb2, 0x92, 0x45, 0x57, 0x96, 0x45, 0x38,
-	0xd6, 0x5e, 0x1f, 0xe1, 0x58, 0x7f, 0x5d, 0x84, 0xe3, 0x57, 0x60, 0x41, 0x04, 0x26, 0x0e, 0x49,
-	0x1d, 0xc1, 0xfd, 0xbd, 0x8a, 0x46, 0xbd, 0x04, 0x0f, 0x10, 0x63, 0x9f, 0x64, 0x85, 0xf8, 0xf0,
-	0x94, 0xa2, 0x69, 0x4d, 0x41, 0xb3, 0x3b, 0x3c, 0xe5, 0xfb, 0xd1, 0xc0, 0x4f, 0xa3, 0x98, 0x3c,
-	0x4a, 0xea, 0x63, 0xc4, 0x13, 0x34, 0xc3, 0x92, 0x68, 0x8a, 0x0a, 0x9a, 0x1a, 0xab, 0x70, 0x61,
-	0xb5, 0x05, 0x7a, 0x28, 0x46, 0xbc, 0x09, 0x2b, 0xd3, 0x84, 0xf7, 0xc7, 0x41, 0x92, 0xe0, 0xe6,
-	0x3b, 0x88, 0xc2, 0x34, 0x8e, 0x46, 0xd2, 0x91, 0xb5, 0x3c, 0x4d, 0xf8, 0x67, 0x22, 0x67, 0x5b,
-	0x64, 0xb0, 0x6f, 0x66, 0x5d, 0x9a, 0xf8, 0x41, 0x9c, 0x74, 0x81, 0xba, 0xa4, 0x46, 0x8a, 0xfd,
-	0x3e, 0xf4, 0x83, 0x58, 0xf7, 0x05, 0x13, 0xc9, 0x6b, 0xc2, 0x2d, 0x65, 0xb4, 0xde, 0x26, 0x34,
-	0xd4, 0xe7, 0x68, 0x59, 0x9f, 0xc4, 0xd1, 0x58, 0x59, 0xd6, 0xf8, 0x9b, 0x2d, 0x42, 0x25, 0x8d,
-	0xa4, 0x55, 0x5c, 0x49, 0x23, 0xf7, 0x7b, 0xd0, 0x32, 0x66, 0x40, 0x86, 0xec, 0x91, 0xde, 0x26,
-	0x4d, 0xf2, 0x9a, 0x30, 0x93, 0x42, 0x3e, 0x7a, 0x3c, 0x64, 0x5f, 0x83, 0xe5, 0x61, 0x10, 0x73,
-	0x0a, 0xea, 0xed, 0xc7, 0xfc, 0x9c, 0xc7, 0x89, 0x72, 0x60, 0x74, 0x74, 0x86, 0x27, 0x70, 0xb7,
-	0x0f, 0x2b, 0x16, 0xd9, 0x68, 0xc6, 0x9d, 0xa3, 0xf0, 0x42, 0xe5, 0x43, 0xb5, 0x43, 0x0f, 0x65,
-	0x1e, 0x6e, 0x79, 0xd2, 0xf7, 0xd2, 0x9f, 0xc4, 0xd1, 0x31, 0x35, 0xe2, 0x78, 0x16, 0xe6, 0xfe,
-	0x59, 0x05, 0xaa, 0x7b, 0xd1, 0xc4, 0x3c, 0x55, 0x72, 0xec, 0x53, 0x25, 0xa9, 0x9b, 0xf6, 0xb5,
-	0xea, 0x29, 0x95, 0x07, 0x0b, 0x64, 0x1b, 0xb0, 0xe8, 0x8f, 0xd3, 0x7e, 0x1a, 0xa1, 0x2e, 0xfe,
-	0xd2, 0x8f, 0x45, 0x1c, 0x62, 0x95, 0xc8, 0x21, 0x97, 0xc3, 0x56, 0xa1, 0xaa, 0x55, 0x29, 0x2a,
-	0x80, 0x49, 0x34, 0x04, 0xe9, 0xd4, 0xfd, 0x52, 0x3a, 0x49, 0x65, 0x8a, 0x7d, 0x08, 0x6b, 0xf6,
-	0xf7, 0xda, 0x16, 0x17, 0xfb, 0xe2, 0x8c, 0x5c, 0xd4, 0xcb, 0x90, 0x0d, 0xc6, 0x96, 0xe6, 0x69,
-	0x42, 0xe6, 0x91, 0x40, 0xc3, 0x3e, 0x12, 0xb8, 0x09, 0xad, 0x74, 0x74, 0xde, 0x9f, 0xf8, 0x97,
-	0xa3, 0xc8, 0x1f, 0x4a, 0x22, 0x34, 0x21, 0xf7, 0xff, 0x38, 0x50, 0xa7, 0xd9, 0x46, 0x75, 0x40,
-	0xc8, 0x4b, 0x7d, 0x0c, 0x45, 0x33, 0xb8, 0xe0, 0xe5, 0x61, 0xe6, 0x5a, 0x01, 0xe9, 0x15, 0x3d,
-	0x7c, 0x33, 0x28, 0xfd, 0x26, 0x34, 0xe5, 0xd1, 0xb4, 0x0a, 0xae, 0xa6, 0x22, 0x19, 0xc8, 0x6e,
-	0x40, 0xed, 0x2c, 0x9a, 0x28, 0xab, 0x09, 0xd4, 0xc9, 0x73, 0x34, 0xf1, 0x08, 0x47, 0x61, 0x96,
-	0xd5, 0xa7, 0x87, 0x2f, 0xd4, 0xd2, 0x92, 0x1c, 0x14, 0xef, 0xba, 0xf2, 0xdc, 0xd4, 0x16, 0x33,
-	0xdc, 0x67, 0xb0, 0x84, 0xbc, 0x61, 0xb8, 0xe6, 0x67, 0x0b, 0xb1, 0xaf, 0xe2, 0xb6, 0x3b, 0x18,
-	0x4d, 0x87, 0xdc, 0xb4, 0x63, 0xc9, 0xf5, 0x2a, 0x71, 0xa5, 0xbd, 0xb9, 0xff, 0xc4, 0x11, 0x3c,
-	0x87, 0xf5, 0xb2, 0x3b, 0x50, 0x43, 0x51, 0x94, 0x73, 0x5b, 0xe8, 0xc0, 0x14, 0x2c, 0xe7, 0x51,
-	0x09, 0xa4, 0x70, 0x72, 0x8e, 0x9a, 0xb5, 0x0b, 0xd7, 0x68, 0x66, 0x04, 0xde, 0x86, 0x45, 0x31,
-	0x8c, 0x9c, 0xed, 0x94, 0x43, 0xd9, 0xa6, 0x71, 0xc2, 0x54, 0xb3, 0xc4, 0x9b, 0xda, 0xe5, 0x87,
-	0xa7, 0xdc, 0x38, 0x59, 0xfa, 0x13, 0x07, 0x16, 0xac, 0x3e, 0x21, 0xd5, 0x8c, 0xfc, 0x24, 0x95,
-	0xc1, 0x02, 0x92, 0x0a, 0x4c, 0xc8, 0xa4, 0xb8, 0x8a, 0x4d, 0x71, 0xfa, 0x84, 0xa2, 0x6a, 0x9e,
-	0x50, 0xdc, 0x83, 0x66, 0x76, 0x3b, 0xc1, 0xee, 0x14, 0xb6, 0xa8, 0x42, 0x74, 0xb2, 0x42, 0x99,
-	0x0f, 0xbc, 0x6e, 0xf8, 0xc0, 0xdd, 0x4f, 0xa0, 0x65, 0x94, 0x37, 0x7d, 0xd8, 0x8e, 0xe5, 0xc3,
-	0xd6, 0x31, 0x6c, 0x95, 0x2c, 0x86, 0xcd, 0xfd, 0x79, 0x05, 0x16, 0x90, 0xd4, 0x83, 0xf0, 0xf4,
-	0x30, 0x1a, 0x05, 0x83, 0x4b, 0x22, 0x79, 0x45, 0xd5, 0x72, 0x2b, 0x52, 0x24, 0x6f, 0xc3, 0x68,
-	0xb2, 0xea, 0x20, 0x5e, 0x21, 0x37, 0x74, 0x9a, 0x6d, 0x40, 0x07, 0xb9, 0xf1, 0xd8, 0x4f, 0x32,
-	0x2e, 0x15, 0x4b, 0x53, 0xc0, 0x65, 0xe8, 0x62, 0x9f, 0xa2, 0x13, 0xc7, 0xc1, 0x68, 0x14, 0xe8,
-	0x2f, 0x6a, 0x3a, 0x74, 0xb1, 0x24, 0x17, 0xdb, 0x1f, 0x06, 0x89, 0x7f, 0x9c, 0x9d, 0x48, 0xea,
-	0x34, 0xb9, 0xf7, 0xfc, 0x0b, 0xdb, 0xbd, 0x37, 0xa7, 0xa3, 0x96, 0x6d, 0xf7, 0x5e, 0x6e, 0x69,
-	0xe7, 0x0b, 0x4b, 0xeb, 0xfe, 0x79, 0x05, 0x5a, 0x06, 0xa1, 0xc8, 0xc3, 0x78, 0x7b, 0x17, 0x30,
-	0x10, 0x95, 0x6f, 0xd9, 0xfb, 0x06, 0xc2, 0x6e, 0xd9, 0x2d, 0x92, 0xd3, 0x9f, 0x44, 0x81, 0x45,
-	0x50, 0xd7, 0xa1, 0x89, 0x8c, 0xf0, 0x01, 0x39, 0x17, 0xe4, 0x45, 0x21, 0x0d, 0xa8, 0xdc, 0xfb,
-	0x94, 0x5b, 0xcf, 0x72, 0x09, 0x78, 0xe5, 0xf1, 0xfd, 0x47, 0xd0, 0x96, 0xd5, 0xd0, 0x8a, 0xd3,
-	0x80, 0x33, 0x56, 0xb4, 0xa8, 0xc1, 0xb3, 0x4a, 0xaa, 0x2f, 0xef, 0xab, 0x2f, 0x1b, 0xaf, 0xfb,
-	0x52, 0x95, 0x74, 0x1f, 0xe9, 0xa8, 0x88, 0x47, 0xb1, 0x3f, 0x39, 0x53, 0xe2, 0xe5, 0x1e, 0xac,
-	0x28, 0x29, 0x32, 0x0d, 0xfd, 0x30, 0x8c, 0xa6, 0xe1, 0x80, 0xab, 0xc0, 0xb7, 0xb2, 0x2c, 0x77,
-	0xa8, 0xc3, 0xa6, 0xa9, 0x22, 0xb6, 0x01, 0x75, 0xa1, 0xda, 0x88, 0xcd, 0xb2, 0x5c, 0xa0, 0x88,
-	0x22, 0xec, 0x0e, 0xd4, 0x85, 0x86, 0x53, 0x99, 0x29, 0x02, 0x44, 0x01, 0x77, 0x03, 0x96, 0x28,
-	0x4e, 0xdb, 0x96, 0x84, 0xf6, 0x26, 0x3a, 0x37, 0x10, 0x91, 0xdc, 0xab, 0xc0, 0x0e, 0x04, 0x87,
-	0x99, 0x67, 0x9a, 0xbf, 0xac, 0x42, 0xcb, 0x80, 0x51, 0x52, 0xd1, 0x41, 0x54, 0x7f, 0x18, 0xf8,
-	0x63, 0x9e, 0xf2, 0x58, 0x72, 0x55, 0x0e, 0xc5, 0x72, 0xfe, 0xf9, 0x29, 0xea, 0x98, 0xfd, 0x21,
-	0x3f, 0x8d, 0x39, 0x97, 0x3b, 0x7b, 0x0e, 0xc5, 0x72, 0x52, 0x17, 0x55, 0xe5, 0xc4, 0xd1, 0x51,
-	0x0e, 0x55, 0x27, 0x94, 0x62, 0x8e, 0x6a, 0xd9, 0x09, 0xa5, 0x98, 0x91, 0xbc, 0x8c, 0xad, 0x97,
-	0xc8, 0xd8, 0x0f, 0x61, 0x4d, 0x48, 0x53, 0x29, 0x47, 0xfa, 0x39, 0xc2, 0x9a, 0x91, 0x8b, 0x2c,
-	0x88, 0x7d, 0x56, 0x6c, 0x41, 0x0e, 0x94, 0x79, 0x1a, 0x4b, 0x01, 0x57, 0xde, 0x78, 0xab, 0x6c,
-	0x23, 0xf3, 0xc6, 0x17, 0xca, 0xfa, 0x17, 0x76, 0x59, 0xe5, 0xb9, 0xcf, 0xe1, 0xec, 0x23, 0x58,
-	0x1f, 0xf3, 0x61, 0xe0, 0xdb, 0x55, 0xf4, 0x13, 0x3f, 0x95, 0xf1, 0x6a, 0xb3, 0xb2, 0xb1, 0x15,
-	0x9c, 0x85, 0x9f, 0x44, 0xe3, 0xe3, 0x40, 0x6c, 0x71, 0xc2, 0x9d, 0x5f, 0xf3, 0x0a, 0xb8, 0xbb,
-	0x00, 0xad, 0xa3, 0x34, 0x9a, 0xa8, 0xa5, 0x5f, 0x84, 0xb6, 0x48, 0xca, 0x30, 0xc7, 0xb7, 0xe0,
-	0x1a, 0xd1, 0xea, 0xd3, 0x68, 0x12, 0x8d, 0xa2, 0xd3, 0x4b, 0xcb, 0x20, 0xff, 0xb7, 0x0e, 0xac,
-	0x58, 0xb9, 0x99, 0x45, 0x4e, 0x1e, 0x44, 0x15, 0xaf, 0x26, 0xc8, 0x7b, 0xd9, 0xd8, 0x20, 0x44,
-	0x41, 0x71, 0x74, 0xf3, 0x4c, 0x86, 0xb0, 0x6d, 0x65, 0x17, 0x30, 0xd4, 0x87, 0x82, 0xd6, 0xbb,
-	0x45, 0x5a, 0x97, 0xdf, 0xab, 0xab, 0x19, 0xaa, 0x8a, 0xdf, 0x95, 0xc1, 0x3d, 0x43, 0x39, 0xe8,
-	0xaa, 0x1d, 0x90, 0x61, 0x3a, 0x70, 0x54, 0x0f, 0x06, 0x1a, 0x4c, 0xdc, 0x9f, 0x39, 0x00, 0x59,
-	0xef, 0x28, 0x24, 0x44, 0x6f, 0x72, 0xe2, 0x6e, 0xb0, 0xb1, 0xa1, 0xbd, 0x0b, 0x6d, 0x7d, 0x9a,
-	0x9f, 0xed, 0x9b, 0x2d, 0x85, 0xa1, 0x9e, 0xf1, 0x1e, 0x2c, 0x9d, 0x8e, 0xa2, 0x63, 0x52, 0x6c,
-	0x28, 0x6e, 0x36, 0x91, 0xc1, 0x9e, 0x8b, 0x02, 0x7e, 0x28, 0xd1, 0x6c, 0x93, 0xad, 0x99, 0x9b,
-	0x6c, 0xf9, 0x96, 0xf9, 0x87, 0x15, 0x7d, 0xa4, 0x9a, 0xcd, 0xc4, 0x4c, 0x0e, 0x67, 0xf7, 0x0b,
-	0xe2, 0x7c, 0xc6, 0x09, 0x26, 0x59, 0x02, 0x87, 0xaf, 0xf5, 0xe7, 0x7e, 0x02, 0x8b, 0xb1, 0x90,
-	0x95, 0x4a, 0x90, 0xd6, 0x5e, 0x21, 0x48, 0x17, 0x62, 0x6b, 0x7f, 0xfe, 0x2a, 0x74, 0xfc, 0xe1,
-	0x39, 0x8f, 0xd3, 0x80, 0x7c, 0x5b, 0xa4, 0x4c, 0x89, 0xc1, 0x2d, 0x19, 0x38, 0xe9, 0x2c, 0xef,
-	0xc1, 0x92, 0x0c, 0xbb, 0xd5, 0x25, 0xe5, 0x0d, 0xbb, 0x0c, 0xc6, 0x82, 0xee, 0xcf, 0xd5, 0xe9,
-	0xad, 0xbd, 0xb2, 0xb3, 0x67, 0xc4, 0x1c, 0x5d, 0x25, 0x37, 0xba, 0xaf, 0xc8, 0x53, 0xd4, 0xa1,
-	0x72, 0xa0, 0x55, 0x8d, 0xf0, 0xb0, 0xa1, 0x3c, 0xf9, 0xb6, 0xa7, 0xb4, 0xf6, 0x26, 0x53, 0xea,
-	0xfe, 0x47, 0x07, 0xe6, 0xf7, 0xa2, 0xc9, 0x9e, 0x0c, 0x94, 0x23, 0xf6, 0xd0, 0xf1, 0xee, 0x2a,
-	0xf9, 0x8a, 0x10, 0xba, 0x59, 0x3a, 0xc9, 0x42, 0x89, 0x4e, 0xf2, 0x97, 0xe0, 0x2d, 0x72, 0xe2,
-	0xc6, 0xd1, 0x24, 0x8a, 0x91, 0x51, 0xfd, 0x91, 0xd0, 0x3e, 0xa2, 0x30, 0x3d, 0x53, 0x82, 0xf4,
-	0x55, 0x45, 0xc8, 0xb3, 0x82, 0xd6, 0xa8, 0xb0, 0x74, 0xa4, 0x26, 0x25, 0xe4, 0x6b, 0x31, 0xc3,
-	0xfd, 0x6d, 0x68, 0x92, 0xc5, 0x41, 0x83, 0x7b, 0x1f, 0x9a, 0x67, 0xd1, 0xa4, 0x7f, 0x16, 0x84,
-	0xa9, 0x62, 0xfc, 0xc5, 0xcc, 0x14, 0xd8, 0xa3, 0x69, 0xd1, 0x05, 0xdc, 0x3f, 0x98, 0x87, 0xf9,
-	0xc7, 0xe1, 0x79, 0x14, 0x0c, 0xe8, 0xac, 0x78, 0xcc, 0xc7, 0x91, 0xba, 0x03, 0x80, 0xbf, 0xd9,
-	0x75, 0x98, 0xa7, 0x20, 0xd8, 0x89, 0x20, 0xdd, 0xb6, 0x88, 0x0b, 0x91, 0x10, 0x5d, 0x80, 0xcd,
-	0xee, 0xf3, 0x09, 0xd6, 0x32, 0x10, 0xb4, 0xdc, 0x62, 0xf3, 0x3e, 0x9e, 0x4c, 0x65, 0xf7, 0x2c,
-	0xea, 0xc6, 0x3d, 0x0b, 0x6c, 0x4b, 0x86, 0xf7, 0x89, 0xf8, 0x2f, 0xd1, 0x96, 0x84, 0xc8, 0xda,
-	0x8c, 0xb9, 0x70, 0xc0, 0x6b, 0x55, 0x0b, 0xad, 0x4d, 0x13, 0x44, 0x75, 0x4c, 0x7c, 0x20, 0xca,
-	0x88, 0x6d, 0xc0, 0x84, 0x50, 0x45, 0xcd, 0xdf, 0x1c, 0x15, 0x37, 0x77, 0xf3, 0x30, 0x2e, 0xf9,
-	0x90, 0x6b, 0x61, 0x2b, 0xc6, 0x01, 0xe2, 0xce, 0x62, 0x1e, 0x37, 0x6c, 0x54, 0x11, 0xa3, 0xac,
-	0x6c, 0xd4, 0x5b, 0xb0, 0x70, 0xe2, 0x8f, 0x46, 0xc7, 0xfe, 0xe0, 0x05, 0x5d, 0x1c, 0xa6, 0xf3,
-	0xda, 0xa6, 0x67, 0x83, 0x74, 0x52, 0x90, 0xad, 0x2a, 0x45, 0xd0, 0xd4, 0x3c, 0x13, 0x62, 0xf7,
-	0xa1, 0x45, 0xb6, 0xbb, 0x5c, 0xd7, 0x45, 0x5a, 0xd7, 0x8e, 0x69, 0xdc, 0xd3, 0xca, 0x9a, 0x85,
-	0xcc, 0xf3, 0xeb, 0xa5, 0x42, 0x04, 0xb1, 0x3f, 0x1c, 0xca, 0xe3, 0xff, 0x8e, 0xf0, 0x43, 0x68,
-	0x80, 0xbc, 0x03, 0x62, 0xc2, 0x44, 0x81, 0x65, 0x2a, 0x60, 0x61, 0xec, 0x06, 0x34, 0xd0, 0xf8,
-	0x9b, 0xf8, 0xc1, 0x90, 0x82, 0x66, 0x84, 0x31, 0xaa, 0x31, 0xd2, 0x44, 0xe4, 0x6f, 0xc9, 0x2c,
-	0x2b, 0xc2, 0xb6, 0xb2, 0x51, 0xda, 0xe7, 0x15, 0x32, 0xb6, 0xe2, 0x8e, 0x0b, 0x38, 0xfb, 0x80,
-	0x0e, 0x60, 0x53, 0x4e, 0xb1, 0xc5, 0x8b, 0xf7, 0xdf, 0x92, 0xa3, 0x97, 0xe4, 0xab, 0xfe, 0x1e,
-	0x61, 0x11, 0x4f, 0x94, 0x44, 0xa5, 0x4d, 0xf8, 0xbf, 0xd7, 0x2c, 0xa5, 0x4d, 0x16, 0x25, 0xff,
-	0xb7, 0x28, 0xc0, 0x7e, 0x0b, 0xd6, 0x8c, 0x5b, 0xba, 0x99, 0x5b, 0x2f, 0xed, 0xfe, 0x72, 0x9e,
-	0x26, 0x6f, 0x46, 0xb6, 0xbb, 0x05, 0x6d, 0xb3, 0x65, 0xd6, 0x80, 0xda, 0x93, 0xc3, 0xdd, 0x83,
-	0xce, 0x15, 0xd6, 0x82, 0xf9, 0xa3, 0xdd, 0xa7, 0x4f, 0xf7, 0x77, 0x77, 0x3a, 0x0e, 0x6b, 0x43,
-	0x43, 0x87, 0x6f, 0x56, 0x30, 0xb5, 0xb5, 0xbd, 0xbd, 0x7b, 0xf8, 0x74, 0x77, 0xa7, 0x53, 0x75,
-	0xff, 0xb4, 0x02, 0x2d, 0xa3, 0x4b, 0xaf, 0x70, 0xb9, 0xdc, 0x00, 0x20, 0xeb, 0x23, 0x0b, 0xdc,
-	0xa8, 0x79, 0x06, 0x82, 0x84, 0x64, 0x1a, 0xeb, 0x55, 0x41, 0x48, 0x06, 0x84, 0x04, 0x29, 0x2e,
-	0x1b, 0x9a, 0x27, 0x14, 0x75, 0xcf, 0x06, 0xa9, 0x1e, 0x01, 0x50, 0x1c, 0xa1, 0x3c, 0xba, 0x32,
-	0x20, 0x24, 0x92, 0x98, 0x27, 0xd1, 0xe8, 0x9c, 0x8b, 0x22, 0x42, 0x9d, 0xb3, 0x30, 0x6c, 0x4b,
-	0xca, 0x29, 0x23, 0xd6, 0xb7, 0xee, 0xd9, 0x20, 0xfb, 0xba, 0x5a, 0xd6, 0x06, 0x2d, 0xeb, 0x7a,
-	0x71, 0x8d, 0xcc, 0x25, 0x75, 0x53, 0x60, 0x5b, 0xc3, 0xa1, 0xcc, 0x35, 0x6f, 0x54, 0xc6, 0xe6,
-	0xf5, 0x5d, 0x25, 0x69, 0x4a, 0xb8, 0xbd, 0x52, 0xce, 0xed, 0xaf, 0xe4, 0x09, 0x77, 0x17, 0x5a,
-	0x87, 0xc6, 0x85, 0x60, 0x12, 0x7c, 0xea, 0x2a, 0xb0, 0x14, 0x98, 0x06, 0x62, 0x74, 0xa7, 0x62,
-	0x76, 0xc7, 0xfd, 0x87, 0x8e, 0xb8, 0x53, 0xa5, 0xbb, 0x2f, 0xda, 0x76, 0xa1, 0xad, 0xdd, 0xcf,
-	0x59, 0x18, 0xbb, 0x85, 0x61, 0x19, 0xea, 0x4a, 0x3f, 0x3a, 0x39, 0x49, 0xb8, 0x0a, 0x3a, 0xb5,
-	0x30, 0xa5, 0x77, 0x0a, 0x12, 0xa5, 0x16, 0x12, 0x19, 0x7c, 0x5a, 0xc0, 0x71, 0x17, 0x96, 0x3e,
-	0x46, 0x15, 0x6e, 0xab, 0xd3, 0x3a, 0xda, 0x3e, 0x3f, 0xcb, 0x1b, 0xd0, 0xd0, 0xf5, 0xda, 0x5b,
-	0x8b, 0x2a, 0xa9, 0xf3, 0x71, 0x0b, 0x23, 0x7b, 0xd4, 0xea, 0xb4, 0xa0, 0xd8, 0x62, 0x06, 0xdb,
-	0x04, 0x76, 0x12, 0xc4, 0xf9, 0xe2, 0x82, 0x7e, 0x4b, 0x72, 0xdc, 0xe7, 0xb0, 0xa2, 0xb8, 0xce,
-	0x50, 0x88, 0xed, 0x45, 0x74, 0x5e, 0x27, 0xd8, 0x2a, 0x45, 0xc1, 0xe6, 0xfe, 0xef, 0x2a, 0xcc,
-	0xcb, 0x95, 0x2e, 0x5c, 0x2a, 0x17, 0xeb, 0x6c, 0x61, 0xac, 0x6b, 0x5d, 0x19, 0x24, 0x29, 0x28,
-	0xb7, 0xb3, 0xc2, 0x86, 0x55, 0x2d, 0xdb, 0xb0, 0x18, 0xd4, 0x26, 0x7e, 0x7a, 0x46, 0x3e, 0x9c,
-	0xa6, 0x47, 0xbf, 0x95, 0x1b, 0xb4, 0x6e, 0xbb, 0x41, 0xcb, 0xae, 0xd0, 0x0b, 0x8d, 0xac, 0x78,
-	0x85, 0xfe, 0x26, 0xb4, 0xc4, 0xb5, 0x6b, 0xcb, 0xc5, 0x69, 0x40, 0xd8, 0x3b, 0x91, 0x54, 0xb2,
-	0x42, 0x6c, 0x95, 0x36, 0xf8, 0x25, 0x36, 0xcb, 0x6f, 0xc2, 0x9c, 0xb8, 0x58, 0x22, 0xc3, 0x8a,
-	0xaf, 0xab, 0xa3, 0x46, 0x51, 0x4e, 0xfd, 0x15, 0xd1, 0x48, 0x9e, 0x2c, 0x6b, 0x5f, 0x4b, 0x6d,
-	0xe5, 0xaf, 0xa5, 0xe6, 0x1c, 0xb5, 0xed, 0x82, 0xa3, 0xd6, 0x7d, 0x08, 0x0b, 0x56, 0xc5, 0x28,
-	0x73, 0x65, 0x80, 0x72, 0xe7, 0x0a, 0x5b, 0x80, 0xe6, 0xe3, 0x83, 0xfe, 0xc3, 0xfd, 0xc7, 0x8f,
-	0xf6, 0x9e, 0x76, 0x1c, 0x4c, 0x1e, 0x3d, 0xdb, 0xde, 0xde, 0xdd, 0xdd, 0x21, 0x19, 0x0c, 0x30,
-	0xf7, 0x70, 0xeb, 0xf1, 0x3e, 0x49, 0xe0, 0x1d, 0x41, 0xef, 0xb2, 0x2e, 0x7d, 0x0a, 0xf3, 0x75,
-	0x60, 0xca, 0x8d, 0x40, 0x61, 0x49, 0x93, 0x11, 0x4f, 0x55, 0x0c, 0xfd, 0xb2, 0xcc, 0x79, 0xac,
-	0x33, 0xd4, 0x15, 0x90, 0xac, 0x96, 0x8c, 0x6d, 0xe4, 0x74, 0xe5, 0xd9, 0x46, 0x16, 0xf5, 0x74,
-	0xbe, 0xdb, 0x83, 0xee, 0x0e, 0xc7, 0xda, 0xb6, 0x46, 0xa3, 0x5c, 0x77, 0xd0, 0x16, 0x2c, 0xc9,
-	0x93, 0x86, 0xe2, 0x77, 0xe0, 0xea, 0x96, 0x08, 0x97, 0xff, 0x4d, 0x45, 0x51, 0xba, 0x5d, 0x58,
-	0xcb, 0x57, 0x29, 0x1b, 0x7b, 0x08, 0xcb, 0x3b, 0xfc, 0x78, 0x7a, 0xba, 0xcf, 0xcf, 0xb3, 0x86,
-	0x18, 0xd4, 0x92, 0xb3, 0xe8, 0xa5, 0x9c, 0x1f, 0xfa, 0xcd, 0xde, 0x06, 0x18, 0x61, 0x99, 0x7e,
-	0x32, 0xe1, 0x03, 0x75, 0x8d, 0x91, 0x90, 0xa3, 0x09, 0x1f, 0xb8, 0x1f, 0x02, 0x33, 0xeb, 0x91,
-	0xf3, 0x85, 0x4a, 0xdc, 0xf4, 0xb8, 0x9f, 0x5c, 0x26, 0x29, 0x1f, 0xab, 0xfb, 0x99, 0x26, 0xe4,
-	0xbe, 0x07, 0xed, 0x43, 0xff, 0xd2, 0xe3, 0x3f, 0x96, 0x0f, 0x2e, 0xac, 0xc3, 0xfc, 0xc4, 0xbf,
-	0x44, 0x62, 0xd4, 0x9e, 0x66, 0xca, 0x76, 0xff, 0x67, 0x05, 0xe6, 0x44, 0x49, 0xac, 0x75, 0xc8,
-	0x93, 0x34, 0x08, 0x89, 0xfb, 0x54, 0xad, 0x06, 0x54, 0xe0, 0xf7, 0x4a, 0x09, 0xbf, 0x4b, 0x97,
-	0x88, 0xe9, 0x94, 0xcc, 0x00, 0xcc, 0xcd, 0x02, 0xa1, 0x85, 0x03, 0x32, 0x03, 0x72, 0x07, 0x19,
-	0x99, 0x92, 0x28, 0x7a, 0xa6, 0x84, 0x98, 0x64, 0x6a, 0x13, 0x2a, 0x55, 0x45, 0xe7, 0x05, 0xef,
-	0x17, 0x54, 0xd1, 0x82, 0xca, 0xd9, 0x78, 0x03, 0x95, 0x53, 0xdd, 0xd0, 0x9b, 0xad, 0x72, 0xc2,
-	0x1b, 0xa8, 0x9c, 0x2e, 0x83, 0x0e, 0xdd, 0x3a, 0x47, 0xa3, 0x46, 0x51, 0xed, 0x1f, 0x39, 0xd0,
-	0x91, 0xf4, 0xa3, 0xf3, 0xd8, 0xbb, 0x96, 0x09, 0x57, 0x7a, 0x9d, 0x69, 0x03, 0x3a, 0x64, 0x55,
-	0x99, 0x22, 0x40, 0x98, 0x8b, 0x05, 0x5c, 0x49, 0x8a, 0x09, 0x8f, 0xd1, 0x8a, 0x92, 0xeb, 0x62,
-	0x42, 0xb8, 0xdd, 0x29, 0x4f, 0x30, 0x2d, 0x8c, 0xe3, 0xe9, 0xb4, 0xfb, 0x2f, 0x1d, 0x58, 0x36,
-	0xba, 0x2d, 0xa9, 0xf0, 0x13, 0x68, 0xeb, 0x27, 0x1e, 0xb8, 0xde, 0xf0, 0xd6, 0x6d, 0xb6, 0xc9,
-	0x3e, 0xb3, 0x0a, 0xd3, 0x92, 0xfa, 0x97, 0xd4, 0xc7, 0x64, 0x3a, 0x96, 0x3b, 0x8d, 0x09, 0x21,
-	0xb1, 0xbd, 0xe4, 0xfc, 0x85, 0x2e, 0x22, 0xf6, 0x3a, 0x0b, 0xc3, 0xa5, 0x1c, 0xa3, 0x41, 0xa8,
-	0x0b, 0x89, 0x4d, 0xdf, 0x06, 0xdd, 0x3f, 0xac, 0xc0, 0x8a, 0xb0, 0xef, 0xa5, 0x4f, 0x45, 0xdf,
-	0xaa, 0x9d, 0x13, 0x6e, 0x0e, 0xc1, 0x91, 0x7b, 0x57, 0x3c, 0x99, 0x66, 0xdf, 0x7a, 0x43, 0x9f,
-	0x84, 0x8e, 0x33, 0x9e, 0xbd, 0x22, 0xd5, 0x19, 0x2b, 0xf2, 0x8a, 0xf9, 0x2e, 0x3b, 0x25, 0xa8,
-	0x97, 0x9f, 0x12, 0x7c, 0x09, 0x4f, 0xfc, 0x83, 0x79, 0xa8, 0x27, 0x83, 0x68, 0xc2, 0xdd, 0x35,
-	0x58, 0xb5, 0xa7, 0x43, 0x0a, 0xad, 0x9f, 0x39, 0xd0, 0x7d, 0x28, 0xce, 0x02, 0x83, 0xf0, 0x74,
-	0x2f, 0x48, 0xd2, 0x28, 0xd6, 0xcf, 0x16, 0xdc, 0x00, 0x48, 0x52, 0x3f, 0x96, 0x0a, 0xaf, 0xf4,
-	0xca, 0x67, 0x08, 0x8e, 0x87, 0x87, 0x43, 0x91, 0x2b, 0x56, 0x53, 0xa7, 0x0b, 0xaa, 0x99, 0xf4,
-	0x59, 0x58, 0x0a, 0xce, 0x6d, 0x11, 0xad, 0x8f, 0xbd, 0xe6, 0xe7, 0xb4, 0x13, 0x08, 0x37, 0x40,
-	0x0e, 0x75, 0xff, 0x83, 0x03, 0x4b, 0x59, 0x27, 0x29, 0x7e, 0xc4, 0x96, 0x2a, 0x52, 0xab, 0xc9,
-	0xa4, 0x8a, 0x3a, 0x2f, 0x08, 0x50, 0xcd, 0x51, 0x36, 0x41, 0x86, 0x10, 0xa7, 0xcb, 0x54, 0x34,
-	0x55, 0x7a, 0xa3, 0x09, 0x89, 0x48, 0x5b, 0x54, 0xb0, 0xa4, 0xb2, 0x28, 0x53, 0x74, 0xff, 0x69,
-	0x9c, 0xd2, 0x57, 0x62, 0xd2, 0x55, 0x92, 0x75, 0x84, 0x86, 0x22, 0x9e, 0x72, 0xa9, 0xca, 0x60,
-	0x37, 0x93, 0x2c, 0xc4, 0xcb, 0x2d, 0xd6, 0x5e, 0xfd, 0xb7, 0x1d, 0xb8, 0x56, 0x32, 0xfd, 0x92,
-	0xdb, 0x76, 0x60, 0xf9, 0x44, 0x67, 0xaa, 0x29, 0x12, 0x2c, 0xb7, 0xa6, 0x62, 0x14, 0xec, 0x69,
-	0xf1, 0x8a, 0x1f, 0x68, 0xa5, 0x53, 0x4c, 0xba, 0x15, 0xdf, 0x5e, 0xcc, 0x70, 0x0f, 0xa1, 0xb7,
-	0x7b, 0x81, 0xcc, 0xbb, 0x6d, 0x3e, 0x3a, 0xa7, 0x28, 0xe2, 0x7e, 0x41, 0x44, 0xbd, 0xde, 0xcb,
-	0x74, 0x02, 0x0b, 0x56, 0x5d, 0xec, 0x1b, 0x6f, 0x5a, 0x89, 0xc9, 0x67, 0x6a, 0xc5, 0xc4, 0xab,
-	0x79, 0x2a, 0xca, 0xde, 0x80, 0xdc, 0x73, 0x58, 0xfa, 0x6c, 0x3a, 0x4a, 0x83, 0xec, 0x05, 0x3d,
-	0xf6, 0x2d, 0xf9, 0x11, 0x55, 0xa1, 0xa6, 0xae, 0xb4, 0x29, 0xb3, 0x1c, 0xce, 0xd8, 0x18, 0x6b,
-	0xea, 0x17, 0x5b, 0x2c, 0x66, 0xb8, 0xd7, 0x60, 0x3d, 0x6b, 0x52, 0xcc, 0x9d, 0x12, 0xf3, 0x3f,
-	0x77, 0x44, 0x70, 0x98, 0xfd, 0xa0, 0x1f, 0x7b, 0x04, 0x2b, 0x49, 0x10, 0x9e, 0x8e, 0xb8, 0x59,
-	0x4f, 0x22, 0x67, 0xe2, 0xaa, 0xdd, 0x3d, 0xf9, 0xe8, 0x9f, 0x57, 0xf6, 0x05, 0x12, 0x48, 0x79,
-	0x47, 0x33, 0x02, 0xc9, 0x4d, 0x49, 0xd9, 0x00, 0xbe, 0x0d, 0x8b, 0x76, 0x63, 0xec, 0x23, 0x19,
-	0x12, 0x9f, 0xf5, 0xcc, 0x3c, 0x0a, 0xb2, 0x29, 0xc3, 0x2a, 0xe9, 0xfe, 0xd4, 0x81, 0xae, 0xc7,
-	0x91, 0x8c, 0xb9, 0xd1, 0xa8, 0xa4, 0x9e, 0x4f, 0x0a, 0xd5, 0xce, 0x1e, 0xb0, 0x0e, 0xb5, 0x57,
-	0x63, 0xdd, 0x9c, 0xb9, 0x28, 0x7b, 0x57, 0x4a, 0x46, 0xf5, 0xa0, 0x01, 0x73, 0x72, 0x7c, 0xeb,
-	0x70, 0x55, 0x76, 0x49, 0x75, 0x27, 0x3b, 0x47, 0xb0, 0x1a, 0xb5, 0xce, 0x11, 0x7a, 0xd0, 0x15,
-	0xaf, 0x49, 0x98, 0xe3, 0x10, 0x1f, 0x6e, 0x5c, 0x40, 0xcb, 0x78, 0x53, 0x83, 0xad, 0xc3, 0xca,
-	0xf3, 0xc7, 0x4f, 0x0f, 0x76, 0x8f, 0x8e, 0xfa, 0x87, 0xcf, 0x1e, 0x7c, 0xba, 0xfb, 0xbd, 0xfe,
-	0xde, 0xd6, 0xd1, 0x5e, 0xe7, 0x0a, 0x5b, 0x03, 0x76, 0xb0, 0x7b, 0xf4, 0x74, 0x77, 0xc7, 0xc2,
-	0x1d, 0xb6, 0x04, 0x2d, 0x13, 0xa8, 0x20, 0x70, 0xb4, 0xed, 0x3d, 0x3e, 0x7c, 0x2a, 0x80, 0x2a,
-	0x7e, 0xf9, 0xec, 0xe0, 0xd9, 0x51, 0xee, 0xcb, 0xda, 0xc6, 0x27, 0xd0, 0xc9, 0x3b, 0x01, 0x2c,
-	0xc7, 0xc9, 0xab, 0x3c, 0x2c, 0xf7, 0x7f, 0x5a, 0x85, 0x45, 0x11, 0xdb, 0x26, 0xde, 0x8f, 0xe4,
-	0x31, 0xfb, 0x0c, 0xe6, 0xe5, 0x43, 0xa4, 0x4c, 0xad, 0x83, 0xfd, 0xf4, 0x69, 0x6f, 0x2d, 0x0f,
-	0xcb, 0xc9, 0x5b, 0xf9, 0x83, 0x7f, 0xff, 0x5f, 0xff, 0x6e, 0x65, 0x81, 0xb5, 0xee, 0x9e, 0x7f,
-	0x70, 0xf7, 0x94, 0x87, 0x09, 0xd6, 0xf1, 0xfb, 0x00, 0xd9, 0xf3, 0x9a, 0xac, 0xab, 0x0d, 0xe1,
-	0xdc, 0xdb, 0xa3, 0xbd, 0x6b, 0x25, 0x39, 0xb2, 0xde, 0x6b, 0x54, 0xef, 0x8a, 0xbb, 0x88, 0xf5,
-	0x06, 0x61, 0x90, 0x8a, 0xa7, 0x36, 0x3f, 0x76, 0x36, 0xd8, 0x10, 0xda, 0xe6, 0xc3, 0x97, 0x4c,
-	0x9d, 0xa1, 0x94, 0x3c, 0xdd, 0xd9, 0x7b, 0xab, 0x34, 0x4f, 0x2d, 0x3c, 0xb5, 0x71, 0xd5, 0xed,
-	0x60, 0x1b, 0x53, 0x2a, 0x91, 0xb5, 0x32, 0x12, 0xec, 0x90, 0xbd, 0x6f, 0xc9, 0xae, 0x1b, 0x14,
-	0x5a, 0x78, 0x5d, 0xb3, 0xf7, 0xf6, 0x8c, 0x5c, 0xd9, 0xd6, 0xdb, 0xd4, 0xd6, 0xba, 0xcb, 0xb0,
-	0xad, 0x01, 0x95, 0x51, 0xaf, 0x6b, 0x7e, 0xec, 0x6c, 0xdc, 0xff, 0xef, 0xb7, 0xa1, 0xa9, 0xcf,
-	0x56, 0xd9, 0x8f, 0x60, 0xc1, 0x0a, 0x3e, 0x64, 0x6a, 0x18, 0x65, 0xb1, 0x8a, 0xbd, 0xeb, 0xe5,
-	0x99, 0xb2, 0xe1, 0x1b, 0xd4, 0x70, 0x97, 0xad, 0x61, 0xc3, 0x32, 0x78, 0xef, 0x2e, 0x85, 0xd1,
-	0x8a, 0xbb, 0x79, 0x2f, 0x0c, 0xb6, 0x17, 0x8d, 0x5d, 0xcf, 0x73, 0xa2, 0xd5, 0xda, 0xdb, 0x33,
-	0x72, 0x65, 0x73, 0xd7, 0xa9, 0xb9, 0x35, 0xb6, 0x6a, 0x36, 0xa7, 0xcf, 0x3c, 0x39, 0x5d, 0x48,
-	0x35, 0x9f, 0x7d, 0x64, 0x6f, 0x6b, 0xc2, 0x2a, 0x7b, 0x0e, 0x52, 0x93, 0x48, 0xf1, 0x4d, 0x48,
-	0xb7, 0x4b, 0x4d, 0x31, 0x46, 0xcb, 0x67, 0xbe, 0xfa, 0xc8, 0x8e, 0xa1, 0x65, 0xbc, 0xf4, 0xc4,
-	0xae, 0xcd, 0x7c, 0x95, 0xaa, 0xd7, 0x2b, 0xcb, 0x2a, 0x1b, 0x8a, 0x59, 0xff, 0x5d, 0xdc, 0xd5,
-	0x7f, 0x00, 0x4d, 0xfd, 0x56, 0x10, 0x5b, 0x37, 0xde, 0x70, 0x32, 0xdf, 0x37, 0xea, 0x75, 0x8b,
-	0x19, 0x65, 0xc4, 0x67, 0xd6, 0x8e, 0xc4, 0xf7, 0x1c, 0x5a, 0xc6, 0x7b, 0x40, 0x7a, 0x00, 0xc5,
-	0x37, 0x87, 0xf4, 0x00, 0x4a, 0x9e, 0x0f, 0x72, 0x97, 0xa9, 0x89, 0x16, 0x6b, 0x12, 0x7d, 0xa7,
-	0x17, 0x51, 0xc2, 0xf6, 0xe1, 0xaa, 0x14, 0x6f, 0xc7, 0xfc, 0xcb, 0x2c, 0x43, 0xc9, 0x4b, 0x9b,
-	0xf7, 0x1c, 0xf6, 0x09, 0x34, 0xd4, 0xd3, 0x4f, 0x6c, 0xad, 0xfc, 0x19, 0xab, 0xde, 0x7a, 0x01,
-	0x97, 0x6a, 0xcd, 0xf7, 0x00, 0xb2, 0xc7, 0x87, 0xb4, 0x90, 0x28, 0x3c, 0x66, 0xa4, 0x29, 0xa0,
-	0xf8, 0x52, 0x91, 0xbb, 0x46, 0x03, 0xec, 0x30, 0x12, 0x12, 0x21, 0x7f, 0xa9, 0xee, 0xa0, 0xff,
-	0x10, 0x5a, 0xc6, 0xfb, 0x43, 0x7a, 0xfa, 0x8a, 0x6f, 0x17, 0xe9, 0xe9, 0x2b, 0x79, 0xae, 0xc8,
-	0xed, 0x51, 0xed, 0xab, 0xee, 0x12, 0xd6, 0x9e, 0x04, 0xa7, 0xe1, 0x58, 0x14, 0xc0, 0x05, 0x3a,
-	0x83, 0x05, 0xeb, 0x91, 0x21, 0xcd, 0xa1, 0x65, 0x4f, 0x18, 0x69, 0x0e, 0x2d, 0x7d, 0x97, 0x48,
-	0xd1, 0x99, 0xbb, 0x8c, 0xed, 0x9c, 0x53, 0x11, 0xa3, 0xa5, 0xef, 0x43, 0xcb, 0x78, 0x30, 0x48,
-	0x8f, 0xa5, 0xf8, 0x36, 0x91, 0x1e, 0x4b, 0xd9, 0xfb, 0x42, 0xab, 0xd4, 0xc6, 0xa2, 0x4b, 0xa4,
-	0x40, 0xb7, 0xa8, 0xb1, 0xee, 0x1f, 0xc1, 0xa2, 0xfd, 0x84, 0x90, 0xe6, 0xfd, 0xd2, 0xc7, 0x88,
-	0x34, 0xef, 0xcf, 0x78, 0x77, 0x48, 0x92, 0xf4, 0xc6, 0x8a, 0x6e, 0xe4, 0xee, 0xe7, 0x32, 0x56,
-	0xeb, 0x0b, 0xf6, 0x1d, 0x14, 0x70, 0xf2, 0x5a, 0x3b, 0x5b, 0x37, 0xa8, 0xd6, 0xbc, 0xfc, 0xae,
-	0xf9, 0xa5, 0x70, 0x03, 0xde, 0x26, 0x66, 0x71, 0x0f, 0x9c, 0x76, 0x2d, 0xba, 0xde, 0x6e, 0xec,
-	0x5a, 0xe6, 0x0d, 0x78, 0x63, 0xd7, 0xb2, 0x6e, 0xc1, 0xe7, 0x77, 0xad, 0x34, 0xc0, 0x3a, 0x42,
-	0x58, 0xca, 0x5d, 0x90, 0xd0, 0x5c, 0x51, 0x7e, 0x8f, 0xad, 0x77, 0xe3, 0xd5, 0xf7, 0x2a, 0x6c,
-	0x09, 0xa2, 0x84, 0xe0, 0x5d, 0x75, 0x6b, 0xf0, 0xaf, 0x40, 0xdb, 0x7c, 0x16, 0x85, 0x99, 0xac,
-	0x9c, 0x6f, 0xe9, 0xad, 0xd2, 0x3c, 0x7b, 0x71, 0x59, 0xdb, 0x6c, 0x86, 0x7d, 0x17, 0xd6, 0x34,
-	0xab, 0x9b, 0x31, 0xf7, 0x09, 0x7b, 0xa7, 0x24, 0x12, 0xdf, 0x54, 0x7a, 0x7a, 0xd7, 0x66, 0x86,
-	0xea, 0xdf, 0x73, 0x90, 0x68, 0xec, 0xf7, 0x26, 0xb2, 0x0d, 0xa3, 0xec, 0x99, 0x8d, 0x6c, 0xc3,
-	0x28, 0x7d, 0xa4, 0x42, 0x11, 0x0d, 0x5b, 0xb1, 0xe6, 0x48, 0x1c, 0x6a, 0xb3, 0xef, 0xc3, 0x92,
-	0x71, 0xa3, 0xe9, 0xe8, 0x32, 0x1c, 0x68, 0x06, 0x28, 0x5e, 0xc1, 0xed, 0x95, 0xa9, 0xf4, 0xee,
-	0x3a, 0xd5, 0xbf, 0xec, 0x5a, 0x93, 0x83, 0xc4, 0xbf, 0x0d, 0x2d, 0xf3, 0xb6, 0xd4, 0x2b, 0xea,
-	0x5d, 0x37, 0xb2, 0xcc, 0x3b, 0xa3, 0xf7, 0x1c, 0x76, 0x28, 0x02, 0x9a, 0xf4, 0x3b, 0x96, 0x51,
-	0x9c, 0xdf, 0x3e, 0xed, 0xf7, 0x2d, 0xf5, 0x42, 0x96, 0xbd, 0x6c, 0x7a, 0xc7, 0xb9, 0xe7, 0xb0,
-	0xbf, 0xef, 0x40, 0xdb, 0xba, 0xd1, 0x64, 0x85, 0x88, 0xe4, 0x7a, 0xd6, 0x35, 0xf3, 0xcc, 0xae,
-	0xb9, 0x1e, 0x0d, 0x7b, 0x7f, 0xe3, 0xdb, 0xd6, 0xb4, 0x7e, 0x6e, 0xf9, 0x91, 0x36, 0xf3, 0x8f,
-	0x59, 0x7e, 0x91, 0x2f, 0x60, 0x5e, 0x7c, 0xfe, 0xe2, 0x9e, 0xc3, 0xfe, 0xc4, 0x81, 0x45, 0xdb,
-	0xef, 0xa9, 0x87, 0x5b, 0xea, 0x61, 0xd5, 0x8b, 0x3f, 0xc3, 0x59, 0xfa, 0x7d, 0xea, 0xe5, 0xd3,
-	0x0d, 0xcf, 0xea, 0xa5, 0x7c, 0xdb, 0xe4, 0xd7, 0xeb, 0x2d, 0xfb, 0x58, 0x3c, 0xd8, 0xac, 0x4e,
-	0x2c, 0x58, 0xf1, 0x0d, 0x60, 0x4d, 0x30, 0xe6, 0xab, 0xbd, 0xb4, 0x08, 0x3f, 0x14, 0x8f, 0x37,
-	0x2a, 0x07, 0x3a, 0xd2, 0xdd, 0x9b, 0x7e, 0xef, 0xde, 0xa2, 0x31, 0xdd, 0x70, 0xaf, 0x59, 0x63,
-	0xca, 0xef, 0xf0, 0x5b, 0xa2, 0x77, 0xf2, 0xc1, 0xdd, 0x6c, 0x8b, 0x2a, 0x3c, 0xc2, 0x3b, 0xbb,
-	0x93, 0x63, 0xd1, 0x49, 0x59, 0xdc, 0x62, 0x8e, 0x37, 0xac, 0xc6, 0xdd, 0xa0, 0xbe, 0xde, 0x72,
-	0xdf, 0x99, 0xd9, 0xd7, 0xbb, 0xe4, 0xc3, 0xc4, 0x1e, 0x1f, 0x02, 0x64, 0xa7, 0x8b, 0x2c, 0x77,
-	0xba, 0xa5, 0x45, 0x46, 0xf1, 0x00, 0xd2, 0xe6, 0x40, 0x75, 0x08, 0x86, 0x35, 0xfe, 0x40, 0x08,
-	0xc0, 0xc7, 0xea, 0x5c, 0xcc, 0x54, 0x73, 0xec, 0x63, 0x40, 0x4b, 0xcd, 0xc9, 0xd7, 0x6f, 0x89,
-	0x3f, 0x7d, 0xc8, 0xf6, 0x0c, 0x16, 0xf6, 0xa3, 0xe8, 0xc5, 0x74, 0xa2, 0x63, 0x38, 0xec, 0x83,
-	0x85, 0x3d, 0x3f, 0x39, 0xeb, 0xe5, 0x46, 0xe1, 0xde, 0xa4, 0xaa, 0x7a, 0xac, 0x6b, 0x54, 0x75,
-	0xf7, 0xf3, 0xec, 0xf4, 0xf2, 0x0b, 0xe6, 0xc3, 0xb2, 0x96, 0xaa, 0xba, 0xe3, 0x3d, 0xbb, 0x1a,
-	0x4b, 0x96, 0xe6, 0x9b, 0xb0, 0xf4, 0x71, 0xd5, 0xdb, 0xbb, 0x89, 0xaa, 0x93, 0x64, 0x4a, 0x7b,
-	0x87, 0x0f, 0xe8, 0x32, 0x05, 0x79, 0xe7, 0x57, 0xb2, 0x8e, 0x6b, 0xb7, 0x7e, 0x6f, 0xc1, 0x02,
-	0xed, 0x9d, 0x66, 0xe2, 0x5f, 0xc6, 0xfc, 0xc7, 0x77, 0x3f, 0x97, 0x7e, 0xff, 0x2f, 0xd4, 0x4e,
-	0xa3, 0x0e, 0x46, 0xac, 0x9d, 0x26, 0x77, 0x92, 0x62, 0xed, 0x34, 0x85, 0x93, 0x14, 0x6b, 0xaa,
-	0xd5, 0xc1, 0x0c, 0x1b, 0xc1, 0x72, 0xe1, 0xf0, 0x45, 0x6f, 0x32, 0xb3, 0x8e, 0x6c, 0x7a, 0x37,
-	0x67, 0x17, 0xb0, 0x5b, 0xdb, 0xb0, 0x5b, 0x3b, 0x82, 0x85, 0x1d, 0x2e, 0x26, 0x4b, 0x84, 0xaa,
-	0xe6, 0xae, 0xc5, 0x99, 0x81, 0xb0, 0xf9, 0x2d, 0x81, 0xf2, 0x6c, 0x55, 0x82, 0xe2, 0x44, 0xd9,
-	0x0f, 0xa0, 0xf5, 0x88, 0xa7, 0x2a, 0x36, 0x55, 0x2b, 0xb3, 0xb9, 0x60, 0xd5, 0x5e, 0x49, 0x68,
-	0xab, 0x4d, 0x33, 0x54, 0xdb, 0x5d, 0x3e, 0x3c, 0xe5, 0x42, 0x38, 0xf5, 0x83, 0xe1, 0x17, 0xec,
-	0x2f, 0x53, 0xe5, 0x3a, 0x54, 0x7f, 0xcd, 0x08, 0x36, 0x34, 0x2b, 0x5f, 0xca, 0xe1, 0x65, 0x35,
-	0x87, 0xd1, 0x90, 0x1b, 0x4a, 0x55, 0x08, 0x2d, 0xe3, 0xaa, 0x8b, 0x66, 0xa0, 0xe2, 0xad, 0x29,
-	0xcd, 0x40, 0x25, 0x37, 0x63, 0xdc, 0x3b, 0xd4, 0x8e, 0xcb, 0x6e, 0x66, 0xed, 0x88, 0xdb, 0x30,
-	0x59, 0x4b, 0x77, 0x3f, 0xf7, 0xc7, 0xe9, 0x17, 0xec, 0x39, 0xbd, 0x31, 0x64, 0xc6, 0xdf, 0x66,
-	0xda, 0x79, 0x3e, 0x54, 0x57, 0x4f, 0x96, 0x91, 0x65, 0x6b, 0xec, 0xa2, 0x29, 0xd2, 0xbd, 0xbe,
-	0x05, 0x70, 0x94, 0x46, 0x93, 0x1d, 0x9f, 0x8f, 0xa3, 0x30, 0x93, 0xb5, 0x59, 0xf4, 0x67, 0x26,
-	0xbf, 0x8c, 0x10, 0x50, 0xf6, 0xdc, 0x30, 0x67, 0xac, 0xf0, 0x65, 0x45, 0x5c, 0x33, 0x03, 0x44,
-	0xf5, 0x84, 0x94, 0x04, 0x89, 0xde, 0x73, 0xd8, 0x16, 0x40, 0x76, 0xfa, 0xa6, 0x8d, 0x93, 0xc2,
-	0xc1, 0x9e, 0x16, 0x7b, 0x25, 0x47, 0x75, 0x87, 0xd0, 0xcc, 0x0e, 0x75, 0xd6, 0xb3, 0xcb, 0x64,
-	0xd6, 0x11, 0x90, 0xde, 0xc1, 0x0b, 0x87, 0x2c, 0x6e, 0x87, 0xa6, 0x0a, 0x58, 0x03, 0xa7, 0x8a,
-	0x4e, 0x4e, 0x02, 0x58, 0x11, 0x1d, 0xd4, 0x0a, 0x0e, 0x45, 0x2e, 0xaa, 0x91, 0x94, 0x1c, 0x74,
-	0x68, 0x6e, 0x2e, 0xf5, 0xfa, 0x5b, 0x3e, 0x16, 0xa4, 0x56, 0x11, 0x35, 0x89, 0xa2, 0x79, 0x0c,
-	0xcb, 0x05, 0x87, 0xb4, 0x66, 0xe9, 0x59, 0x27, 0x05, 0x9a, 0xa5, 0x67, 0xfa, 0xb2, 0xdd, 0xab,
-	0xd4, 0xe4, 0x92, 0x0b, 0x64, 0x53, 0xbd, 0x0c, 0xd2, 0xc1, 0x19, 0x36, 0xf7, 0x73, 0x07, 0x56,
-	0x4a, 0xfc, 0xcd, 0xec, 0x5d, 0x65, 0x9e, 0xcf, 0xf4, 0x45, 0xf7, 0x4a, 0xdd, 0x91, 0xee, 0x11,
-	0xb5, 0xf3, 0x19, 0xfb, 0xd4, 0xda, 0xd8, 0x84, 0x27, 0x50, 0x72, 0xe6, 0x2b, 0x95, 0x8a, 0x52,
-	0x8d, 0xe2, 0xc7, 0xb0, 0x2e, 0x3a, 0xb2, 0x35, 0x1a, 0xe5, 0x5c, 0xa5, 0x37, 0x0a, 0xff, 0xb7,
-	0xc5, 0x72, 0x01, 0xf7, 0x66, 0xff, 0x5f, 0x97, 0x19, 0x0a, 0xb0, 0xe8, 0x2a, 0x9b, 0x42, 0x27,
-	0xef, 0x7e, 0x64, 0xb3, 0xeb, 0xea, 0xbd, 0x63, 0x19, 0x9a, 0x45, 0x97, 0xa5, 0xfb, 0x17, 0xa8,
-	0xb1, 0x77, 0xdc, 0x5e, 0xd9, 0xbc, 0x08, 0xdb, 0x13, 0xd7, 0xe3, 0xaf, 0x69, 0x5f, 0x69, 0x6e,
-	0x9c, 0xaa, 0x81, 0x59, 0xce, 0x5d, 0x6d, 0xea, 0x96, 0xbb, 0x5a, 0x6f, 0x53, 0xf3, 0x37, 0xdd,
-	0xb7, 0xca, 0x9a, 0x8f, 0xc5, 0x27, 0xc2, 0xe8, 0x5d, 0xcf, 0xf3, 0xb5, 0xea, 0xc1, 0xcd, 0xb2,
-	0xf5, 0x9e, 0x69, 0xbd, 0xe4, 0xe6, 0xfa, 0xca, 0x3d, 0xe7, 0xc1, 0xcd, 0xef, 0xdf, 0x38, 0x0d,
-	0xd2, 0xb3, 0xe9, 0xf1, 0xe6, 0x20, 0x1a, 0xdf, 0x1d, 0xf2, 0x41, 0xcc, 0x87, 0x77, 0x87, 0x83,
-	0x78, 0x14, 0x0e, 0xef, 0xd2, 0x87, 0xc7, 0x73, 0xf4, 0xff, 0x9f, 0xbe, 0xf1, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x83, 0x44, 0xb8, 0xf3, 0x31, 0x6a, 0x00, 0x00,
+	// 8618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbd, 0x7d, 0x5b, 0x6c, 0x24, 0xd9,
+	0x75, 0xd8, 0xf6, 0x83, 0x64, 0xf3, 0x36, 0x9f, 0xc5, 0xe1, 0x63, 0x7a, 0x5f, 0xa3, 0xd2, 0x66,
+	0xb5, 0x1e, 0x4b, 0x1c, 0xed, 0xe8, 0x61, 0x79, 0xd7, 0x8e, 0xc2, 0x21, 0x39, 0x0f, 0x2d, 0x87,
+	0x43, 0x15, 0x39, 0xda, 0x48, 0x76, 0xd0, 0x2a, 0x76, 0x17, 0xc9, 0xd6, 0xf4, 0x4b, 0x55, 0xd5,
+	0x9c, 0xa1, 0xe4, 0xcd, 0x87, 0x61, 0x04, 0x41, 0x00, 0xc3, 0xb0, 0x0d, 0x03, 0x46, 0x80, 0x20,
+	0x86, 0x95, 0x1f, 0x27, 0xf9, 0x08, 0x10, 0x24, 0x48, 0x80, 0x00, 0xfa, 0x09, 0x90, 0xfc, 0x04,
+	0xf9, 0xf0, 0x47, 0x90, 0x7c, 0x04, 0x48, 0x90, 0x40, 0x31, 0xe2, 0x1f, 0xc3, 0xf0, 0x7f, 0xce,
+	0xeb, 0xde, 0xba, 0xb7, 0xaa, 0x7a, 0x38, 0x2b, 0x29, 0xfe, 0x99, 0xe9, 0x7b, 0xce, 0xad, 0xfb,
+	0x3c, 0xe7, 0xdc, 0xf3, 0xba, 0x97, 0x6a, 0x3e, 0x1e, 0x77, 0xb6, 0xc7, 0xf1, 0x28, 0x1d, 0x79,
+	0x33, 0xfd, 0x21, 0x14, 0x5a, 0x6f, 0x9c, 0x8f, 0x46, 0xe7, 0xfd, 0xe8, 0x4e, 0x38, 0xee, 0xdd,
+	0x09, 0x87, 0xc3, 0x51, 0x1a, 0xa6, 0xbd, 0xd1, 0x30, 0xe1, 0x4a, 0xfe, 0x77, 0xd5, 0xd2, 0x83,
+	0x68, 0x78, 0x1c, 0x45, 0xdd, 0x20, 0xfa, 0xfe, 0x24, 0x4a, 0x52, 0xef, 0x17, 0xd5, 0x6a, 0x18,
+	0xfd, 0x00, 0x00, 0xed, 0x71, 0x98, 0x24, 0xe3, 0x8b, 0x38, 0x4c, 0xa2, 0xad, 0xca, 0xad, 0xca,
+	0x7b, 0x0b, 0xc1, 0x0a, 0x23, 0x8e, 0x0c, 0xdc, 0xfb, 0x8c, 0x5a, 0x48, 0xb0, 0x6a, 0x34, 0x4c,
+	0xe3, 0xd1, 0xf8, 0x6a, 0xab, 0x4a, 0xf5, 0x9a, 0x08, 0xdb, 0x67, 0x90, 0xdf, 0x57, 0xcb, 0xa6,
+	0x87, 0x64, 0x0c, 0x3d, 0x47, 0xde, 0x17, 0xd5, 0x8d, 0x4e, 0x6f, 0x7c, 0x11, 0xc5, 0x6d, 0xfa,
+	0x78, 0x30, 0x8c, 0x06, 0xa3, 0x61, 0xaf, 0x03, 0xbd, 0xd4, 0xde, 0x9b, 0x0f, 0x3c, 0xc6, 0xe1,
+	0x17, 0x8f, 0x05, 0xe3, 0x7d, 0x4e, 0x2d, 0x47, 0x43, 0x86, 0xc3, 0x07, 0xf8, 0x95, 0x74, 0xb5,
+	0x94, 0x81, 0xf1, 0x03, 0xff, 0xef, 0x57, 0xd5, 0xea, 0xa3, 0x61, 0x2f, 0xfd, 0x38, 0xec, 0xf7,
+	0xa3, 0x54, 0xcf, 0x09, 0x3e, 0x7f, 0x4e, 0x00, 0x9a, 0xd3, 0xf3, 0x51, 0xdc, 0x95, 0x19, 0x2d,
+	0x31, 0xf8, 0x48, 0xa0, 0x53, 0x47, 0x56, 0x9d, 0x3a, 0xb2, 0xd2, 0xe5, 0xaa, 0x4d, 0x59, 0x2e,
+	0x18, 0x47, 0x1c, 0x75, 0x46, 0x97, 0x51, 0x7c, 0xd5, 0x7e, 0xde, 0x1b, 0x76, 0x47, 0xcf, 0xb7,
+	0xea, 0x50, 0x75, 0x26, 0x58, 0xd2, 0xe0, 0x8f, 0x09, 0xea, 0xdd, 0x53, 0xcb, 0x9d, 0x0b, 0xd8,
+	0xad, 0xa8, 0xdf, 0x3e, 0x0d, 0x3b, 0xcf, 0x26, 0xe3, 0x64, 0x6b, 0x06, 0x2a, 0x36, 0xef, 0xde,
+	0xdc, 0xa6, 0x5d, 0xdd, 0xde, 0x05, 0xec, 0x3d, 0xc2, 0x1c, 0x0f, 0xc3, 0x71, 0x72, 0x31, 0x4a,
+	0x83, 0x25, 0xf9, 0x82, 0xc1, 0x89, 0x7f, 0x43, 0x79, 0xf6, 0x4a, 0xf0, 0xda, 0xfb, 0xff, 0xac,
+	0xa2, 0xd6, 0x9e, 0x0e, 0xfb, 0xa3, 0xce, 0xb3, 0x9f, 0x72, 0x89, 0x4a, 0xe6, 0x50, 0x7d, 0xd5,
+	0x39, 0xd4, 0x3e, 0xed, 0x1c, 0x36, 0xd4, 0x0d, 0x77, 0xb0, 0x32, 0x8b, 0x48, 0xad, 0xe3, 0xd7,
+	0xe7, 0x91, 0x1e, 0x96, 0x9e, 0xc6, 0x2f, 0xa8, 0x95, 0xce, 0x24, 0x8e, 0x81, 0x1e, 0xf3, 0xf3,
+	0x58, 0x16, 0xb8, 0x99, 0x08, 0xd0, 0xee, 0x30, 0x7a, 0x9e, 0x55, 0x13, 0xda, 0x05, 0x98, 0xae,
+	0xe2, 0x6f, 0xa9, 0x8d, 0x7c, 0x37, 0x32, 0x80, 0xff, 0x5d, 0x51, 0xf5, 0xa7, 0xe9, 0x8b, 0x91,
+	0xb7, 0xad, 0xea, 0xe9, 0xd5, 0x98, 0x39, 0x64, 0xe9, 0xae, 0x27, 0x53, 0xdb, 0xe9, 0x76, 0xe3,
+	0x28, 0x49, 0x4e, 0x00, 0x13, 0x2c, 0x84, 0x5c, 0x68, 0x63, 0x3d, 0x6f, 0x4b, 0xcd, 0x49, 0x99,
+	0x3a, 0x9c, 0x0f, 0x74, 0xd1, 0xf3, 0xd5, 0x42, 0x38, 0x18, 0x4d, 0x60, 0xe4, 0x61, 0x3a, 0x1a,
+	0xf0, 0x62, 0xd5, 0x02, 0x07, 0xe6, 0xbd, 0xa1, 0xe6, 0xc7, 0xcf, 0xda, 0x49, 0x27, 0xee, 0x8d,
+	0x53, 0x22, 0x9d, 0xf9, 0x20, 0x03, 0x00, 0x2d, 0x36, 0x46, 0x93, 0x74, 0x3c, 0xea, 0x0d, 0x53,
+	0x21, 0x97, 0x65, 0x19, 0xcf, 0x93, 0x49, 0x7a, 0x84, 0xe0, 0xc0, 0x54, 0xf0, 0xde, 0x51, 0x8b,
+	0x9d, 0xd1, 0xf0, 0xac, 0x17, 0x0f, 0x58, 0x20, 0x6c, 0xcd, 0x52, 0x7f, 0x2e, 0xd0, 0xff, 0xb7,
+	0x55, 0xd5, 0x3c, 0x89, 0xc3, 0x61, 0x12, 0x76, 0x10, 0x80, 0xc3, 0x4f, 0x5f, 0xb4, 0x2f, 0xc2,
+	0xe4, 0x82, 0x66, 0x0c, 0xc3, 0x97, 0xa2, 0xb7, 0xa1, 0x66, 0x79, 0xa8, 0x34, 0xaf, 0x5a, 0x20,
+	0x25, 0xef, 0xf3, 0x6a, 0x75, 0x38, 0x19, 0xb4, 0xdd, 0xbe, 0x6a, 0x44, 0x31, 0x45, 0x84, 0xf7,
+	0x96, 0x52, 0xa7, 0xb8, 0xdf, 0xdc, 0x05, 0xcf, 0xd0, 0x82, 0xe0, 0x22, 0x49, 0x29, 0xea, 0x9d,
+	0x5f, 0xf0, 0x34, 0x67, 0x02, 0x07, 0x86, 0x6d, 0xa4, 0xbd, 0x41, 0xd4, 0x4e, 0xd2, 0x70, 0x30,
+	0x96, 0x69, 0x59, 0x10, 0xc2, 0x83, 0x18, 0xec, 0xb7, 0xcf, 0xa2, 0x28, 0xd9, 0x9a, 0x13, 0xbc,
+	0x81, 0x78, 0xef, 0xaa, 0xa5, 0x2e, 0xd0, 0x52, 0x5b, 0x36, 0x06, 0xea, 0x34, 0x88, 0xfd, 0x73,
+	0x50, 0x6c, 0x27, 0x0e, 0x9f, 0xb7, 0x71, 0x01, 0xa2, 0x17, 0x5b, 0xf3, 0x3c, 0xd6, 0x0c, 0x82,
+	0xd4, 0xf3, 0x20, 0x4a, 0xad, 0xd5, 0x4b, 0x84, 0x4a, 0xfd, 0x03, 0xe5, 0x59, 0xe0, 0xbd, 0x28,
+	0x0d, 0x7b, 0xfd, 0xc4, 0xfb, 0xaa, 0x5a, 0x48, 0xad, 0xca, 0x24, 0x0e, 0x9b, 0x86, 0xa4, 0xac,
+	0x0f, 0x02, 0xa7, 0x9e, 0xff, 0x40, 0x35, 0xee, 0x47, 0xd1, 0x41, 0x6f, 0xd0, 0x4b, 0x61, 0x17,
+	0x66, 0xce, 0x7a, 0x2f, 0x22, 0x26, 0xfa, 0xda, 0xc3, 0xd7, 0x02, 0x2e, 0x7a, 0x2d, 0x35, 0x37,
+	0x8e, 0xe2, 0x4e, 0xa4, 0xb7, 0x07, 0x30, 0x1a, 0x70, 0x6f, 0x4e, 0xcd, 0xf4, 0xf1, 0x63, 0xff,
+	0x8f, 0xea, 0xaa, 0x79, 0x1c, 0x0d, 0x0d, 0x33, 0x79, 0xaa, 0x8e, 0x53, 0x16, 0x06, 0xa2, 0xdf,
+	0xde, 0xdb, 0xaa, 0x49, 0xcb, 0x90, 0xa4, 0x71, 0x6f, 0x78, 0x2e, 0x34, 0xac, 0x10, 0x74, 0x4c,
+	0x10, 0x6f, 0x45, 0xd5, 0xc2, 0x41, 0x2a, 0xd4, 0x8b, 0x3f, 0x91, 0xd1, 0xc6, 0xe1, 0xd5, 0x00,
+	0x79, 0xd2, 0xec, 0x2a, 0x30, 0x9a, 0xc0, 0x1e, 0xe2, 0xb6, 0x6e, 0xab, 0x35, 0xbb, 0x8a, 0x6e,
+	0x7d, 0x86, 0x5a, 0x5f, 0xb5, 0x6a, 0x4a, 0x27, 0x20, 0x84, 0x74, 0xfd, 0x98, 0x07, 0x4b, 0xfb,
+	0x0c, 0x7b, 0x24, 0x60, 0x3d, 0x85, 0xf7, 0xd4, 0xca, 0x59, 0x6f, 0x08, 0x3b, 0xdb, 0xe9, 0xa7,
+	0x97, 0xed, 0x6e, 0xd4, 0x4f, 0x43, 0xda, 0x71, 0x10, 0x57, 0x04, 0xdf, 0x05, 0xf0, 0x1e, 0x42,
+	0x81, 0x4e, 0xe7, 0x61, 0xf7, 0xdb, 0xb4, 0x12, 0xb0, 0xe1, 0x36, 0xf7, 0xe8, 0xd5, 0x0d, 0x1a,
+	0x67, 0x7a, 0x9d, 0xa1, 0x5d, 0xe0, 0xa4, 0x73, 0xe0, 0xa4, 0xf3, 0x36, 0xca, 0xac, 0x76, 0xaf,
+	0xbb, 0xa5, 0xe0, 0xa3, 0x7a, 0xb0, 0xa4, 0xe1, 0x28, 0x39, 0x1e, 0x75, 0xbd, 0xaf, 0xa8, 0xcd,
+	0xde, 0xf9, 0x70, 0x14, 0x47, 0xed, 0x41, 0xf8, 0xa2, 0x0d, 0xc8, 0x53, 0x60, 0x8b, 0x6e, 0x1b,
+	0xd7, 0x08, 0x49, 0xa6, 0x11, 0xdc, 0x60, 0xf4, 0xe3, 0xf0, 0xc5, 0x13, 0x41, 0xee, 0xc0, 0xa2,
+	0xbd, 0xa9, 0x14, 0x0d, 0x99, 0xc7, 0xd3, 0x84, 0x9a, 0x8b, 0xc1, 0x3c, 0x42, 0xb8, 0xff, 0x0f,
+	0x54, 0x83, 0xb6, 0x21, 0xed, 0x5f, 0x6e, 0x2d, 0x10, 0x9d, 0xbc, 0x2d, 0x83, 0xb5, 0x36, 0x70,
+	0x7b, 0x0f, 0xfe, 0x39, 0xe9, 0x5f, 0xe2, 0x51, 0x7c, 0x15, 0xcc, 0x75, 0xb9, 0xd4, 0xfa, 0x40,
+	0x2d, 0xd8, 0x08, 0xdc, 0xb1, 0x67, 0xd1, 0x15, 0xed, 0x72, 0x3d, 0xc0, 0x9f, 0xde, 0x0d, 0x35,
+	0x73, 0x19, 0xf6, 0x27, 0x91, 0xc8, 0x44, 0x2e, 0x7c, 0x50, 0xfd, 0x5a, 0xc5, 0xff, 0x37, 0x15,
+	0xb5, 0xc0, 0x3d, 0xc8, 0x59, 0x0e, 0x62, 0x44, 0xef, 0x44, 0x14, 0xc7, 0xa3, 0x58, 0xc4, 0x82,
+	0x0b, 0xf4, 0x6e, 0xab, 0x15, 0x0d, 0x18, 0xc7, 0x51, 0x6f, 0x10, 0x9e, 0xeb, 0xb6, 0x0b, 0x70,
+	0xef, 0x6e, 0xd6, 0x62, 0x0c, 0xcb, 0x15, 0xc9, 0xa9, 0xb1, 0x20, 0xf3, 0x0b, 0x10, 0x16, 0xb8,
+	0x55, 0x50, 0x2c, 0x94, 0x90, 0x98, 0x03, 0xf3, 0x7f, 0xb7, 0xa2, 0x3c, 0x1c, 0xfa, 0xc9, 0x88,
+	0x9b, 0x10, 0x0a, 0xc9, 0x53, 0x67, 0xe5, 0x95, 0xa9, 0xb3, 0x3a, 0x8d, 0x3a, 0x7d, 0x35, 0xc3,
+	0x23, 0xaf, 0x97, 0x8c, 0x9c, 0x51, 0xdf, 0xa8, 0x37, 0x6a, 0x2b, 0x75, 0xff, 0xbf, 0xd5, 0xd4,
+	0x8d, 0x5d, 0x3e, 0xf2, 0x76, 0x3a, 0x9d, 0x68, 0x6c, 0xe8, 0x16, 0xd8, 0x6c, 0x38, 0xea, 0x46,
+	0xed, 0xf1, 0xe4, 0x54, 0xef, 0xcd, 0x42, 0xa0, 0x10, 0x74, 0x44, 0x10, 0xa2, 0x8f, 0x8b, 0xb0,
+	0x37, 0xe4, 0x41, 0xf3, 0x5a, 0xce, 0x13, 0x84, 0x86, 0xfc, 0x2e, 0x30, 0x08, 0xcc, 0xd5, 0x26,
+	0x4f, 0x56, 0x4a, 0x16, 0x05, 0x2c, 0xd4, 0x09, 0xfd, 0x9c, 0x4d, 0xb8, 0x1e, 0x52, 0x64, 0x9d,
+	0x68, 0x40, 0x09, 0x08, 0xe9, 0xf0, 0xa6, 0x6a, 0x8c, 0x27, 0x30, 0x67, 0xc4, 0xce, 0x10, 0x76,
+	0x0e, 0xcb, 0x42, 0xa2, 0xdd, 0x09, 0xd0, 0x20, 0x93, 0xe8, 0x2c, 0x21, 0xe7, 0x11, 0xc2, 0x24,
+	0xfa, 0x05, 0xb5, 0x86, 0x14, 0x4f, 0xb4, 0xd3, 0x86, 0x81, 0x9e, 0xf5, 0x49, 0x62, 0xcf, 0x51,
+	0xbd, 0x15, 0x40, 0x7d, 0x0b, 0x31, 0x8f, 0x86, 0xf7, 0x09, 0x8e, 0x2c, 0xad, 0xd5, 0x05, 0x90,
+	0xaf, 0x51, 0x7c, 0x19, 0x11, 0x17, 0xd6, 0x8d, 0x4e, 0x10, 0x30, 0x14, 0x47, 0x34, 0xc0, 0x79,
+	0xa7, 0xfd, 0x0e, 0x71, 0x10, 0x8c, 0x08, 0xca, 0x0f, 0xa1, 0x08, 0xc7, 0xa3, 0x42, 0x1e, 0x06,
+	0xc1, 0xd6, 0x7e, 0x76, 0x2a, 0xfc, 0x88, 0x3c, 0x7b, 0x14, 0xc5, 0x1f, 0x9d, 0x7a, 0xaf, 0xab,
+	0xf9, 0x4e, 0x42, 0x42, 0x20, 0xbc, 0x12, 0x8e, 0x6a, 0x00, 0x60, 0x0f, 0xcb, 0xc0, 0xfe, 0x1e,
+	0x8e, 0x36, 0xa4, 0x5d, 0x00, 0x6d, 0x0e, 0x9b, 0x4f, 0x80, 0xb5, 0xb0, 0x16, 0x0e, 0x76, 0x47,
+	0x10, 0xd8, 0x4f, 0xe2, 0x7d, 0x16, 0x0e, 0x4f, 0x19, 0xec, 0x59, 0x3f, 0x3c, 0x4f, 0xb6, 0x16,
+	0xa9, 0xe2, 0x82, 0x00, 0xef, 0x23, 0xcc, 0xff, 0x98, 0x95, 0x14, 0x6b, 0x6f, 0x85, 0x67, 0xf0,
+	0xa8, 0x24, 0x08, 0xed, 0x6b, 0x23, 0x90, 0x52, 0xd9, 0xa6, 0x55, 0x4b, 0x36, 0xcd, 0xff, 0x63,
+	0x60, 0x42, 0x69, 0x99, 0x4e, 0x75, 0x50, 0x5b, 0x3d, 0xbd, 0x8b, 0xe9, 0x8b, 0x5e, 0xb7, 0x7d,
+	0x7a, 0x95, 0x46, 0x09, 0x13, 0x0d, 0x08, 0xfa, 0x12, 0x1c, 0x4c, 0x77, 0xc5, 0x81, 0x02, 0x49,
+	0x33, 0x3d, 0x43, 0xfd, 0x02, 0x06, 0xd9, 0x0b, 0xf5, 0x86, 0x49, 0x0a, 0xfb, 0xd8, 0x85, 0xb3,
+	0xae, 0xc6, 0xb3, 0xb5, 0x61, 0xf7, 0x96, 0xd4, 0x82, 0xfd, 0x9d, 0xff, 0x3d, 0xd5, 0xd0, 0x5a,
+	0x07, 0x9d, 0xb8, 0xb9, 0x71, 0x05, 0x16, 0x04, 0x4e, 0xa7, 0x86, 0x3b, 0x8a, 0xa0, 0xf1, 0x69,
+	0xfa, 0xf6, 0xff, 0xa6, 0x5a, 0x39, 0x40, 0x22, 0x1a, 0x22, 0xd1, 0x8a, 0x3a, 0x05, 0x8b, 0x6c,
+	0x31, 0xcf, 0x7c, 0x20, 0x25, 0x3c, 0xd4, 0x2e, 0x46, 0x49, 0x2a, 0xfd, 0xd0, 0x6f, 0xff, 0x3f,
+	0x80, 0x68, 0xd8, 0x4f, 0x40, 0x45, 0x08, 0xd3, 0x08, 0x84, 0xbd, 0x66, 0xc2, 0x27, 0x6a, 0x01,
+	0x5b, 0x3b, 0x19, 0xed, 0xb0, 0x62, 0xc3, 0x07, 0xf2, 0x2f, 0x0a, 0x3b, 0x17, 0x3f, 0xd8, 0xb6,
+	0x6b, 0xb3, 0xd0, 0x75, 0x1a, 0x40, 0x6e, 0x4b, 0xc3, 0xf8, 0x1c, 0x94, 0x6c, 0xd4, 0x7a, 0x44,
+	0x6f, 0x56, 0x0c, 0xda, 0x05, 0x48, 0xeb, 0xeb, 0x6a, 0xb5, 0xd0, 0x86, 0x2d, 0x9f, 0xe7, 0x4b,
+	0xe4, 0x73, 0xcd, 0x96, 0xcf, 0xcf, 0xd4, 0x9a, 0x33, 0x2e, 0xa1, 0xb8, 0x37, 0xf8, 0x70, 0x63,
+	0xc5, 0x92, 0x54, 0x83, 0x20, 0x03, 0x80, 0xe2, 0xb1, 0x01, 0x85, 0x18, 0xbe, 0x61, 0x00, 0x31,
+	0x10, 0xee, 0x8c, 0xb4, 0x3f, 0x05, 0xeb, 0xff, 0xa4, 0xa2, 0x96, 0x51, 0xa2, 0x3e, 0x0e, 0x87,
+	0x57, 0x7a, 0xcd, 0x0e, 0x4a, 0xd7, 0xec, 0x3d, 0xeb, 0x70, 0xb2, 0x6a, 0x7f, 0xda, 0x05, 0xab,
+	0xe5, 0x17, 0x0c, 0x8e, 0x9f, 0xa5, 0xdc, 0x90, 0x67, 0x44, 0x6d, 0x46, 0x28, 0xf0, 0xfd, 0x3d,
+	0x80, 0xfd, 0xec, 0xcb, 0xfa, 0xae, 0x5a, 0xc9, 0x86, 0x2e, 0x6b, 0x0a, 0x84, 0x84, 0x44, 0x2a,
+	0x0d, 0xd0, 0x6f, 0xff, 0x8f, 0x2a, 0x5c, 0x71, 0x17, 0xc8, 0x3e, 0xb1, 0xd4, 0x28, 0x54, 0x1a,
+	0x75, 0x45, 0xfc, 0x3d, 0x55, 0x5b, 0xfe, 0xf9, 0x4c, 0x18, 0x65, 0x64, 0x12, 0xa1, 0x96, 0xd1,
+	0xef, 0x93, 0x60, 0x6e, 0x04, 0x73, 0x58, 0xde, 0xe9, 0xf7, 0xfd, 0xcf, 0xa9, 0x55, 0x6b, 0x84,
+	0x2f, 0x99, 0xcb, 0xa1, 0xf2, 0x0e, 0x7a, 0x49, 0xfa, 0x74, 0x98, 0x8c, 0x2d, 0x85, 0x0a, 0x84,
+	0x28, 0x4a, 0x5f, 0x1c, 0x1d, 0x53, 0xd2, 0x4c, 0x80, 0xe2, 0x18, 0xc7, 0x96, 0x10, 0x12, 0x84,
+	0x28, 0x23, 0xab, 0x82, 0x0c, 0x5f, 0x10, 0xd2, 0xff, 0x9a, 0x5a, 0x73, 0xda, 0x93, 0xae, 0x3f,
+	0xa3, 0x66, 0x26, 0x60, 0x48, 0x69, 0x75, 0xb7, 0x29, 0x94, 0x82, 0xc6, 0x55, 0xc0, 0x18, 0xff,
+	0x43, 0xb5, 0x7a, 0x18, 0x3d, 0x17, 0xc6, 0xd6, 0x03, 0x79, 0xf7, 0x5a, 0xc3, 0x8b, 0xf0, 0xfe,
+	0xb6, 0xf2, 0xec, 0x8f, 0xa5, 0x57, 0xcb, 0x0c, 0xab, 0x38, 0x66, 0x18, 0x6c, 0xb5, 0x77, 0x0c,
+	0x1a, 0xd9, 0x63, 0xf8, 0x0d, 0xda, 0x88, 0xee, 0x0d, 0x88, 0x65, 0x90, 0x9c, 0x8b, 0xe8, 0xc2,
+	0x9f, 0xfe, 0x97, 0xd4, 0x9a, 0x53, 0x2f, 0xe3, 0xb4, 0x04, 0xc0, 0x61, 0x3a, 0x89, 0x23, 0x69,
+	0x3a, 0x03, 0xf8, 0xf7, 0xd5, 0x8d, 0x6f, 0x45, 0x71, 0xef, 0xec, 0xea, 0xba, 0xe6, 0xdd, 0x76,
+	0xaa, 0xf9, 0x76, 0xf6, 0xd5, 0x7a, 0xae, 0x1d, 0xe9, 0x9e, 0x49, 0x58, 0x76, 0xb2, 0x11, 0x70,
+	0xc1, 0x92, 0x85, 0x55, 0x5b, 0x16, 0xfa, 0x4f, 0x95, 0x07, 0x7b, 0x33, 0x8c, 0x3a, 0xe9, 0x11,
+	0x70, 0x78, 0xe6, 0x01, 0xca, 0xe8, 0xb5, 0x79, 0x77, 0x53, 0x56, 0x36, 0x2f, 0x60, 0x85, 0x90,
+	0x81, 0x72, 0x80, 0x12, 0x07, 0xd4, 0x70, 0x23, 0xa0, 0xdf, 0xfe, 0xba, 0x5a, 0x73, 0x9a, 0x15,
+	0x9b, 0xf9, 0x7d, 0xb5, 0xbe, 0xd7, 0x4b, 0x3a, 0xc5, 0x0e, 0x61, 0x33, 0x60, 0x40, 0xed, 0x8c,
+	0x1b, 0x75, 0x11, 0x4d, 0xa8, 0xfc, 0x27, 0xd2, 0xd8, 0xdf, 0x03, 0x03, 0xfc, 0xe1, 0xc9, 0xc1,
+	0x2e, 0x9e, 0x1d, 0xbd, 0x61, 0x67, 0x34, 0x40, 0x8d, 0x8c, 0x27, 0x6d, 0xca, 0x53, 0xb9, 0x0c,
+	0x16, 0x97, 0x14, 0x39, 0xb4, 0x1a, 0x45, 0x2f, 0xca, 0x00, 0x68, 0xb1, 0x46, 0x2f, 0xc6, 0xbd,
+	0x98, 0x4c, 0x52, 0x6d, 0x68, 0xd6, 0xe9, 0xd8, 0x29, 0x22, 0xfc, 0xff, 0x39, 0xab, 0xe6, 0xe4,
+	0x30, 0xe6, 0x83, 0x3d, 0xed, 0x5d, 0x46, 0xd9, 0xc1, 0x8e, 0x25, 0x54, 0x92, 0xe3, 0x68, 0x30,
+	0x4a, 0x8d, 0x3e, 0xc7, 0xdb, 0xe0, 0x02, 0xc9, 0x22, 0x17, 0xa5, 0x82, 0x6d, 0xf8, 0x1a, 0xd7,
+	0x72, 0x80, 0xb8, 0x58, 0x5a, 0x39, 0x60, 0x6d, 0x4d, 0x17, 0x71, 0x25, 0x3a, 0xe1, 0x38, 0xec,
+	0xf4, 0xd2, 0x2b, 0x11, 0x0a, 0xa6, 0x8c, 0x6d, 0xc3, 0xdc, 0x42, 0x74, 0xc5, 0xf4, 0xc3, 0x61,
+	0x27, 0xd2, 0xd6, 0xbe, 0x03, 0x44, 0xcb, 0x57, 0x86, 0xa4, 0xab, 0xb1, 0x75, 0x9c, 0x83, 0xe2,
+	0x79, 0x0e, 0x2b, 0x0c, 0x4a, 0x1e, 0x1a, 0xcc, 0xa4, 0xa6, 0x81, 0x05, 0x9d, 0x41, 0xbc, 0x5b,
+	0xaa, 0x29, 0xa5, 0xa4, 0xf7, 0x83, 0x88, 0xb4, 0xb4, 0x5a, 0x60, 0x83, 0xb0, 0x85, 0x9c, 0xa6,
+	0x06, 0x2d, 0x64, 0x10, 0xdc, 0x83, 0x09, 0x6c, 0x73, 0x9a, 0xf6, 0x41, 0x17, 0xd3, 0x83, 0x69,
+	0x52, 0xb5, 0x22, 0x02, 0xcd, 0x0b, 0xb6, 0xdf, 0x59, 0x34, 0x26, 0x68, 0xe6, 0x2e, 0x50, 0xe5,
+	0x02, 0x1c, 0xcc, 0x8b, 0x1b, 0x36, 0x2c, 0x8e, 0x3a, 0x11, 0x6c, 0x51, 0x97, 0x34, 0xb8, 0x5a,
+	0x50, 0x8a, 0xc3, 0xf9, 0xa0, 0xab, 0x62, 0x32, 0xee, 0x86, 0xa8, 0xc0, 0x2c, 0xd1, 0xba, 0xdb,
+	0x20, 0xef, 0x7d, 0xa5, 0x75, 0x34, 0xd1, 0x1c, 0x97, 0x1d, 0x69, 0x86, 0x94, 0x1a, 0xb8, 0x35,
+	0x90, 0x08, 0x33, 0x75, 0x74, 0x45, 0x0c, 0x3c, 0x0d, 0x20, 0x9e, 0x88, 0x7b, 0x97, 0xd0, 0xf8,
+	0xd6, 0x2a, 0x0b, 0x70, 0x29, 0xe2, 0x77, 0xbd, 0x61, 0x2f, 0xed, 0xc1, 0x18, 0xe3, 0x2d, 0x8f,
+	0x70, 0x19, 0x00, 0x17, 0x8e, 0xe8, 0x21, 0x49, 0x41, 0x52, 0x24, 0xa2, 0x9d, 0xae, 0xb1, 0xa5,
+	0x52, 0x40, 0x80, 0x19, 0xb9, 0xc5, 0x14, 0x40, 0x28, 0xd1, 0xbb, 0x45, 0x4d, 0xb8, 0x41, 0x0b,
+	0x32, 0x15, 0xef, 0xfd, 0x8a, 0xba, 0x29, 0x64, 0x51, 0xf2, 0xf1, 0x3a, 0x7d, 0x3c, 0xbd, 0x02,
+	0x8e, 0x13, 0x47, 0xd2, 0xeb, 0xb4, 0xa5, 0x0e, 0xb2, 0xc5, 0x06, 0xcd, 0xa6, 0x88, 0xf0, 0xff,
+	0x71, 0x85, 0x0f, 0x0f, 0x61, 0xb4, 0xc4, 0x32, 0x93, 0x98, 0xc5, 0xda, 0xa3, 0x61, 0xff, 0x4a,
+	0xb8, 0x4e, 0x31, 0xe8, 0x09, 0x40, 0x50, 0x51, 0x07, 0x33, 0xdf, 0xaa, 0xc2, 0x72, 0x6a, 0x41,
+	0x03, 0xa9, 0x12, 0xb4, 0x02, 0x2c, 0xd8, 0x87, 0x2e, 0xa9, 0x4a, 0x8d, 0x5b, 0x61, 0x10, 0x55,
+	0x40, 0x1b, 0x91, 0x57, 0x9f, 0x6b, 0xd4, 0xa9, 0x46, 0x53, 0x60, 0x58, 0xc5, 0xbf, 0xa7, 0x6e,
+	0xb8, 0x03, 0x14, 0x81, 0x7c, 0x1b, 0x98, 0x52, 0x60, 0x40, 0xbf, 0x48, 0x13, 0x4b, 0x96, 0xfb,
+	0x13, 0xcd, 0x1a, 0x83, 0xf7, 0xff, 0x75, 0x1d, 0x04, 0x27, 0x17, 0x76, 0xfb, 0xa3, 0x24, 0x3a,
+	0x9e, 0x0c, 0x06, 0x61, 0x5c, 0x22, 0x18, 0x2a, 0xd7, 0x08, 0x86, 0xaa, 0x2b, 0x18, 0xde, 0x72,
+	0x6c, 0x45, 0x96, 0x2a, 0x16, 0xc4, 0x7b, 0x0f, 0x4c, 0x2f, 0xe8, 0x8f, 0x55, 0x77, 0xdb, 0xf3,
+	0x96, 0x07, 0x17, 0x05, 0xd9, 0x4c, 0x99, 0x20, 0xb3, 0x05, 0xd1, 0x6c, 0x4e, 0x10, 0x81, 0x3a,
+	0x8f, 0x8d, 0x46, 0x5a, 0xae, 0xce, 0x89, 0xe1, 0x64, 0xc1, 0x70, 0x3c, 0x79, 0xd6, 0x67, 0x19,
+	0x93, 0x07, 0x83, 0xe1, 0xb3, 0x46, 0x8e, 0x3d, 0x94, 0xdb, 0x56, 0x6d, 0x16, 0x38, 0x65, 0x28,
+	0xef, 0x3e, 0xfa, 0x55, 0xb0, 0x2f, 0x52, 0x1e, 0x14, 0x29, 0x0f, 0xef, 0xba, 0x3b, 0x62, 0xaf,
+	0xfd, 0x36, 0x16, 0xe0, 0xc4, 0x25, 0x85, 0xc2, 0xfa, 0xd2, 0xff, 0x07, 0x15, 0xd5, 0xb4, 0x70,
+	0xde, 0xba, 0x5a, 0xdd, 0x7d, 0xf2, 0xe4, 0x68, 0x3f, 0xd8, 0x39, 0x79, 0xf4, 0xad, 0xfd, 0xf6,
+	0xee, 0xc1, 0x93, 0xe3, 0xfd, 0x95, 0xd7, 0x10, 0x7c, 0xf0, 0x64, 0x77, 0xe7, 0xa0, 0x7d, 0xff,
+	0x49, 0xb0, 0xab, 0xc1, 0x15, 0x38, 0x28, 0xbc, 0x60, 0xff, 0xf1, 0x93, 0x93, 0x7d, 0x07, 0x5e,
+	0x05, 0x3d, 0x60, 0xe1, 0x5e, 0xb0, 0xbf, 0xb3, 0xfb, 0x50, 0x20, 0x35, 0x38, 0xd0, 0x57, 0xee,
+	0x3f, 0x3d, 0xdc, 0x7b, 0x74, 0xf8, 0xa0, 0xbd, 0xbb, 0x73, 0xb8, 0xbb, 0x7f, 0xb0, 0xbf, 0xb7,
+	0x52, 0xf7, 0x16, 0xd5, 0xfc, 0xce, 0xbd, 0x9d, 0xc3, 0xbd, 0x27, 0x87, 0x50, 0x9c, 0xf1, 0xff,
+	0x7b, 0x05, 0x4c, 0x4d, 0x1c, 0x5b, 0x37, 0xcf, 0x20, 0x24, 0x89, 0x47, 0x63, 0x54, 0xdf, 0xb3,
+	0x63, 0xc9, 0x06, 0x21, 0xf1, 0x33, 0x8b, 0x9f, 0x8d, 0xe2, 0x4e, 0x24, 0xfc, 0xa1, 0x08, 0x74,
+	0x1f, 0x21, 0x48, 0xfc, 0xb2, 0xbd, 0x5c, 0x83, 0xd9, 0xa3, 0xc9, 0x30, 0xae, 0x02, 0xe7, 0xde,
+	0x69, 0x1c, 0x85, 0x9d, 0x0b, 0xe1, 0x0c, 0x29, 0xa1, 0x37, 0x5e, 0xdb, 0x84, 0x1d, 0x5c, 0x7d,
+	0xd8, 0x3a, 0xa2, 0x98, 0x46, 0xb0, 0x2c, 0xf0, 0x5d, 0x01, 0xa3, 0x54, 0x0b, 0x4f, 0xc3, 0x61,
+	0x77, 0x34, 0x84, 0x3a, 0xac, 0xb2, 0x66, 0x00, 0xff, 0x48, 0x6d, 0xe4, 0xe7, 0x27, 0xfc, 0xf5,
+	0x55, 0x8b, 0xbf, 0x58, 0x83, 0x6c, 0x4d, 0xdf, 0x4d, 0x8b, 0xd7, 0x7e, 0x52, 0x55, 0x75, 0x54,
+	0x28, 0xa6, 0x2b, 0x1f, 0xb6, 0x8e, 0x58, 0x73, 0x5d, 0xf5, 0xe8, 0xa5, 0x46, 0xc3, 0x95, 0x4f,
+	0x1a, 0x71, 0x9a, 0x64, 0x90, 0x0c, 0x0f, 0x27, 0xc8, 0xa5, 0xb8, 0x4d, 0x2c, 0x08, 0xe2, 0xad,
+	0x93, 0x4a, 0x3c, 0xd4, 0xd6, 0x19, 0x65, 0xf0, 0xf4, 0xfd, 0x9c, 0x8d, 0xa7, 0xef, 0x61, 0x64,
+	0xbd, 0x21, 0xb9, 0x0a, 0x89, 0x31, 0xe0, 0x70, 0x90, 0x22, 0x05, 0x08, 0x88, 0x61, 0x81, 0xf4,
+	0x85, 0x0d, 0x32, 0x00, 0x9c, 0x7d, 0xf3, 0xc9, 0xd5, 0xb0, 0x63, 0xd3, 0xfe, 0x0d, 0x59, 0x2d,
+	0x5c, 0x8b, 0xed, 0x63, 0x40, 0x12, 0xa5, 0x67, 0xd5, 0xfc, 0xaf, 0xab, 0x86, 0x06, 0x23, 0x79,
+	0x3e, 0x3d, 0xfc, 0xe8, 0xf0, 0xc9, 0xc7, 0x87, 0xed, 0xe3, 0x6f, 0x1f, 0xee, 0x02, 0x7d, 0x2f,
+	0xab, 0xe6, 0xce, 0x2e, 0x51, 0x3c, 0x01, 0x2a, 0x58, 0xe5, 0x68, 0xe7, 0xf8, 0xd8, 0x40, 0xaa,
+	0xbe, 0x87, 0xc6, 0x79, 0x42, 0xda, 0x9b, 0x71, 0x80, 0x7f, 0x15, 0xd8, 0x22, 0x83, 0x65, 0x96,
+	0xc0, 0x18, 0x01, 0x39, 0x4b, 0x80, 0xd4, 0x3e, 0xc6, 0xf8, 0x2b, 0x18, 0xae, 0x4c, 0x1f, 0x0d,
+	0xcf, 0x46, 0xba, 0xa5, 0x9f, 0xd4, 0x31, 0xbe, 0x28, 0x20, 0x69, 0x08, 0xe4, 0x47, 0xaf, 0x0b,
+	0xeb, 0x08, 0xf2, 0xa6, 0xed, 0xf8, 0x00, 0xf2, 0x60, 0x54, 0x97, 0x41, 0x41, 0x0e, 0x75, 0x2c,
+	0x86, 0x0b, 0xa8, 0x22, 0xe0, 0xd9, 0x6e, 0xfb, 0x62, 0x88, 0xbe, 0xd8, 0xf5, 0x50, 0x8a, 0x43,
+	0x49, 0x84, 0x70, 0x39, 0x6a, 0xcc, 0x27, 0xac, 0x36, 0x96, 0xa1, 0x70, 0xab, 0xb8, 0x25, 0x9c,
+	0xf2, 0x0c, 0x9f, 0xff, 0x06, 0x50, 0x08, 0x74, 0xcc, 0xb2, 0x9c, 0xcc, 0x07, 0x3a, 0xac, 0x60,
+	0x49, 0xa3, 0x10, 0x2c, 0x41, 0x39, 0x0a, 0x5b, 0x07, 0xd2, 0x2f, 0x1d, 0xb5, 0x49, 0xde, 0x8b,
+	0xcb, 0x39, 0x0f, 0x86, 0xb1, 0xcc, 0x01, 0x71, 0xa6, 0xc3, 0x28, 0x25, 0xb2, 0x68, 0xdc, 0xab,
+	0x6e, 0x55, 0x02, 0x0d, 0x42, 0x1d, 0x7f, 0x12, 0xf7, 0x12, 0x72, 0x34, 0x83, 0x75, 0x88, 0xbf,
+	0xbd, 0x2f, 0xab, 0xf5, 0x53, 0x74, 0x40, 0x5f, 0x44, 0x61, 0x17, 0x54, 0x36, 0x24, 0x2f, 0x8e,
+	0xb7, 0xb0, 0x1e, 0x55, 0x8e, 0x44, 0xc2, 0xbd, 0x84, 0xd9, 0x81, 0xfa, 0x4c, 0x4a, 0x14, 0xb0,
+	0x94, 0x14, 0xb1, 0x3d, 0x9c, 0xbc, 0x39, 0xac, 0xcd, 0x0a, 0x2e, 0xd3, 0xc4, 0xcb, 0x91, 0x70,
+	0x1e, 0xcd, 0xd2, 0x04, 0x12, 0x50, 0xa0, 0x6a, 0x96, 0xab, 0x75, 0x17, 0x81, 0x81, 0xe0, 0x70,
+	0x97, 0x3b, 0xa3, 0x3e, 0x68, 0x4b, 0xab, 0xbc, 0xcb, 0x54, 0x70, 0x57, 0xe7, 0x3c, 0x0e, 0xc7,
+	0x17, 0xa2, 0x4d, 0xe5, 0xc1, 0xdf, 0xa8, 0x37, 0x9a, 0x2b, 0x0b, 0xfe, 0x2f, 0xa9, 0x19, 0x6a,
+	0x96, 0x9a, 0xa3, 0xc5, 0xac, 0x48, 0x73, 0x04, 0x85, 0xa9, 0xc1, 0x5a, 0x3d, 0x1f, 0xc5, 0xcf,
+	0x74, 0x60, 0x4f, 0x8a, 0xfe, 0x0f, 0xc8, 0xca, 0x32, 0x41, 0xae, 0xa7, 0xa4, 0x32, 0xa2, 0xad,
+	0xcc, 0x5b, 0x95, 0x5c, 0x84, 0x62, 0xf8, 0x35, 0x08, 0x70, 0x7c, 0x11, 0xa2, 0xcc, 0x75, 0x76,
+	0x9f, 0x6d, 0xe9, 0x26, 0xc1, 0x1e, 0xf2, 0xe6, 0xbf, 0xa3, 0x96, 0x74, 0xf8, 0x2c, 0x69, 0xf7,
+	0xa3, 0xb3, 0x54, 0x7b, 0xc6, 0x00, 0x4a, 0x06, 0xf7, 0x01, 0xc0, 0xc0, 0x88, 0x5f, 0x15, 0x39,
+	0xf8, 0x04, 0x48, 0x56, 0xba, 0xfe, 0xe5, 0x32, 0x7d, 0xa2, 0x79, 0x77, 0xcd, 0x15, 0x9c, 0x1c,
+	0x30, 0x74, 0x6b, 0xfa, 0x01, 0xcc, 0xc5, 0x92, 0xab, 0xd2, 0xa0, 0x1c, 0xea, 0xda, 0xf7, 0x27,
+	0xd3, 0x71, 0x60, 0xb8, 0x3e, 0xc9, 0xa4, 0xd3, 0xd1, 0x81, 0x4f, 0xf4, 0x48, 0x70, 0xd1, 0xff,
+	0xe7, 0xa0, 0xdc, 0x51, 0x6b, 0x5a, 0x23, 0x92, 0xb3, 0xeb, 0x6b, 0x9f, 0x62, 0x98, 0xda, 0xf3,
+	0xca, 0xfe, 0x46, 0xd8, 0x21, 0xfb, 0x34, 0xe3, 0xc2, 0x4f, 0xe3, 0x5b, 0xa9, 0x17, 0x7d, 0x2b,
+	0xfe, 0x1f, 0x56, 0x60, 0x4d, 0xe9, 0x50, 0x21, 0x4d, 0x5a, 0x96, 0xe0, 0x57, 0x60, 0xb0, 0xa4,
+	0x1d, 0x88, 0x64, 0x90, 0xc1, 0x66, 0xe2, 0x95, 0xa0, 0x5c, 0xf9, 0xe1, 0x6b, 0x81, 0x5b, 0xd9,
+	0xfb, 0x90, 0x34, 0xb4, 0x61, 0x9b, 0xa0, 0x25, 0x61, 0x72, 0x77, 0xbd, 0xe1, 0x7b, 0xab, 0xfa,
+	0xbd, 0x86, 0x9a, 0x65, 0x33, 0xc4, 0x7f, 0xa0, 0x16, 0x9d, 0x8e, 0x1c, 0xbf, 0xce, 0x02, 0xfb,
+	0x75, 0x0a, 0x0e, 0xd5, 0x6a, 0x89, 0x43, 0xf5, 0xc7, 0x35, 0xe5, 0x21, 0xc1, 0xe4, 0x76, 0xe4,
+	0x96, 0x1b, 0x95, 0xd0, 0x11, 0xf3, 0x0c, 0xe4, 0x6d, 0x2b, 0xcf, 0x2a, 0xea, 0x48, 0x09, 0x1f,
+	0x9f, 0x25, 0x18, 0x14, 0xb5, 0xa2, 0x7d, 0x98, 0x28, 0x04, 0xd9, 0xeb, 0xbc, 0xf0, 0xa5, 0x38,
+	0x14, 0x7b, 0x1c, 0x92, 0x20, 0x4b, 0x83, 0x2d, 0x5d, 0x0b, 0x92, 0xdf, 0xe7, 0xd9, 0x57, 0xd8,
+	0xe7, 0xb9, 0x12, 0x1f, 0x9a, 0x65, 0x81, 0x35, 0x5c, 0x0b, 0x0c, 0xcc, 0x4d, 0x1d, 0x81, 0x68,
+	0x0f, 0x64, 0x18, 0x7c, 0xd6, 0x16, 0xe0, 0x58, 0x57, 0x1b, 0x41, 0xc6, 0xd8, 0x53, 0x1c, 0x55,
+	0xc8, 0xc3, 0xf1, 0x44, 0xc8, 0x7c, 0x6b, 0x4d, 0x1a, 0x76, 0x06, 0x20, 0x8b, 0x09, 0xe9, 0xa5,
+	0x3d, 0x19, 0x4a, 0xcc, 0x1c, 0x34, 0xa5, 0x05, 0xb1, 0x98, 0xf2, 0x08, 0xff, 0xf7, 0x2a, 0x6a,
+	0x05, 0x77, 0xd0, 0x21, 0xd2, 0x0f, 0x14, 0xf1, 0xc9, 0x2b, 0xd2, 0xa8, 0x53, 0x17, 0xb8, 0x71,
+	0x9e, 0xca, 0xa0, 0x39, 0x0e, 0x85, 0x42, 0xb7, 0x5c, 0x0a, 0xcd, 0x24, 0x0c, 0x7c, 0x9c, 0x55,
+	0xb6, 0xe8, 0xf3, 0x3f, 0x83, 0xd2, 0x2c, 0xbd, 0xfc, 0xd4, 0xbe, 0x9b, 0x96, 0x95, 0xe4, 0xc0,
+	0x74, 0x95, 0xe5, 0x34, 0x80, 0x48, 0x1f, 0xa0, 0x83, 0x0c, 0x4f, 0x78, 0xc7, 0x6f, 0x93, 0x07,
+	0xe3, 0x71, 0x4d, 0xc2, 0x34, 0x81, 0xc3, 0xa9, 0xdf, 0xd6, 0x58, 0x49, 0x27, 0x28, 0x43, 0xa1,
+	0x4c, 0x81, 0x33, 0xec, 0x3c, 0x92, 0x93, 0x98, 0x0b, 0xe8, 0xa0, 0x3a, 0xca, 0x62, 0x33, 0x96,
+	0xe6, 0xed, 0xff, 0xcb, 0x45, 0xb5, 0x59, 0x40, 0x99, 0x04, 0xa8, 0x35, 0xf6, 0x33, 0xf4, 0x7b,
+	0x83, 0xd3, 0x91, 0x31, 0x5b, 0x2a, 0x62, 0xb6, 0x14, 0x51, 0xde, 0xb9, 0x5a, 0xd7, 0x2a, 0x07,
+	0xae, 0x69, 0x76, 0x3c, 0x56, 0xe9, 0xdc, 0x7b, 0xdf, 0xdd, 0xc2, 0x7c, 0x87, 0x1a, 0x6e, 0xb3,
+	0x74, 0x79, 0x7b, 0xde, 0x85, 0xda, 0x32, 0xba, 0x8d, 0x88, 0x6f, 0x4b, 0xff, 0xc1, 0xbe, 0x3e,
+	0x7f, 0x4d, 0x5f, 0x8e, 0xa2, 0x1e, 0x4c, 0x6d, 0xcd, 0xbb, 0x52, 0x6f, 0x69, 0x1c, 0xc9, 0xe7,
+	0x62, 0x7f, 0xf5, 0x57, 0x9a, 0x1b, 0x99, 0x20, 0x6e, 0xa7, 0xd7, 0x34, 0xec, 0x7d, 0x4f, 0x6d,
+	0x3c, 0x0f, 0x7b, 0xa9, 0x1e, 0x96, 0xa5, 0x6d, 0xcc, 0x50, 0x97, 0x77, 0xaf, 0xe9, 0xf2, 0x63,
+	0xfe, 0xd8, 0x39, 0xb4, 0xa6, 0xb4, 0xd8, 0xfa, 0xf7, 0x55, 0xb5, 0xe4, 0xb6, 0x83, 0x64, 0x2a,
+	0xbc, 0xaf, 0x25, 0xa2, 0xd6, 0x4f, 0x73, 0xe0, 0xa2, 0xe5, 0x5f, 0x2d, 0xb3, 0xfc, 0x6d, 0x7b,
+	0xbb, 0x76, 0x9d, 0xe3, 0xaf, 0xfe, 0x6a, 0x8e, 0xbf, 0x99, 0x52, 0xc7, 0xdf, 0xcb, 0xfc, 0x45,
+	0xb3, 0x3f, 0x8b, 0xbf, 0x68, 0xee, 0x1a, 0x7f, 0x51, 0xeb, 0x2f, 0x2a, 0xca, 0x2b, 0x52, 0xb1,
+	0xf7, 0x80, 0x9d, 0x1e, 0xf0, 0x53, 0x84, 0xd9, 0x17, 0x5e, 0x8d, 0x13, 0xf4, 0xae, 0xe9, 0xaf,
+	0x91, 0x25, 0xed, 0x4c, 0x24, 0x5b, 0xf1, 0x02, 0xfd, 0xbd, 0x04, 0x95, 0x73, 0x82, 0xd6, 0xaf,
+	0x73, 0x82, 0xce, 0x5c, 0xe7, 0x04, 0x9d, 0xcd, 0x3b, 0x41, 0x5b, 0xbf, 0x05, 0x8a, 0x51, 0x09,
+	0xa9, 0xfd, 0xfc, 0x26, 0x8d, 0xc4, 0xe1, 0x48, 0xa0, 0xaa, 0x10, 0x87, 0x0d, 0x6c, 0xfd, 0x86,
+	0x5a, 0x74, 0xd8, 0xeb, 0xe7, 0xd7, 0x7f, 0x5e, 0x6f, 0x64, 0xea, 0x76, 0x60, 0xad, 0xff, 0x5b,
+	0x55, 0x5e, 0x91, 0xc5, 0xff, 0x5a, 0xc7, 0x50, 0x5c, 0xa7, 0x5a, 0xc9, 0x3a, 0xfd, 0x7f, 0x3d,
+	0x7d, 0xe0, 0xf0, 0x97, 0xf4, 0x4a, 0xcb, 0xcd, 0xc5, 0x14, 0x53, 0x44, 0xa0, 0xe6, 0xec, 0x7a,
+	0xa3, 0x1b, 0x4e, 0x2a, 0x99, 0x75, 0x04, 0xe7, 0x9c, 0xd2, 0x7e, 0x4b, 0x6d, 0xc9, 0x0a, 0xed,
+	0x5f, 0x82, 0xa9, 0x7c, 0x3c, 0x39, 0xe5, 0xdc, 0x42, 0xa0, 0x7b, 0xff, 0x5f, 0xd5, 0x8c, 0xf2,
+	0x4f, 0x48, 0x51, 0x2a, 0xbe, 0x0c, 0xfa, 0xa4, 0x75, 0x84, 0xc8, 0x76, 0xe4, 0xbc, 0x9c, 0xa8,
+	0x4e, 0xd8, 0xb5, 0xbc, 0x3d, 0xb5, 0x44, 0x82, 0xb2, 0x6b, 0xbe, 0xab, 0xd2, 0x77, 0x2f, 0xf1,
+	0xde, 0x40, 0x1b, 0xb9, 0x6f, 0xbc, 0x5f, 0x05, 0x4d, 0xce, 0x31, 0x09, 0x45, 0x33, 0x29, 0xb3,
+	0x11, 0xf0, 0x73, 0xb7, 0xb2, 0xb7, 0xa3, 0x56, 0xf2, 0x36, 0xa5, 0xe4, 0xec, 0x4c, 0x69, 0xa0,
+	0x50, 0x1d, 0x96, 0x9a, 0xc3, 0x90, 0x33, 0xe4, 0x4d, 0x79, 0xc7, 0xfd, 0xcc, 0x5a, 0xa6, 0x6d,
+	0xfe, 0xcf, 0x0a, 0x4c, 0xfe, 0xba, 0x52, 0x19, 0x0c, 0xfd, 0x26, 0x4f, 0x8e, 0xf6, 0x0f, 0xdb,
+	0xbb, 0x0f, 0x77, 0x0e, 0x0f, 0xf7, 0x0f, 0x56, 0x5e, 0x03, 0xdd, 0x7d, 0x89, 0x9c, 0x80, 0x7b,
+	0x06, 0x56, 0x41, 0x98, 0xb8, 0x5b, 0x34, 0xac, 0x8a, 0x1e, 0xc2, 0x47, 0x87, 0x39, 0x68, 0xed,
+	0xde, 0xbc, 0xe1, 0x0f, 0x4c, 0xa2, 0xe5, 0xf4, 0xd9, 0x7b, 0x4c, 0x1e, 0x5a, 0x43, 0xf9, 0x47,
+	0x15, 0xb5, 0x9e, 0x43, 0x64, 0x49, 0x5d, 0xac, 0x84, 0xb8, 0x9a, 0x89, 0x0b, 0xa4, 0x50, 0x83,
+	0xd6, 0x37, 0x73, 0x12, 0xa4, 0x88, 0x40, 0x9a, 0xb7, 0xf4, 0xd3, 0x1c, 0x27, 0x95, 0xa1, 0xfc,
+	0x4d, 0x93, 0x3f, 0x93, 0x1b, 0xf8, 0x7f, 0xac, 0x70, 0x5e, 0xae, 0x8d, 0xc9, 0xe2, 0xba, 0xee,
+	0x98, 0x75, 0x11, 0x2d, 0x0d, 0x47, 0xe3, 0x71, 0x07, 0x5c, 0x8a, 0x43, 0x6b, 0x06, 0xe3, 0xd9,
+	0xe2, 0x5c, 0xd3, 0xb6, 0x09, 0x0f, 0xb9, 0x04, 0x83, 0x73, 0xcc, 0x25, 0xf9, 0x59, 0xc6, 0x4c,
+	0x19, 0xca, 0xff, 0xd3, 0x19, 0xe5, 0x7d, 0x73, 0x12, 0xc5, 0x57, 0x94, 0x1c, 0x66, 0xdc, 0xb6,
+	0x9b, 0x79, 0xa7, 0x24, 0x46, 0x6c, 0x3f, 0x8a, 0xae, 0x74, 0x76, 0x65, 0x35, 0xcb, 0xae, 0x2c,
+	0xcb, 0x70, 0xac, 0x5f, 0x9f, 0xe1, 0x38, 0x73, 0x5d, 0x86, 0x23, 0x46, 0x4e, 0x28, 0x31, 0xb1,
+	0x4b, 0xea, 0x08, 0x9e, 0xef, 0x35, 0x34, 0xea, 0x05, 0x78, 0x88, 0x30, 0xb0, 0x5b, 0x4d, 0xa5,
+	0xa8, 0x7b, 0x4e, 0xd9, 0xb4, 0xb6, 0xa0, 0xd9, 0x07, 0xd8, 0x01, 0xe8, 0x03, 0xe9, 0x28, 0x26,
+	0x8f, 0x92, 0xfe, 0x18, 0xe1, 0xe8, 0xbc, 0x59, 0x4a, 0x46, 0x13, 0x54, 0xd0, 0xf4, 0x5c, 0xd9,
+	0x85, 0xb5, 0xc0, 0xd0, 0x23, 0x9e, 0xf1, 0x36, 0xd0, 0x0d, 0xe8, 0x53, 0x83, 0x5e, 0x82, 0x7e,
+	0x22, 0xb4, 0x85, 0xd2, 0x78, 0xd4, 0x17, 0x47, 0xd6, 0x2a, 0xa0, 0x1e, 0x33, 0x66, 0x97, 0x11,
+	0x20, 0x8e, 0xcc, 0x90, 0xc6, 0x61, 0x2f, 0x4e, 0xc0, 0xda, 0xaa, 0x59, 0x33, 0xc5, 0x71, 0x1f,
+	0x01, 0xdc, 0x8c, 0x05, 0x0b, 0xc9, 0x75, 0xe9, 0x96, 0xef, 0xab, 0xf5, 0x38, 0x1c, 0x3e, 0x03,
+	0x63, 0xb1, 0x1d, 0xbd, 0x18, 0x47, 0x1d, 0xcc, 0x10, 0xeb, 0x60, 0x16, 0x11, 0xdb, 0x5f, 0x1e,
+	0x22, 0xef, 0x5d, 0xed, 0x0b, 0x6a, 0x17, 0x30, 0x20, 0x5b, 0xb2, 0x0c, 0xcd, 0x45, 0x1a, 0x82,
+	0x0e, 0x33, 0x14, 0xf7, 0xbb, 0x3c, 0x51, 0x13, 0xf3, 0xc1, 0xfa, 0x21, 0xba, 0xd1, 0x46, 0x63,
+	0x6d, 0x72, 0x2f, 0x73, 0x3e, 0x18, 0x82, 0x1f, 0x8e, 0xc6, 0x92, 0x0b, 0xf8, 0xba, 0x9a, 0xa7,
+	0x50, 0xc7, 0x38, 0x8e, 0xce, 0x28, 0x92, 0x58, 0x09, 0x1a, 0x08, 0x38, 0x82, 0x32, 0x5e, 0x69,
+	0x20, 0x1b, 0x52, 0x34, 0x46, 0xa3, 0x28, 0x2e, 0x09, 0x15, 0xf7, 0xb4, 0x2e, 0xb5, 0x2b, 0x98,
+	0x9f, 0x25, 0x3f, 0x54, 0xd2, 0x1a, 0xb7, 0x55, 0x43, 0xaf, 0x33, 0xba, 0x20, 0xce, 0xe2, 0xd1,
+	0x40, 0xbb, 0x20, 0xf0, 0xb7, 0xb7, 0xa4, 0xaa, 0xe9, 0x48, 0x3e, 0x86, 0x5f, 0xfe, 0xb7, 0x55,
+	0xd3, 0x22, 0x15, 0xc9, 0x6d, 0xa4, 0xe1, 0x8a, 0xef, 0xa2, 0xce, 0xf6, 0x24, 0x40, 0x1e, 0x75,
+	0xf1, 0xca, 0x45, 0xb7, 0x07, 0x87, 0x1e, 0x29, 0x63, 0x71, 0x84, 0x1e, 0x44, 0xed, 0xe9, 0x59,
+	0x31, 0x88, 0x80, 0xe1, 0xfe, 0x1f, 0x80, 0x0e, 0xe5, 0x2c, 0xb8, 0x11, 0x71, 0xb3, 0x94, 0x88,
+	0xa9, 0xbd, 0xcd, 0x6e, 0x92, 0xa6, 0xe0, 0x50, 0x39, 0x10, 0x2f, 0x15, 0x2c, 0xee, 0xe8, 0x94,
+	0x7a, 0x01, 0x32, 0xb6, 0x61, 0xe8, 0xb9, 0x74, 0x68, 0xc2, 0xb8, 0x04, 0x58, 0x4e, 0x94, 0x23,
+	0xfd, 0xff, 0x51, 0x55, 0x35, 0xd8, 0x41, 0x3b, 0x6a, 0x57, 0x71, 0xa3, 0x76, 0xa2, 0xfb, 0x67,
+	0x3b, 0x26, 0xca, 0x99, 0x03, 0xf4, 0x6e, 0xc3, 0x09, 0x38, 0x48, 0xd1, 0x57, 0x09, 0xb6, 0xce,
+	0xf3, 0x30, 0xe6, 0x3c, 0xcf, 0x1a, 0xb1, 0x5b, 0x0e, 0x03, 0xdb, 0x56, 0x33, 0xaa, 0x2a, 0x55,
+	0xc0, 0x22, 0x1a, 0xda, 0x94, 0xd5, 0x70, 0x25, 0x4e, 0x68, 0x29, 0x61, 0x56, 0x98, 0xfb, 0xbd,
+	0x99, 0x18, 0xeb, 0x1d, 0x53, 0xb0, 0xa8, 0xf7, 0xa2, 0x98, 0x19, 0x38, 0x9a, 0xbd, 0x0d, 0xb2,
+	0x43, 0x2e, 0x0d, 0x37, 0xe4, 0x02, 0xdf, 0x02, 0xbf, 0x00, 0xe3, 0x5e, 0xf5, 0x47, 0x61, 0x57,
+	0x98, 0xdc, 0x06, 0xa1, 0x3f, 0x45, 0xcf, 0xbd, 0x3d, 0x19, 0x3e, 0x1b, 0x8e, 0x9e, 0x0f, 0xd9,
+	0x65, 0x1d, 0x14, 0xe0, 0xfe, 0x5f, 0x55, 0xd4, 0x0c, 0xed, 0x27, 0xaa, 0x66, 0x7c, 0x76, 0x99,
+	0x90, 0x20, 0xad, 0x36, 0xa8, 0x66, 0x39, 0x30, 0xec, 0xb8, 0x7d, 0x39, 0xa0, 0x6a, 0x96, 0xca,
+	0xbe, 0x20, 0x70, 0x0b, 0xf8, 0x8d, 0xd3, 0x04, 0x74, 0xa2, 0x3b, 0x55, 0xc9, 0x80, 0xa0, 0xd9,
+	0xd7, 0x81, 0x69, 0xb5, 0x05, 0xab, 0x74, 0x16, 0xc0, 0x68, 0x1c, 0x10, 0x1c, 0x0f, 0x96, 0xac,
+	0x3d, 0xb3, 0x54, 0x6c, 0x22, 0x94, 0x60, 0xf0, 0xa8, 0x35, 0x8d, 0xe7, 0xb6, 0xa1, 0x88, 0xf0,
+	0x9f, 0xaa, 0x65, 0x64, 0x3f, 0x2b, 0x4c, 0x32, 0xfd, 0x40, 0xf9, 0x05, 0x54, 0x81, 0x3a, 0xfd,
+	0x49, 0x37, 0xb2, 0x7d, 0x0a, 0xe4, 0x06, 0x17, 0xb8, 0xd6, 0xa4, 0xfd, 0x7f, 0x51, 0x61, 0xb6,
+	0xc6, 0x76, 0x61, 0x45, 0xeb, 0x78, 0x2c, 0xe4, 0x5c, 0x48, 0x26, 0x49, 0x08, 0xeb, 0x05, 0x54,
+	0x03, 0x79, 0x88, 0x1c, 0xd5, 0x76, 0xeb, 0xec, 0xa6, 0xce, 0x0c, 0x72, 0xb0, 0x3f, 0x79, 0x1a,
+	0x39, 0x3b, 0x36, 0x07, 0x85, 0x75, 0x6b, 0xe4, 0xbc, 0x03, 0x5e, 0x4e, 0xe3, 0x02, 0x31, 0x62,
+	0x45, 0xf9, 0xfe, 0xa4, 0xa2, 0x16, 0x9d, 0x31, 0x21, 0x85, 0x91, 0x4c, 0x65, 0x8f, 0x94, 0x50,
+	0x81, 0x0d, 0xb2, 0xa9, 0xb3, 0xea, 0x52, 0xa7, 0x89, 0x16, 0xd5, 0xec, 0x68, 0xd1, 0x17, 0xd5,
+	0x7c, 0x76, 0x53, 0xc4, 0x1d, 0x14, 0xf6, 0xa8, 0xd3, 0xa5, 0xb2, 0x4a, 0x59, 0x3c, 0x62, 0xc6,
+	0x8a, 0x47, 0xf8, 0x1f, 0xaa, 0xa6, 0x55, 0xdf, 0x8e, 0x27, 0x54, 0x9c, 0x78, 0x82, 0xc9, 0x27,
+	0xac, 0x66, 0xf9, 0x84, 0xfe, 0x8f, 0xaa, 0x6a, 0x11, 0x49, 0x1d, 0xa6, 0x79, 0x34, 0xea, 0xf7,
+	0x3a, 0x57, 0x44, 0xf2, 0x9a, 0xaa, 0x45, 0x2d, 0xd0, 0x24, 0xef, 0x82, 0xd1, 0x7d, 0x60, 0x12,
+	0xaa, 0x59, 0xc6, 0x98, 0x32, 0xb2, 0x1b, 0x72, 0xee, 0x69, 0x98, 0x44, 0x39, 0xb9, 0x56, 0x80,
+	0x4b, 0x1a, 0x69, 0x9b, 0x32, 0x45, 0x07, 0xbd, 0x7e, 0xbf, 0x67, 0xbe, 0xa8, 0x9b, 0x34, 0xd2,
+	0x12, 0x2c, 0xf6, 0xdf, 0xed, 0x25, 0xe1, 0x69, 0x16, 0x1d, 0x36, 0x65, 0x72, 0xb5, 0x82, 0xda,
+	0xe4, 0xb8, 0x5a, 0x67, 0x4d, 0x06, 0xb9, 0xeb, 0x6a, 0xcd, 0x6d, 0xed, 0x5c, 0x61, 0x6b, 0xfd,
+	0x1f, 0x57, 0x55, 0xd3, 0x22, 0x14, 0x49, 0x8c, 0x70, 0x0f, 0x1a, 0x0b, 0xa2, 0xf1, 0x8e, 0xef,
+	0xc5, 0x82, 0x80, 0x88, 0x76, 0x7a, 0xa4, 0x00, 0x0c, 0x89, 0x02, 0x87, 0xa0, 0x30, 0xd0, 0x07,
+	0x1b, 0xfb, 0x3e, 0x39, 0x7a, 0xe4, 0xd2, 0x96, 0x01, 0x68, 0xec, 0x5d, 0xc2, 0xce, 0x64, 0x58,
+	0x02, 0xbc, 0x34, 0x95, 0xe2, 0x6b, 0xc0, 0x58, 0xdc, 0x0c, 0xed, 0x38, 0x4d, 0x38, 0x63, 0x45,
+	0x87, 0x1a, 0x02, 0xa7, 0xa6, 0xfe, 0xf2, 0xae, 0xfe, 0xb2, 0x71, 0xdd, 0x97, 0xba, 0xa6, 0xff,
+	0xc0, 0x64, 0xa8, 0x3c, 0xc0, 0xd0, 0x98, 0x16, 0x2f, 0xa0, 0xf8, 0x6a, 0x29, 0x32, 0x19, 0xe2,
+	0x2d, 0xd3, 0x09, 0x46, 0xd0, 0xc4, 0xa7, 0x5b, 0x86, 0xf2, 0xbb, 0x26, 0x85, 0x9d, 0x1a, 0x82,
+	0x8d, 0x9e, 0x61, 0x35, 0x93, 0x8f, 0xe3, 0x72, 0x81, 0xc2, 0x55, 0x80, 0xb4, 0x67, 0x58, 0xdb,
+	0xac, 0x4e, 0x15, 0x01, 0x5c, 0xc1, 0xbf, 0xad, 0x96, 0x29, 0x67, 0xde, 0x95, 0x84, 0xee, 0x81,
+	0x8b, 0x51, 0x42, 0xcc, 0xaa, 0xbf, 0x81, 0x89, 0xa2, 0xc4, 0x61, 0x76, 0x7c, 0xf9, 0xcf, 0x6a,
+	0xc0, 0x96, 0x19, 0x18, 0x25, 0x15, 0x05, 0x05, 0xdb, 0xdd, 0x5e, 0x38, 0x88, 0xd2, 0x28, 0x16,
+	0xae, 0xca, 0x41, 0xb1, 0x5e, 0x78, 0x79, 0x8e, 0xfa, 0x3e, 0x70, 0xd9, 0x79, 0x1c, 0x45, 0xa2,
+	0x3b, 0xe4, 0xa0, 0x58, 0x4f, 0xec, 0x02, 0x5d, 0x8f, 0xc3, 0x78, 0x39, 0xa8, 0x8e, 0x16, 0xf3,
+	0x1a, 0xd5, 0xb3, 0x68, 0x31, 0xaf, 0x48, 0x5e, 0xc6, 0xce, 0x94, 0xc8, 0x58, 0x60, 0x4f, 0x96,
+	0xa6, 0x22, 0x47, 0xda, 0x39, 0xc2, 0x9a, 0x82, 0x45, 0x16, 0xc4, 0x31, 0x6b, 0xb6, 0x20, 0x67,
+	0xd6, 0x1c, 0xcd, 0xa5, 0x00, 0xd7, 0x91, 0x11, 0xa7, 0x6e, 0x23, 0x8b, 0x8c, 0x14, 0xea, 0x62,
+	0xb2, 0xb0, 0x5d, 0x57, 0x47, 0x51, 0x72, 0x70, 0x20, 0xd8, 0x4d, 0xb0, 0x0c, 0x7b, 0xa1, 0xdb,
+	0x44, 0x3b, 0x09, 0x53, 0xc9, 0x1d, 0x9c, 0x86, 0xc6, 0x5e, 0x70, 0x15, 0x7e, 0x30, 0x1a, 0x9c,
+	0xf6, 0xf8, 0x88, 0xe3, 0xd0, 0x0a, 0x08, 0x90, 0x3c, 0xdc, 0x5f, 0x54, 0xcd, 0xe3, 0x14, 0xce,
+	0x68, 0xd9, 0xfa, 0x25, 0xb5, 0xc0, 0x45, 0x49, 0x39, 0x7d, 0x5d, 0xdd, 0x24, 0x5a, 0x3d, 0x19,
+	0x01, 0x33, 0x8c, 0xce, 0xaf, 0x1c, 0xe7, 0xc8, 0x7f, 0x02, 0x3d, 0xd3, 0xc1, 0x66, 0xde, 0x11,
+	0xf2, 0xe6, 0xea, 0xdc, 0x41, 0x26, 0xef, 0x55, 0xeb, 0x80, 0xe0, 0x8a, 0x1c, 0x46, 0x7b, 0x2a,
+	0xe9, 0x84, 0x3b, 0xd9, 0x65, 0x18, 0xfd, 0x21, 0xd3, 0xfa, 0x56, 0x91, 0xd6, 0xe5, 0x7b, 0x7d,
+	0x4d, 0x46, 0x37, 0xf1, 0xab, 0x92, 0x68, 0xd5, 0x95, 0x49, 0xd7, 0xdc, 0xe4, 0x18, 0xdb, 0x99,
+	0xa6, 0x47, 0xd0, 0x31, 0xc0, 0x04, 0xef, 0x98, 0xa8, 0x6c, 0x74, 0x94, 0x9e, 0x63, 0x0e, 0x39,
+	0xbe, 0xa7, 0x6d, 0x1d, 0x68, 0x9f, 0x51, 0x0b, 0x26, 0xb3, 0x22, 0x3b, 0x37, 0x9b, 0x1a, 0x86,
+	0x7a, 0xc6, 0xe7, 0xd4, 0xf2, 0x79, 0x7f, 0x74, 0x4a, 0x8a, 0x0d, 0xe5, 0x30, 0x27, 0x92, 0x78,
+	0xbb, 0xc4, 0xe0, 0xfb, 0x02, 0xcd, 0x0e, 0xd9, 0xba, 0x7d, 0xc8, 0x96, 0x1f, 0x99, 0xbf, 0x5d,
+	0x35, 0xe1, 0xed, 0x6c, 0x25, 0xa6, 0x72, 0xb8, 0x77, 0xb7, 0x20, 0xce, 0xa7, 0x44, 0x93, 0xc9,
+	0xd8, 0x38, 0xba, 0xd6, 0xb7, 0xfe, 0xa1, 0x5a, 0x8a, 0x59, 0x56, 0x6a, 0x41, 0x5a, 0x7f, 0x89,
+	0x20, 0x5d, 0x8c, 0x9d, 0xf3, 0x19, 0x14, 0xaf, 0xb0, 0x0b, 0x36, 0x4a, 0xda, 0x23, 0x3f, 0x23,
+	0x29, 0x53, 0x3c, 0xb9, 0x65, 0x0b, 0x4e, 0x3a, 0x0b, 0x5e, 0x8d, 0xe2, 0x14, 0x68, 0x53, 0x53,
+	0x6e, 0x3b, 0x66, 0x60, 0xac, 0xe8, 0xff, 0x48, 0x47, 0xd2, 0xdd, 0x9d, 0x9d, 0xbe, 0x22, 0xf6,
+	0xec, 0xaa, 0xb9, 0xd9, 0x7d, 0x56, 0x22, 0xda, 0x5d, 0xed, 0xcc, 0xac, 0x59, 0xa9, 0x7a, 0x5d,
+	0xc9, 0x42, 0x70, 0x97, 0xb4, 0xfe, 0x2a, 0x4b, 0xea, 0xff, 0xd7, 0x8a, 0x9a, 0x03, 0x55, 0xf8,
+	0xa1, 0x24, 0x2d, 0x12, 0x7b, 0x98, 0xbb, 0x07, 0xba, 0xf8, 0x92, 0x74, 0xc6, 0x69, 0x3a, 0xc9,
+	0x62, 0x89, 0x4e, 0xf2, 0xb7, 0xd4, 0xeb, 0xe4, 0x50, 0x8f, 0x81, 0x2b, 0x63, 0x64, 0x54, 0x20,
+	0x40, 0xd2, 0x3e, 0x46, 0xc3, 0xf4, 0x42, 0x0b, 0xd2, 0x97, 0x55, 0x21, 0x2f, 0x17, 0x7a, 0x06,
+	0xd8, 0x2a, 0x12, 0x4d, 0x8a, 0xe5, 0x6b, 0x11, 0xe1, 0xff, 0xb2, 0x9a, 0x27, 0x8b, 0x83, 0x26,
+	0xf7, 0x79, 0x35, 0x8f, 0xa6, 0xfb, 0x05, 0xfc, 0xd6, 0x8c, 0xbf, 0x94, 0x99, 0x02, 0x0f, 0x69,
+	0x59, 0x4c, 0x05, 0xff, 0x37, 0xe7, 0xd4, 0xdc, 0xa3, 0xe1, 0xe5, 0xa8, 0xd7, 0xa1, 0xb8, 0xfd,
+	0x20, 0x1a, 0x8c, 0xf4, 0x7d, 0x0c, 0xfc, 0x8d, 0x39, 0x3a, 0x94, 0x90, 0x3c, 0x66, 0xd2, 0x5d,
+	0xe0, 0x1c, 0x1d, 0x01, 0xd1, 0x65, 0xe4, 0xec, 0x6e, 0x25, 0xb3, 0x96, 0x05, 0x41, 0x2b, 0x2f,
+	0xb6, 0xef, 0x46, 0x4a, 0x29, 0x33, 0xe5, 0x67, 0xac, 0x3b, 0x2f, 0xd8, 0x97, 0xa4, 0x5a, 0x72,
+	0x2e, 0x1e, 0xf7, 0x25, 0x20, 0xb2, 0x4c, 0xe3, 0x88, 0x83, 0x21, 0x46, 0xd5, 0x42, 0xcb, 0xd4,
+	0x06, 0xa2, 0x3a, 0xc6, 0x1f, 0x70, 0x1d, 0x3e, 0x06, 0x6c, 0x10, 0xaa, 0xa8, 0xf9, 0x5b, 0xbc,
+	0x7c, 0x8b, 0x3a, 0x0f, 0xc6, 0x2d, 0x87, 0x63, 0x4e, 0x0b, 0x5b, 0x9e, 0x87, 0xe2, 0xfb, 0xa3,
+	0x79, 0xb8, 0x65, 0xcf, 0x72, 0xbe, 0xb8, 0xb6, 0x67, 0x61, 0xd4, 0x67, 0x61, 0xbf, 0x8f, 0x6f,
+	0x11, 0xd0, 0x25, 0x6e, 0xf2, 0xdd, 0xcc, 0x07, 0x2e, 0x90, 0xa2, 0x36, 0xd9, 0xae, 0x52, 0x36,
+	0x53, 0x3d, 0xb0, 0x41, 0x40, 0xf2, 0x4d, 0xf2, 0x0e, 0xc8, 0xbe, 0x2e, 0xd1, 0xbe, 0xae, 0xd8,
+	0xee, 0x03, 0xda, 0x59, 0xbb, 0x92, 0x9d, 0x4b, 0xb0, 0x5c, 0xc8, 0xe6, 0x86, 0x7e, 0x25, 0x15,
+	0x63, 0x85, 0x5d, 0x1d, 0x06, 0x40, 0xfe, 0x07, 0x5e, 0x30, 0xae, 0xb0, 0x4a, 0x15, 0x1c, 0x18,
+	0xec, 0x7c, 0x03, 0x8d, 0xbf, 0x71, 0x08, 0x9c, 0xe2, 0x19, 0x63, 0xd4, 0xc0, 0x48, 0x13, 0x91,
+	0xdf, 0xc2, 0x2c, 0x6b, 0x6c, 0x5b, 0xb9, 0x50, 0x3a, 0xe7, 0x35, 0x64, 0xe0, 0xe4, 0x80, 0x17,
+	0xe0, 0xde, 0xfb, 0x14, 0x0c, 0x87, 0xd9, 0xac, 0x93, 0xdb, 0xfb, 0x75, 0x99, 0xbd, 0x90, 0xaf,
+	0xfe, 0x1f, 0x73, 0x0f, 0xa2, 0x80, 0x6b, 0xa2, 0xd2, 0xc6, 0xb1, 0x88, 0x0d, 0x47, 0x69, 0x93,
+	0xaa, 0x14, 0x8b, 0xe0, 0x0a, 0xde, 0x2f, 0xa9, 0x0d, 0xeb, 0xc6, 0x74, 0xe6, 0x62, 0x4d, 0xb7,
+	0xfe, 0x6c, 0x8e, 0x16, 0x6f, 0x0a, 0xda, 0xdf, 0x51, 0x0b, 0x76, 0xcf, 0x5e, 0x43, 0xd5, 0xd1,
+	0xa7, 0xbe, 0xf2, 0x9a, 0xd7, 0x54, 0x73, 0xc7, 0xfb, 0x27, 0x27, 0x98, 0x3c, 0x5b, 0xf1, 0x16,
+	0x54, 0xc3, 0xa4, 0xd2, 0x56, 0xb1, 0xb4, 0xb3, 0xbb, 0xbb, 0x7f, 0x74, 0x02, 0xa5, 0x9a, 0xff,
+	0x4f, 0xc1, 0x42, 0xb0, 0x86, 0xf4, 0x12, 0xf7, 0x0c, 0x30, 0x1c, 0x59, 0x1f, 0x59, 0x12, 0x0d,
+	0xd8, 0x0e, 0x19, 0x04, 0x09, 0xc9, 0x36, 0xd6, 0x6b, 0x4c, 0x48, 0x16, 0x08, 0x09, 0x92, 0x2f,
+	0x7e, 0xda, 0xd1, 0xa2, 0x99, 0xc0, 0x05, 0x52, 0x3b, 0x0c, 0xa0, 0x9c, 0x4e, 0x09, 0x23, 0x5a,
+	0x20, 0x24, 0x12, 0x38, 0x38, 0x47, 0xfd, 0xcb, 0x88, 0xab, 0xb0, 0x3a, 0xe7, 0xc0, 0xb0, 0x2f,
+	0x91, 0x53, 0x56, 0xde, 0x35, 0xf4, 0xe5, 0x00, 0xbd, 0x2f, 0xe8, 0x6d, 0x6d, 0xd0, 0xb6, 0x6e,
+	0x16, 0xf7, 0xc8, 0xde, 0x52, 0x3f, 0x55, 0x1e, 0x58, 0xab, 0x82, 0xb5, 0x6f, 0xb7, 0xc6, 0xf6,
+	0x55, 0x6a, 0x2d, 0x69, 0x4a, 0xb8, 0xbd, 0x5a, 0xce, 0xed, 0x2f, 0xe5, 0x09, 0x7f, 0x5f, 0x35,
+	0x8f, 0xac, 0xcb, 0xd9, 0x24, 0xf8, 0xf4, 0xb5, 0x6c, 0x11, 0x98, 0x16, 0xc4, 0x1a, 0x4e, 0xd5,
+	0x1e, 0x8e, 0xff, 0x4f, 0x2a, 0x7c, 0xbf, 0xcd, 0x0c, 0x9f, 0xfb, 0xc6, 0x9b, 0xe4, 0x3a, 0x14,
+	0x90, 0x5d, 0x29, 0x70, 0x60, 0x58, 0x87, 0x86, 0xd2, 0x1e, 0x9d, 0x9d, 0x01, 0x2b, 0x4a, 0x02,
+	0xb0, 0x03, 0xd3, 0x7a, 0x27, 0x93, 0x28, 0xf5, 0x90, 0x48, 0x22, 0x70, 0x01, 0x8e, 0xa7, 0xb0,
+	0xb8, 0x31, 0x75, 0xea, 0xb3, 0x29, 0x9b, 0x9b, 0x0f, 0xf9, 0x55, 0xbe, 0x8d, 0xa9, 0x33, 0xd2,
+	0xae, 0x7b, 0xb4, 0xe8, 0x9a, 0x06, 0x8f, 0x47, 0x18, 0xd9, 0xa3, 0xce, 0xa0, 0x99, 0x62, 0x8b,
+	0x08, 0xf4, 0x4d, 0x9d, 0xf5, 0xe2, 0x7c, 0x75, 0xa6, 0xdf, 0x12, 0x8c, 0xff, 0xb1, 0x5a, 0xd3,
+	0x5c, 0x67, 0x29, 0xc4, 0xee, 0x26, 0x56, 0xae, 0x13, 0x6c, 0xd5, 0xa2, 0x60, 0xf3, 0xff, 0xb2,
+	0xa6, 0xe6, 0x64, 0xa7, 0x0b, 0x17, 0xfc, 0x79, 0x9f, 0x1d, 0x18, 0xf0, 0xaa, 0x7d, 0x7d, 0x93,
+	0xa4, 0xa0, 0x1c, 0x67, 0x85, 0x03, 0xab, 0x56, 0x76, 0x60, 0xe1, 0x55, 0xb6, 0x30, 0xbd, 0x20,
+	0x1f, 0x0e, 0x1c, 0xba, 0xf8, 0x5b, 0xbb, 0x4c, 0x67, 0x5c, 0x97, 0x69, 0xd9, 0x73, 0x06, 0xac,
+	0x91, 0x15, 0x9f, 0x33, 0x00, 0xfe, 0xe5, 0x2b, 0xf0, 0x8e, 0x3b, 0xd4, 0x02, 0xe1, 0xe8, 0xb8,
+	0xa8, 0x65, 0x05, 0x1f, 0x95, 0x2e, 0xf0, 0x53, 0x1c, 0x96, 0x5f, 0x56, 0xb3, 0x7c, 0xc9, 0x47,
+	0x52, 0xbc, 0xdf, 0xd0, 0x61, 0x5f, 0xae, 0xa7, 0xff, 0xe7, 0xcc, 0xb0, 0x40, 0xea, 0xba, 0x57,
+	0x84, 0x9b, 0xf9, 0x2b, 0xc2, 0x39, 0xa7, 0xee, 0x42, 0xc1, 0xa9, 0xeb, 0xdf, 0x57, 0x8b, 0x4e,
+	0xc3, 0x28, 0x73, 0x25, 0x59, 0x1c, 0x04, 0xf0, 0xa2, 0x9a, 0x7f, 0x74, 0xd8, 0xbe, 0x7f, 0xf0,
+	0xe8, 0xc1, 0xc3, 0x13, 0x10, 0xc1, 0x50, 0x3c, 0x7e, 0x0a, 0x52, 0x77, 0x7f, 0x8f, 0x64, 0xb0,
+	0x52, 0xb3, 0xf7, 0x77, 0x1e, 0x1d, 0x90, 0x04, 0xde, 0x63, 0x7a, 0x97, 0xb6, 0x4c, 0x44, 0xec,
+	0x0b, 0xca, 0xd3, 0x6e, 0x04, 0x4a, 0x11, 0x1b, 0xf7, 0xa3, 0x54, 0xdf, 0x67, 0x58, 0x15, 0xcc,
+	0x23, 0x83, 0xd0, 0xd7, 0x71, 0xb2, 0x56, 0x32, 0xb6, 0x91, 0xe5, 0xca, 0xb3, 0x8d, 0x54, 0x0d,
+	0x0c, 0x1e, 0x63, 0xe1, 0x7b, 0x11, 0xb6, 0xb6, 0xd3, 0xef, 0xe7, 0x86, 0x83, 0xb6, 0x60, 0x09,
+	0x4e, 0x0c, 0xc5, 0x6f, 0xaa, 0xf5, 0x1d, 0xbe, 0xba, 0xf0, 0xf3, 0xca, 0x68, 0xc5, 0x3c, 0xb3,
+	0x7c, 0x93, 0xd2, 0xd9, 0x7d, 0xb5, 0xba, 0x17, 0x9d, 0x4e, 0xce, 0x0f, 0x40, 0x62, 0xf4, 0xad,
+	0x2b, 0xc7, 0xc9, 0xc5, 0xe8, 0xb9, 0xac, 0x0f, 0xfd, 0xc6, 0xa8, 0x4a, 0x1f, 0xeb, 0xb4, 0x93,
+	0x71, 0xd4, 0xd1, 0x57, 0x4a, 0x09, 0x72, 0x0c, 0x00, 0xff, 0xab, 0xca, 0xb3, 0xdb, 0x91, 0xf5,
+	0x42, 0x25, 0x6e, 0x72, 0xda, 0x4e, 0xae, 0x92, 0x34, 0x1a, 0xe8, 0xbb, 0xb2, 0x36, 0xc8, 0xff,
+	0x9c, 0x5a, 0x80, 0x05, 0x80, 0x8e, 0xe5, 0xf1, 0x0b, 0xf4, 0x34, 0x87, 0x57, 0x48, 0x8c, 0xc6,
+	0xd3, 0x4c, 0x68, 0xff, 0xcf, 0xab, 0x6a, 0x96, 0x6b, 0x62, 0xab, 0x18, 0xe3, 0xea, 0x0d, 0x89,
+	0xfb, 0x74, 0xab, 0x16, 0xa8, 0xc0, 0xef, 0xd5, 0x12, 0x7e, 0x17, 0x97, 0x88, 0xed, 0x94, 0xcc,
+	0x00, 0x88, 0xcd, 0x92, 0xd2, 0xd9, 0x01, 0x99, 0x01, 0x72, 0x41, 0x8f, 0x4c, 0x49, 0xe4, 0x91,
+	0x69, 0x21, 0x26, 0x4c, 0x6d, 0x83, 0x4a, 0x55, 0xd1, 0x39, 0xe6, 0xfd, 0x82, 0x2a, 0x5a, 0x50,
+	0x39, 0x1b, 0xaf, 0xa0, 0x72, 0xea, 0xdb, 0x92, 0xd3, 0x55, 0x4e, 0xf5, 0x0a, 0x2a, 0x27, 0x5e,
+	0xbb, 0xa0, 0x17, 0x00, 0xd0, 0xa8, 0xd1, 0x54, 0x0b, 0x87, 0xc9, 0x8a, 0xd0, 0x8f, 0xc1, 0x81,
+	0xf1, 0x6e, 0x9b, 0x70, 0xa5, 0x57, 0xcb, 0x60, 0xce, 0x64, 0x55, 0xd9, 0x22, 0x80, 0xcd, 0xc5,
+	0x02, 0x5c, 0x4b, 0x0a, 0x4c, 0x61, 0x02, 0x2b, 0x4a, 0xf6, 0xc5, 0x06, 0xe1, 0x71, 0xa7, 0x3d,
+	0xc1, 0xb4, 0x31, 0x95, 0xc0, 0x94, 0xfd, 0x7f, 0x57, 0x51, 0xab, 0xd6, 0xb0, 0x85, 0x0a, 0x3f,
+	0x54, 0x0b, 0xe6, 0xb9, 0x8d, 0xc8, 0x1c, 0x78, 0x9b, 0x2e, 0xdb, 0x64, 0x9f, 0x39, 0x95, 0x69,
+	0x4b, 0x81, 0x20, 0xb1, 0x8b, 0x64, 0x32, 0x90, 0x93, 0xc6, 0x06, 0x21, 0xb1, 0x3d, 0x8f, 0xa2,
+	0x67, 0xa6, 0x0a, 0x9f, 0x75, 0x0e, 0x0c, 0xb7, 0x72, 0x80, 0x06, 0xa1, 0xa9, 0xc4, 0x87, 0xbe,
+	0x0b, 0x44, 0x87, 0xc4, 0x1a, 0xdb, 0xf7, 0xe2, 0x53, 0x31, 0x37, 0x9c, 0x67, 0xd9, 0xcd, 0xc1,
+	0x1c, 0xf9, 0xf0, 0xb5, 0x40, 0xca, 0xde, 0x57, 0x5e, 0xd1, 0x27, 0x61, 0x72, 0xbe, 0xa7, 0xef,
+	0x48, 0x6d, 0xca, 0x8e, 0xbc, 0x64, 0xbd, 0xcb, 0xa2, 0x04, 0x33, 0xe5, 0x51, 0x82, 0x4f, 0xe1,
+	0x89, 0xc7, 0x17, 0xa5, 0x92, 0xce, 0x68, 0x1c, 0x61, 0xea, 0x89, 0xbb, 0x1c, 0x22, 0xb4, 0xfe,
+	0xb8, 0xa2, 0xb6, 0xee, 0x73, 0xdc, 0x10, 0x13, 0x91, 0x40, 0x52, 0x8f, 0x62, 0xf3, 0x84, 0x04,
+	0x68, 0x74, 0xc0, 0xa4, 0xb1, 0x28, 0xbc, 0xe2, 0x95, 0xcf, 0x20, 0x38, 0x1f, 0xcc, 0x76, 0x26,
+	0x2c, 0xef, 0xa6, 0x29, 0x17, 0x54, 0x33, 0xf1, 0x59, 0x38, 0x0a, 0xce, 0xbb, 0x7c, 0x73, 0x02,
+	0x47, 0x1d, 0x5d, 0xd2, 0x49, 0xc0, 0x6e, 0x80, 0x1c, 0xd4, 0xff, 0x2f, 0x15, 0xb5, 0x9c, 0x0d,
+	0x92, 0x72, 0x79, 0x5c, 0xa9, 0x22, 0x5a, 0x4d, 0x26, 0x55, 0x74, 0xbc, 0xa0, 0x87, 0x6a, 0x8e,
+	0xb6, 0x09, 0x32, 0x08, 0x71, 0xba, 0x94, 0x80, 0x53, 0x85, 0x84, 0x6c, 0x10, 0x67, 0x3d, 0xa3,
+	0x82, 0x25, 0xca, 0xa2, 0x94, 0xe8, 0x2e, 0x1a, 0xfc, 0xc2, 0xaf, 0x78, 0xd1, 0x75, 0x11, 0xa3,
+	0xf3, 0xa8, 0xa1, 0xf0, 0xb3, 0x3a, 0x35, 0x49, 0x3c, 0xb4, 0xc9, 0x82, 0x5f, 0xd1, 0x71, 0xce,
+	0xea, 0xdf, 0xa9, 0xa8, 0x9b, 0x25, 0xcb, 0x2f, 0xdc, 0xb6, 0xa7, 0x56, 0xcf, 0x0c, 0x52, 0x2f,
+	0x11, 0xb3, 0xdc, 0x86, 0xce, 0x17, 0x71, 0x97, 0x25, 0x28, 0x7e, 0x60, 0x94, 0x4e, 0x5e, 0x74,
+	0xe7, 0xae, 0x41, 0x11, 0xe1, 0x1f, 0xa9, 0xd6, 0xfe, 0x0b, 0x64, 0xde, 0x5d, 0xfb, 0x01, 0x40,
+	0x4d, 0x11, 0x77, 0x0b, 0x22, 0xea, 0x7a, 0x2f, 0xd3, 0x99, 0x5a, 0x74, 0xda, 0xf2, 0xbe, 0xf4,
+	0xaa, 0x8d, 0xd8, 0x7c, 0xa6, 0x77, 0x8c, 0x5f, 0x30, 0xd4, 0x37, 0x1e, 0x2c, 0x90, 0x7f, 0xa9,
+	0x96, 0x1f, 0x4f, 0xfa, 0x69, 0x2f, 0x7b, 0xcd, 0x10, 0x78, 0xba, 0x99, 0x35, 0xa1, 0x97, 0xae,
+	0xb4, 0x2b, 0xbb, 0x1e, 0xae, 0xd8, 0x00, 0x5b, 0x6a, 0x17, 0x7b, 0x2c, 0x22, 0xfc, 0x9b, 0x6a,
+	0x33, 0xeb, 0x92, 0xd7, 0x4e, 0x8b, 0xf9, 0x1f, 0x55, 0x38, 0x51, 0xcf, 0x7d, 0x5c, 0xd1, 0x7b,
+	0xa0, 0xd6, 0xd0, 0xa5, 0xd8, 0x8f, 0xec, 0x76, 0x12, 0x59, 0x89, 0x75, 0x77, 0x78, 0xf2, 0x00,
+	0x63, 0x50, 0xf6, 0x05, 0x12, 0x48, 0xf9, 0x40, 0x33, 0x02, 0xc9, 0x2d, 0x49, 0xd9, 0x04, 0xbe,
+	0xa1, 0x96, 0xdc, 0xce, 0x30, 0x2c, 0x95, 0x1b, 0x99, 0x1d, 0x0a, 0x72, 0x29, 0xc3, 0xa9, 0x89,
+	0x6f, 0x7b, 0x6d, 0x01, 0xfd, 0x02, 0x19, 0x47, 0x56, 0xa7, 0x42, 0x3d, 0x1f, 0x16, 0x9a, 0x9d,
+	0x3e, 0x61, 0x73, 0xed, 0x41, 0xcf, 0x75, 0x7b, 0xea, 0xa6, 0x40, 0xd5, 0x22, 0x0a, 0x2f, 0x3b,
+	0xc8, 0xfc, 0x36, 0xd5, 0xba, 0x0c, 0x49, 0x0f, 0x27, 0x8b, 0x23, 0x38, 0x9d, 0x3a, 0x71, 0x04,
+	0x50, 0x3a, 0xf9, 0x65, 0x0f, 0x7b, 0x1e, 0xfc, 0xe1, 0xed, 0x17, 0xaa, 0x69, 0xbd, 0x6f, 0x02,
+	0x9a, 0xd6, 0xda, 0xc7, 0x8f, 0x4e, 0x0e, 0xf7, 0x8f, 0x8f, 0xdb, 0x47, 0x4f, 0xef, 0x7d, 0xb4,
+	0xff, 0xed, 0xf6, 0xc3, 0x9d, 0xe3, 0x87, 0xa0, 0x6c, 0x6f, 0x28, 0x0f, 0xa0, 0x27, 0xfb, 0x7b,
+	0x0e, 0xbc, 0x82, 0x97, 0x35, 0x6d, 0x40, 0x15, 0x01, 0xc7, 0xbb, 0xc1, 0xa3, 0xa3, 0x13, 0x06,
+	0xd4, 0xf0, 0xcb, 0xa7, 0x87, 0x4f, 0x8f, 0x73, 0x5f, 0xd6, 0x6f, 0x7f, 0xa8, 0x56, 0xf2, 0x4e,
+	0x00, 0xc7, 0x71, 0xf2, 0x32, 0x0f, 0xcb, 0xdd, 0xdf, 0xad, 0xa9, 0x25, 0xce, 0x33, 0xe4, 0xb7,
+	0x3c, 0xa3, 0xd8, 0x7b, 0xac, 0xe6, 0xe4, 0x51, 0x58, 0x4f, 0xef, 0x83, 0xfb, 0x0c, 0x6d, 0x6b,
+	0x23, 0x0f, 0x96, 0xc5, 0x5b, 0xfb, 0xcd, 0x3f, 0xfd, 0x5f, 0xbf, 0x5f, 0x5d, 0xf4, 0x9a, 0x77,
+	0x2e, 0xdf, 0xbf, 0x73, 0x1e, 0x0d, 0xf1, 0x9d, 0x56, 0xef, 0xd7, 0x95, 0xca, 0x9e, 0x3a, 0xf5,
+	0xb6, 0x8c, 0x21, 0x9c, 0x7b, 0x07, 0xb6, 0x75, 0xb3, 0x04, 0x23, 0xed, 0xde, 0xa4, 0x76, 0xd7,
+	0xfc, 0x25, 0x6c, 0x17, 0x9f, 0x53, 0xe0, 0x67, 0x4f, 0x3f, 0xa8, 0xdc, 0xf6, 0xba, 0x6a, 0xc1,
+	0x7e, 0x84, 0xd4, 0xd3, 0x31, 0x94, 0x92, 0x67, 0x54, 0x5b, 0xaf, 0x97, 0xe2, 0xf4, 0xc6, 0x53,
+	0x1f, 0xeb, 0xfe, 0x0a, 0xf6, 0x31, 0xa1, 0x1a, 0x59, 0x2f, 0x7d, 0x66, 0x87, 0xec, 0xad, 0x51,
+	0xef, 0x0d, 0x8b, 0x42, 0x0b, 0x2f, 0x9d, 0xb6, 0xde, 0x9c, 0x82, 0x95, 0xbe, 0xde, 0xa4, 0xbe,
+	0x36, 0x7d, 0x0f, 0xfb, 0xea, 0x50, 0x1d, 0xfd, 0xd2, 0x29, 0xf4, 0x76, 0xf7, 0xff, 0xbc, 0xab,
+	0xe6, 0x4d, 0x6c, 0xd5, 0xfb, 0x9e, 0x5a, 0x74, 0x12, 0x41, 0x3d, 0x3d, 0x8d, 0xb2, 0xbc, 0xd1,
+	0xd6, 0x1b, 0xe5, 0x48, 0xe9, 0xf8, 0x2d, 0xea, 0x78, 0xcb, 0xdb, 0xc0, 0x8e, 0x25, 0x91, 0xf2,
+	0x0e, 0xa5, 0x34, 0xf3, 0x3d, 0xc9, 0x67, 0x16, 0xdb, 0x73, 0x67, 0x6f, 0xe4, 0x39, 0xd1, 0xe9,
+	0xed, 0xcd, 0x29, 0x58, 0xe9, 0xee, 0x0d, 0xea, 0x6e, 0xc3, 0xbb, 0x61, 0x77, 0x67, 0x62, 0x9e,
+	0x11, 0x5d, 0x0e, 0xb6, 0x9f, 0xe0, 0xf4, 0xde, 0x34, 0x84, 0x55, 0xf6, 0x34, 0xa7, 0x21, 0x91,
+	0xe2, 0xfb, 0x9c, 0xfe, 0x16, 0x75, 0xe5, 0x79, 0xb4, 0x7d, 0xf6, 0x0b, 0x9c, 0xde, 0xa9, 0x6a,
+	0x5a, 0xaf, 0x6e, 0x79, 0x37, 0xa7, 0xbe, 0x10, 0xd6, 0x6a, 0x95, 0xa1, 0xca, 0xa6, 0x62, 0xb7,
+	0x7f, 0x07, 0x4f, 0xf5, 0x5f, 0x03, 0x93, 0x59, 0xbf, 0xdb, 0xe4, 0x6d, 0x5a, 0xef, 0x69, 0xd9,
+	0x6f, 0x4d, 0xb5, 0xb6, 0x8a, 0x88, 0x32, 0xe2, 0xb3, 0x5b, 0x47, 0xe2, 0xfb, 0x58, 0x35, 0xad,
+	0xb7, 0x99, 0xcc, 0x04, 0x8a, 0xef, 0x3f, 0x99, 0x09, 0x94, 0x3c, 0xe5, 0xe4, 0xaf, 0x52, 0x17,
+	0x4d, 0x6f, 0x9e, 0xe8, 0x1b, 0x9f, 0x6e, 0xf2, 0x0e, 0xd4, 0xba, 0x88, 0xb7, 0xd3, 0xe8, 0xd3,
+	0x6c, 0x43, 0xc9, 0xab, 0xa7, 0x5f, 0xac, 0x80, 0x24, 0x6f, 0xe8, 0x67, 0xb8, 0xbc, 0x8d, 0xf2,
+	0x27, 0xc5, 0x5a, 0x9b, 0x05, 0xb8, 0xa8, 0x35, 0xdf, 0x56, 0x2a, 0x7b, 0x08, 0xca, 0x08, 0x89,
+	0xc2, 0xc3, 0x52, 0x86, 0x02, 0x8a, 0xaf, 0x46, 0xf9, 0x1b, 0x34, 0xc1, 0x15, 0x8f, 0x84, 0xc4,
+	0x30, 0x7a, 0xae, 0xdf, 0x03, 0xf8, 0x2e, 0xc8, 0xd1, 0xec, 0x2d, 0x28, 0xb3, 0x7c, 0xc5, 0x77,
+	0xa4, 0xcc, 0xf2, 0x95, 0x3c, 0x1d, 0xe5, 0xb7, 0xa8, 0xf5, 0x1b, 0xfe, 0x32, 0xb6, 0x8e, 0x6f,
+	0x3d, 0x0d, 0xb8, 0x02, 0x6e, 0xd0, 0x85, 0x5a, 0x74, 0x1e, 0x7c, 0x32, 0x1c, 0x5a, 0xf6, 0x9c,
+	0x94, 0xe1, 0xd0, 0xd2, 0x37, 0xa2, 0x34, 0x9d, 0xf9, 0xab, 0xd8, 0xcf, 0x25, 0x55, 0xb1, 0x7a,
+	0xfa, 0x8e, 0x6a, 0x5a, 0x8f, 0x37, 0x99, 0xb9, 0x14, 0xdf, 0x89, 0x32, 0x73, 0x29, 0x7b, 0xeb,
+	0xe9, 0x06, 0xf5, 0xb1, 0xe4, 0x13, 0x29, 0xd0, 0x8d, 0x76, 0x6c, 0xfb, 0x7b, 0x6a, 0xc9, 0x7d,
+	0xce, 0xc9, 0xf0, 0x7e, 0xe9, 0xc3, 0x50, 0x86, 0xf7, 0xa7, 0xbc, 0x01, 0x25, 0x24, 0x7d, 0x7b,
+	0xcd, 0x74, 0x72, 0xe7, 0x87, 0x92, 0xab, 0xf5, 0x89, 0xf7, 0x4d, 0x14, 0x70, 0xf2, 0xc4, 0x80,
+	0xb7, 0x69, 0x51, 0xad, 0xfd, 0x10, 0x81, 0xe1, 0x97, 0xc2, 0x6b, 0x04, 0x2e, 0x31, 0xf3, 0x9d,
+	0x7c, 0x3a, 0xb5, 0xe8, 0xa9, 0x01, 0xeb, 0xd4, 0xb2, 0x5f, 0x23, 0xb0, 0x4e, 0x2d, 0xe7, 0x45,
+	0x82, 0xfc, 0xa9, 0x95, 0xf6, 0xb0, 0x8d, 0xa1, 0x5a, 0xce, 0x5d, 0x56, 0x31, 0x5c, 0x51, 0x7e,
+	0xa7, 0xb0, 0xf5, 0xd6, 0xcb, 0xef, 0xb8, 0xb8, 0x12, 0x44, 0x0b, 0xc1, 0x3b, 0xfa, 0x06, 0xe7,
+	0xdf, 0x51, 0x0b, 0xf6, 0x13, 0x35, 0x9e, 0xcd, 0xca, 0xf9, 0x9e, 0x5e, 0x2f, 0xc5, 0xb9, 0x9b,
+	0xeb, 0x2d, 0xd8, 0xdd, 0x78, 0xdf, 0x52, 0x1b, 0x86, 0xd5, 0xed, 0xfb, 0x0f, 0x89, 0xf7, 0x76,
+	0xc9, 0xad, 0x08, 0x5b, 0xe9, 0x69, 0xdd, 0x9c, 0x7a, 0x6d, 0x02, 0x98, 0x1e, 0x88, 0xc6, 0x7d,
+	0xfb, 0x23, 0x3b, 0x30, 0xca, 0x9e, 0x3c, 0xc9, 0x0e, 0x8c, 0xd2, 0x07, 0x43, 0x34, 0xd1, 0x78,
+	0x6b, 0xce, 0x1a, 0x71, 0x50, 0x1b, 0x88, 0x7f, 0xd9, 0xba, 0x5d, 0x86, 0xef, 0x5e, 0x18, 0x06,
+	0x28, 0x5e, 0x87, 0x6e, 0x95, 0xa9, 0xf4, 0xfe, 0x26, 0xb5, 0xbf, 0xea, 0x3b, 0x8b, 0x83, 0xc4,
+	0xbf, 0xab, 0x9a, 0xf6, 0xcd, 0xb5, 0x97, 0xb4, 0xbb, 0x69, 0xa1, 0xec, 0xfb, 0xbb, 0xb0, 0x18,
+	0x47, 0x9c, 0xd0, 0x64, 0xde, 0x14, 0x1d, 0xc5, 0xf9, 0xe3, 0xd3, 0x7d, 0x6b, 0xd4, 0x6c, 0x64,
+	0xd9, 0x2b, 0xb3, 0xef, 0x55, 0xa0, 0xc5, 0x7f, 0x88, 0x8f, 0x89, 0xda, 0xb7, 0xcb, 0x9c, 0x14,
+	0x91, 0xdc, 0xc8, 0xb6, 0x6c, 0x9c, 0x3d, 0x34, 0x3f, 0xa0, 0x69, 0x1f, 0xdc, 0xfe, 0x86, 0xb3,
+	0xac, 0x3f, 0x74, 0xfc, 0x48, 0xdb, 0xf9, 0x87, 0x45, 0x3f, 0xc9, 0x57, 0xb0, 0x2f, 0xa1, 0x7f,
+	0x02, 0x83, 0xfb, 0x93, 0x8a, 0x5a, 0x72, 0xfd, 0x9e, 0x66, 0xba, 0xa5, 0x1e, 0x56, 0xb3, 0xf9,
+	0x53, 0x9c, 0xa5, 0xdf, 0xa1, 0x51, 0x9e, 0xdc, 0x0e, 0x9c, 0x51, 0xca, 0x3b, 0x33, 0x3f, 0xdb,
+	0x68, 0xbd, 0x0f, 0xf8, 0xf1, 0x6c, 0x1d, 0xb1, 0xf0, 0x8a, 0xef, 0x31, 0x1b, 0x82, 0xb1, 0x5f,
+	0x50, 0xa6, 0x4d, 0xf8, 0x2e, 0x3f, 0xa4, 0xa9, 0x1d, 0xe8, 0x48, 0x77, 0xaf, 0xfa, 0xbd, 0xff,
+	0x0e, 0xcd, 0xe9, 0x2d, 0xff, 0xa6, 0x33, 0xa7, 0xfc, 0x09, 0xbf, 0xc3, 0xa3, 0x93, 0xc7, 0x8f,
+	0xb3, 0x23, 0xaa, 0xf0, 0x20, 0xf2, 0xf4, 0x41, 0x0e, 0x78, 0x90, 0x52, 0xdd, 0x61, 0x8e, 0x57,
+	0x6c, 0xc6, 0xbf, 0x4d, 0x63, 0x7d, 0xc7, 0x7f, 0x7b, 0xea, 0x58, 0xef, 0x90, 0x0f, 0x13, 0x47,
+	0x7c, 0xa4, 0x54, 0x16, 0x5d, 0xf4, 0x72, 0xd1, 0x2d, 0x23, 0x32, 0x8a, 0x01, 0x48, 0x97, 0x03,
+	0x75, 0x10, 0x0c, 0x5b, 0xfc, 0x35, 0x16, 0x80, 0x8f, 0x74, 0x5c, 0xcc, 0x56, 0x73, 0xdc, 0x30,
+	0xa0, 0xa3, 0xe6, 0xe4, 0xdb, 0x77, 0xc4, 0x9f, 0x09, 0xb2, 0x3d, 0x55, 0x8b, 0x07, 0xa3, 0x11,
+	0x98, 0x6b, 0x26, 0x87, 0xc3, 0x0d, 0x2c, 0x60, 0xb0, 0xb2, 0x95, 0x9b, 0x85, 0x7f, 0x8b, 0x9a,
+	0x6a, 0x79, 0x5b, 0x56, 0x53, 0x77, 0x7e, 0x98, 0x45, 0x2f, 0x3f, 0xf1, 0x42, 0xb5, 0x6a, 0xa4,
+	0xaa, 0x19, 0x78, 0xcb, 0x6d, 0xc6, 0x91, 0xa5, 0xf9, 0x2e, 0x1c, 0x7d, 0x5c, 0x8f, 0xf6, 0x4e,
+	0xa2, 0xdb, 0x24, 0x99, 0xb2, 0xb0, 0x17, 0x75, 0xe8, 0xbe, 0x06, 0x79, 0xe7, 0xd7, 0xb2, 0x81,
+	0x1b, 0xb7, 0x7e, 0x6b, 0xd1, 0x01, 0xba, 0x27, 0xcd, 0x38, 0xbc, 0x8a, 0xa3, 0xef, 0xc3, 0xd9,
+	0xcb, 0x7e, 0xff, 0x4f, 0xf4, 0x49, 0xa3, 0x03, 0x23, 0xce, 0x49, 0x93, 0x8b, 0xa4, 0x38, 0x27,
+	0x4d, 0x21, 0x92, 0xe2, 0x2c, 0xb5, 0x0e, 0xcc, 0x80, 0xa9, 0xb4, 0x5a, 0x08, 0xbe, 0x98, 0x43,
+	0x66, 0x5a, 0xc8, 0xa6, 0x75, 0x6b, 0x7a, 0x05, 0xb7, 0xb7, 0xdb, 0x6e, 0x6f, 0xc7, 0x6a, 0x71,
+	0x2f, 0xe2, 0xc5, 0xe2, 0x54, 0xd5, 0xdc, 0x15, 0x45, 0x3b, 0x11, 0x36, 0x7f, 0x24, 0x10, 0xce,
+	0x55, 0x25, 0x28, 0x4f, 0x14, 0x48, 0xb1, 0x09, 0x3a, 0x82, 0xce, 0x4d, 0x35, 0xca, 0x6c, 0x2e,
+	0x59, 0xb5, 0x55, 0x92, 0xda, 0xea, 0xd2, 0x0c, 0xb5, 0x76, 0x07, 0x93, 0x5d, 0x59, 0x38, 0xb5,
+	0x7b, 0xdd, 0x4f, 0xbc, 0xbf, 0x4d, 0x8d, 0x9b, 0x54, 0xfd, 0x0d, 0x2b, 0xd9, 0xd0, 0x6e, 0x7c,
+	0x39, 0x07, 0x2f, 0x6b, 0x19, 0xb3, 0xb1, 0x2c, 0xa5, 0x6a, 0xa8, 0x9a, 0xd6, 0x65, 0x1a, 0xc3,
+	0x40, 0xc5, 0x1b, 0x4d, 0x86, 0x81, 0x4a, 0xee, 0xde, 0xf8, 0xef, 0x51, 0x3f, 0xbe, 0x77, 0x2b,
+	0xeb, 0x87, 0xef, 0xdb, 0x64, 0x3d, 0xdd, 0xf9, 0x61, 0x38, 0x48, 0x3f, 0x01, 0xbb, 0x04, 0xdf,
+	0x7b, 0xb2, 0xf3, 0x6f, 0x33, 0xed, 0x3c, 0x9f, 0xaa, 0x6b, 0x16, 0xcb, 0x42, 0xb9, 0x1a, 0x3b,
+	0x77, 0x45, 0xba, 0xd7, 0x57, 0x94, 0xc2, 0xdc, 0xce, 0xbd, 0x10, 0xff, 0x8c, 0x4b, 0x26, 0x6b,
+	0xb3, 0xec, 0xcf, 0x4c, 0x7e, 0x59, 0x29, 0xa0, 0x30, 0x9e, 0xf5, 0xbc, 0x8e, 0xc3, 0x34, 0xa1,
+	0x89, 0x6b, 0x6a, 0x82, 0xa8, 0x59, 0x90, 0x92, 0x24, 0x51, 0xe0, 0xc1, 0x1d, 0xa5, 0xb2, 0xe8,
+	0x9b, 0x31, 0x4e, 0x0a, 0x81, 0x3d, 0x23, 0xf6, 0x4a, 0x42, 0x75, 0x47, 0x6a, 0x3e, 0x0b, 0xea,
+	0x6c, 0x66, 0x17, 0xfb, 0x9c, 0x10, 0x90, 0x39, 0xc1, 0x0b, 0x41, 0x16, 0x7f, 0x85, 0x96, 0x4a,
+	0x79, 0x0d, 0x5c, 0x2a, 0x8a, 0x9c, 0xf4, 0xd4, 0x1a, 0x0f, 0xd0, 0x28, 0x38, 0x94, 0xb9, 0xa8,
+	0x67, 0x52, 0x12, 0xe8, 0x30, 0xdc, 0x5c, 0xea, 0xf5, 0x77, 0x7c, 0x2c, 0x48, 0xad, 0x9c, 0x35,
+	0x89, 0xa2, 0x79, 0xa0, 0x56, 0x0b, 0x0e, 0x69, 0xc3, 0xd2, 0xd3, 0x22, 0x05, 0x86, 0xa5, 0xa7,
+	0xfa, 0xb2, 0xfd, 0x75, 0xea, 0x72, 0xd9, 0x57, 0x64, 0x53, 0x3d, 0xef, 0xa5, 0x9d, 0x0b, 0xec,
+	0x0e, 0x13, 0x25, 0x4b, 0xfc, 0xcd, 0xde, 0x67, 0xb4, 0x79, 0x3e, 0xd5, 0x17, 0xdd, 0x2a, 0x75,
+	0x47, 0xfa, 0xc7, 0xd4, 0xcf, 0x63, 0xef, 0x23, 0xe7, 0x60, 0x63, 0x4f, 0xa0, 0x70, 0xe6, 0x4b,
+	0x95, 0x8a, 0x52, 0x8d, 0xe2, 0xfb, 0x6a, 0x93, 0x07, 0x02, 0xc2, 0x2a, 0xe7, 0x2a, 0x7d, 0xab,
+	0xf0, 0x37, 0x74, 0x1c, 0x17, 0x70, 0x6b, 0xfa, 0xdf, 0xd8, 0x99, 0xa2, 0x00, 0xf3, 0x50, 0xbd,
+	0x89, 0x5a, 0xc9, 0xbb, 0x1f, 0xbd, 0xe9, 0x6d, 0xb5, 0xde, 0x76, 0x0c, 0xcd, 0xa2, 0xcb, 0xd2,
+	0xff, 0x1b, 0xd4, 0xd9, 0xdb, 0x7e, 0xab, 0x6c, 0x5d, 0xd8, 0xf6, 0xc4, 0xfd, 0xf8, 0xbb, 0xc6,
+	0x57, 0x9a, 0x9b, 0xa7, 0xee, 0x60, 0x9a, 0x73, 0xd7, 0x98, 0xba, 0xe5, 0xae, 0xd6, 0x77, 0xa9,
+	0xfb, 0x5b, 0xfe, 0xeb, 0x65, 0xdd, 0xc7, 0xfc, 0x09, 0x1b, 0xbd, 0x9b, 0x79, 0xbe, 0xd6, 0x23,
+	0xb8, 0x55, 0xb6, 0xdf, 0x53, 0xad, 0x97, 0xdc, 0x5a, 0xbf, 0xf6, 0xc5, 0xca, 0xbd, 0x5b, 0xdf,
+	0x79, 0xeb, 0xbc, 0x97, 0x5e, 0x4c, 0x4e, 0xb7, 0x3b, 0xa3, 0xc1, 0x9d, 0x6e, 0xd4, 0x89, 0xa3,
+	0xee, 0x9d, 0x6e, 0x27, 0xee, 0x0f, 0xbb, 0x77, 0xe8, 0xc3, 0xd3, 0x59, 0xfa, 0x5b, 0x5c, 0x5f,
+	0xfa, 0x7f, 0xcc, 0xdc, 0x50, 0xe3, 0xbd, 0x6b, 0x00, 0x00,
 }
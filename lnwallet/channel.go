@@ -6044,6 +6044,12 @@ func (lc *LightningChannel) validateFeeRate(feePerKB AtomPerKByte) error {
 			lc.channelState.LocalChanCfg.ChanReserve)
 	}
 
+	// The new fee rate must also respect the floor configured for the
+	// channel, if any.
+	if err := lc.validateMinFeeRate(feePerKB); err != nil {
+		return err
+	}
+
 	// TODO(halseth): should fail if fee update is unreasonable,
 	// as specified in BOLT#2.
 	//  * COMMENT(roasbeef): can cross-check with our ideal fee rate
@@ -6051,6 +6057,35 @@ func (lc *LightningChannel) validateFeeRate(feePerKB AtomPerKByte) error {
 	return nil
 }
 
+// validateMinFeeRate ensures that the passed fee rate isn't below the minimum
+// commitment fee rate configured for the channel, if any.
+func (lc *LightningChannel) validateMinFeeRate(feePerKB AtomPerKByte) error {
+	minFeePerKB, err := lc.channelState.MinCommitFeePerKB()
+	if err != nil {
+		return err
+	}
+
+	if dcrutil.Amount(feePerKB) < minFeePerKB {
+		return channeldb.ErrCommitFeeBelowMin{
+			FeePerKB:    dcrutil.Amount(feePerKB),
+			MinFeePerKB: minFeePerKB,
+		}
+	}
+
+	return nil
+}
+
+// MinCommitFeeRate returns the minimum fee rate the commitment transactions of
+// the channel must pay, or zero if there is no such floor.
+func (lc *LightningChannel) MinCommitFeeRate() (AtomPerKByte, error) {
+	minFeePerKB, err := lc.channelState.MinCommitFeePerKB()
+	if err != nil {
+		return 0, err
+	}
+
+	return AtomPerKByte(minFeePerKB), nil
+}
+
 // UpdateFee initiates a fee update for this channel. Must only be called by
 // the channel initiator, and must be called before sending update_fee to
 // the remote.
@@ -6092,6 +6127,12 @@ func (lc *LightningChannel) ReceiveUpdateFee(feePerKB AtomPerKByte) error {
 		return fmt.Errorf("received fee update as initiator")
 	}
 
+	// Refuse fee updates that would lead us to sign a commitment below
+	// the channel's fee rate floor.
+	if err := lc.validateMinFeeRate(feePerKB); err != nil {
+		return err
+	}
+
 	// TODO(roasbeef): or just modify to use the other balance?
 	pd := &PaymentDescriptor{
 		LogIndex:  lc.remoteUpdateLog.logIndex,
@@ -2059,6 +2059,57 @@ func TestUpdateFeeFail(t *testing.T) {
 
 }
 
+// TestUpdateFeeBelowMinCommitFee asserts that fee updates below the commitment
+// fee rate floor of a channel are refused, both when sending and receiving
+// them, before any commitment is signed.
+func TestUpdateFeeBelowMinCommitFee(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(true)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	const minFee = 2e4
+	err = aliceChannel.channelState.SetMinCommitFeePerKB(minFee)
+	if err != nil {
+		t.Fatalf("unable to set min commit fee: %v", err)
+	}
+	err = bobChannel.channelState.SetMinCommitFeePerKB(minFee)
+	if err != nil {
+		t.Fatalf("unable to set min commit fee: %v", err)
+	}
+
+	// Alice, as the initiator, may not propose a fee rate below the
+	// floor.
+	err = aliceChannel.UpdateFee(minFee - 1)
+	if _, ok := err.(channeldb.ErrCommitFeeBelowMin); !ok {
+		t.Fatalf("expected ErrCommitFeeBelowMin, got %v", err)
+	}
+
+	// Neither will Bob accept one.
+	err = bobChannel.ReceiveUpdateFee(minFee - 1)
+	if _, ok := err.(channeldb.ErrCommitFeeBelowMin); !ok {
+		t.Fatalf("expected ErrCommitFeeBelowMin, got %v", err)
+	}
+
+	// A fee rate at the floor is accepted and can be locked in.
+	if err := aliceChannel.UpdateFee(minFee); err != nil {
+		t.Fatalf("unable to send fee update: %v", err)
+	}
+	if err := bobChannel.ReceiveUpdateFee(minFee); err != nil {
+		t.Fatalf("unable to receive fee update: %v", err)
+	}
+	if err := ForceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state transition: %v", err)
+	}
+	bobFee := bobChannel.channelState.LocalCommitment.FeePerKB
+	if bobFee != minFee {
+		t.Fatalf("expected fee rate %v, got %v", minFee, bobFee)
+	}
+}
+
 // TestUpdateFeeConcurrentSig tests that the channel can properly handle a fee
 // update that it receives concurrently with signing its next commitment.
 func TestUpdateFeeConcurrentSig(t *testing.T) {
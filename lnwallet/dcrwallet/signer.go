@@ -1,6 +1,7 @@
 package dcrwallet
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -96,6 +97,68 @@ func (b *DcrWallet) fetchOutputAddr(scriptVersion uint16, script []byte) (udb.Ma
 	return nil, lnwallet.ErrNotMine
 }
 
+// fetchAddrPrivKey returns the private key of the passed wallet address.
+func (b *DcrWallet) fetchAddrPrivKey(
+	walletAddr udb.ManagedAddress) (*secp256k1.PrivateKey, error) {
+
+	privKeyWifStr, err := b.wallet.DumpWIFPrivateKey(
+		context.TODO(), walletAddr.Address(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wif string for address: %v",
+			err)
+	}
+	privKeyWif, err := dcrutil.DecodeWIF(
+		privKeyWifStr, b.netParams.PrivateKeyID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding wif string for "+
+			"address: %v", err)
+	}
+	privKey, isSecp := privKeyWif.PrivKey.(*secp256k1.PrivateKey)
+	if !isSecp {
+		return nil, fmt.Errorf("private key returned is not secp256k1")
+	}
+
+	return privKey, nil
+}
+
+// PubKeyHashWitnessType returns the witness type required to sweep the given
+// p2pkh wallet output, depending on whether it pays to the hash of the
+// compressed or the uncompressed public key of its address.
+//
+// This is a part of the sweep.PubKeyHashWitnessTyper interface.
+func (b *DcrWallet) PubKeyHashWitnessType(
+	utxo *lnwallet.Utxo) (input.WitnessType, error) {
+
+	walletAddr, err := b.fetchOutputAddr(scriptVersion, utxo.PkScript)
+	if err != nil {
+		return 0, err
+	}
+
+	privKey, err := b.fetchAddrPrivKey(walletAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	pubKey := privKey.PubKey()
+	compressedHash := dcrutil.Hash160(pubKey.SerializeCompressed())
+	uncompressedHash := dcrutil.Hash160(pubKey.SerializeUncompressed())
+
+	pkHash := walletAddr.Address().ScriptAddress()
+	switch {
+	case bytes.Equal(compressedHash, pkHash):
+		return input.PublicKeyHash, nil
+
+	case bytes.Equal(uncompressedHash, pkHash):
+		return input.PublicKeyHashUncompressed, nil
+
+	default:
+		return 0, fmt.Errorf("output %v doesn't pay to the key of "+
+			"its address", utxo.OutPoint)
+	}
+}
+
 // maybeTweakPrivKey examines the single and double tweak parameters on the
 // passed sign descriptor and may perform a mapping on the passed private key
 // in order to utilize the tweaks, if populated.
@@ -200,17 +263,9 @@ func (b *DcrWallet) ComputeInputScript(tx *wire.MsgTx,
 	}
 
 	// Fetch the private key for the given wallet address.
-	privKeyWifStr, err := b.wallet.DumpWIFPrivateKey(context.TODO(), walletAddr.Address())
+	privKey, err := b.fetchAddrPrivKey(walletAddr)
 	if err != nil {
-		return nil, fmt.Errorf("invalid wif string for address: %v", err)
-	}
-	privKeyWif, err := dcrutil.DecodeWIF(privKeyWifStr, b.netParams.PrivateKeyID)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding wif string for address: %v", err)
-	}
-	privKey, isSecp := privKeyWif.PrivKey.(*secp256k1.PrivateKey)
-	if !isSecp {
-		return nil, fmt.Errorf("private key returned is not secp256k1")
+		return nil, err
 	}
 
 	// If a tweak (single or double) is specified, then we'll need to use
@@ -221,10 +276,20 @@ func (b *DcrWallet) ComputeInputScript(tx *wire.MsgTx,
 		return nil, err
 	}
 
+	// Legacy outputs may pay to the hash of the uncompressed public key,
+	// in which case the signature script must reveal the key in the same
+	// format for the output to be redeemable.
+	uncompressedHash := dcrutil.Hash160(
+		privKey.PubKey().SerializeUncompressed(),
+	)
+	compress := !bytes.Equal(
+		uncompressedHash, walletAddr.Address().ScriptAddress(),
+	)
+
 	// Generate a valid witness stack for the input.
 	// TODO(roasbeef): adhere to passed HashType
 	sigScript, err := txscript.SignatureScript(tx, signDesc.InputIndex,
-		outputScript, signDesc.HashType, privKey, true)
+		outputScript, signDesc.HashType, privKey, compress)
 	if err != nil {
 		return nil, err
 	}
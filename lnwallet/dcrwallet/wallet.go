@@ -348,8 +348,11 @@ func (b *DcrWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 		return nil, err
 	}
 
-	// Convert the dcrjson formatted unspents into lnwallet.Utxo's
+	// Convert the dcrjson formatted unspents into lnwallet.Utxo's. The
+	// account of each output is reported by name, so we'll keep track of
+	// the account numbers we've already looked up.
 	witnessOutputs := make([]*lnwallet.Utxo, 0, len(unspentOutputs))
+	accounts := make(map[string]uint32)
 	for _, output := range unspentOutputs {
 		pkScript, err := hex.DecodeString(output.ScriptPubKey)
 		if err != nil {
@@ -374,6 +377,17 @@ func (b *DcrWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 			return nil, err
 		}
 
+		account, ok := accounts[output.Account]
+		if !ok {
+			account, err = b.wallet.AccountNumber(
+				context.TODO(), output.Account,
+			)
+			if err != nil {
+				return nil, err
+			}
+			accounts[output.Account] = account
+		}
+
 		utxo := &lnwallet.Utxo{
 			AddressType: addressType,
 			Value:       amt,
@@ -384,6 +398,7 @@ func (b *DcrWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 				Tree:  output.Tree,
 			},
 			Confirmations: output.Confirmations,
+			Account:       account,
 		}
 		witnessOutputs = append(witnessOutputs, utxo)
 	}
@@ -77,6 +77,16 @@ func ErrChanReserveTooLarge(reserve,
 	}
 }
 
+// ErrCommitFeeTooLow returns an error indicating that the commitment fee rate
+// proposed by the remote party is below our floor.
+func ErrCommitFeeTooLow(feePerKB,
+	minFeePerKB AtomPerKByte) ReservationError {
+	return ReservationError{
+		fmt.Errorf("commitment fee rate too low: %v atoms/kB, min "+
+			"is %v atoms/kB", int64(feePerKB), int64(minFeePerKB)),
+	}
+}
+
 // ErrNonZeroPushAmount is returned by a remote peer that receives a
 // FundingOpen request for a channel with non-zero push amount while
 // they have 'rejectpush' enabled.
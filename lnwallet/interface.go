@@ -69,6 +69,9 @@ type Utxo struct {
 	PkScript      []byte
 	wire.OutPoint
 
+	// Account is the number of the wallet account the output belongs to.
+	Account uint32
+
 	// TODO(decred) this needs to include ScriptVersion. Then this version needs
 	// to be filled and used everywhere instead of DefaultScriptVersion.
 }
@@ -524,6 +524,7 @@ func (b *DcrWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 			PkScript:      msg.PkScript,
 			OutPoint:      outp,
 			Confirmations: int64(confs),
+			Account:       b.account,
 		}
 		utxos = append(utxos, utxo)
 	}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/decred/dcrd/connmgr"
 	"github.com/decred/dcrlnd/autopilot"
@@ -202,6 +203,10 @@ func initLogRotator(logFile string, maxLogFileSize, maxLogFiles int) {
 	logRotator = r
 }
 
+// logLevelMtx guards the log levels of the subsystem loggers, as they may be
+// changed at runtime.
+var logLevelMtx sync.RWMutex
+
 // setLogLevel sets the logging level for provided subsystem.  Invalid
 // subsystems are ignored.  Uninitialized subsystems are dynamically created as
 // needed.
@@ -214,7 +219,65 @@ func setLogLevel(subsystemID string, logLevel string) {
 
 	// Defaults to info if the log level is invalid.
 	level, _ := slog.LevelFromString(logLevel)
+
+	logLevelMtx.Lock()
 	logger.SetLevel(level)
+	logLevelMtx.Unlock()
+}
+
+// SetSubsystemLogLevel changes the logging level of a single subsystem at
+// runtime. An error is returned if the subsystem or log level is invalid.
+func SetSubsystemLogLevel(subsystemID, logLevel string) error {
+	if _, ok := subsystemLoggers[subsystemID]; !ok {
+		return fmt.Errorf("the specified subsystem [%v] is invalid -- "+
+			"supported subsystems %v", subsystemID,
+			supportedSubsystems())
+	}
+
+	if !validLogLevel(logLevel) {
+		return fmt.Errorf("the specified debug level [%v] is invalid",
+			logLevel)
+	}
+
+	setLogLevel(subsystemID, logLevel)
+
+	return nil
+}
+
+// GetLogLevels returns the current logging level of each subsystem, keyed by
+// subsystem identifier. The levels use the same names accepted by
+// SetSubsystemLogLevel.
+func GetLogLevels() map[string]string {
+	logLevelMtx.RLock()
+	defer logLevelMtx.RUnlock()
+
+	levels := make(map[string]string, len(subsystemLoggers))
+	for subsystemID, logger := range subsystemLoggers {
+		levels[subsystemID] = logLevelName(logger.Level())
+	}
+
+	return levels
+}
+
+// logLevelName returns the name of the given log level as accepted by
+// validLogLevel.
+func logLevelName(level slog.Level) string {
+	switch level {
+	case slog.LevelTrace:
+		return "trace"
+	case slog.LevelDebug:
+		return "debug"
+	case slog.LevelInfo:
+		return "info"
+	case slog.LevelWarn:
+		return "warn"
+	case slog.LevelError:
+		return "error"
+	case slog.LevelCritical:
+		return "critical"
+	default:
+		return "off"
+	}
 }
 
 // setLogLevels sets the log level for all subsystem loggers to the passed
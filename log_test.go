@@ -0,0 +1,46 @@
+// +build !rpctest
+
+package dcrlnd
+
+import "testing"
+
+// TestSetSubsystemLogLevel asserts that the log level of a single subsystem
+// can be changed and read back, and that invalid subsystems and levels are
+// rejected.
+func TestSetSubsystemLogLevel(t *testing.T) {
+	const subsystem = "CNCT"
+
+	prevLevel := GetLogLevels()[subsystem]
+	defer setLogLevel(subsystem, prevLevel)
+
+	if err := SetSubsystemLogLevel(subsystem, "debug"); err != nil {
+		t.Fatalf("unable to set log level: %v", err)
+	}
+	if level := GetLogLevels()[subsystem]; level != "debug" {
+		t.Fatalf("expected log level debug, got %v", level)
+	}
+
+	if err := SetSubsystemLogLevel(subsystem, "trace"); err != nil {
+		t.Fatalf("unable to set log level: %v", err)
+	}
+	levels := GetLogLevels()
+	if levels[subsystem] != "trace" {
+		t.Fatalf("expected log level trace, got %v", levels[subsystem])
+	}
+	if len(levels) != len(subsystemLoggers) {
+		t.Fatalf("expected %v subsystems, got %v",
+			len(subsystemLoggers), len(levels))
+	}
+
+	if err := SetSubsystemLogLevel("XXXX", "debug"); err == nil {
+		t.Fatal("expected unknown subsystem to be rejected")
+	}
+	if err := SetSubsystemLogLevel(subsystem, "verbose"); err == nil {
+		t.Fatal("expected invalid log level to be rejected")
+	}
+
+	// Rejected changes must leave the current level untouched.
+	if level := GetLogLevels()[subsystem]; level != "trace" {
+		t.Fatalf("expected log level trace, got %v", level)
+	}
+}
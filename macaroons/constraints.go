@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/peer"
@@ -87,3 +88,85 @@ func IPLockChecker() (string, checkers.Func) {
 		return nil
 	}
 }
+
+// IPRangeConstraint locks macaroon to a set of IP addresses and subnets. Each
+// entry may either be a single IP address or a CIDR subnet. If no entries are
+// given, this constraint does nothing to accommodate default value's desired
+// behavior.
+func IPRangeConstraint(allowlist ...string) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if len(allowlist) == 0 {
+			return nil
+		}
+
+		subnets := make([]string, 0, len(allowlist))
+		for _, entry := range allowlist {
+			subnet, err := parseIPRange(entry)
+			if err != nil {
+				return err
+			}
+			subnets = append(subnets, subnet.String())
+		}
+
+		caveat := checkers.Condition("iprange",
+			strings.Join(subnets, ","))
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// IPRangeChecker accepts client IP from the validation context and checks
+// that it's contained in one of the subnets the macaroon is locked to. It is
+// of the `Checker` type.
+func IPRangeChecker() (string, checkers.Func) {
+	return "iprange", func(ctx context.Context, cond, arg string) error {
+		// Get peer info and extract IP address from it for macaroon
+		// check.
+		pr, ok := peer.FromContext(ctx)
+		if !ok {
+			return fmt.Errorf("unable to get peer info from context")
+		}
+		peerAddr, _, err := net.SplitHostPort(pr.Addr.String())
+		if err != nil {
+			return fmt.Errorf("unable to parse peer address")
+		}
+		peerIP := net.ParseIP(peerAddr)
+
+		for _, entry := range strings.Split(arg, ",") {
+			subnet, err := parseIPRange(entry)
+			if err != nil {
+				return err
+			}
+			if subnet.Contains(peerIP) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("macaroon locked to different IP range")
+	}
+}
+
+// parseIPRange parses either a single IP address or a CIDR subnet into the
+// subnet it denotes.
+func parseIPRange(entry string) (*net.IPNet, error) {
+	if !strings.Contains(entry, "/") {
+		ip := net.ParseIP(entry)
+		if ip == nil {
+			return nil, fmt.Errorf("incorrect macaroon IP-range "+
+				"address: %v", entry)
+		}
+
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+			bits = 8 * net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+
+	_, subnet, err := net.ParseCIDR(entry)
+	if err != nil {
+		return nil, fmt.Errorf("incorrect macaroon IP-range subnet: %v",
+			entry)
+	}
+	return subnet, nil
+}
@@ -107,3 +107,33 @@ func TestIPLockBadIP(t *testing.T) {
 		t.Fatalf("IPLockConstraint with bad IP should fail.")
 	}
 }
+
+// TestIPRangeConstraint tests that a caveat locking a macaroon to a set of IP
+// addresses and subnets is created.
+func TestIPRangeConstraint(t *testing.T) {
+	constraintFunc := macaroons.IPRangeConstraint(
+		"10.0.0.0/8", "127.0.0.1",
+	)
+	testMacaroon := createDummyMacaroon(t)
+	err := constraintFunc(testMacaroon)
+	if err != nil {
+		t.Fatalf("Error applying IP range constraint: %v", err)
+	}
+
+	expected := "iprange 10.0.0.0/8,127.0.0.1/32"
+	if string(testMacaroon.Caveats()[0].Id) != expected {
+		t.Fatalf("Added caveat '%s' does not meet the expectations!",
+			testMacaroon.Caveats()[0].Id)
+	}
+}
+
+// TestIPRangeBadSubnet tests that an IP range constraint cannot be added if
+// one of the provided entries is not a valid IP address or subnet.
+func TestIPRangeBadSubnet(t *testing.T) {
+	constraintFunc := macaroons.IPRangeConstraint("10.0.0.0/8", "10.0.0/80")
+	testMacaroon := createDummyMacaroon(t)
+	err := constraintFunc(testMacaroon)
+	if err == nil {
+		t.Fatalf("IPRangeConstraint with bad subnet should fail.")
+	}
+}
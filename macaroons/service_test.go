@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"io/ioutil"
+	"net"
 	"os"
 	"path"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/decred/dcrlnd/macaroons"
 	bolt "go.etcd.io/bbolt"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
 )
@@ -137,3 +139,67 @@ func TestValidateMacaroon(t *testing.T) {
 		t.Fatalf("Error validating the macaroon: %v", err)
 	}
 }
+
+// TestValidateMacaroonIPRange tests that a macaroon locked to a subnet is only
+// valid for requests originating from within that subnet.
+func TestValidateMacaroonIPRange(t *testing.T) {
+	// First, initialize the service and unlock it.
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+	service, err := macaroons.NewService(
+		tempDir, macaroons.IPLockChecker, macaroons.IPRangeChecker,
+	)
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	defer service.Close()
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	// Then, create a new macaroon locked to a subnet that we can
+	// serialize.
+	macaroon, err := service.Oven.NewMacaroon(context.TODO(),
+		bakery.LatestVersion, nil, testOperation)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+	lockedMac, err := macaroons.AddConstraints(
+		macaroon.M(), macaroons.IPRangeConstraint("192.168.1.0/24"),
+	)
+	if err != nil {
+		t.Fatalf("Error adding constraint: %v", err)
+	}
+	macaroonBinary, err := lockedMac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Error serializing macaroon: %v", err)
+	}
+
+	md := metadata.New(map[string]string{
+		"macaroon": hex.EncodeToString(macaroonBinary),
+	})
+
+	// validateFrom validates the macaroon in a context mocking a request
+	// from the given IP address.
+	validateFrom := func(ip string) error {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		ctx = peer.NewContext(ctx, &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 10009},
+		})
+		return service.ValidateMacaroon(
+			ctx, []bakery.Op{testOperation},
+		)
+	}
+
+	// A request from within the subnet should be allowed.
+	if err := validateFrom("192.168.1.42"); err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+
+	// A request from outside of it should be rejected.
+	if err := validateFrom("192.168.2.42"); err == nil {
+		t.Fatalf("Expected macaroon validation to fail outside of " +
+			"the allowed subnet")
+	}
+}
@@ -1934,6 +1934,10 @@ out:
 				// channel state to ensure we act to on-chain
 				// events as normal.
 				chanCloser.cfg.channel.ResetState()
+				p.notifyCoopCloseNegotiation(
+					chanCloser.cfg.channel.ChannelPoint(),
+					false,
+				)
 
 				if chanCloser.CloseRequest() != nil {
 					chanCloser.CloseRequest().Err <- err
@@ -1989,6 +1993,20 @@ out:
 			}
 			p.activeChanMtx.Unlock()
 
+			// Any close negotiations that haven't finished yet
+			// are aborted along with the connection, so we'll let
+			// the chain arbitrator know.
+			for _, chanCloser := range p.activeChanCloses {
+				if chanCloser.state == closeFinished {
+					continue
+				}
+
+				p.notifyCoopCloseNegotiation(
+					chanCloser.cfg.channel.ChannelPoint(),
+					false,
+				)
+			}
+
 			break out
 		}
 	}
@@ -2103,6 +2121,7 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 			nil,
 		)
 		p.activeChanCloses[chanID] = chanCloser
+		p.notifyCoopCloseNegotiation(channel.ChannelPoint(), true)
 	}
 
 	return chanCloser, nil
@@ -2163,6 +2182,7 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 			req,
 		)
 		p.activeChanCloses[chanID] = chanCloser
+		p.notifyCoopCloseNegotiation(channel.ChannelPoint(), true)
 
 		// Finally, we'll initiate the channel shutdown within the
 		// chanCloser, and send the shutdown message to the remote
@@ -2176,6 +2196,9 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 			// As we were unable to shutdown the channel, we'll
 			// return it back to its normal state.
 			channel.ResetState()
+			p.notifyCoopCloseNegotiation(
+				channel.ChannelPoint(), false,
+			)
 			return
 		}
 
@@ -2232,8 +2255,8 @@ func (p *peer) handleLinkFailure(failure linkFailureReport) {
 		peerLog.Warnf("Force closing link(%v)",
 			failure.shortChanID)
 
-		closeTx, err := p.server.chainArb.ForceCloseContract(
-			failure.chanPoint,
+		closeResult, err := p.server.chainArb.ForceCloseContract(
+			failure.chanPoint, channeldb.CloseCauseLinkFailure,
 		)
 		if err != nil {
 			peerLog.Errorf("unable to force close "+
@@ -2241,7 +2264,8 @@ func (p *peer) handleLinkFailure(failure linkFailureReport) {
 		} else {
 			peerLog.Infof("channel(%v) force "+
 				"closed with txid %v",
-				failure.shortChanID, closeTx.TxHash())
+				failure.shortChanID,
+				closeResult.CloseTx.TxHash())
 		}
 	}
 
@@ -2266,6 +2290,19 @@ func (p *peer) handleLinkFailure(failure linkFailureReport) {
 	}
 }
 
+// notifyCoopCloseNegotiation lets the chain arbitrator know that a cooperative
+// close negotiation for the given channel was started (active) or aborted
+// (!active).
+func (p *peer) notifyCoopCloseNegotiation(chanPoint *wire.OutPoint,
+	active bool) {
+
+	err := p.server.chainArb.NotifyCoopCloseNegotiation(*chanPoint, active)
+	if err != nil {
+		peerLog.Warnf("Unable to notify chain arbitrator of close "+
+			"negotiation for ChannelPoint(%v): %v", chanPoint, err)
+	}
+}
+
 // finalizeChanClosure performs the final clean up steps once the cooperative
 // closure transaction has been fully broadcast. The finalized closing state
 // machine should be passed in. Once the transaction has been sufficiently
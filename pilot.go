@@ -166,6 +166,19 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.ManagerCfg, er
 		return nil, err
 	}
 
+	// Load the failures recorded by previous autopilot runs, so nodes we
+	// recently failed to open channels with aren't retried right away.
+	failureStore, err := autopilot.NewFailureStore(svr.chanDB)
+	if err != nil {
+		return nil, err
+	}
+	blacklist, err := autopilot.NewBlacklist(
+		failureStore, cfg.FailureHalfLife,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// With the heuristic itself created, we can now populate the remainder
 	// of the items that the autopilot agent needs to perform its duties.
 	self := svr.identityPriv.PubKey()
@@ -183,6 +196,7 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.ManagerCfg, er
 		},
 		Graph:       autopilot.ChannelGraphFromDatabase(svr.chanDB.ChannelGraph()),
 		Constraints: atplConstraints,
+		Blacklist:   blacklist,
 		ConnectToPeer: func(target *secp256k1.PublicKey, addrs []net.Addr) (bool, error) {
 			// First, we'll check if we're already connected to the
 			// target peer. If we are, we can exit early. Otherwise,
@@ -0,0 +1,126 @@
+package dcrlnd
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/decred/dcrlnd/signal"
+)
+
+const (
+	// readinessShutdownTimeout is the maximum amount of time we'll wait
+	// for in-flight readiness requests to complete during shutdown.
+	readinessShutdownTimeout = 5 * time.Second
+)
+
+// readinessServer is a minimal HTTP server that exposes a single readiness
+// probe suitable for orchestration systems. The probe only reports the node
+// as ready once the chain backend has finished its initial sync and the main
+// server has been started.
+type readinessServer struct {
+	synced  int32 // To be used atomically.
+	started int32 // To be used atomically.
+
+	listenAddr string
+	httpServer *http.Server
+
+	stopOnce sync.Once
+	quit     chan struct{}
+}
+
+// newReadinessServer creates a new readiness server that will listen on the
+// passed address once started.
+func newReadinessServer(listenAddr string) *readinessServer {
+	r := &readinessServer{
+		listenAddr: listenAddr,
+		quit:       make(chan struct{}),
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", r)
+	r.httpServer = &http.Server{Handler: mux}
+
+	return r
+}
+
+// Start binds the readiness server to its listen address and begins serving
+// requests. The server is automatically stopped once a shutdown is requested
+// through the signal package.
+func (r *readinessServer) Start() error {
+	listener, err := net.Listen("tcp", r.listenAddr)
+	if err != nil {
+		return err
+	}
+
+	ltndLog.Infof("Readiness probe listening on %s", listener.Addr())
+
+	go func() {
+		err := r.httpServer.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			ltndLog.Errorf("Readiness server failed: %v", err)
+		}
+	}()
+
+	go func() {
+		select {
+		case <-signal.ShutdownChannel():
+			r.Stop()
+		case <-r.quit:
+		}
+	}()
+
+	return nil
+}
+
+// Stop gracefully shuts down the readiness server. It is safe to call Stop
+// multiple times.
+func (r *readinessServer) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.quit)
+
+		ctx, cancel := context.WithTimeout(
+			context.Background(), readinessShutdownTimeout,
+		)
+		defer cancel()
+
+		if err := r.httpServer.Shutdown(ctx); err != nil {
+			ltndLog.Errorf("Unable to shut down readiness "+
+				"server: %v", err)
+		}
+	})
+}
+
+// SetSynced marks the chain backend as fully synced.
+func (r *readinessServer) SetSynced() {
+	atomic.StoreInt32(&r.synced, 1)
+}
+
+// SetStarted marks the main server as started.
+func (r *readinessServer) SetStarted() {
+	atomic.StoreInt32(&r.started, 1)
+}
+
+// ServeHTTP responds with 200 if the node is ready and with 503 otherwise.
+//
+// NOTE: This is part of the http.Handler interface.
+func (r *readinessServer) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	switch {
+	case atomic.LoadInt32(&r.synced) == 0:
+		http.Error(
+			w, "chain backend not synced", http.StatusServiceUnavailable,
+		)
+
+	case atomic.LoadInt32(&r.started) == 0:
+		http.Error(
+			w, "server not started", http.StatusServiceUnavailable,
+		)
+
+	default:
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ready\n"))
+	}
+}
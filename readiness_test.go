@@ -0,0 +1,44 @@
+// +build !rpctest
+
+package dcrlnd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestReadinessServer asserts that the readiness probe only reports the node
+// as ready once both the chain backend is synced and the server has started.
+func TestReadinessServer(t *testing.T) {
+	r := newReadinessServer("127.0.0.1:0")
+
+	assertStatus := func(expected int) {
+		t.Helper()
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/", nil)
+		r.ServeHTTP(rec, req)
+
+		if rec.Code != expected {
+			t.Fatalf("expected status %v, got %v", expected,
+				rec.Code)
+		}
+	}
+
+	assertStatus(http.StatusServiceUnavailable)
+
+	r.SetSynced()
+	assertStatus(http.StatusServiceUnavailable)
+
+	r.SetStarted()
+	assertStatus(http.StatusOK)
+
+	// Starting and stopping the server must work, and stopping it twice
+	// must be safe.
+	if err := r.Start(); err != nil {
+		t.Fatalf("unable to start readiness server: %v", err)
+	}
+	r.Stop()
+	r.Stop()
+}
@@ -44,6 +44,12 @@ type ControlTower interface {
 	// flight and a channel that provides the final outcome of the payment.
 	SubscribePayment(paymentHash lntypes.Hash) (bool, chan PaymentResult,
 		error)
+
+	// UnsubscribePayment cancels a subscription obtained through
+	// SubscribePayment, such that the subscriber is no longer notified of
+	// the final outcome of the payment.
+	UnsubscribePayment(paymentHash lntypes.Hash,
+		subscriber chan PaymentResult)
 }
 
 // PaymentResult is the struct describing the events received by payment
@@ -240,6 +246,33 @@ func (p *controlTower) SubscribePayment(paymentHash lntypes.Hash) (
 	return false, c, nil
 }
 
+// UnsubscribePayment cancels a subscription obtained through
+// SubscribePayment, such that the subscriber is no longer notified of the final
+// outcome of the payment. It's safe to call after the subscriber already
+// received the final outcome.
+func (p *controlTower) UnsubscribePayment(paymentHash lntypes.Hash,
+	subscriber chan PaymentResult) {
+
+	p.subscribersMtx.Lock()
+	defer p.subscribersMtx.Unlock()
+
+	list := p.subscribers[paymentHash]
+	for i, c := range list {
+		if c != subscriber {
+			continue
+		}
+
+		list = append(list[:i], list[i+1:]...)
+		break
+	}
+
+	if len(list) == 0 {
+		delete(p.subscribers, paymentHash)
+		return
+	}
+	p.subscribers[paymentHash] = list
+}
+
 // notifyFinalEvent sends a final payment event to all subscribers of this
 // payment. The channel will be closed after this.
 func (p *controlTower) notifyFinalEvent(paymentHash lntypes.Hash,
@@ -163,6 +163,74 @@ func TestControlTowerSubscribeSuccess(t *testing.T) {
 	}
 }
 
+// TestControlTowerUnsubscribe tests that a cancelled subscription is no longer
+// notified of the payment outcome, while other subscribers still are.
+func TestControlTowerUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	db, err := initDB()
+	if err != nil {
+		t.Fatalf("unable to init db: %v", err)
+	}
+
+	pControl := NewControlTower(channeldb.NewPaymentControl(db))
+
+	info, attempt, preimg, err := genInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = pControl.InitPayment(info.PaymentHash, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pControl.RegisterAttempt(info.PaymentHash, attempt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, subscriber1, err := pControl.SubscribePayment(info.PaymentHash)
+	if err != nil {
+		t.Fatalf("expected subscribe to succeed, but got: %v", err)
+	}
+	_, subscriber2, err := pControl.SubscribePayment(info.PaymentHash)
+	if err != nil {
+		t.Fatalf("expected subscribe to succeed, but got: %v", err)
+	}
+
+	pControl.UnsubscribePayment(info.PaymentHash, subscriber1)
+
+	// Only the second subscriber should remain registered.
+	tower := pControl.(*controlTower)
+	tower.subscribersMtx.Lock()
+	numSubscribers := len(tower.subscribers[info.PaymentHash])
+	tower.subscribersMtx.Unlock()
+	if numSubscribers != 1 {
+		t.Fatalf("expected 1 subscriber, got %v", numSubscribers)
+	}
+
+	if err := pControl.Success(info.PaymentHash, preimg); err != nil {
+		t.Fatal(err)
+	}
+
+	// The remaining subscriber should be notified.
+	select {
+	case result := <-subscriber2:
+		if !result.Success {
+			t.Fatal("unexpected payment state")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timeout waiting for payment result")
+	}
+
+	// The cancelled subscriber shouldn't receive anything.
+	select {
+	case <-subscriber1:
+		t.Fatal("unexpected result for cancelled subscriber")
+	default:
+	}
+}
+
 // TestPaymentControlSubscribeFail tests that payment updates for a
 // failed payment are properly sent to subscribers.
 func TestPaymentControlSubscribeFail(t *testing.T) {
@@ -51,6 +51,10 @@ const (
 	// ErrFeeLimitExceeded is returned when the total fees of a route exceed
 	// the user-specified fee limit.
 	ErrFeeLimitExceeded
+
+	// ErrPathFindingCanceled is returned when path finding is aborted
+	// because the caller is no longer interested in the result.
+	ErrPathFindingCanceled
 )
 
 // routerError is a structure that represent the error inside the routing package,
@@ -1,6 +1,8 @@
 package routing
 
 import (
+	"errors"
+	"fmt"
 	"math"
 	"sync"
 	"time"
@@ -380,6 +382,67 @@ func (m *MissionControl) GetHistorySnapshot() *MissionControlSnapshot {
 	return &snapshot
 }
 
+// ImportHistory merges a previously obtained history snapshot into the current
+// mission control state. Per node and per pair, the imported data is only
+// applied if it is more recent than what mission control already knows about,
+// unless force is set in which case the imported data always takes
+// precedence.
+//
+// NOTE: Imported data is only held in memory. It isn't added to the payment
+// result store and therefore needs to be imported again after a restart.
+func (m *MissionControl) ImportHistory(snapshot *MissionControlSnapshot,
+	force bool) error {
+
+	if snapshot == nil {
+		return errors.New("cannot import nil history snapshot")
+	}
+
+	// We'll validate the snapshot in full before applying any of it, so
+	// an invalid snapshot leaves mission control untouched.
+	for _, pair := range snapshot.Pairs {
+		if pair.Pair.From == pair.Pair.To {
+			return fmt.Errorf("invalid pair in snapshot: %v",
+				pair.Pair)
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	var importedNodes, importedPairs int
+	for _, node := range snapshot.Nodes {
+		current, ok := m.lastNodeFailure[node.Node]
+		if ok && !force && !node.LastFail.After(current) {
+			continue
+		}
+
+		m.lastNodeFailure[node.Node] = node.LastFail
+		importedNodes++
+	}
+
+	for _, pair := range snapshot.Pairs {
+		current, ok := m.lastPairResult[pair.Pair]
+		if ok && !force && !pair.Timestamp.After(current.timestamp) {
+			continue
+		}
+
+		m.lastPairResult[pair.Pair] = timedPairResult{
+			timestamp: pair.Timestamp,
+			pairResult: pairResult{
+				minPenalizeAmt: pair.MinPenalizeAmt,
+				success:        pair.LastAttemptSuccessful,
+			},
+		}
+		importedPairs++
+	}
+
+	log.Debugf("Imported mission control history: nodes=%v/%v, "+
+		"pairs=%v/%v, force=%v", importedNodes, len(snapshot.Nodes),
+		importedPairs, len(snapshot.Pairs), force)
+
+	return nil
+}
+
 // ReportPaymentFail reports a failed payment to mission control as input for
 // future probability estimates. The failureSourceIdx argument indicates the
 // failure source. If it is nil, the failure source is unknown. This function
@@ -201,3 +201,82 @@ func TestMissionControlChannelUpdate(t *testing.T) {
 	)
 	ctx.expectP(0, 0)
 }
+
+// TestMissionControlImportHistory asserts that importing a history snapshot
+// only overwrites existing data with more recent data, unless forced.
+func TestMissionControlImportHistory(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.now = testTime
+
+	// Report a failure for the test pair, so that mission control has
+	// existing data for it.
+	ctx.reportFailure(0, lnwire.NewTemporaryChannelFailure(nil))
+	ctx.expectP(1000, 0)
+
+	pair := NewDirectedNodePair(mcTestNode1, mcTestNode2)
+	otherPair := NewDirectedNodePair(mcTestNode2, mcTestNode1)
+
+	// Import a snapshot with an older success for the test pair and data
+	// for a pair that mission control doesn't know about yet.
+	snapshot := &MissionControlSnapshot{
+		Pairs: []MissionControlPairSnapshot{
+			{
+				Pair:                  pair,
+				Timestamp:             testTime.Add(-time.Hour),
+				LastAttemptSuccessful: true,
+			},
+			{
+				Pair:                  otherPair,
+				Timestamp:             testTime.Add(-time.Hour),
+				LastAttemptSuccessful: true,
+			},
+		},
+	}
+	if err := ctx.mc.ImportHistory(snapshot, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// The more recent failure must be kept, while the unknown pair is
+	// imported.
+	ctx.expectP(1000, 0)
+	p := ctx.mc.GetProbability(mcTestNode2, mcTestNode1, 1000)
+	if p != prevSuccessProbability {
+		t.Fatalf("expected imported pair probability %v, got %v",
+			prevSuccessProbability, p)
+	}
+
+	// Importing the same snapshot with force set overwrites the more
+	// recent failure.
+	if err := ctx.mc.ImportHistory(snapshot, true); err != nil {
+		t.Fatal(err)
+	}
+	ctx.expectP(1000, prevSuccessProbability)
+
+	// A snapshot containing an invalid pair must be rejected as a whole,
+	// without applying the valid failure that precedes it.
+	invalidSnapshot := &MissionControlSnapshot{
+		Pairs: []MissionControlPairSnapshot{
+			{
+				Pair:      pair,
+				Timestamp: testTime.Add(time.Hour),
+			},
+			{
+				Pair: NewDirectedNodePair(
+					mcTestNode1, mcTestNode1,
+				),
+				Timestamp: testTime.Add(time.Hour),
+			},
+		},
+	}
+	if err := ctx.mc.ImportHistory(invalidSnapshot, true); err == nil {
+		t.Fatal("expected invalid snapshot import to fail")
+	}
+	ctx.expectP(1000, prevSuccessProbability)
+
+	// A nil snapshot can't be imported.
+	if err := ctx.mc.ImportHistory(nil, false); err == nil {
+		t.Fatal("expected nil snapshot import to fail")
+	}
+}
@@ -310,3 +310,7 @@ func (m *mockControlTower) SubscribePayment(paymentHash lntypes.Hash) (
 
 	return false, nil, errors.New("not implemented")
 }
+
+func (m *mockControlTower) UnsubscribePayment(paymentHash lntypes.Hash,
+	subscriber chan PaymentResult) {
+}
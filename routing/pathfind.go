@@ -6,6 +6,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/routing/route"
@@ -203,8 +204,12 @@ func newRoute(amtToSend lnwire.MilliAtom, sourceVertex route.Vertex,
 // channels with shorter time lock deltas and shorter (hops) routes in general.
 // RiskFactor controls the influence of time lock on route selection. This is
 // currently a fixed value, but might be configurable in the future.
+//
+// timePref shifts the balance between both terms. It ranges from -1, where
+// only the fee is taken into account, to 1, where only the time lock penalty
+// is. A value of 0 weighs both terms equally.
 func edgeWeight(lockedAmt lnwire.MilliAtom, fee lnwire.MilliAtom,
-	timeLockDelta uint16) int64 {
+	timeLockDelta uint16, timePref float64) int64 {
 	// timeLockPenalty is the penalty for the time lock delta of this channel.
 	// It is controlled by RiskFactorBillionths and scales proportional
 	// to the amount that will pass through channel. Rationale is that it if
@@ -212,7 +217,15 @@ func edgeWeight(lockedAmt lnwire.MilliAtom, fee lnwire.MilliAtom,
 	timeLockPenalty := int64(lockedAmt) * int64(timeLockDelta) *
 		RiskFactorBillionths / 1000000000
 
-	return int64(fee) + timeLockPenalty
+	if timePref == 0 {
+		return int64(fee) + timeLockPenalty
+	}
+
+	feeFactor := 1 - timePref
+	timeLockFactor := 1 + timePref
+
+	return int64(feeFactor*float64(fee) +
+		timeLockFactor*float64(timeLockPenalty))
 }
 
 // graphParams wraps the set of graph parameters passed to findPath.
@@ -255,6 +268,10 @@ type RestrictParams struct {
 	// hop. If nil, any channel may be used.
 	OutgoingChannelID *uint64
 
+	// LastHop is the pubkey of the last node before the final destination
+	// is reached. If nil, any node may be used.
+	LastHop *route.Vertex
+
 	// CltvLimit is the maximum time lock of the route excluding the final
 	// ctlv. After path finding is complete, the caller needs to increase
 	// all cltv expiry heights with the required final cltv delta.
@@ -264,6 +281,27 @@ type RestrictParams struct {
 	// payload at the final hop in order to properly complete this payment
 	// attempt.
 	DestPayloadTLV bool
+
+	// PaymentAttemptPenalty overrides the payment attempt penalty of the
+	// path finding config if set.
+	PaymentAttemptPenalty *lnwire.MilliAtom
+
+	// TimePreference expresses the preference between lower fees and a
+	// lower cumulative time lock when selecting a route. It ranges from
+	// -1, which only optimizes for fees, to 1, which only optimizes for
+	// time lock. The default of 0 weighs both the same way path finding
+	// always has.
+	TimePreference float64
+
+	// MinChannelCapacity is the minimum capacity of the channels the path
+	// may traverse. Channels with an unknown capacity and private channels
+	// from route hints are not subject to this restriction. If zero, any
+	// channel may be used.
+	MinChannelCapacity dcrutil.Amount
+
+	// Cancel, if non-nil, aborts path finding once it is closed, which
+	// allows callers to bound the time spent on a query.
+	Cancel <-chan struct{}
 }
 
 // PathFindingConfig defines global parameters that control the trade-off in
@@ -307,6 +345,13 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			"time=%v", nodesVisited, edgesExpanded, timeElapsed)
 	}()
 
+	if math.IsNaN(r.TimePreference) || r.TimePreference < -1 ||
+		r.TimePreference > 1 {
+
+		return nil, fmt.Errorf("time preference %v out of range "+
+			"[-1, 1]", r.TimePreference)
+	}
+
 	var err error
 	tx := g.tx
 	if tx == nil {
@@ -388,13 +433,21 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		}
 	}
 
+	// If we're paying to ourselves, the source and target are the same
+	// vertex, and we'll need to find a circular route back to us.
+	routeToSelf := source == target
+
 	// We can't always assume that the end destination is publicly
 	// advertised to the network and included in the graph.ForEachNode call
 	// above, so we'll manually include the target node. The target node
 	// charges no fee. Distance is set to 0, because this is the starting
 	// point of the graph traversal. We are searching backwards to get the
 	// fees first time right and correctly match channel bandwidth.
-	distance[target] = nodeWithDist{
+	//
+	// When routing to self, the target isn't recorded in the distance map,
+	// as the source vertex needs to start out at an infinite distance for
+	// the search to be able to reach it.
+	targetDist := nodeWithDist{
 		dist:            0,
 		weight:          0,
 		node:            target,
@@ -402,19 +455,39 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		incomingCltv:    0,
 		probability:     1,
 	}
+	if !routeToSelf {
+		distance[target] = targetDist
+	}
 
 	// We'll use this map as a series of "next" hop pointers. So to get
 	// from `Vertex` to the target node, we'll take the edge that it's
 	// mapped to within `next`.
 	next := make(map[route.Vertex]*channeldb.ChannelEdgePolicy)
 
+	// Determine the virtual cost of a failed payment attempt that is used
+	// to trade off fees against probability.
+	attemptPenalty := cfg.PaymentAttemptPenalty
+	if r.PaymentAttemptPenalty != nil {
+		attemptPenalty = *r.PaymentAttemptPenalty
+	}
+
 	// processEdge is a helper closure that will be used to make sure edges
 	// satisfy our specific requirements.
 	processEdge := func(fromVertex route.Vertex, bandwidth lnwire.MilliAtom,
-		edge *channeldb.ChannelEdgePolicy, toNode route.Vertex) {
+		edge *channeldb.ChannelEdgePolicy, toNodeDist nodeWithDist) {
 
 		edgesExpanded++
 
+		toNode := toNodeDist.node
+
+		// If we have a last hop restriction and this edge leads into
+		// the target from any other node, skip it.
+		if r.LastHop != nil && toNode == target &&
+			fromVertex != *r.LastHop {
+
+			return
+		}
+
 		// If this is not a local channel and it is disabled, we will
 		// skip it.
 		// TODO(halseth): also ignore disable flags for non-local
@@ -438,7 +511,6 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 		// Calculate amount that the candidate node would have to sent
 		// out.
-		toNodeDist := distance[toNode]
 		amountToSend := toNodeDist.amountToReceive
 
 		// Request the success probability for this edge.
@@ -528,7 +600,9 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// weight composed of the fee that this node will charge and
 		// the amount that will be locked for timeLockDelta blocks in
 		// the HTLC that is handed out to fromVertex.
-		weight := edgeWeight(amountToReceive, fee, timeLockDelta)
+		weight := edgeWeight(
+			amountToReceive, fee, timeLockDelta, r.TimePreference,
+		)
 
 		// Compute the tentative weight to this new channel/edge
 		// which is the weight from our toNode to the target node
@@ -539,7 +613,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// probability.
 		tempDist := getProbabilityBasedDist(
 			tempWeight, probability,
-			int64(cfg.PaymentAttemptPenalty),
+			int64(attemptPenalty),
 		)
 
 		// If the current best route is better than this candidate
@@ -581,25 +655,23 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// TODO(roasbeef): also add path caching
 	//  * similar to route caching, but doesn't factor in the amount
 
-	// To start, our target node will the sole item within our distance
-	// heap.
-	heap.Push(&nodeHeap, distance[target])
+	// To start, we'll expand the incoming edges of our target node.
+	partialPath := targetDist
+	for {
+		// Bail out if the caller is no longer interested in the
+		// path.
+		select {
+		case <-r.Cancel:
+			return nil, newErr(
+				ErrPathFindingCanceled, "path finding canceled",
+			)
+		default:
+		}
 
-	for nodeHeap.Len() != 0 {
 		nodesVisited++
 
-		// Fetch the node within the smallest distance from our source
-		// from the heap.
-		partialPath := heap.Pop(&nodeHeap).(nodeWithDist)
 		pivot := partialPath.node
 
-		// If we've reached our source (or we don't have any incoming
-		// edges), then we're done here and can exit the graph
-		// traversal early.
-		if pivot == source {
-			break
-		}
-
 		cb := func(_ *bolt.Tx, edgeInfo *channeldb.ChannelEdgeInfo, _,
 			inEdge *channeldb.ChannelEdgePolicy) error {
 
@@ -611,6 +683,14 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 				return nil
 			}
 
+			// Skip channels that are known to be smaller than the
+			// requested capacity floor.
+			if edgeInfo.Capacity != 0 &&
+				edgeInfo.Capacity < r.MinChannelCapacity {
+
+				return nil
+			}
+
 			// We'll query the lower layer to see if we can obtain
 			// any more up to date information concerning the
 			// bandwidth of this edge.
@@ -640,7 +720,10 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 			// Check if this candidate node is better than what we
 			// already have.
-			processEdge(route.Vertex(chanSource), edgeBandwidth, inEdge, pivot)
+			processEdge(
+				route.Vertex(chanSource), edgeBandwidth, inEdge,
+				partialPath,
+			)
 			return nil
 		}
 
@@ -660,7 +743,23 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		bandWidth := partialPath.amountToReceive
 		for _, reverseEdge := range additionalEdgesWithSrc[pivot] {
 			processEdge(reverseEdge.sourceNode, bandWidth,
-				reverseEdge.edge, pivot)
+				reverseEdge.edge, partialPath)
+		}
+
+		// If there are no more nodes to visit, there's nothing left to
+		// explore.
+		if nodeHeap.Len() == 0 {
+			break
+		}
+
+		// Fetch the node within the smallest distance from our source
+		// from the heap.
+		partialPath = heap.Pop(&nodeHeap).(nodeWithDist)
+
+		// If we've reached our source, then we're done here and can
+		// exit the graph traversal early.
+		if partialPath.node == source {
+			break
 		}
 	}
 
@@ -672,10 +771,11 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	}
 
 	// Use the nextHop map to unravel the forward path from source to
-	// target.
+	// target. When routing to self, the source is also the target, so
+	// we'll always take at least one step.
 	pathEdges := make([]*channeldb.ChannelEdgePolicy, 0, len(next))
 	currentNode := source
-	for currentNode != target { // TODO(roasbeef): assumes no cycles
+	for {
 		// Determine the next hop forward using the next map.
 		nextNode := next[currentNode]
 
@@ -684,6 +784,10 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 		// Advance current node.
 		currentNode = route.Vertex(nextNode.Node.PubKeyBytes)
+
+		if currentNode == target {
+			break
+		}
 	}
 
 	// The route is invalid if it spans more than 20 hops. The current
@@ -667,6 +667,103 @@ func TestFindLowestFeePath(t *testing.T) {
 	}
 }
 
+// TestFindPathTimePreference asserts that the time preference of the
+// restrictions flips the selection between a cheap route with a long time lock
+// and a more expensive route with a short time lock.
+func TestFindPathTimePreference(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph with two paths from roasbeef to target. The path
+	// through a is cheap but has a large time lock delta, while the path
+	// through b is more expensive but has a small one.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			MinHTLC: 1,
+			MaxHTLC: 100000000,
+		}),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  400,
+			FeeRate: 1,
+			MinHTLC: 1,
+			MaxHTLC: 100000000,
+		}),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			MinHTLC: 1,
+			MaxHTLC: 100000000,
+		}),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  40,
+			FeeRate: 5,
+			MinHTLC: 1,
+			MaxHTLC: 100000000,
+		}),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(
+		testChannels, "roasbeef",
+	)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+
+	paymentAmt := lnwire.NewMAtomsFromAtoms(50000)
+	target := testGraphInstance.aliasMap["target"]
+
+	findViaNode := func(timePref float64) string {
+		restrictions := *noRestrictions
+		restrictions.TimePreference = timePref
+
+		path, err := findPath(
+			&graphParams{
+				graph: testGraphInstance.graph,
+			},
+			&restrictions, testPathFindingConfig,
+			sourceNode.PubKeyBytes, target, paymentAmt,
+		)
+		if err != nil {
+			t.Fatalf("unable to find path: %v", err)
+		}
+
+		return getAliasFromPubKey(
+			path[0].Node.PubKeyBytes, testGraphInstance.aliasMap,
+		)
+	}
+
+	// Preferring low fees should select the cheap route through a.
+	if via := findViaNode(-1); via != "a" {
+		t.Fatalf("expected route through a when preferring fees, "+
+			"got %v", via)
+	}
+
+	// Preferring a low time lock should select the route through b.
+	if via := findViaNode(1); via != "b" {
+		t.Fatalf("expected route through b when preferring time "+
+			"lock, got %v", via)
+	}
+
+	// An out of range preference is rejected.
+	restrictions := *noRestrictions
+	restrictions.TimePreference = 2
+	_, err = findPath(
+		&graphParams{
+			graph: testGraphInstance.graph,
+		},
+		&restrictions, testPathFindingConfig,
+		sourceNode.PubKeyBytes, target, paymentAmt,
+	)
+	if err == nil {
+		t.Fatalf("expected out of range time preference to fail")
+	}
+}
+
 func getAliasFromPubKey(pubKey route.Vertex,
 	aliases map[string]route.Vertex) string {
 
@@ -1938,6 +2035,314 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 	}
 }
 
+// TestRestrictLastHop asserts that a last hop restriction is obeyed by the
+// path finding algorithm.
+func TestRestrictLastHop(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph with two possible paths from roasbeef to target.
+	// The path through b is the more expensive one.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 3),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 4),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(
+		testChannels, "roasbeef",
+	)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourceVertex := route.Vertex(sourceNode.PubKeyBytes)
+
+	paymentAmt := lnwire.NewMAtomsFromAtoms(100)
+	target := testGraphInstance.aliasMap["target"]
+	lastHop := testGraphInstance.aliasMap["b"]
+
+	// Find the best path given the restriction to arrive at the target
+	// through b.
+	path, err := findPath(
+		&graphParams{
+			graph: testGraphInstance.graph,
+		},
+		&RestrictParams{
+			FeeLimit:          noFeeLimit,
+			LastHop:           &lastHop,
+			ProbabilitySource: noProbabilitySource,
+			CltvLimit:         math.MaxUint32,
+		},
+		testPathFindingConfig,
+		sourceVertex, target, paymentAmt,
+	)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+
+	// Assert that the route arrives at the target through b, in line with
+	// the specified restriction.
+	if len(path) != 2 {
+		t.Fatalf("expected path of length 2, got %v", len(path))
+	}
+	if route.Vertex(path[0].Node.PubKeyBytes) != lastHop {
+		t.Fatalf("expected path to pass through b")
+	}
+	if path[1].ChannelID != 4 {
+		t.Fatalf("expected last channel 4, got %v", path[1].ChannelID)
+	}
+}
+
+// TestRestrictLastHopSelfPayment asserts that a circular route back to the
+// source node can be found when both the outgoing channel and the last hop
+// are restricted.
+func TestRestrictLastHopSelfPayment(t *testing.T) {
+	t.Parallel()
+
+	// Set up a ring graph: roasbeef -> a -> b -> roasbeef. Without a last
+	// hop restriction, the cheapest way back would be to return through
+	// channel 1 directly from a.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("b", "roasbeef", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 3),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(
+		testChannels, "roasbeef",
+	)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourceVertex := route.Vertex(sourceNode.PubKeyBytes)
+
+	const (
+		startingHeight = 100
+		finalHopCLTV   = 1
+	)
+
+	paymentAmt := lnwire.NewMAtomsFromAtoms(100)
+	lastHop := testGraphInstance.aliasMap["b"]
+	outgoingChannelID := uint64(1)
+
+	// Find a path from ourselves back to ourselves, leaving through
+	// channel 1 and coming back in from b.
+	path, err := findPath(
+		&graphParams{
+			graph: testGraphInstance.graph,
+		},
+		&RestrictParams{
+			FeeLimit:          noFeeLimit,
+			OutgoingChannelID: &outgoingChannelID,
+			LastHop:           &lastHop,
+			ProbabilitySource: noProbabilitySource,
+			CltvLimit:         math.MaxUint32,
+		},
+		testPathFindingConfig,
+		sourceVertex, sourceVertex, paymentAmt,
+	)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	route, err := newRoute(
+		paymentAmt, sourceVertex, path, startingHeight,
+		finalHopCLTV, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to create path: %v", err)
+	}
+
+	// The route should go around the full ring and terminate back at the
+	// source node.
+	if len(route.Hops) != 3 {
+		t.Fatalf("expected 3 hops, got %v", len(route.Hops))
+	}
+	for i, chanID := range []uint64{1, 2, 3} {
+		if route.Hops[i].ChannelID != chanID {
+			t.Fatalf("expected hop %v to use channel %v, got %v",
+				i, chanID, route.Hops[i].ChannelID)
+		}
+	}
+	if route.Hops[2].PubKeyBytes != sourceVertex {
+		t.Fatalf("expected route to terminate at the source node")
+	}
+}
+
+// TestMinChannelCapacity asserts that channels below the minimum channel
+// capacity are avoided by the path finding algorithm.
+func TestMinChannelCapacity(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph with two possible paths from roasbeef to target.
+	// The cheaper path through a consists of small channels, while the
+	// more expensive path through b consists of large channels.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 1000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "target", 1000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 3),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 4),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(
+		testChannels, "roasbeef",
+	)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourceVertex := route.Vertex(sourceNode.PubKeyBytes)
+
+	paymentAmt := lnwire.NewMAtomsFromAtoms(100)
+	target := testGraphInstance.aliasMap["target"]
+
+	findPathWithFloor := func(floor dcrutil.Amount) (
+		[]*channeldb.ChannelEdgePolicy, error) {
+
+		return findPath(
+			&graphParams{
+				graph: testGraphInstance.graph,
+			},
+			&RestrictParams{
+				FeeLimit:           noFeeLimit,
+				ProbabilitySource:  noProbabilitySource,
+				CltvLimit:          math.MaxUint32,
+				MinChannelCapacity: floor,
+			},
+			testPathFindingConfig,
+			sourceVertex, target, paymentAmt,
+		)
+	}
+
+	// Without a capacity floor, the cheaper path through the small
+	// channels is expected.
+	path, err := findPathWithFloor(0)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	if path[0].ChannelID != 1 {
+		t.Fatalf("expected first channel 1, got %v", path[0].ChannelID)
+	}
+
+	// With a floor above the capacity of the small channels, the path
+	// through the large channels must be taken.
+	path, err = findPathWithFloor(50000)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	if len(path) != 2 {
+		t.Fatalf("expected path of length 2, got %v", len(path))
+	}
+	if path[0].ChannelID != 3 || path[1].ChannelID != 4 {
+		t.Fatalf("expected channels 3 and 4, got %v and %v",
+			path[0].ChannelID, path[1].ChannelID)
+	}
+
+	// A floor above the capacity of all channels leaves no path.
+	_, err = findPathWithFloor(200000)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected no path found, got %v", err)
+	}
+}
+
+// TestPathFindingCanceled asserts that path finding is aborted once the
+// cancel channel of the restrictions is closed.
+func TestPathFindingCanceled(t *testing.T) {
+	t.Parallel()
+
+	testGraphInstance, err := parseTestGraph(basicGraphFilePath)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourceVertex := route.Vertex(sourceNode.PubKeyBytes)
+
+	paymentAmt := lnwire.NewMAtomsFromAtoms(100)
+	target := testGraphInstance.aliasMap["sophon"]
+
+	cancel := make(chan struct{})
+	close(cancel)
+
+	restrictions := *noRestrictions
+	restrictions.Cancel = cancel
+
+	_, err = findPath(
+		&graphParams{
+			graph: testGraphInstance.graph,
+		},
+		&restrictions, testPathFindingConfig,
+		sourceVertex, target, paymentAmt,
+	)
+	if !IsError(err, ErrPathFindingCanceled) {
+		t.Fatalf("expected path finding to be canceled, got %v", err)
+	}
+}
+
 // TestCltvLimit asserts that a cltv limit is obeyed by the path finding
 // algorithm.
 func TestCltvLimit(t *testing.T) {
@@ -472,6 +472,7 @@ func newRPCServer(s *server, macService *macaroons.Service,
 		return nil, err
 	}
 	graph := s.chanDB.ChannelGraph()
+	routingConfig := routerrpc.GetRoutingConfig(cfg.SubRPCServers.RouterRPC)
 	routerBackend := &routerrpc.RouterBackend{
 		MaxPaymentMAtoms: MaxPaymentMAtoms,
 		SelfNode:         selfNode.PubKeyBytes,
@@ -499,11 +500,86 @@ func newRPCServer(s *server, macService *macaroons.Service,
 
 			return info.NodeKey1Bytes, info.NodeKey2Bytes, nil
 		},
+		FetchChannelTimeLockDelta: func(chanID uint64,
+			node route.Vertex) (uint16, error) {
+
+			info, edge1, edge2, err := graph.FetchChannelEdgesByID(
+				chanID,
+			)
+			if err != nil {
+				return 0, err
+			}
+
+			policy := edge2
+			if node == info.NodeKey1Bytes {
+				policy = edge1
+			}
+			if policy == nil {
+				return 0, channeldb.ErrEdgeNotFound
+			}
+			return policy.TimeLockDelta, nil
+		},
+		FetchLocalChannelID: func(chanPoint wire.OutPoint) (uint64,
+			error) {
+
+			channel, err := s.chanDB.FetchChannel(chanPoint)
+			if err != nil {
+				return 0, err
+			}
+
+			// A pending channel only has a placeholder short
+			// channel id, which can't be used to pin the first
+			// hop.
+			if channel.IsPending {
+				return 0, fmt.Errorf("channel %v is still "+
+					"pending", chanPoint)
+			}
+			return channel.ShortChanID().ToUint64(), nil
+		},
 		FindRoute:        s.chanRouter.FindRoute,
 		MissionControl:   s.missionControl,
 		ActiveNetParams:  activeNetParams.Params,
 		Tower:            s.controlTower,
 		MaxTotalTimelock: cfg.MaxOutgoingCltvExpiry,
+		AttemptCost: lnwire.NewMAtomsFromAtoms(
+			routingConfig.AttemptCost,
+		),
+		AttemptCostPPM:   routingConfig.AttemptCostPPM,
+		MaxRouteHints:    routingConfig.MaxRouteHints,
+		MaxRouteHintHops: routingConfig.MaxRouteHintHops,
+
+		AllowCircularRoute:    routingConfig.AllowCircularRoute,
+		DefaultFinalCLTVDelta: routingConfig.DefaultFinalCltvDelta,
+		HopTimeLockDelta:      cfg.Decred.TimeLockDelta,
+		SendOnChain: func(addr dcrutil.Address,
+			amt dcrutil.Amount) (*chainhash.Hash, error) {
+
+			pkScript, err := txscript.PayToAddrScript(addr)
+			if err != nil {
+				return nil, err
+			}
+
+			// Leaving the fee preference empty makes use of
+			// the default confirmation target.
+			feeRate, _, err := sweep.DetermineFeePerKB(
+				s.cc.feeEstimator, sweep.FeePreference{},
+				sweep.DefaultMaxFeeRate,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			output := wire.NewTxOut(int64(amt), pkScript)
+			tx, err := s.cc.wallet.SendOutputs(
+				[]*wire.TxOut{output}, feeRate,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			txHash := tx.TxHash()
+			return &txHash, nil
+		},
 	}
 
 	var (
@@ -913,10 +989,10 @@ func (r *rpcServer) EstimateFee(ctx context.Context,
 	// Query the fee estimator for the fee rate for the given confirmation
 	// target.
 	target := in.TargetConf
-	feePerKB, err := sweep.DetermineFeePerKB(
+	feePerKB, _, err := sweep.DetermineFeePerKB(
 		r.server.cc.feeEstimator, sweep.FeePreference{
 			ConfTarget: uint32(target),
-		},
+		}, sweep.DefaultMaxFeeRate,
 	)
 	if err != nil {
 		return nil, err
@@ -957,21 +1033,18 @@ func (r *rpcServer) EstimateFee(ctx context.Context,
 func (r *rpcServer) SendCoins(ctx context.Context,
 	in *lnrpc.SendCoinsRequest) (*lnrpc.SendCoinsResponse, error) {
 
-	// Based on the passed fee related parameters, we'll determine an
-	// appropriate fee rate for this transaction.
+	// The fee rate of the transaction is determined from the passed fee
+	// related parameters once we know whether we're sweeping all coins,
+	// as a sweep resolves them itself.
 	atomsPerKB := lnwallet.AtomPerKByte(in.AtomsPerByte * 1000)
-	feePerKB, err := sweep.DetermineFeePerKB(
-		r.server.cc.feeEstimator, sweep.FeePreference{
-			ConfTarget: uint32(in.TargetConf),
-			FeeRate:    atomsPerKB,
-		},
-	)
-	if err != nil {
-		return nil, err
+	feePref := sweep.FeePreference{
+		ConfTarget: uint32(in.TargetConf),
+		FeeRate:    atomsPerKB,
 	}
 
-	rpcsLog.Infof("[sendcoins] addr=%v, amt=%v, atom/kb=%v, sweep_all=%v",
-		in.Addr, dcrutil.Amount(in.Amount), int64(feePerKB),
+	rpcsLog.Infof("[sendcoins] addr=%v, amt=%v, atom/kb=%v, "+
+		"conf_target=%v, sweep_all=%v", in.Addr,
+		dcrutil.Amount(in.Amount), int64(atomsPerKB), in.TargetConf,
 		in.SendAll)
 
 	// Decode the address receiving the coins, we need to check whether the
@@ -1011,20 +1084,45 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 			return nil, err
 		}
 
+		// If the wallet is able to tell which p2pkh outputs pay to an
+		// uncompressed public key, we'll let it, so those outputs can
+		// be swept as well.
+		var pkhWitnessType sweep.PubKeyHashWitnessTypeFunc
+		walletCtrl := wallet.WalletController
+		if typer, ok := walletCtrl.(sweep.PubKeyHashWitnessTyper); ok {
+			pkhWitnessType = typer.PubKeyHashWitnessType
+		}
+
 		// With the sweeper instance created, we can now generate a
 		// transaction that will sweep ALL outputs from the wallet in a
 		// single transaction. This will be generated in a concurrent
 		// safe manner, so no need to worry about locking.
 		sweepTxPkg, err := sweep.CraftSweepAllTx(
-			feePerKB, uint32(bestHeight), targetAddr, wallet,
-			wallet.WalletController, wallet.WalletController,
-			r.server.cc.feeEstimator, r.server.cc.signer,
-			activeNetParams.Params,
+			ctx, feePref, uint32(bestHeight), targetAddr,
+			&sweep.WalletSweepConfig{
+				CoinSelectLocker: wallet,
+				UtxoSource:       wallet.WalletController,
+				OutpointLocker:   wallet.WalletController,
+				SweepStore:       r.server.walletSweepStore,
+				PkhWitnessType:   pkhWitnessType,
+				FeeEstimator:     r.server.cc.feeEstimator,
+				MaxFeeRate:       sweep.DefaultMaxFeeRate,
+				Signer:           r.server.cc.signer,
+				NetParams:        activeNetParams.Params,
+			},
 		)
 		if err != nil {
 			return nil, err
 		}
 
+		// Outputs whose key format couldn't be determined are left
+		// in the wallet, so we'll let the operator know about them.
+		for _, utxo := range sweepTxPkg.SkippedOutputs {
+			rpcsLog.Warnf("Sweep all left out wallet output %v "+
+				"of %v, unable to determine its key format",
+				utxo.OutPoint, utxo.Value)
+		}
+
 		rpcsLog.Debugf("Sweeping all coins from wallet to addr=%v, "+
 			"with tx=%v", in.Addr, spew.Sdump(sweepTxPkg.SweepTx))
 
@@ -1038,17 +1136,27 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 			return nil, fmt.Errorf("unable to broadcast sweep "+
 				"transaction: %v", err)
 		}
+		sweepTxPkg.CompleteSweepAttempt()
 
 		sweepTXID := sweepTxPkg.SweepTx.TxHash()
 		txid = &sweepTXID
 	} else {
+		// Based on the passed fee related parameters, we'll determine
+		// an appropriate fee rate for this transaction.
+		feePerKB, _, err := sweep.DetermineFeePerKB(
+			r.server.cc.feeEstimator, feePref,
+			sweep.DefaultMaxFeeRate,
+		)
+		if err != nil {
+			return nil, err
+		}
 
 		// We'll now construct out payment map, and use the wallet's
 		// coin selection synchronization method to ensure that no coin
 		// selection (funding, sweep alls, other sends) can proceed
 		// while we instruct the wallet to send this transaction.
 		paymentMap := map[string]int64{targetAddr.String(): in.Amount}
-		err := wallet.WithCoinSelectLock(func() error {
+		err = wallet.WithCoinSelectLock(func() error {
 			newTXID, err := r.sendCoinsOnChain(paymentMap, feePerKB)
 			if err != nil {
 				return err
@@ -1076,11 +1184,11 @@ func (r *rpcServer) SendMany(ctx context.Context,
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for this transaction.
 	atomsPerKB := lnwallet.AtomPerKByte(in.AtomsPerByte * 1000)
-	feePerKB, err := sweep.DetermineFeePerKB(
+	feePerKB, _, err := sweep.DetermineFeePerKB(
 		r.server.cc.feeEstimator, sweep.FeePreference{
 			ConfTarget: uint32(in.TargetConf),
 			FeeRate:    atomsPerKB,
-		},
+		}, sweep.DefaultMaxFeeRate,
 	)
 	if err != nil {
 		return nil, err
@@ -1448,11 +1556,11 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for the funding transaction.
 	atomsPerKB := lnwallet.AtomPerKByte(in.AtomsPerByte * 1000)
-	feeRate, err := sweep.DetermineFeePerKB(
+	feeRate, _, err := sweep.DetermineFeePerKB(
 		r.server.cc.feeEstimator, sweep.FeePreference{
 			ConfTarget: uint32(in.TargetConf),
 			FeeRate:    atomsPerKB,
-		},
+		}, sweep.DefaultMaxFeeRate,
 	)
 	if err != nil {
 		return err
@@ -1594,11 +1702,11 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for the funding transaction.
 	atomsPerKB := lnwallet.AtomPerKByte(in.AtomsPerByte * 1000)
-	feeRate, err := sweep.DetermineFeePerKB(
+	feeRate, _, err := sweep.DetermineFeePerKB(
 		r.server.cc.feeEstimator, sweep.FeePreference{
 			ConfTarget: uint32(in.TargetConf),
 			FeeRate:    atomsPerKB,
-		},
+		}, sweep.DefaultMaxFeeRate,
 	)
 	if err != nil {
 		return nil, err
@@ -1745,14 +1853,20 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// With the necessary indexes cleaned up, we'll now force close
 		// the channel.
 		chainArbitrator := r.server.chainArb
-		closingTx, err := chainArbitrator.ForceCloseContract(
-			*chanPoint,
+		closeResult, err := chainArbitrator.ForceCloseContract(
+			*chanPoint, channeldb.CloseCauseUserRequest,
 		)
 		if err != nil {
 			rpcsLog.Errorf("unable to force close transaction: %v", err)
 			return err
 		}
 
+		rpcsLog.Infof("Force closed ChannelPoint(%v), %d HTLCs pending, "+
+			"expected resolution within %d blocks", chanPoint,
+			len(closeResult.PendingHTLCs),
+			closeResult.BlocksToResolution)
+
+		closingTx := closeResult.CloseTx
 		closingTxid := closingTx.TxHash()
 
 		// With the transaction broadcast, we send our first update to
@@ -1788,11 +1902,11 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// an appropriate fee rate for the cooperative closure
 		// transaction.
 		atomsPerKB := lnwallet.AtomPerKByte(in.AtomsPerByte * 1000)
-		feeRate, err := sweep.DetermineFeePerKB(
+		feeRate, _, err := sweep.DetermineFeePerKB(
 			r.server.cc.feeEstimator, sweep.FeePreference{
 				ConfTarget: uint32(in.TargetConf),
 				FeeRate:    atomsPerKB,
-			},
+			}, sweep.DefaultMaxFeeRate,
 		)
 		if err != nil {
 			return err
@@ -4606,22 +4720,19 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 	// Convert between the `lnrpc` and `routing` types.
 	routeHints := invoicesrpc.CreateRPCRouteHints(payReq.RouteHints)
 
-	amt := int64(0)
-	if payReq.MilliAt != nil {
-		amt = int64(payReq.MilliAt.ToAtoms())
-	}
+	summary := payReq.Summary()
 
 	dest := payReq.Destination.SerializeCompressed()
 	return &lnrpc.PayReq{
 		Destination:     hex.EncodeToString(dest),
 		PaymentHash:     hex.EncodeToString(payReq.PaymentHash[:]),
-		NumAtoms:        amt,
+		NumAtoms:        int64(summary.Amount.ToAtoms()),
 		Timestamp:       payReq.Timestamp.Unix(),
 		Description:     desc,
 		DescriptionHash: hex.EncodeToString(descHash),
 		FallbackAddr:    fallbackAddr,
 		Expiry:          expiry,
-		CltvExpiry:      int64(payReq.MinFinalCLTVExpiry()),
+		CltvExpiry:      int64(summary.MinFinalCLTVExpiry),
 		RouteHints:      routeHints,
 	}, nil
 }
@@ -211,6 +211,9 @@ type server struct {
 
 	sweeper *sweep.UtxoSweeper
 
+	// walletSweepStore persists in-progress sweeps of all wallet funds.
+	walletSweepStore sweep.WalletSweepStore
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinx *hop.OnionProcessor
@@ -403,6 +406,10 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		quit: make(chan struct{}),
 	}
 
+	// The replay log retains its entries past their expiry for the
+	// configured number of blocks before garbage collecting them.
+	s.sphinx.SetReplayLogRetention(replayLog, cfg.ReplayLogRetention)
+
 	s.witnessBeacon = &preimageBeacon{
 		wCache:      chanDB.NewWitnessCache(),
 		subscribers: make(map[uint64]*preimageSubscriber),
@@ -772,6 +779,12 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		return nil, err
 	}
 
+	s.walletSweepStore, err = sweep.NewWalletSweepStore(chanDB)
+	if err != nil {
+		srvrLog.Errorf("unable to create wallet sweep store: %v", err)
+		return nil, err
+	}
+
 	s.sweeper = sweep.New(&sweep.UtxoSweeperConfig{
 		FeeEstimator:       cc.feeEstimator,
 		GenSweepScript:     newSweepPkScriptGen(cc.wallet),
@@ -818,6 +831,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		ChainHash:              activeNetParams.GenesisHash,
 		IncomingBroadcastDelta: DefaultIncomingBroadcastDelta,
 		OutgoingBroadcastDelta: DefaultOutgoingBroadcastDelta,
+		DanglingBroadcastDelta: cfg.DanglingBroadcastDelta,
+		ForceCloseMaxFeeRate:   lnwallet.AtomPerKByte(cfg.ForceCloseMaxFeeRate),
 		NewSweepAddr:           newSweepPkScriptGen(cc.wallet),
 		PublishTx:              cc.wallet.PublishTransaction,
 		DeliverResolutionMsg: func(msgs ...contractcourt.ResolutionMsg) error {
@@ -889,6 +904,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		Sweeper:             s.sweeper,
 		Registry:            s.invoices,
 		NotifyClosedChannel: s.channelNotifier.NotifyClosedChannelEvent,
+		CoopCloseConfDepth:  cfg.CoopCloseConfDepth,
 	}, chanDB)
 
 	s.breachArbiter = newBreachArbiter(&BreachConfig{
@@ -1079,6 +1095,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB,
 		RejectPush:             cfg.RejectPush,
 		NotifyOpenChannelEvent: s.channelNotifier.NotifyOpenChannelEvent,
 		OpenChannelPredicate:   chanPredicate,
+		MinCommitFeePerKB: lnwallet.AtomPerKByte(
+			cfg.MinCommitFeeRate,
+		),
 	})
 	if err != nil {
 		return nil, err
@@ -1231,6 +1250,17 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+
+		// Rebroadcast any wallet sweeps that were interrupted before
+		// being published, so their outputs aren't reused while the
+		// original sweep may still confirm.
+		err := sweep.ResumeSweep(
+			s.walletSweepStore, s.cc.wallet,
+			s.cc.wallet.PublishTransaction, true,
+		)
+		if err != nil {
+			srvrLog.Errorf("Unable to resume wallet sweeps: %v", err)
+		}
 		if err := s.utxoNursery.Start(); err != nil {
 			startErr = err
 			return
@@ -15,6 +15,7 @@ import (
 	"math/big"
 	"net"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -114,7 +115,9 @@ func TestTLSAutoRegeneration(t *testing.T) {
 
 	// Now let's run getTLSConfig. If it works properly, it should delete
 	// the cert and create a new one.
-	_, _, _, err = getTLSConfig(certPath, keyPath, nil, nil, rpcListeners)
+	_, _, _, err = getTLSConfig(
+		certPath, keyPath, nil, nil, rpcListeners, nil, 0,
+	)
 	if err != nil {
 		t.Fatalf("couldn't retrieve TLS config")
 	}
@@ -138,6 +141,92 @@ func TestTLSAutoRegeneration(t *testing.T) {
 	}
 }
 
+// TestTLSExtraSANsRegeneration tests that a valid TLS certificate pair is
+// regenerated when the configured extra IPs or domains are not covered by
+// it, and is left untouched otherwise.
+func TestTLSExtraSANsRegeneration(t *testing.T) {
+	tempDirPath, err := ioutil.TempDir("", ".testLnd")
+	if err != nil {
+		t.Fatalf("couldn't create temporary cert directory")
+	}
+	defer os.RemoveAll(tempDirPath)
+
+	certPath := tempDirPath + "/tls.cert"
+	keyPath := tempDirPath + "/tls.key"
+
+	rpcListener := net.IPAddr{IP: net.ParseIP("127.0.0.1"), Zone: ""}
+	rpcListeners := []net.Addr{&rpcListener}
+
+	loadCert := func() *x509.Certificate {
+		certData, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			t.Fatalf("couldn't load certificate: %v", err)
+		}
+		cert, err := x509.ParseCertificate(certData.Certificate[0])
+		if err != nil {
+			t.Fatalf("couldn't parse certificate: %v", err)
+		}
+		return cert
+	}
+
+	getConfig := func(extraIPs, extraDomains []string) *x509.Certificate {
+		tlsCfg, _, _, err := getTLSConfig(
+			certPath, keyPath, extraIPs, extraDomains,
+			rpcListeners, nil, 0,
+		)
+		if err != nil {
+			t.Fatalf("couldn't retrieve TLS config: %v", err)
+		}
+
+		// The served certificate must match the one on disk.
+		cert := loadCert()
+		served := tlsCfg.Certificates[0].Certificate[0]
+		if !bytes.Equal(served, cert.Raw) {
+			t.Fatalf("served certificate doesn't match the one " +
+				"on disk")
+		}
+		return cert
+	}
+
+	// The initial run creates a new pair with the configured entries.
+	cert := getConfig([]string{"10.1.2.3"}, []string{"node.example"})
+
+	// Running again with the same, or a subset of the same, entries must
+	// keep the existing certificate.
+	sameCert := getConfig([]string{"10.1.2.3"}, []string{"node.example"})
+	if !bytes.Equal(sameCert.Raw, cert.Raw) {
+		t.Fatalf("certificate regenerated without SAN changes")
+	}
+	sameCert = getConfig(nil, nil)
+	if !bytes.Equal(sameCert.Raw, cert.Raw) {
+		t.Fatalf("certificate regenerated after removing SANs")
+	}
+
+	// Adding a new extra IP must regenerate the pair to include it.
+	newCert := getConfig(
+		[]string{"10.1.2.3", "10.4.5.6"}, []string{"node.example"},
+	)
+	if bytes.Equal(newCert.Raw, cert.Raw) {
+		t.Fatalf("certificate not regenerated after adding an IP")
+	}
+	if !certCoversSANs(
+		newCert, []string{"10.1.2.3", "10.4.5.6"},
+		[]string{"node.example"},
+	) {
+		t.Fatalf("regenerated certificate is missing SANs")
+	}
+
+	// Likewise for a new extra domain.
+	cert = newCert
+	newCert = getConfig(nil, []string{"other.example"})
+	if bytes.Equal(newCert.Raw, cert.Raw) {
+		t.Fatalf("certificate not regenerated after adding a domain")
+	}
+	if !certCoversSANs(newCert, nil, []string{"other.example"}) {
+		t.Fatalf("regenerated certificate is missing SANs")
+	}
+}
+
 // genExpiredCertPair generates an expired key/cert pair to test that expired
 // certificates are being regenerated correctly.
 func genExpiredCertPair(t *testing.T, certDirPath string) ([]byte, []byte) {
@@ -196,3 +285,67 @@ func genExpiredCertPair(t *testing.T, certDirPath string) ([]byte, []byte) {
 
 	return certDerBytes, keyBytes
 }
+
+// TestParseTLSOptions tests parsing of the configurable TLS minimum version
+// and cipher suites.
+func TestParseTLSOptions(t *testing.T) {
+	t.Parallel()
+
+	version, err := parseTLSMinVersion("1.3")
+	if err != nil {
+		t.Fatalf("unable to parse version: %v", err)
+	}
+	if version != tls.VersionTLS13 {
+		t.Fatalf("expected TLS 1.3, got %x", version)
+	}
+	version, err = parseTLSMinVersion("")
+	if err != nil {
+		t.Fatalf("unable to parse version: %v", err)
+	}
+	if version != tls.VersionTLS12 {
+		t.Fatalf("expected default of TLS 1.2, got %x", version)
+	}
+	if _, err := parseTLSMinVersion("1.1"); err == nil {
+		t.Fatal("expected TLS 1.1 to be rejected")
+	}
+
+	// Without any configured suites, the defaults are used.
+	suites, err := parseTLSCipherSuites(nil)
+	if err != nil {
+		t.Fatalf("unable to parse cipher suites: %v", err)
+	}
+	if !reflect.DeepEqual(suites, tlsCipherSuites) {
+		t.Fatalf("expected default cipher suites, got %v", suites)
+	}
+
+	suites, err = parseTLSCipherSuites([]string{
+		"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	})
+	if err != nil {
+		t.Fatalf("unable to parse cipher suites: %v", err)
+	}
+	if len(suites) != 1 ||
+		suites[0] != tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384 {
+
+		t.Fatalf("unexpected cipher suites: %v", suites)
+	}
+
+	_, err = parseTLSCipherSuites([]string{"TLS_UNKNOWN_SUITE"})
+	if err == nil {
+		t.Fatal("expected unknown cipher suite to be rejected")
+	}
+
+	// Suites without an AEAD cipher must be rejected, even though they're
+	// implemented by the Go runtime. This includes the CBC suite that is
+	// only kept in the defaults for older clients.
+	for _, name := range []string{
+		"TLS_RSA_WITH_RC4_128_SHA",
+		"TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA",
+		"TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
+	} {
+		if _, err := parseTLSCipherSuites([]string{name}); err == nil {
+			t.Fatalf("expected cipher suite %v to be rejected",
+				name)
+		}
+	}
+}
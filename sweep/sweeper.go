@@ -432,7 +432,9 @@ func (s *UtxoSweeper) feeRateForPreference(
 		return 0, ErrNoFeePreference
 	}
 
-	feeRate, err := DetermineFeePerKB(s.cfg.FeeEstimator, feePreference)
+	feeRate, _, err := DetermineFeePerKB(
+		s.cfg.FeeEstimator, feePreference, s.cfg.MaxFeeRate,
+	)
 	if err != nil {
 		return 0, err
 	}
@@ -440,10 +442,6 @@ func (s *UtxoSweeper) feeRateForPreference(
 		return 0, fmt.Errorf("fee preference resulted in invalid fee "+
 			"rate %v, mininum is %v", feeRate, s.relayFeeRate)
 	}
-	if feeRate > s.cfg.MaxFeeRate {
-		return 0, fmt.Errorf("fee preference resulted in invalid fee "+
-			"rate %v, maximum is %v", feeRate, s.cfg.MaxFeeRate)
-	}
 
 	return feeRate, nil
 }
@@ -862,6 +860,7 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate lnwallet.AtomPerKByte,
 	// Create sweep tx.
 	tx, err := createSweepTx(
 		inputs, s.currentOutputScript, uint32(currentHeight), feeRate,
+		s.relayFeeRate, s.cfg.MaxFeeRate,
 		s.cfg.Signer, s.cfg.NetParams,
 	)
 	if err != nil {
@@ -1130,7 +1129,9 @@ func (s *UtxoSweeper) handleBumpFeeReq(req *bumpFeeReq,
 func (s *UtxoSweeper) CreateSweepTx(inputs []input.Input, feePref FeePreference,
 	currentBlockHeight uint32) (*wire.MsgTx, error) {
 
-	feePerKB, err := DetermineFeePerKB(s.cfg.FeeEstimator, feePref)
+	feePerKB, _, err := DetermineFeePerKB(
+		s.cfg.FeeEstimator, feePref, s.cfg.MaxFeeRate,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1142,7 +1143,9 @@ func (s *UtxoSweeper) CreateSweepTx(inputs []input.Input, feePref FeePreference,
 	}
 
 	return createSweepTx(
-		inputs, pkScript, currentBlockHeight, feePerKB, s.cfg.Signer, s.cfg.NetParams,
+		inputs, pkScript, currentBlockHeight, feePerKB,
+		s.cfg.FeeEstimator.RelayFeePerKB(), s.cfg.MaxFeeRate,
+		s.cfg.Signer, s.cfg.NetParams,
 	)
 }
 
@@ -159,12 +159,32 @@ func getPositiveYieldInputs(sweepableInputs []input.Input, maxInputs int,
 }
 
 // createSweepTx builds a signed tx spending the inputs to a the output script.
+// If signer is nil, the tx is returned unsigned, leaving the signature script
+// of each input empty.
+//
+// The fee rate is raised to relayFeePerKB if it falls below it, so the tx
+// isn't rejected when broadcast. An error is returned if that would exceed
+// maxFeeRate, unless it is zero.
 func createSweepTx(inputs []input.Input, outputPkScript []byte,
-	currentBlockHeight uint32, feePerKB lnwallet.AtomPerKByte,
-	signer input.Signer, netParams *chaincfg.Params) (*wire.MsgTx, error) {
+	currentBlockHeight uint32, feePerKB, relayFeePerKB,
+	maxFeeRate lnwallet.AtomPerKByte, signer input.Signer,
+	netParams *chaincfg.Params) (*wire.MsgTx, error) {
 
 	inputs, txSize, csvCount, cltvCount := getSizeEstimate(inputs)
 
+	if feePerKB < relayFeePerKB {
+		if maxFeeRate != 0 && relayFeePerKB > maxFeeRate {
+			return nil, fmt.Errorf("min relay fee rate of %v "+
+				"exceeds max fee rate of %v", relayFeePerKB,
+				maxFeeRate)
+		}
+
+		log.Debugf("Raising sweep fee rate of %v to min relay fee "+
+			"rate of %v", feePerKB, relayFeePerKB)
+
+		feePerKB = relayFeePerKB
+	}
+
 	log.Infof("Creating sweep transaction for %v inputs (%v CSV, %v CLTV) "+
 		"using %v atom/kB", len(inputs), csvCount, cltvCount,
 		int64(feePerKB))
@@ -218,6 +238,11 @@ func createSweepTx(inputs []input.Input, outputPkScript []byte,
 		return nil, fmt.Errorf("error checking sweepTx sanity: %v", err)
 	}
 
+	// Without a signer, signing is left to the caller.
+	if signer == nil {
+		return sweepTx, nil
+	}
+
 	// With all the inputs in place, use each output's unique input script
 	// function to generate the final witness required for spending.
 	addInputScript := func(idx int, tso input.Input) error {
@@ -246,6 +271,14 @@ func createSweepTx(inputs []input.Input, outputPkScript []byte,
 		}
 	}
 
+	// As the fee is based on an estimate of the size of the signed tx,
+	// make sure it still pays the min relay fee for its actual size.
+	relayFee := relayFeePerKB.FeeForSize(int64(sweepTx.SerializeSize()))
+	if txFee < relayFee {
+		return nil, fmt.Errorf("sweep tx fee of %v is below min relay "+
+			"fee of %v", txFee, relayFee)
+	}
+
 	return sweepTx, nil
 }
 
@@ -293,6 +326,9 @@ func getInputSigScriptSizeUpperBound(inp input.Input) (int64, error) {
 	case input.PublicKeyHash:
 		return input.P2PKHSigScriptSize, nil
 
+	// A p2pkh signature script for an uncompressed public key.
+	case input.PublicKeyHashUncompressed:
+		return input.P2PKHUncompressedSigScriptSize, nil
 	}
 
 	return 0, fmt.Errorf("unexpected witness type: %v", inp.WitnessType())
@@ -0,0 +1,66 @@
+package sweep
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/lnwallet"
+)
+
+// TestCreateSweepTxRelayFee asserts that the fee rate of a sweep tx is raised
+// to the min relay fee rate when it falls below it, and that the sweep fails
+// if that would exceed the max fee rate.
+func TestCreateSweepTxRelayFee(t *testing.T) {
+	const (
+		numInputs  = 50
+		inputValue = 1000000
+
+		feeRate      = lnwallet.AtomPerKByte(1000)
+		relayFeeRate = lnwallet.FeePerKBFloor
+	)
+
+	// Create a large set of inputs, so the size of the sweep tx makes the
+	// low fee rate fall well short of the min relay fee.
+	inputs := make([]input.Input, 0, numInputs)
+	for i := 0; i < numInputs; i++ {
+		inp := createTestInput(inputValue, input.CommitmentTimeLock)
+		inputs = append(inputs, &inp)
+	}
+
+	sweepTx, err := createSweepTx(
+		inputs, sweepScript, 100, feeRate, relayFeeRate,
+		DefaultMaxFeeRate, &mockSigner{}, chaincfg.TestNet3Params(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create sweep tx: %v", err)
+	}
+
+	if len(sweepTx.TxIn) != numInputs {
+		t.Fatalf("expected %v inputs, got %v", numInputs,
+			len(sweepTx.TxIn))
+	}
+
+	txSize := int64(sweepTx.SerializeSize())
+	relayFee := relayFeeRate.FeeForSize(txSize)
+	if feeRate.FeeForSize(txSize) >= relayFee {
+		t.Fatalf("expected fee at %v to be below the min relay fee",
+			feeRate)
+	}
+
+	txFee := int64(numInputs*inputValue) - sweepTx.TxOut[0].Value
+	if txFee < int64(relayFee) {
+		t.Fatalf("expected fee of at least %v, got %v", relayFee,
+			txFee)
+	}
+
+	// If the max fee rate doesn't allow paying the min relay fee, the
+	// sweep tx can't be created.
+	_, err = createSweepTx(
+		inputs, sweepScript, 100, feeRate, relayFeeRate,
+		relayFeeRate-1, &mockSigner{}, chaincfg.TestNet3Params(),
+	)
+	if err == nil {
+		t.Fatal("expected sweep tx exceeding max fee rate to fail")
+	}
+}
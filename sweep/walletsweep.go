@@ -1,8 +1,10 @@
 package sweep
 
 import (
+	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/decred/dcrd/chaincfg/v2"
 	"github.com/decred/dcrd/dcrutil/v2"
@@ -10,6 +12,7 @@ import (
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/lnwallet"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -19,8 +22,56 @@ const (
 	defaultNumBlocksEstimate = 6
 
 	scriptVersion uint16 = 0
+
+	// feeFloorLogInterval is the minimum interval between log messages
+	// about manual fee rates being raised to the fee rate floor.
+	feeFloorLogInterval = time.Minute
 )
 
+// feeFloorLogLimiter rate limits the log messages about manual fee rates being
+// raised to the fee rate floor, as these would otherwise be logged for every
+// sweep using such a rate.
+var feeFloorLogLimiter = rate.NewLimiter(rate.Every(feeFloorLogInterval), 1)
+
+// ValueConfTarget maps a minimum total input value to the confirmation target
+// to use when sweeping at least that value.
+type ValueConfTarget struct {
+	// MinValue is the smallest total input value for which ConfTarget is
+	// used.
+	MinValue dcrutil.Amount
+
+	// ConfTarget is the number of blocks the sweep should confirm within.
+	ConfTarget uint32
+}
+
+// ConfTargetSchedule is a set of value bands used to select a confirmation
+// target based on the value being swept, so that large sweeps confirm faster
+// than dust. Bands must be sorted by decreasing MinValue.
+type ConfTargetSchedule []ValueConfTarget
+
+// DefaultConfTargetSchedule returns the schedule used to select the conf
+// target of wallet sweeps, unless a different one is configured.
+func DefaultConfTargetSchedule() ConfTargetSchedule {
+	return ConfTargetSchedule{
+		{MinValue: 10 * dcrutil.AtomsPerCoin, ConfTarget: 2},
+		{MinValue: dcrutil.AtomsPerCoin, ConfTarget: 3},
+		{MinValue: dcrutil.AtomsPerCoin / 10, ConfTarget: 4},
+	}
+}
+
+// ConfTargetForValue returns the confirmation target of the first band of the
+// schedule the passed value falls within. Values below all bands use the
+// default conf target.
+func (s ConfTargetSchedule) ConfTargetForValue(value dcrutil.Amount) uint32 {
+	for _, band := range s {
+		if value >= band.MinValue {
+			return band.ConfTarget
+		}
+	}
+
+	return defaultNumBlocksEstimate
+}
+
 // FeePreference allows callers to express their time value for inclusion of a
 // transaction into a block via either a confirmation target, or a fee rate.
 type FeePreference struct {
@@ -44,16 +95,51 @@ func (p FeePreference) String() string {
 // DetermineFeePerKw will determine the fee in atom/KB that should be paid
 // given an estimator, a confirmation target, and a manual value for sat/byte.
 // A value is chosen based on the two free parameters as one, or both of them
-// can be zero.
+// can be zero. Unless maxFeeRate is zero, an explicit fee rate above it is
+// rejected to guard against a fat-fingered manual rate, while an estimated fee
+// rate above it is capped to guard against a fee estimator spike. The returned
+// boolean indicates whether a manual rate was raised to the fee
+// rate floor, so callers can report it as they see fit.
 func DetermineFeePerKB(feeEstimator lnwallet.FeeEstimator,
-	feePref FeePreference) (lnwallet.AtomPerKByte, error) {
+	feePref FeePreference,
+	maxFeeRate lnwallet.AtomPerKByte) (lnwallet.AtomPerKByte, bool, error) {
+
+	feePerKB, floored, err := determineFeePerKB(feeEstimator, feePref)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if maxFeeRate != 0 && feePerKB > maxFeeRate {
+		// An explicit fee rate was chosen by the caller, so we won't
+		// silently pay a different one.
+		if feePref.FeeRate != 0 {
+			return 0, false, fmt.Errorf("fee rate of %d atom/KB "+
+				"exceeds the maximum of %d atom/KB", feePerKB,
+				maxFeeRate)
+		}
+
+		log.Warnf("Fee rate of %d atom/KB for fee preference %v is "+
+			"too high, using %d atom/KB instead", feePerKB,
+			feePref, maxFeeRate)
+
+		feePerKB = maxFeeRate
+	}
+
+	return feePerKB, floored, nil
+}
+
+// determineFeePerKB maps the fee preference to a fee rate, applying the fee
+// rate floor to manual rates. The returned boolean indicates whether the floor
+// was applied.
+func determineFeePerKB(feeEstimator lnwallet.FeeEstimator,
+	feePref FeePreference) (lnwallet.AtomPerKByte, bool, error) {
 
 	switch {
 	// If both values are set, then we'll return an error as we require a
 	// strict directive.
 	case feePref.FeeRate != 0 && feePref.ConfTarget != 0:
-		return 0, fmt.Errorf("only FeeRate or ConfTarget should " +
-			"be set for FeePreferences")
+		return 0, false, fmt.Errorf("only FeeRate or ConfTarget " +
+			"should be set for FeePreferences")
 
 	// If the target number of confirmations is set, then we'll use that to
 	// consult our fee estimator for an adequate fee.
@@ -62,11 +148,11 @@ func DetermineFeePerKB(feeEstimator lnwallet.FeeEstimator,
 			feePref.ConfTarget,
 		)
 		if err != nil {
-			return 0, fmt.Errorf("unable to query fee "+
+			return 0, false, fmt.Errorf("unable to query fee "+
 				"estimator: %v", err)
 		}
 
-		return feePerKw, nil
+		return feePerKw, false, nil
 
 	// If a manual sat/byte fee rate is set, then we'll use that directly.
 	// We'll need to convert it to atom/KB as this is what we use
@@ -74,14 +160,17 @@ func DetermineFeePerKB(feeEstimator lnwallet.FeeEstimator,
 	case feePref.FeeRate != 0:
 		feePerKB := feePref.FeeRate
 		if feePerKB < lnwallet.FeePerKBFloor {
-			log.Infof("Manual fee rate input of %d atom/KB is "+
-				"too low, using %d atom/KB instead", feePerKB,
-				lnwallet.FeePerKBFloor)
-
-			feePerKB = lnwallet.FeePerKBFloor
+			if feeFloorLogLimiter.Allow() {
+				log.Infof("Manual fee rate input of %d "+
+					"atom/KB is too low, using %d atom/KB "+
+					"instead", feePerKB,
+					lnwallet.FeePerKBFloor)
+			}
+
+			return lnwallet.FeePerKBFloor, true, nil
 		}
 
-		return feePerKB, nil
+		return feePerKB, false, nil
 
 	// Otherwise, we'll attempt a relaxed confirmation target for the
 	// transaction
@@ -90,11 +179,11 @@ func DetermineFeePerKB(feeEstimator lnwallet.FeeEstimator,
 			defaultNumBlocksEstimate,
 		)
 		if err != nil {
-			return 0, fmt.Errorf("unable to query fee estimator: "+
-				"%v", err)
+			return 0, false, fmt.Errorf("unable to query fee "+
+				"estimator: %v", err)
 		}
 
-		return feePerKB, nil
+		return feePerKB, false, nil
 	}
 }
 
@@ -139,30 +228,171 @@ type OutpointLocker interface {
 type WalletSweepPackage struct {
 	// SweepTx is a fully signed, and valid transaction that is broadcast,
 	// will sweep ALL confirmed coins in the wallet with a single
-	// transaction.
+	// transaction. If no signer was provided, the transaction is unsigned.
 	SweepTx *wire.MsgTx
 
+	// SignDescs holds the sign descriptor of each input of an unsigned
+	// SweepTx, in input order, allowing an external signer to complete
+	// the transaction. It is nil for signed sweeps.
+	SignDescs []*input.SignDescriptor
+
 	// CancelSweepAttempt allows the caller to cancel the sweep attempt.
 	//
 	// NOTE: If the sweeping transaction isn't or cannot be broadcast, then
 	// this closure MUST be called, otherwise all selected utxos will be
 	// unable to be used.
 	CancelSweepAttempt func()
+
+	// CompleteSweepAttempt must be called once the sweep transaction has
+	// been successfully published. It removes the persisted record of the
+	// sweep, if any, as the wallet now tracks its inputs as spent.
+	CompleteSweepAttempt func()
+
+	// SweptOutputs is the set of wallet outputs spent by the sweep
+	// transaction, recording e.g. the account each input is swept from.
+	SweptOutputs []*lnwallet.Utxo
+
+	// SkippedOutputs is the set of wallet outputs that were left out of
+	// the sweep transaction because the format of the key they pay to
+	// couldn't be determined. These outputs are not locked.
+	SkippedOutputs []*lnwallet.Utxo
+}
+
+// PubKeyHashWitnessTypeFunc returns the witness type required to sweep the
+// given p2pkh wallet output, which is either input.PublicKeyHash or
+// input.PublicKeyHashUncompressed depending on the format of the public key
+// whose hash the output pays to. An error should be returned if the key format
+// can't be determined.
+type PubKeyHashWitnessTypeFunc func(utxo *lnwallet.Utxo) (input.WitnessType,
+	error)
+
+// PubKeyHashWitnessTyper is implemented by wallets that are able to determine
+// the format of the public key their p2pkh outputs pay to.
+type PubKeyHashWitnessTyper interface {
+	// PubKeyHashWitnessType returns the witness type required to sweep
+	// the given p2pkh wallet output.
+	PubKeyHashWitnessType(utxo *lnwallet.Utxo) (input.WitnessType, error)
+}
+
+// UtxoFilterFunc decides whether a wallet output should be included in a
+// sweep.
+type UtxoFilterFunc func(utxo *lnwallet.Utxo) bool
+
+// AddressUtxoFilter returns a UtxoFilterFunc that only accepts outputs paying
+// to one of the given addresses.
+func AddressUtxoFilter(netParams *chaincfg.Params,
+	addrs ...dcrutil.Address) UtxoFilterFunc {
+
+	accepted := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		accepted[addr.String()] = struct{}{}
+	}
+
+	return func(utxo *lnwallet.Utxo) bool {
+		_, utxoAddrs, _, err := txscript.ExtractPkScriptAddrs(
+			scriptVersion, utxo.PkScript, netParams,
+		)
+		if err != nil {
+			return false
+		}
+
+		for _, addr := range utxoAddrs {
+			if _, ok := accepted[addr.String()]; ok {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// AccountUtxoFilter returns a UtxoFilterFunc that only accepts outputs
+// belonging to the given wallet account.
+func AccountUtxoFilter(account uint32) UtxoFilterFunc {
+	return func(utxo *lnwallet.Utxo) bool {
+		return utxo.Account == account
+	}
+}
+
+// WalletSweepConfig houses the wallet dependencies and options used by
+// CraftSweepAllTx to craft a sweep of the outputs of the wallet.
+type WalletSweepConfig struct {
+	// CoinSelectLocker ensures no other coin selection takes place while
+	// the outputs to sweep are fetched and locked.
+	CoinSelectLocker CoinSelectionLocker
+
+	// UtxoSource is the source of the wallet outputs to sweep.
+	UtxoSource UtxoSource
+
+	// UtxoFilter, if non-nil, restricts the sweep to the wallet outputs
+	// it accepts, allowing a partial sweep of e.g. the outputs of a
+	// single address. Outputs it rejects are left untouched.
+	UtxoFilter UtxoFilterFunc
+
+	// OutpointLocker is used to lock the outputs to sweep, so they aren't
+	// used by other transactions while the sweep is being crafted.
+	OutpointLocker OutpointLocker
+
+	// SweepStore, if non-nil, records the sweep transaction before it's
+	// returned, so that a sweep interrupted by a crash or restart before
+	// being published can later be resumed through ResumeSweep.
+	SweepStore WalletSweepStore
+
+	// PkhWitnessType determines the witness type of each p2pkh output.
+	// Outputs for which it fails are skipped and reported within the
+	// returned package rather than producing an unsignable input. If nil,
+	// all p2pkh outputs are assumed to pay to compressed public keys.
+	PkhWitnessType PubKeyHashWitnessTypeFunc
+
+	// FeeEstimator is consulted to determine the fee rate of the sweep
+	// if the fee preference doesn't specify one explicitly.
+	FeeEstimator lnwallet.FeeEstimator
+
+	// MaxFeeRate is the maximum fee rate the sweep transaction may pay.
+	MaxFeeRate lnwallet.AtomPerKByte
+
+	// ConfTargetSchedule selects the conf target of a sweep crafted
+	// without a fee preference, based on the total value being swept. If
+	// nil, DefaultConfTargetSchedule is used.
+	ConfTargetSchedule ConfTargetSchedule
+
+	// Signer signs the inputs of the sweep transaction. If nil, the sweep
+	// transaction is left unsigned and the sign descriptors of its inputs
+	// are returned within the package, so it can be signed externally,
+	// e.g. by an air-gapped signer. The outputs are locked just the same,
+	// but the unsigned transaction isn't persisted within SweepStore, as
+	// it can't be rebroadcast.
+	Signer input.Signer
+
+	// NetParams are the parameters of the network the wallet is on.
+	NetParams *chaincfg.Params
 }
 
 // CraftSweepAllTx attempts to craft a WalletSweepPackage which will allow the
 // caller to sweep ALL outputs within the wallet to a single UTXO, as specified
 // by the delivery address. The sweep transaction will be crafted with the
-// target fee rate, and will use the utxoSource and outpointLocker as sources
-// for wallet funds.
-func CraftSweepAllTx(feeRate lnwallet.AtomPerKByte, blockHeight uint32,
-	deliveryAddr dcrutil.Address, coinSelectLocker CoinSelectionLocker,
-	utxoSource UtxoSource, outpointLocker OutpointLocker,
-	feeEstimator lnwallet.FeeEstimator,
-	signer input.Signer, netParams *chaincfg.Params) (*WalletSweepPackage, error) {
+// fee rate determined by the given fee preference, and will use the wallet
+// dependencies within cfg as sources for wallet funds.
+//
+// If ctx is canceled while the sweep is being crafted, all outputs locked so
+// far are unlocked again and ctx.Err() is returned.
+func CraftSweepAllTx(ctx context.Context, feePref FeePreference,
+	blockHeight uint32, deliveryAddr dcrutil.Address,
+	cfg *WalletSweepConfig) (*WalletSweepPackage, error) {
+
+	// The sweep store and witness type function may be overridden below,
+	// so we'll work with copies to leave the caller's config untouched.
+	sweepStore := cfg.SweepStore
+	pkhWitnessType := cfg.PkhWitnessType
 
 	// TODO(roasbeef): turn off ATPL as well when available?
 
+	// Unless told otherwise, we'll assume all p2pkh outputs pay to a
+	// compressed public key.
+	if pkhWitnessType == nil {
+		pkhWitnessType = compressedPubKeyHash
+	}
+
 	var allOutputs []*lnwallet.Utxo
 
 	// We'll make a function closure up front that allows us to unlock all
@@ -171,30 +401,43 @@ func CraftSweepAllTx(feeRate lnwallet.AtomPerKByte, blockHeight uint32,
 	// can actually craft a sweeping transaction.
 	unlockOutputs := func() {
 		for _, utxo := range allOutputs {
-			outpointLocker.UnlockOutpoint(utxo.OutPoint)
+			cfg.OutpointLocker.UnlockOutpoint(utxo.OutPoint)
 		}
 	}
 
-	// Next, we'll use the coinSelectLocker to ensure that no coin
+	// Next, we'll use the CoinSelectLocker to ensure that no coin
 	// selection takes place while we fetch and lock all outputs the wallet
 	// knows of.  Otherwise, it may be possible for a new funding flow to
 	// lock an output while we fetch the set of unspent witnesses.
-	err := coinSelectLocker.WithCoinSelectLock(func() error {
+	err := cfg.CoinSelectLocker.WithCoinSelectLock(func() error {
 		// Now that we can be sure that no other coin selection
 		// operations are going on, we can grab a clean snapshot of the
 		// current UTXO state of the wallet.
-		utxos, err := utxoSource.ListUnspentWitness(
+		utxos, err := cfg.UtxoSource.ListUnspentWitness(
 			1, math.MaxInt32,
 		)
 		if err != nil {
 			return err
 		}
 
+		// If we were asked to only sweep some of the outputs, we'll
+		// filter the rest out before locking anything, so they remain
+		// untouched.
+		if cfg.UtxoFilter != nil {
+			filtered := make([]*lnwallet.Utxo, 0, len(utxos))
+			for _, utxo := range utxos {
+				if cfg.UtxoFilter(utxo) {
+					filtered = append(filtered, utxo)
+				}
+			}
+			utxos = filtered
+		}
+
 		// We'll now lock each UTXO to ensure that other callers don't
 		// attempt to use these UTXOs in transactions while we're
 		// crafting out sweep all transaction.
 		for _, utxo := range utxos {
-			outpointLocker.LockOutpoint(utxo.OutPoint)
+			cfg.OutpointLocker.LockOutpoint(utxo.OutPoint)
 		}
 
 		allOutputs = append(allOutputs, utxos...)
@@ -210,11 +453,32 @@ func CraftSweepAllTx(feeRate lnwallet.AtomPerKByte, blockHeight uint32,
 			"utxos: %v", err)
 	}
 
+	// Bail out before doing any more work if the caller is no longer
+	// interested in the sweep.
+	if err := ctx.Err(); err != nil {
+		unlockOutputs()
+
+		return nil, err
+	}
+
 	// Now that we've locked all the potential outputs to sweep, we'll
 	// assemble an input for each of them, so we can hand it off to the
 	// sweeper to generate and sign a transaction for us.
-	var inputsToSweep []input.Input
+	var (
+		inputsToSweep  []input.Input
+		sweptOutputs   []*lnwallet.Utxo
+		skippedOutputs []*lnwallet.Utxo
+	)
 	for _, output := range allOutputs {
+		// We'll check for cancellation before each output, as
+		// determining its witness type may take a while for wallets
+		// with many outputs.
+		if err := ctx.Err(); err != nil {
+			unlockOutputs()
+
+			return nil, err
+		}
+
 		// As we'll be signing for outputs under control of the wallet,
 		// we only need to populate the output value and output script.
 		// The rest of the items will be populated internally within
@@ -241,7 +505,16 @@ func CraftSweepAllTx(feeRate lnwallet.AtomPerKByte, blockHeight uint32,
 		// We only support redeeming standard p2pkh outputs for the
 		// moment.
 		case scriptClass == txscript.PubKeyHashTy:
-			witnessType = input.PublicKeyHash
+			var err error
+			witnessType, err = pkhWitnessType(output)
+			if err != nil {
+				log.Warnf("Skipping wallet output %v, unable "+
+					"to determine key format: %v",
+					output.OutPoint, err)
+
+				skippedOutputs = append(skippedOutputs, output)
+				continue
+			}
 
 		// All other output types we count as unknown and will fail to
 		// sweep.
@@ -257,7 +530,15 @@ func CraftSweepAllTx(feeRate lnwallet.AtomPerKByte, blockHeight uint32,
 		// sweeping.
 		input := input.MakeBaseInput(&output.OutPoint, witnessType, signDesc, 0)
 		inputsToSweep = append(inputsToSweep, &input)
+		sweptOutputs = append(sweptOutputs, output)
+	}
+
+	// Any skipped outputs are released right away, as they won't be part
+	// of the sweep transaction.
+	for _, output := range skippedOutputs {
+		cfg.OutpointLocker.UnlockOutpoint(output.OutPoint)
 	}
+	allOutputs = sweptOutputs
 
 	// Next, we'll convert the delivery addr to a pkScript that we can use
 	// to create the sweep transaction.
@@ -268,11 +549,46 @@ func CraftSweepAllTx(feeRate lnwallet.AtomPerKByte, blockHeight uint32,
 		return nil, err
 	}
 
+	// If the caller didn't express a fee preference, we'll pick a conf
+	// target based on the total value being swept, so large sweeps are
+	// confirmed faster.
+	if feePref.ConfTarget == 0 && feePref.FeeRate == 0 {
+		var totalValue dcrutil.Amount
+		for _, output := range allOutputs {
+			totalValue += output.Value
+		}
+		schedule := cfg.ConfTargetSchedule
+		if schedule == nil {
+			schedule = DefaultConfTargetSchedule()
+		}
+		feePref.ConfTarget = schedule.ConfTargetForValue(totalValue)
+	}
+
+	// Determine the fee rate to use for the sweep transaction based on the
+	// fee preference of the caller.
+	feeRate, _, err := DetermineFeePerKB(
+		cfg.FeeEstimator, feePref, cfg.MaxFeeRate,
+	)
+	if err != nil {
+		unlockOutputs()
+
+		return nil, err
+	}
+
+	// Make sure the caller is still interested in the sweep before
+	// crafting and signing the transaction.
+	if err := ctx.Err(); err != nil {
+		unlockOutputs()
+
+		return nil, err
+	}
+
 	// Finally, we'll ask the sweeper to craft a sweep transaction which
 	// respects our fee preference and targets all the UTXOs of the wallet.
 	sweepTx, err := createSweepTx(
-		inputsToSweep, deliveryPkScript, blockHeight, feeRate, signer,
-		netParams,
+		inputsToSweep, deliveryPkScript, blockHeight, feeRate,
+		cfg.FeeEstimator.RelayFeePerKB(), cfg.MaxFeeRate, cfg.Signer,
+		cfg.NetParams,
 	)
 	if err != nil {
 		unlockOutputs()
@@ -280,8 +596,137 @@ func CraftSweepAllTx(feeRate lnwallet.AtomPerKByte, blockHeight uint32,
 		return nil, err
 	}
 
+	// If the sweep is to be signed externally, we'll hand back the sign
+	// descriptor of each input in the order of the transaction.
+	var signDescs []*input.SignDescriptor
+	if cfg.Signer == nil {
+		signDescs = make([]*input.SignDescriptor, len(sweepTx.TxIn))
+		for i, txIn := range sweepTx.TxIn {
+			for _, inp := range inputsToSweep {
+				if *inp.OutPoint() != txIn.PreviousOutPoint {
+					continue
+				}
+
+				signDesc := *inp.SignDesc()
+				signDesc.InputIndex = i
+				signDescs[i] = &signDesc
+				break
+			}
+		}
+
+		// An unsigned sweep can't be rebroadcast by ResumeSweep,
+		// so there's no point in persisting it.
+		sweepStore = nil
+	}
+
+	// Before handing the sweep back to the caller, we'll persist it if
+	// requested, so the locked outputs aren't silently forgotten if we go
+	// down before the sweep is published.
+	if sweepStore != nil {
+		if err := sweepStore.AddWalletSweep(sweepTx); err != nil {
+			unlockOutputs()
+
+			return nil, fmt.Errorf("unable to persist sweep: %v",
+				err)
+		}
+	}
+
+	removeRecord := func() {
+		if sweepStore == nil {
+			return
+		}
+
+		err := sweepStore.RemoveWalletSweep(sweepTx.TxHash())
+		if err != nil {
+			log.Errorf("Unable to remove wallet sweep %v: %v",
+				sweepTx.TxHash(), err)
+		}
+	}
+
 	return &WalletSweepPackage{
-		SweepTx:            sweepTx,
-		CancelSweepAttempt: unlockOutputs,
+		SweepTx:   sweepTx,
+		SignDescs: signDescs,
+		CancelSweepAttempt: func() {
+			unlockOutputs()
+			removeRecord()
+		},
+		CompleteSweepAttempt: removeRecord,
+		SweptOutputs:         allOutputs,
+		SkippedOutputs:       skippedOutputs,
 	}, nil
 }
+
+// ResumeSweep processes all wallet sweeps recorded within sweepStore, which
+// were crafted but not known to have been published, e.g. due to a crash. If
+// rebroadcast is true, each sweep transaction is published again. Sweeps that
+// are published, or whose inputs turn out to already be spent, are forgotten,
+// while the outputs of sweeps that fail to be published remain locked and
+// recorded, and an error is returned. If rebroadcast is false, each sweep is
+// deliberately abandoned instead: its outputs are unlocked and its record
+// removed.
+func ResumeSweep(sweepStore WalletSweepStore, outpointLocker OutpointLocker,
+	publishTx func(*wire.MsgTx) error, rebroadcast bool) error {
+
+	sweepTxs, err := sweepStore.FetchWalletSweeps()
+	if err != nil {
+		return err
+	}
+
+	var publishErr error
+	for _, sweepTx := range sweepTxs {
+		txHash := sweepTx.TxHash()
+
+		if !rebroadcast {
+			log.Infof("Abandoning wallet sweep %v", txHash)
+
+			for _, txIn := range sweepTx.TxIn {
+				outpointLocker.UnlockOutpoint(txIn.PreviousOutPoint)
+			}
+
+			if err := sweepStore.RemoveWalletSweep(txHash); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		log.Infof("Rebroadcasting wallet sweep %v", txHash)
+
+		// Lock the outputs while we attempt to publish the sweep, so
+		// they can't be used elsewhere in the meantime.
+		for _, txIn := range sweepTx.TxIn {
+			outpointLocker.LockOutpoint(txIn.PreviousOutPoint)
+		}
+
+		err := publishTx(sweepTx)
+		switch {
+		// Either the sweep was published, or its inputs have already
+		// been spent, in which case there's nothing left for us to do.
+		case err == nil, err == lnwallet.ErrDoubleSpend:
+			for _, txIn := range sweepTx.TxIn {
+				outpointLocker.UnlockOutpoint(txIn.PreviousOutPoint)
+			}
+
+			if err := sweepStore.RemoveWalletSweep(txHash); err != nil {
+				return err
+			}
+
+		// Otherwise, we'll keep the outputs locked and the sweep
+		// recorded, and continue with the remaining ones.
+		default:
+			log.Errorf("Unable to rebroadcast wallet sweep %v: %v",
+				txHash, err)
+
+			publishErr = fmt.Errorf("unable to rebroadcast wallet "+
+				"sweep %v: %v", txHash, err)
+		}
+	}
+
+	return publishErr
+}
+
+// compressedPubKeyHash is a PubKeyHashWitnessTypeFunc which assumes all p2pkh
+// outputs pay to compressed public keys.
+func compressedPubKeyHash(*lnwallet.Utxo) (input.WitnessType, error) {
+	return input.PublicKeyHash, nil
+}
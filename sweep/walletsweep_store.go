@@ -0,0 +1,122 @@
+package sweep
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// walletSweepsBucketKey is the key that points to a bucket containing
+	// all wallet sweep transactions that were crafted but not yet known to
+	// have been published.
+	//
+	// maps: txHash -> serialized_tx
+	walletSweepsBucketKey = []byte("wallet-sweeps")
+
+	// errNoWalletSweepsBucket is returned when the wallet sweeps bucket
+	// can't be found.
+	errNoWalletSweepsBucket = errors.New("wallet sweeps bucket not found")
+)
+
+// WalletSweepStore persists in-progress wallet sweeps. The outputs locked by
+// a sweep are the inputs of its transaction, so recording the transaction is
+// enough to deal with them deliberately after a restart.
+type WalletSweepStore interface {
+	// AddWalletSweep records the given sweep transaction as in progress.
+	AddWalletSweep(tx *wire.MsgTx) error
+
+	// RemoveWalletSweep removes the record of the sweep transaction with
+	// the given hash, if it exists.
+	RemoveWalletSweep(hash chainhash.Hash) error
+
+	// FetchWalletSweeps returns all sweep transactions currently recorded
+	// as in progress.
+	FetchWalletSweeps() ([]*wire.MsgTx, error)
+}
+
+type walletSweepStore struct {
+	db *channeldb.DB
+}
+
+// NewWalletSweepStore returns a new bolt backed WalletSweepStore.
+func NewWalletSweepStore(db *channeldb.DB) (WalletSweepStore, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(walletSweepsBucketKey)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &walletSweepStore{
+		db: db,
+	}, nil
+}
+
+// AddWalletSweep records the given sweep transaction as in progress.
+func (s *walletSweepStore) AddWalletSweep(sweepTx *wire.MsgTx) error {
+	var b bytes.Buffer
+	if err := sweepTx.Serialize(&b); err != nil {
+		return err
+	}
+
+	txHash := sweepTx.TxHash()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		sweepsBucket := tx.Bucket(walletSweepsBucketKey)
+		if sweepsBucket == nil {
+			return errNoWalletSweepsBucket
+		}
+
+		return sweepsBucket.Put(txHash[:], b.Bytes())
+	})
+}
+
+// RemoveWalletSweep removes the record of the sweep transaction with the
+// given hash, if it exists.
+func (s *walletSweepStore) RemoveWalletSweep(hash chainhash.Hash) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		sweepsBucket := tx.Bucket(walletSweepsBucketKey)
+		if sweepsBucket == nil {
+			return errNoWalletSweepsBucket
+		}
+
+		return sweepsBucket.Delete(hash[:])
+	})
+}
+
+// FetchWalletSweeps returns all sweep transactions currently recorded as in
+// progress.
+func (s *walletSweepStore) FetchWalletSweeps() ([]*wire.MsgTx, error) {
+	var sweepTxs []*wire.MsgTx
+	err := s.db.View(func(tx *bolt.Tx) error {
+		sweepsBucket := tx.Bucket(walletSweepsBucketKey)
+		if sweepsBucket == nil {
+			return errNoWalletSweepsBucket
+		}
+
+		return sweepsBucket.ForEach(func(_, v []byte) error {
+			sweepTx := &wire.MsgTx{}
+			err := sweepTx.Deserialize(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			sweepTxs = append(sweepTxs, sweepTx)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepTxs, nil
+}
+
+// Compile-time constraint to ensure walletSweepStore implements
+// WalletSweepStore.
+var _ WalletSweepStore = (*walletSweepStore)(nil)
@@ -0,0 +1,155 @@
+package sweep
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwallet"
+)
+
+// TestWalletSweepResume asserts that an in-progress wallet sweep is persisted
+// when crafted and can be resumed after a simulated crash, either by
+// rebroadcasting it or by deliberately releasing its outputs.
+func TestWalletSweepResume(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to open channel db: %v", err)
+	}
+	defer cleanUp()
+
+	store, err := NewWalletSweepStore(cdb)
+	if err != nil {
+		t.Fatalf("unable to create wallet sweep store: %v", err)
+	}
+
+	targetUTXOs := testUtxos[:2]
+
+	craftSweep := func() *WalletSweepPackage {
+		t.Helper()
+
+		utxoSource := newMockUtxoSource(targetUTXOs)
+		sweepPkg, err := CraftSweepAllTx(
+			context.Background(), FeePreference{},
+			100, deliveryAddr,
+			&WalletSweepConfig{
+				CoinSelectLocker: &mockCoinSelectionLocker{},
+				UtxoSource:       utxoSource,
+				OutpointLocker:   newMockOutpointLocker(),
+				SweepStore:       store,
+				FeeEstimator:     newMockFeeEstimator(0, 0),
+				MaxFeeRate:       DefaultMaxFeeRate,
+				Signer:           &mockSigner{},
+				NetParams:        chaincfg.TestNet3Params(),
+			},
+		)
+		if err != nil {
+			t.Fatalf("unable to make sweep tx: %v", err)
+		}
+
+		return sweepPkg
+	}
+
+	assertNumSweeps := func(num int) []*wire.MsgTx {
+		t.Helper()
+
+		sweepTxs, err := store.FetchWalletSweeps()
+		if err != nil {
+			t.Fatalf("unable to fetch wallet sweeps: %v", err)
+		}
+		if len(sweepTxs) != num {
+			t.Fatalf("expected %v wallet sweeps, got %v", num,
+				len(sweepTxs))
+		}
+
+		return sweepTxs
+	}
+
+	// Crafting a sweep should persist it, and both cancelling and
+	// completing the attempt should remove it again.
+	sweepPkg := craftSweep()
+	assertNumSweeps(1)
+	sweepPkg.CancelSweepAttempt()
+	assertNumSweeps(0)
+
+	sweepPkg = craftSweep()
+	assertNumSweeps(1)
+	sweepPkg.CompleteSweepAttempt()
+	assertNumSweeps(0)
+
+	// Now we'll craft a sweep and "crash" before publishing it. The
+	// recorded sweep should match the one we crafted.
+	sweepPkg = craftSweep()
+	sweepTxs := assertNumSweeps(1)
+	if sweepTxs[0].TxHash() != sweepPkg.SweepTx.TxHash() {
+		t.Fatalf("unexpected sweep tx recorded")
+	}
+
+	// Upon restart, the locks are gone. Attempting to rebroadcast the
+	// sweep with a failing backend should lock its outputs again and keep
+	// it recorded.
+	utxoLocker := newMockOutpointLocker()
+	err = ResumeSweep(
+		store, utxoLocker, func(*wire.MsgTx) error {
+			return errors.New("backend unavailable")
+		}, true,
+	)
+	if err == nil {
+		t.Fatalf("expected rebroadcast failure")
+	}
+	assertUtxosLocked(t, utxoLocker, targetUTXOs)
+	assertNoUtxosUnlocked(t, utxoLocker, targetUTXOs)
+	assertNumSweeps(1)
+
+	// Once the backend accepts the sweep, it should be forgotten.
+	var published []*wire.MsgTx
+	utxoLocker = newMockOutpointLocker()
+	err = ResumeSweep(
+		store, utxoLocker, func(tx *wire.MsgTx) error {
+			published = append(published, tx)
+			return nil
+		}, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to resume sweep: %v", err)
+	}
+	if len(published) != 1 ||
+		published[0].TxHash() != sweepPkg.SweepTx.TxHash() {
+
+		t.Fatalf("expected sweep tx to be rebroadcast")
+	}
+	assertNumSweeps(0)
+
+	// A sweep whose inputs have already been spent should also be
+	// forgotten.
+	craftSweep()
+	err = ResumeSweep(
+		store, newMockOutpointLocker(), func(*wire.MsgTx) error {
+			return lnwallet.ErrDoubleSpend
+		}, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to resume sweep: %v", err)
+	}
+	assertNumSweeps(0)
+
+	// Finally, a sweep can be abandoned deliberately, which releases its
+	// outputs without publishing it.
+	craftSweep()
+	utxoLocker = newMockOutpointLocker()
+	err = ResumeSweep(
+		store, utxoLocker, func(*wire.MsgTx) error {
+			t.Fatalf("abandoned sweep must not be published")
+			return nil
+		}, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to abandon sweep: %v", err)
+	}
+	assertUtxosUnlocked(t, utxoLocker, targetUTXOs)
+	assertNumSweeps(0)
+}
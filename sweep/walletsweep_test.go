@@ -2,6 +2,8 @@ package sweep
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrd/txscript/v2"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/lnwallet"
 )
 
@@ -29,6 +32,11 @@ func TestDetermineFeePerKw(t *testing.T) {
 	feeEstimator.blocksToFee[50] = 30000
 	feeEstimator.blocksToFee[defaultNumBlocksEstimate] = 20000
 
+	// We'll also populate a conf target which maps to a fee rate above
+	// our maximum to ensure it's properly capped.
+	maxFeeRate := lnwallet.AtomPerKByte(200000)
+	feeEstimator.blocksToFee[2] = maxFeeRate * 10
+
 	testCases := []struct {
 		// feePref is the target fee preference for this case.
 		feePref FeePreference
@@ -37,6 +45,10 @@ func TestDetermineFeePerKw(t *testing.T) {
 		// the FeePreference above
 		fee lnwallet.AtomPerKByte
 
+		// floored indicates whether the fee rate is expected to be
+		// raised to the fee rate floor.
+		floored bool
+
 		// fail determines if this test case should fail or not.
 		fail bool
 	}{
@@ -45,6 +57,15 @@ func TestDetermineFeePerKw(t *testing.T) {
 			feePref: FeePreference{
 				FeeRate: lnwallet.AtomPerKByte(99),
 			},
+			fee:     lnwallet.FeePerKBFloor,
+			floored: true,
+		},
+
+		// A fee rate exactly at the floor isn't raised.
+		{
+			feePref: FeePreference{
+				FeeRate: lnwallet.FeePerKBFloor,
+			},
 			fee: lnwallet.FeePerKBFloor,
 		},
 
@@ -57,6 +78,31 @@ func TestDetermineFeePerKw(t *testing.T) {
 			fee: 90000,
 		},
 
+		// A fee rate exactly at the maximum should pass through.
+		{
+			feePref: FeePreference{
+				FeeRate: maxFeeRate,
+			},
+			fee: maxFeeRate,
+		},
+
+		// An explicit fee rate above the maximum should be rejected.
+		{
+			feePref: FeePreference{
+				FeeRate: maxFeeRate + 1,
+			},
+			fail: true,
+		},
+
+		// An estimated fee rate above the maximum should also be
+		// capped.
+		{
+			feePref: FeePreference{
+				ConfTarget: 2,
+			},
+			fee: maxFeeRate,
+		},
+
 		// A specified confirmation target should cause the function to
 		// query the estimator which will return our value specified
 		// above.
@@ -86,8 +132,8 @@ func TestDetermineFeePerKw(t *testing.T) {
 		},
 	}
 	for i, testCase := range testCases {
-		targetFee, err := DetermineFeePerKB(
-			feeEstimator, testCase.feePref,
+		targetFee, floored, err := DetermineFeePerKB(
+			feeEstimator, testCase.feePref, maxFeeRate,
 		)
 		switch {
 		case testCase.fail && err != nil:
@@ -104,6 +150,11 @@ func TestDetermineFeePerKw(t *testing.T) {
 			t.Fatalf("#%v: wrong fee: expected %v got %v", i,
 				testCase.fee, targetFee)
 		}
+
+		if floored != testCase.floored {
+			t.Fatalf("#%v: expected floored=%v, got %v", i,
+				testCase.floored, floored)
+		}
 	}
 }
 
@@ -286,8 +337,14 @@ func TestCraftSweepAllTxCoinSelectFail(t *testing.T) {
 	utxoLocker := newMockOutpointLocker()
 
 	_, err := CraftSweepAllTx(
-		0, 100, nil, coinSelectLocker, utxoSource, utxoLocker, nil, nil,
-		chaincfg.TestNet3Params(),
+		context.Background(), FeePreference{}, 100, nil,
+		&WalletSweepConfig{
+			CoinSelectLocker: coinSelectLocker,
+			UtxoSource:       utxoSource,
+			OutpointLocker:   utxoLocker,
+			MaxFeeRate:       DefaultMaxFeeRate,
+			NetParams:        chaincfg.TestNet3Params(),
+		},
 	)
 
 	// Since we instructed the coin select locker to fail above, we should
@@ -312,8 +369,14 @@ func TestCraftSweepAllTxUnknownWitnessType(t *testing.T) {
 	utxoLocker := newMockOutpointLocker()
 
 	_, err := CraftSweepAllTx(
-		0, 100, nil, coinSelectLocker, utxoSource, utxoLocker, nil, nil,
-		chaincfg.TestNet3Params(),
+		context.Background(), FeePreference{}, 100, nil,
+		&WalletSweepConfig{
+			CoinSelectLocker: coinSelectLocker,
+			UtxoSource:       utxoSource,
+			OutpointLocker:   utxoLocker,
+			MaxFeeRate:       DefaultMaxFeeRate,
+			NetParams:        chaincfg.TestNet3Params(),
+		},
 	)
 
 	// Since passed in a p2wsh output, which is unknown, we should fail to
@@ -347,8 +410,16 @@ func TestCraftSweepAllTx(t *testing.T) {
 	utxoLocker := newMockOutpointLocker()
 
 	sweepPkg, err := CraftSweepAllTx(
-		0, 100, deliveryAddr, coinSelectLocker, utxoSource, utxoLocker,
-		feeEstimator, signer, chaincfg.TestNet3Params(),
+		context.Background(), FeePreference{}, 100, deliveryAddr,
+		&WalletSweepConfig{
+			CoinSelectLocker: coinSelectLocker,
+			UtxoSource:       utxoSource,
+			OutpointLocker:   utxoLocker,
+			FeeEstimator:     feeEstimator,
+			MaxFeeRate:       DefaultMaxFeeRate,
+			Signer:           signer,
+			NetParams:        chaincfg.TestNet3Params(),
+		},
 	)
 	if err != nil {
 		t.Fatalf("unable to make sweep tx: %v", err)
@@ -382,8 +453,8 @@ func TestCraftSweepAllTx(t *testing.T) {
 			expectedSweepValue, output.Value)
 
 	case !bytes.Equal(sweepScript, output.PkScript):
-		t.Fatalf("expected %x sweep script, instead got %x", sweepScript,
-			output.PkScript)
+		t.Fatalf("expected %x sweep script, instead got %x",
+			sweepScript, output.PkScript)
 	}
 
 	// If we cancel the sweep attempt, then we should find that all the
@@ -391,3 +462,596 @@ func TestCraftSweepAllTx(t *testing.T) {
 	sweepPkg.CancelSweepAttempt()
 	assertUtxosUnlocked(t, utxoLocker, testUtxos[:2])
 }
+
+// TestCraftSweepAllTxUnsigned tests that without a signer, the sweep
+// transaction is left unsigned and the sign descriptors of its inputs are
+// returned, while the outputs are still locked until the attempt is canceled.
+func TestCraftSweepAllTxUnsigned(t *testing.T) {
+	t.Parallel()
+
+	feeEstimator := newMockFeeEstimator(0, 0)
+
+	targetUTXOs := testUtxos[:2]
+	utxoSource := newMockUtxoSource(targetUTXOs)
+	coinSelectLocker := &mockCoinSelectionLocker{}
+	utxoLocker := newMockOutpointLocker()
+
+	sweepPkg, err := CraftSweepAllTx(
+		context.Background(), FeePreference{}, 100, deliveryAddr,
+		&WalletSweepConfig{
+			CoinSelectLocker: coinSelectLocker,
+			UtxoSource:       utxoSource,
+			OutpointLocker:   utxoLocker,
+			FeeEstimator:     feeEstimator,
+			MaxFeeRate:       DefaultMaxFeeRate,
+			NetParams:        chaincfg.TestNet3Params(),
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to make sweep tx: %v", err)
+	}
+
+	assertUtxosLocked(t, utxoLocker, targetUTXOs)
+	assertNoUtxosUnlocked(t, utxoLocker, targetUTXOs)
+
+	// Each input should spend one of our outputs without a signature
+	// script, and come with a matching sign descriptor.
+	sweepTx := sweepPkg.SweepTx
+	if len(sweepTx.TxIn) != len(targetUTXOs) {
+		t.Fatalf("expected %v inputs, got %v", len(targetUTXOs),
+			len(sweepTx.TxIn))
+	}
+	if len(sweepPkg.SignDescs) != len(sweepTx.TxIn) {
+		t.Fatalf("expected %v sign descriptors, got %v",
+			len(sweepTx.TxIn), len(sweepPkg.SignDescs))
+	}
+
+	utxos := make(map[wire.OutPoint]*lnwallet.Utxo)
+	for _, utxo := range targetUTXOs {
+		utxos[utxo.OutPoint] = utxo
+	}
+	for i, txIn := range sweepTx.TxIn {
+		if len(txIn.SignatureScript) != 0 {
+			t.Fatalf("input %v has a signature script", i)
+		}
+
+		utxo, ok := utxos[txIn.PreviousOutPoint]
+		if !ok {
+			t.Fatalf("input %v spends unknown outpoint %v", i,
+				txIn.PreviousOutPoint)
+		}
+		delete(utxos, txIn.PreviousOutPoint)
+
+		signDesc := sweepPkg.SignDescs[i]
+		switch {
+		case signDesc.InputIndex != i:
+			t.Fatalf("expected input index %v, got %v", i,
+				signDesc.InputIndex)
+
+		case signDesc.Output.Value != int64(utxo.Value):
+			t.Fatalf("expected value %v, got %v", utxo.Value,
+				signDesc.Output.Value)
+
+		case !bytes.Equal(signDesc.Output.PkScript, utxo.PkScript):
+			t.Fatalf("expected pkScript %x, got %x", utxo.PkScript,
+				signDesc.Output.PkScript)
+		}
+	}
+
+	// The single output should sweep the full value to our script.
+	if len(sweepTx.TxOut) != 1 {
+		t.Fatalf("should have %v outputs, instead have %v", 1,
+			len(sweepTx.TxOut))
+	}
+	output := sweepTx.TxOut[0]
+	switch {
+	case output.Value != 3000:
+		t.Fatalf("expected %v sweep value, instead got %v", 3000,
+			output.Value)
+
+	case !bytes.Equal(sweepScript, output.PkScript):
+		t.Fatalf("expected %x sweep script, instead got %x",
+			sweepScript, output.PkScript)
+	}
+
+	sweepPkg.CancelSweepAttempt()
+	assertUtxosUnlocked(t, utxoLocker, targetUTXOs)
+}
+
+// TestCraftSweepAllTxConfTarget tests that a sweep transaction can be crafted
+// using a fee preference expressed as a confirmation target, in which case the
+// fee estimator is consulted for the fee rate.
+func TestCraftSweepAllTxConfTarget(t *testing.T) {
+	t.Parallel()
+
+	const confTarget = 3
+
+	// We'll use a zero fee rate by default, and only return a non-zero
+	// fee rate for our target number of confirmations, so we can assert
+	// that the conf target was used.
+	signer := &mockSigner{}
+	feeEstimator := newMockFeeEstimator(0, 0)
+	feeEstimator.blocksToFee[confTarget] = 1e3
+
+	targetUTXOs := testUtxos[:2]
+	utxoSource := newMockUtxoSource(targetUTXOs)
+	coinSelectLocker := &mockCoinSelectionLocker{}
+	utxoLocker := newMockOutpointLocker()
+
+	sweepPkg, err := CraftSweepAllTx(
+		context.Background(), FeePreference{ConfTarget: confTarget},
+		100, deliveryAddr,
+		&WalletSweepConfig{
+			CoinSelectLocker: coinSelectLocker,
+			UtxoSource:       utxoSource,
+			OutpointLocker:   utxoLocker,
+			FeeEstimator:     feeEstimator,
+			MaxFeeRate:       DefaultMaxFeeRate,
+			Signer:           signer,
+			NetParams:        chaincfg.TestNet3Params(),
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to make sweep tx: %v", err)
+	}
+
+	// The sweep output should pay less than the total input value, as a
+	// fee is now being paid.
+	sweepTx := sweepPkg.SweepTx
+	if len(sweepTx.TxOut) != 1 {
+		t.Fatalf("should have %v outputs, instead have %v", 1,
+			len(sweepTx.TxOut))
+	}
+	if sweepTx.TxOut[0].Value >= 3000 {
+		t.Fatalf("expected sweep to pay a fee, got sweep value %v",
+			sweepTx.TxOut[0].Value)
+	}
+	sweepPkg.CancelSweepAttempt()
+
+	// Specifying both a fee rate and a conf target is invalid, and all
+	// outputs should be unlocked again.
+	utxoLocker = newMockOutpointLocker()
+	_, err = CraftSweepAllTx(
+		context.Background(),
+		FeePreference{ConfTarget: confTarget, FeeRate: 1e4}, 100,
+		deliveryAddr,
+		&WalletSweepConfig{
+			CoinSelectLocker: coinSelectLocker,
+			UtxoSource:       utxoSource,
+			OutpointLocker:   utxoLocker,
+			FeeEstimator:     feeEstimator,
+			MaxFeeRate:       DefaultMaxFeeRate,
+			Signer:           signer,
+			NetParams:        chaincfg.TestNet3Params(),
+		},
+	)
+	if err == nil {
+		t.Fatalf("sweep tx should have failed")
+	}
+	assertUtxosLockedAndUnlocked(t, utxoLocker, targetUTXOs)
+}
+
+// TestCraftSweepAllTxUtxoFilter tests that only the wallet outputs accepted by
+// the utxo filter are locked and swept, and that cancelling the sweep unlocks
+// exactly those outputs.
+func TestCraftSweepAllTxUtxoFilter(t *testing.T) {
+	t.Parallel()
+
+	signer := &mockSigner{}
+	feeEstimator := newMockFeeEstimator(0, 0)
+
+	// The wallet holds two outputs paying to different addresses, of
+	// which we'll only sweep the second one.
+	walletUTXOs := testUtxos[:2]
+	sweptUTXO := walletUTXOs[1]
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		0, sweptUTXO.PkScript, chaincfg.TestNet3Params(),
+	)
+	if err != nil {
+		t.Fatalf("unable to extract address: %v", err)
+	}
+	filter := AddressUtxoFilter(chaincfg.TestNet3Params(), addrs[0])
+
+	utxoSource := newMockUtxoSource(walletUTXOs)
+	coinSelectLocker := &mockCoinSelectionLocker{}
+	utxoLocker := newMockOutpointLocker()
+
+	sweepPkg, err := CraftSweepAllTx(
+		context.Background(), FeePreference{}, 100, deliveryAddr,
+		&WalletSweepConfig{
+			CoinSelectLocker: coinSelectLocker,
+			UtxoSource:       utxoSource,
+			UtxoFilter:       filter,
+			OutpointLocker:   utxoLocker,
+			FeeEstimator:     feeEstimator,
+			MaxFeeRate:       DefaultMaxFeeRate,
+			Signer:           signer,
+			NetParams:        chaincfg.TestNet3Params(),
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to make sweep tx: %v", err)
+	}
+
+	// Only the filtered output should have been locked and swept.
+	if len(utxoLocker.lockedOutpoints) != 1 {
+		t.Fatalf("expected 1 locked output, got %v",
+			len(utxoLocker.lockedOutpoints))
+	}
+	assertUtxosLocked(t, utxoLocker, []*lnwallet.Utxo{sweptUTXO})
+
+	sweepTx := sweepPkg.SweepTx
+	if len(sweepTx.TxIn) != 1 {
+		t.Fatalf("expected 1 input, got %v", len(sweepTx.TxIn))
+	}
+	if sweepTx.TxIn[0].PreviousOutPoint != sweptUTXO.OutPoint {
+		t.Fatalf("expected input %v, got %v", sweptUTXO.OutPoint,
+			sweepTx.TxIn[0].PreviousOutPoint)
+	}
+	if sweepTx.TxOut[0].Value != int64(sweptUTXO.Value) {
+		t.Fatalf("expected output value %v, got %v", sweptUTXO.Value,
+			sweepTx.TxOut[0].Value)
+	}
+
+	// Cancelling the sweep should unlock exactly the swept output.
+	sweepPkg.CancelSweepAttempt()
+	if len(utxoLocker.unlockedOutpoints) != 1 {
+		t.Fatalf("expected 1 unlocked output, got %v",
+			len(utxoLocker.unlockedOutpoints))
+	}
+	assertUtxosUnlocked(t, utxoLocker, []*lnwallet.Utxo{sweptUTXO})
+}
+
+// TestCraftSweepAllTxAccountFilter tests that a sweep restricted to a wallet
+// account only locks and spends the outputs of that account, and that the
+// swept outputs record the account they're swept from.
+func TestCraftSweepAllTxAccountFilter(t *testing.T) {
+	t.Parallel()
+
+	const sweptAccount = 1
+
+	signer := &mockSigner{}
+	feeEstimator := newMockFeeEstimator(0, 0)
+
+	// The wallet holds outputs across two accounts, of which we'll only
+	// sweep those of the second one.
+	walletUTXOs := make([]*lnwallet.Utxo, 0, 4)
+	for i, account := range []uint32{0, sweptAccount, 0, sweptAccount} {
+		utxo := *testUtxos[i%2]
+		utxo.OutPoint.Index = uint32(i + 10)
+		utxo.Account = account
+		walletUTXOs = append(walletUTXOs, &utxo)
+	}
+	sweptUTXOs := []*lnwallet.Utxo{walletUTXOs[1], walletUTXOs[3]}
+
+	utxoSource := newMockUtxoSource(walletUTXOs)
+	coinSelectLocker := &mockCoinSelectionLocker{}
+	utxoLocker := newMockOutpointLocker()
+
+	sweepPkg, err := CraftSweepAllTx(
+		context.Background(), FeePreference{}, 100, deliveryAddr,
+		&WalletSweepConfig{
+			CoinSelectLocker: coinSelectLocker,
+			UtxoSource:       utxoSource,
+			UtxoFilter:       AccountUtxoFilter(sweptAccount),
+			OutpointLocker:   utxoLocker,
+			FeeEstimator:     feeEstimator,
+			MaxFeeRate:       DefaultMaxFeeRate,
+			Signer:           signer,
+			NetParams:        chaincfg.TestNet3Params(),
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to make sweep tx: %v", err)
+	}
+
+	// Only the outputs of the swept account should have been locked.
+	if len(utxoLocker.lockedOutpoints) != len(sweptUTXOs) {
+		t.Fatalf("expected %v locked outputs, got %v", len(sweptUTXOs),
+			len(utxoLocker.lockedOutpoints))
+	}
+	assertUtxosLocked(t, utxoLocker, sweptUTXOs)
+
+	sweepTx := sweepPkg.SweepTx
+	if len(sweepTx.TxIn) != len(sweptUTXOs) {
+		t.Fatalf("expected %v inputs, got %v", len(sweptUTXOs),
+			len(sweepTx.TxIn))
+	}
+	for _, txIn := range sweepTx.TxIn {
+		idx := txIn.PreviousOutPoint.Index
+		if idx != sweptUTXOs[0].Index && idx != sweptUTXOs[1].Index {
+			t.Fatalf("unexpected input %v", txIn.PreviousOutPoint)
+		}
+	}
+
+	// Each swept output should be attributed to the swept account.
+	if len(sweepPkg.SweptOutputs) != len(sweptUTXOs) {
+		t.Fatalf("expected %v swept outputs, got %v", len(sweptUTXOs),
+			len(sweepPkg.SweptOutputs))
+	}
+	for _, utxo := range sweepPkg.SweptOutputs {
+		if utxo.Account != sweptAccount {
+			t.Fatalf("expected output %v of account %v, got %v",
+				utxo.OutPoint, sweptAccount, utxo.Account)
+		}
+	}
+
+	sweepPkg.CancelSweepAttempt()
+	assertUtxosUnlocked(t, utxoLocker, sweptUTXOs)
+}
+
+// TestCraftSweepAllTxCancel tests that canceling the context while the inputs
+// of the sweep are being assembled aborts the sweep and unlocks all outputs.
+func TestCraftSweepAllTxCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// We'll cancel the context while determining the witness type of the
+	// first output, so the sweep is aborted before reaching the second
+	// one.
+	var numResolved int
+	pkhWitnessType := func(*lnwallet.Utxo) (input.WitnessType, error) {
+		numResolved++
+		cancel()
+		return input.PublicKeyHash, nil
+	}
+
+	targetUTXOs := testUtxos[:2]
+	utxoSource := newMockUtxoSource(targetUTXOs)
+	coinSelectLocker := &mockCoinSelectionLocker{}
+	utxoLocker := newMockOutpointLocker()
+
+	_, err := CraftSweepAllTx(
+		ctx, FeePreference{}, 100, deliveryAddr,
+		&WalletSweepConfig{
+			CoinSelectLocker: coinSelectLocker,
+			UtxoSource:       utxoSource,
+			OutpointLocker:   utxoLocker,
+			PkhWitnessType:   pkhWitnessType,
+			FeeEstimator:     newMockFeeEstimator(0, 0),
+			MaxFeeRate:       DefaultMaxFeeRate,
+			Signer:           &mockSigner{},
+			NetParams:        chaincfg.TestNet3Params(),
+		},
+	)
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if numResolved != 1 {
+		t.Fatalf("expected sweep to be aborted after 1 output, "+
+			"resolved %v", numResolved)
+	}
+
+	// All the outputs locked for the sweep must have been released.
+	assertUtxosLockedAndUnlocked(t, utxoLocker, targetUTXOs)
+}
+
+// TestConfTargetForValue tests that the conf target is selected based on the
+// value band the swept value falls within.
+func TestConfTargetForValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value      dcrutil.Amount
+		confTarget uint32
+	}{
+		{value: 0, confTarget: defaultNumBlocksEstimate},
+		{value: 3000, confTarget: defaultNumBlocksEstimate},
+		{value: 1e7 - 1, confTarget: defaultNumBlocksEstimate},
+		{value: 1e7, confTarget: 4},
+		{value: 5e7, confTarget: 4},
+		{value: 1e8, confTarget: 3},
+		{value: 9e8, confTarget: 3},
+		{value: 1e9, confTarget: 2},
+		{value: 21e14, confTarget: 2},
+	}
+
+	schedule := DefaultConfTargetSchedule()
+	for _, testCase := range testCases {
+		confTarget := schedule.ConfTargetForValue(testCase.value)
+		if confTarget != testCase.confTarget {
+			t.Fatalf("expected conf target %v for value %v, got %v",
+				testCase.confTarget, testCase.value, confTarget)
+		}
+	}
+
+	// A custom schedule is consulted in order.
+	schedule = ConfTargetSchedule{
+		{MinValue: 500, ConfTarget: 1},
+		{MinValue: 100, ConfTarget: 10},
+	}
+	if confTarget := schedule.ConfTargetForValue(499); confTarget != 10 {
+		t.Fatalf("expected conf target 10, got %v", confTarget)
+	}
+	if confTarget := schedule.ConfTargetForValue(500); confTarget != 1 {
+		t.Fatalf("expected conf target 1, got %v", confTarget)
+	}
+	if confTarget := schedule.ConfTargetForValue(99); confTarget !=
+		defaultNumBlocksEstimate {
+
+		t.Fatalf("expected default conf target, got %v", confTarget)
+	}
+}
+
+// TestCraftSweepAllTxValueConfTarget tests that a sweep crafted without an
+// explicit fee preference uses the conf target matching the swept value.
+func TestCraftSweepAllTxValueConfTarget(t *testing.T) {
+	t.Parallel()
+
+	// Sweep two outputs worth a total of 1.5 DCR, which should map to a
+	// conf target of 3 blocks.
+	bigUtxo := *testUtxos[0]
+	bigUtxo.Value = 1e8
+	otherUtxo := *testUtxos[1]
+	otherUtxo.Value = 5e7
+	targetUTXOs := []*lnwallet.Utxo{&bigUtxo, &otherUtxo}
+
+	var usedConfTarget uint32
+	feeEstimator := newMockFeeEstimator(0, 0)
+	feeEstimator.estimateFeePerKW = func(
+		numBlocks uint32) (lnwallet.AtomPerKByte, error) {
+
+		usedConfTarget = numBlocks
+		return 0, nil
+	}
+
+	craftSweep := func(schedule ConfTargetSchedule) {
+		t.Helper()
+
+		utxoSource := newMockUtxoSource(targetUTXOs)
+		sweepPkg, err := CraftSweepAllTx(
+			context.Background(), FeePreference{}, 100,
+			deliveryAddr, &WalletSweepConfig{
+				CoinSelectLocker:   &mockCoinSelectionLocker{},
+				UtxoSource:         utxoSource,
+				OutpointLocker:     newMockOutpointLocker(),
+				FeeEstimator:       feeEstimator,
+				MaxFeeRate:         DefaultMaxFeeRate,
+				ConfTargetSchedule: schedule,
+				Signer:             &mockSigner{},
+				NetParams:          chaincfg.TestNet3Params(),
+			},
+		)
+		if err != nil {
+			t.Fatalf("unable to make sweep tx: %v", err)
+		}
+		sweepPkg.CancelSweepAttempt()
+	}
+
+	// Without a configured schedule, the default one is used.
+	craftSweep(nil)
+	if usedConfTarget != 3 {
+		t.Fatalf("expected conf target 3, got %v", usedConfTarget)
+	}
+
+	// A configured schedule takes precedence over the default one.
+	craftSweep(ConfTargetSchedule{
+		{MinValue: dcrutil.AtomsPerCoin, ConfTarget: 6},
+	})
+	if usedConfTarget != 6 {
+		t.Fatalf("expected conf target 6, got %v", usedConfTarget)
+	}
+}
+
+// TestCraftSweepAllTxKeyFormat tests that the witness type of p2pkh outputs is
+// determined per output through the passed resolver, and that outputs whose
+// key format can't be determined are skipped and reported.
+func TestCraftSweepAllTxKeyFormat(t *testing.T) {
+	t.Parallel()
+
+	const (
+		confTarget = 3
+		feeRate    = lnwallet.AtomPerKByte(1e3)
+	)
+
+	signer := &mockSigner{}
+	feeEstimator := newMockFeeEstimator(0, 0)
+	feeEstimator.blocksToFee[confTarget] = feeRate
+	feePref := FeePreference{ConfTarget: confTarget}
+
+	targetUTXOs := testUtxos[:2]
+	uncompressedUTXO := targetUTXOs[1]
+
+	craftSweep := func(pkhWitnessType PubKeyHashWitnessTypeFunc) (
+		*WalletSweepPackage, *mockOutpointLocker) {
+
+		utxoSource := newMockUtxoSource(targetUTXOs)
+		coinSelectLocker := &mockCoinSelectionLocker{}
+		utxoLocker := newMockOutpointLocker()
+
+		sweepPkg, err := CraftSweepAllTx(
+			context.Background(), feePref, 100, deliveryAddr,
+			&WalletSweepConfig{
+				CoinSelectLocker: coinSelectLocker,
+				UtxoSource:       utxoSource,
+				OutpointLocker:   utxoLocker,
+				PkhWitnessType:   pkhWitnessType,
+				FeeEstimator:     feeEstimator,
+				MaxFeeRate:       DefaultMaxFeeRate,
+				Signer:           signer,
+				NetParams:        chaincfg.TestNet3Params(),
+			},
+		)
+		if err != nil {
+			t.Fatalf("unable to make sweep tx: %v", err)
+		}
+
+		return sweepPkg, utxoLocker
+	}
+
+	// First, we'll sweep assuming all outputs pay to compressed keys.
+	compressedPkg, _ := craftSweep(
+		func(*lnwallet.Utxo) (input.WitnessType, error) {
+			return input.PublicKeyHash, nil
+		},
+	)
+	if len(compressedPkg.SweepTx.TxIn) != len(targetUTXOs) {
+		t.Fatalf("expected %v inputs, got %v", len(targetUTXOs),
+			len(compressedPkg.SweepTx.TxIn))
+	}
+	if len(compressedPkg.SkippedOutputs) != 0 {
+		t.Fatalf("expected no skipped outputs, got %v",
+			len(compressedPkg.SkippedOutputs))
+	}
+	compressedPkg.CancelSweepAttempt()
+
+	// Next, we'll flag one of the outputs as paying to an uncompressed
+	// key. Both outputs should still be swept, but the larger signature
+	// script should be accounted for in the fee.
+	uncompressedPkg, _ := craftSweep(
+		func(utxo *lnwallet.Utxo) (input.WitnessType, error) {
+			if utxo == uncompressedUTXO {
+				return input.PublicKeyHashUncompressed, nil
+			}
+			return input.PublicKeyHash, nil
+		},
+	)
+	if len(uncompressedPkg.SweepTx.TxIn) != len(targetUTXOs) {
+		t.Fatalf("expected %v inputs, got %v", len(targetUTXOs),
+			len(uncompressedPkg.SweepTx.TxIn))
+	}
+	uncompressedPkg.CancelSweepAttempt()
+
+	sizeDelta := input.P2PKHUncompressedSigScriptSize -
+		input.P2PKHSigScriptSize
+	expectedFeeDelta := int64(feeRate.FeeForSize(sizeDelta))
+	feeDelta := compressedPkg.SweepTx.TxOut[0].Value -
+		uncompressedPkg.SweepTx.TxOut[0].Value
+	if feeDelta != expectedFeeDelta {
+		t.Fatalf("expected fee delta of %v, got %v", expectedFeeDelta,
+			feeDelta)
+	}
+
+	// Finally, if the key format of an output can't be determined, it
+	// should be skipped, reported and unlocked right away.
+	skippedPkg, utxoLocker := craftSweep(
+		func(utxo *lnwallet.Utxo) (input.WitnessType, error) {
+			if utxo == uncompressedUTXO {
+				return 0, errors.New("unknown key format")
+			}
+			return input.PublicKeyHash, nil
+		},
+	)
+	if len(skippedPkg.SweepTx.TxIn) != 1 {
+		t.Fatalf("expected 1 input, got %v",
+			len(skippedPkg.SweepTx.TxIn))
+	}
+	if skippedPkg.SweepTx.TxIn[0].PreviousOutPoint !=
+		targetUTXOs[0].OutPoint {
+
+		t.Fatalf("unexpected input swept: %v",
+			skippedPkg.SweepTx.TxIn[0].PreviousOutPoint)
+	}
+	if len(skippedPkg.SkippedOutputs) != 1 ||
+		skippedPkg.SkippedOutputs[0] != uncompressedUTXO {
+
+		t.Fatalf("expected output %v to be skipped, got %v",
+			uncompressedUTXO.OutPoint, skippedPkg.SkippedOutputs)
+	}
+	assertUtxosLockedAndUnlocked(
+		t, utxoLocker, []*lnwallet.Utxo{uncompressedUTXO},
+	)
+	assertNoUtxosUnlocked(t, utxoLocker, targetUTXOs[:1])
+}
@@ -87,8 +87,70 @@ var (
 
 	// ErrInvoiceTooLarge is returned when an invoice exceeds maxInvoiceLength.
 	ErrInvoiceTooLarge = errors.New("invoice is too large")
+
+	// ErrBothDescriptionAndHash is returned when an invoice sets both a
+	// description and a description hash. BOLT-0011 requires exactly one
+	// of them.
+	ErrBothDescriptionAndHash = errors.New("both description and " +
+		"description hash set")
+
+	// ErrNoDescriptionOrHash is returned when an invoice sets neither a
+	// description nor a description hash.
+	ErrNoDescriptionOrHash = errors.New("neither description nor " +
+		"description hash set")
 )
 
+// ErrUnknownRequiredFeature is returned when decoding an invoice that requires
+// a feature we don't understand. Such an invoice can't be paid until we're
+// upgraded to support the feature.
+type ErrUnknownRequiredFeature struct {
+	// Bit is the unknown required feature bit. If the invoice requires
+	// several unknown features, this is the lowest of their bits.
+	Bit lnwire.FeatureBit
+}
+
+// Error returns a human readable description of the error.
+func (e ErrUnknownRequiredFeature) Error() string {
+	return fmt.Sprintf("invoice requires unknown feature bit %d, an "+
+		"upgrade is required to pay this invoice", e.Bit)
+}
+
+// ErrWrongNetwork is returned when decoding an invoice that was created for a
+// different network than the one it is decoded for.
+type ErrWrongNetwork struct {
+	// Network is the name of the network the invoice was created for. It
+	// is empty if the invoice's network is unknown.
+	Network string
+
+	// ActiveNetwork is the name of the network the invoice was decoded
+	// for.
+	ActiveNetwork string
+}
+
+// Error returns a human readable description of the error.
+func (e ErrWrongNetwork) Error() string {
+	if e.Network == "" {
+		return fmt.Sprintf("invoice not for current active network "+
+			"'%s'", e.ActiveNetwork)
+	}
+
+	return fmt.Sprintf("invoice is for network '%s', but the current "+
+		"active network is '%s'", e.Network, e.ActiveNetwork)
+}
+
+// hrpNetwork returns the name of the network whose prefix the given HRP,
+// stripped of its "ln" prefix, starts with. An empty string is returned if it
+// doesn't match any known network.
+func hrpNetwork(hrp string) string {
+	for name, prefix := range decredHRPPrefixes {
+		if strings.HasPrefix(hrp, prefix) {
+			return name
+		}
+	}
+
+	return ""
+}
+
 // decredHRPPrefixes are the prefixes that should be present on the HRP (human
 // readable part) section of ln addresses for each decred network.
 var decredHRPPrefixes = map[string]string{
@@ -169,6 +231,13 @@ type Invoice struct {
 	// Optional.
 	FallbackAddr dcrutil.Address
 
+	// extraFallbackAddrs holds any fallback addresses beyond FallbackAddr
+	// found when decoding the invoice.
+	//
+	// This field is unexported and can be read by the FallbackAddrs()
+	// method.
+	extraFallbackAddrs []dcrutil.Address
+
 	// RouteHints represents one or more different route hints. Each route
 	// hint can be individually used to reach the destination. These usually
 	// represent private routes.
@@ -244,6 +313,20 @@ func FallbackAddr(fallbackAddr dcrutil.Address) func(*Invoice) {
 	}
 }
 
+// FallbackAddrs is a functional option that allows callers of NewInvoice to
+// set multiple fallback on-chain addresses for the Invoice, in order of
+// preference. The first address is also available as FallbackAddr.
+func FallbackAddrs(fallbackAddrs ...dcrutil.Address) func(*Invoice) {
+	return func(i *Invoice) {
+		if len(fallbackAddrs) == 0 {
+			return
+		}
+
+		i.FallbackAddr = fallbackAddrs[0]
+		i.extraFallbackAddrs = fallbackAddrs[1:]
+	}
+}
+
 // RouteHint is a functional option that allows callers of NewInvoice to add
 // one or more hop hints that represent a private route to the destination.
 func RouteHint(routeHint []HopHint) func(*Invoice) {
@@ -308,8 +391,10 @@ func Decode(invoice string, net *chaincfg.Params) (*Invoice, error) {
 	// The next characters should be a valid prefix for a segwit BIP173
 	// address that match the active network.
 	if !strings.HasPrefix(hrp[2:], decredHRPPrefixes[net.Name]) {
-		return nil, fmt.Errorf(
-			"invoice not for current active network '%s'", net.Name)
+		return nil, ErrWrongNetwork{
+			Network:       hrpNetwork(hrp[2:]),
+			ActiveNetwork: net.Name,
+		}
 	}
 	decodedInvoice.Net = net
 
@@ -511,6 +596,32 @@ func (invoice *Invoice) Expiry() time.Duration {
 	return 3600 * time.Second
 }
 
+// WithExpiry returns a copy of the invoice with its expiry set to the given
+// duration. All other fields, including the timestamp, are preserved, so the
+// expiry keeps being relative to the original creation time. The copy must be
+// re-signed with Encode, using the key of the invoice's destination, to
+// obtain a payable invoice.
+func (invoice *Invoice) WithExpiry(expiry time.Duration) *Invoice {
+	reissued := *invoice
+	reissued.expiry = &expiry
+
+	return &reissued
+}
+
+// FallbackAddrs returns all fallback on-chain addresses of this invoice, in
+// the order they were encoded. The first one, if any, is FallbackAddr.
+func (invoice *Invoice) FallbackAddrs() []dcrutil.Address {
+	if invoice.FallbackAddr == nil {
+		return nil
+	}
+
+	addrs := make(
+		[]dcrutil.Address, 0, len(invoice.extraFallbackAddrs)+1,
+	)
+	addrs = append(addrs, invoice.FallbackAddr)
+	return append(addrs, invoice.extraFallbackAddrs...)
+}
+
 // MinFinalCLTVExpiry returns the minimum final CLTV expiry delta as specified
 // by the creator of the invoice. This value specifies the delta between the
 // current height and the expiry height of the HTLC extended in the last hop.
@@ -522,6 +633,44 @@ func (invoice *Invoice) MinFinalCLTVExpiry() uint64 {
 	return DefaultFinalCLTVDelta
 }
 
+// InvoiceSummary holds the payment related details of an invoice that are
+// typically displayed to a user before paying it.
+type InvoiceSummary struct {
+	// Amount is the amount requested by the invoice. It is zero if the
+	// invoice doesn't specify an amount.
+	Amount lnwire.MilliAtom
+
+	// ExpiresAt is the wall-clock time after which the invoice should no
+	// longer be paid.
+	ExpiresAt time.Time
+
+	// MinFinalCLTVExpiry is the minimum final CLTV expiry delta required
+	// by the creator of the invoice.
+	MinFinalCLTVExpiry uint64
+
+	// Expired is true if ExpiresAt was already in the past at the time the
+	// summary was created.
+	Expired bool
+}
+
+// Summary returns an InvoiceSummary holding the amount, expiry and minimum
+// final CLTV expiry delta of the invoice.
+func (invoice *Invoice) Summary() InvoiceSummary {
+	var amt lnwire.MilliAtom
+	if invoice.MilliAt != nil {
+		amt = *invoice.MilliAt
+	}
+
+	expiresAt := invoice.Timestamp.Add(invoice.Expiry())
+
+	return InvoiceSummary{
+		Amount:             amt,
+		ExpiresAt:          expiresAt,
+		MinFinalCLTVExpiry: invoice.MinFinalCLTVExpiry(),
+		Expired:            time.Now().After(expiresAt),
+	}
+}
+
 // validateInvoice does a sanity check of the provided Invoice, making sure it
 // has all the necessary fields set for it to be considered valid by BOLT-0011.
 func validateInvoice(invoice *Invoice) error {
@@ -535,12 +684,14 @@ func validateInvoice(invoice *Invoice) error {
 		return fmt.Errorf("no payment hash found")
 	}
 
-	// Either Description or DescriptionHash must be set, not both.
+	// Either Description or DescriptionHash must be set, not both. The
+	// field parsers accept each of them on its own, so this is where the
+	// combination is enforced.
 	if invoice.Description != nil && invoice.DescriptionHash != nil {
-		return fmt.Errorf("both description and description hash set")
+		return ErrBothDescriptionAndHash
 	}
 	if invoice.Description == nil && invoice.DescriptionHash == nil {
-		return fmt.Errorf("neither description nor description hash set")
+		return ErrNoDescriptionOrHash
 	}
 
 	// Check that we support the field lengths.
@@ -670,13 +821,24 @@ func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) er
 
 			invoice.minFinalCLTVExpiry, err = parseMinFinalCLTVExpiry(base32Data)
 		case fieldTypeF:
-			if invoice.FallbackAddr != nil {
-				// We skip the field if we have already seen a
-				// supported one.
-				continue
+			// An `f` field can be included in an invoice multiple
+			// times, so we won't skip it if we have already seen
+			// one.
+			addr, err := parseFallbackAddr(base32Data, net)
+			if err != nil {
+				return err
 			}
 
-			invoice.FallbackAddr, err = parseFallbackAddr(base32Data, net)
+			// Addresses of unknown versions are ignored.
+			switch {
+			case addr == nil:
+			case invoice.FallbackAddr == nil:
+				invoice.FallbackAddr = addr
+			default:
+				invoice.extraFallbackAddrs = append(
+					invoice.extraFallbackAddrs, addr,
+				)
+			}
 		case fieldTypeR:
 			// An `r` field can be included in an invoice multiple
 			// times, so we won't skip it if we have already seen
@@ -902,8 +1064,16 @@ func parseFeatures(data []byte) (*lnwire.FeatureVector, error) {
 	fv := lnwire.NewFeatureVector(rawFeatures, InvoiceFeatures)
 	unknownFeatures := fv.UnknownRequiredFeatures()
 	if len(unknownFeatures) > 0 {
-		return nil, fmt.Errorf("invoice contains unknown required "+
-			"features: %v", unknownFeatures)
+		// Report the lowest unknown bit, such that the error doesn't
+		// depend on the order in which the features were iterated.
+		lowest := unknownFeatures[0]
+		for _, bit := range unknownFeatures[1:] {
+			if bit < lowest {
+				lowest = bit
+			}
+		}
+
+		return nil, ErrUnknownRequiredFeature{Bit: lowest}
 	}
 
 	return fv, nil
@@ -978,9 +1148,9 @@ func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
 		}
 	}
 
-	if invoice.FallbackAddr != nil {
+	for _, fallbackAddr := range invoice.FallbackAddrs() {
 		var version byte
-		switch invoice.FallbackAddr.(type) {
+		switch fallbackAddr.(type) {
 		case *dcrutil.AddressPubKeyHash:
 			version = 17
 		case *dcrutil.AddressScriptHash:
@@ -989,7 +1159,7 @@ func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
 			return fmt.Errorf("unknown fallback address type")
 		}
 		base32Addr, err := bech32.ConvertBits(
-			invoice.FallbackAddr.ScriptAddress(), 8, 5, true)
+			fallbackAddr.ScriptAddress(), 8, 5, true)
 		if err != nil {
 			return err
 		}
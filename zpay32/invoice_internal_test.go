@@ -1,6 +1,7 @@
 package zpay32
 
 import (
+	"bytes"
 	"encoding/binary"
 	"math"
 	"reflect"
@@ -759,3 +760,36 @@ func TestParseRouteHint(t *testing.T) {
 		}
 	}
 }
+
+// TestParseFeatures checks that unknown optional features are accepted, while
+// unknown required features are rejected with the lowest unknown bit.
+func TestParseFeatures(t *testing.T) {
+	t.Parallel()
+
+	encodeFeatures := func(bits ...lnwire.FeatureBit) []byte {
+		var b bytes.Buffer
+		err := lnwire.NewRawFeatureVector(bits...).EncodeBase32(&b)
+		if err != nil {
+			t.Fatalf("unable to encode features: %v", err)
+		}
+		return b.Bytes()
+	}
+
+	fv, err := parseFeatures(encodeFeatures(1, 9, 101))
+	if err != nil {
+		t.Fatalf("unexpected error for optional features: %v", err)
+	}
+	if !fv.IsSet(101) {
+		t.Fatalf("expected feature 101 to be set")
+	}
+
+	_, err = parseFeatures(encodeFeatures(9, 102, 100))
+	featureErr, ok := err.(ErrUnknownRequiredFeature)
+	if !ok {
+		t.Fatalf("expected ErrUnknownRequiredFeature, got %v", err)
+	}
+	if featureErr.Bit != 100 {
+		t.Fatalf("expected unknown feature bit 100, got %d",
+			featureErr.Bit)
+	}
+}
@@ -726,6 +726,182 @@ func TestMaxInvoiceLength(t *testing.T) {
 	}
 }
 
+// TestDecodeUnknownRequiredFeature asserts that decoding an invoice which
+// requires a feature we don't understand fails with ErrUnknownRequiredFeature
+// carrying the unknown bit.
+func TestDecodeUnknownRequiredFeature(t *testing.T) {
+	t.Parallel()
+
+	// On mainnet, please send $30 coffee beans supporting features 1, 9,
+	// and requiring the unknown feature 100.
+	const encodedInvoice = "lndcr25m1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypqdq5vdhkven9v5sxyetpdees9q4pqqqqqqqqqqqqqqqqqqszmzyy64av3x52dfu7urlge0yneqyjv3yl0rpsgh6mx4u05ylsvnpp6g0h6g7jdv8z3ltql0etlnt9umz8k6z6cuvy44ddnzypvj52tvcpjex0g8"
+
+	_, err := Decode(encodedInvoice, chaincfg.MainNetParams())
+	featureErr, ok := err.(ErrUnknownRequiredFeature)
+	if !ok {
+		t.Fatalf("expected ErrUnknownRequiredFeature, got %v", err)
+	}
+	if featureErr.Bit != 100 {
+		t.Fatalf("expected unknown feature bit 100, got %d",
+			featureErr.Bit)
+	}
+}
+
+// TestDecodeWrongNetwork asserts that decoding an invoice for a different
+// network fails with ErrWrongNetwork, naming the invoice's network.
+func TestDecodeWrongNetwork(t *testing.T) {
+	t.Parallel()
+
+	invoice, err := NewInvoice(
+		chaincfg.MainNetParams(), testPaymentHash,
+		time.Unix(1496314658, 0), Amount(testMilliAt20mDCR),
+		Description(testCupOfCoffee),
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	encoded, err := invoice.Encode(testMessageSigner)
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	_, err = Decode(encoded, chaincfg.TestNet3Params())
+	netErr, ok := err.(ErrWrongNetwork)
+	if !ok {
+		t.Fatalf("expected ErrWrongNetwork, got %v", err)
+	}
+	if netErr.Network != chaincfg.MainNetParams().Name {
+		t.Fatalf("expected network %v, got %v",
+			chaincfg.MainNetParams().Name, netErr.Network)
+	}
+	if netErr.ActiveNetwork != chaincfg.TestNet3Params().Name {
+		t.Fatalf("expected active network %v, got %v",
+			chaincfg.TestNet3Params().Name, netErr.ActiveNetwork)
+	}
+}
+
+// TestDecodeDescriptionFields asserts that decoding an invoice fails with the
+// matching typed error if it carries both or neither of the description and
+// description hash fields, and succeeds if it carries exactly one of them.
+func TestDecodeDescriptionFields(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		encodedInvoice string
+		expectedError  error
+	}{
+		{
+			name:           "both set",
+			encodedInvoice: "lndcr20m1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypqdpl2pkx2ctnv5sxxmmwwd5kgetjypeh2ursdae8g6twvus8g6rfwvs8qun0dfjkxaqhp5p0y6smqsu95wrj2v9dzntwn88pmz4ck92063nkhxju832w0tr5hs0pwljxh5kezjcylatfknd2wgpc5kyayf8wntsjsaxhyw3cw0a6s9ue5y4lkeja470cldvwx075d2s06acaphjsnc4mq74nzcu0lcr0qqkady8f",
+			expectedError:  ErrBothDescriptionAndHash,
+		},
+		{
+			name:           "neither set",
+			encodedInvoice: "lndcr20m1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypq8puwce8w28d8elye424a7y7l845llxkwtku8sjpf2c42ltqd7vehefdhpr0zgq4dxjl36savrdn0perhuu2n9dxhd30suv24rltkdtcpgx6lc2",
+			expectedError:  ErrNoDescriptionOrHash,
+		},
+		{
+			name:           "description only",
+			encodedInvoice: "lndcr1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypqdq5xysxxatsyp3k7enxv4jsmvp0ygkvzd3zh9wkfj59cuze0se5fzuh4f7rysdukv68n6fafa45sudrzg8d33paaw50zczd5mzmppqaalvzneu0yd3zfrvzhnfzpkgppyrza2",
+		},
+		{
+			name:           "description hash only",
+			encodedInvoice: "lndcr20m1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypqhp5p0y6smqsu95wrj2v9dzntwn88pmz4ck92063nkhxju832w0tr5hs3uzs6up5wjzjy7pl352h0rd0tujcwrvej3035gs59x2funkpx44z7r3ku04xf8xgvlxrc4dhaut5t9yxvwv2kvdge6g25zk6p87550qp0c2rnh",
+		},
+	}
+
+	net := chaincfg.MainNetParams()
+
+	for _, test := range tests {
+		_, err := Decode(test.encodedInvoice, net)
+		if err != test.expectedError {
+			t.Fatalf("%s: expected error %v, got %v", test.name,
+				test.expectedError, err)
+		}
+	}
+}
+
+// TestInvoiceSummary asserts that the summary of a decoded invoice reflects its
+// amount, expiry and minimum final CLTV expiry delta.
+func TestInvoiceSummary(t *testing.T) {
+	t.Parallel()
+
+	net := chaincfg.MainNetParams()
+	timestamp := time.Unix(1496314658, 0)
+
+	tests := []struct {
+		name           string
+		encodedInvoice string
+		expected       InvoiceSummary
+	}{
+		{
+			name:           "custom expiry",
+			encodedInvoice: "lndcr2500u1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypqdq5xysxxatsyp3k7enxv4jsxqzpumkqh4xxuwhghf0dy5mglqnnttyg46a3ursmwv33dlwvmvkt9d8z9k7h4nhm0uun3a8hly8e92hd926j0tm0afrnzqeyapnlqhrx6cugphffhw0",
+			expected: InvoiceSummary{
+				Amount:             testMilliAt2500uDCR,
+				ExpiresAt:          timestamp.Add(testExpiry60),
+				MinFinalCLTVExpiry: DefaultFinalCLTVDelta,
+				Expired:            true,
+			},
+		},
+		{
+			name:           "custom cltv expiry",
+			encodedInvoice: "lndcr2500u1pvjluezpp5qqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqqqsyqcyq5rqwzqfqypqdq5xysxxatsyp3k7enxv4jscqzysnp4q0n326hr8v9zprg8gsvezcch06gfaqqhde2aj730yg0durunfhv66fxyjhqjk2p5tfgzh8ddfkc7s25nc0qefzt86v7ct6rccv6577f753juhjra927ma6cg05wpd6a2tqjgc56ttp5xygrk237apy5e5vgqq660qqy",
+			expected: InvoiceSummary{
+				Amount:             testMilliAt2500uDCR,
+				ExpiresAt:          timestamp.Add(time.Hour),
+				MinFinalCLTVExpiry: 144,
+				Expired:            true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		invoice, err := Decode(test.encodedInvoice, net)
+		if err != nil {
+			t.Fatalf("%s: unable to decode invoice: %v", test.name,
+				err)
+		}
+
+		summary := invoice.Summary()
+		if summary.Amount != test.expected.Amount {
+			t.Fatalf("%s: expected amount %v, got %v", test.name,
+				test.expected.Amount, summary.Amount)
+		}
+		if !summary.ExpiresAt.Equal(test.expected.ExpiresAt) {
+			t.Fatalf("%s: expected expiry %v, got %v", test.name,
+				test.expected.ExpiresAt, summary.ExpiresAt)
+		}
+		if summary.MinFinalCLTVExpiry != test.expected.MinFinalCLTVExpiry {
+			t.Fatalf("%s: expected min final cltv expiry %d, got %d",
+				test.name, test.expected.MinFinalCLTVExpiry,
+				summary.MinFinalCLTVExpiry)
+		}
+		if summary.Expired != test.expected.Expired {
+			t.Fatalf("%s: expected expired=%v, got %v", test.name,
+				test.expected.Expired, summary.Expired)
+		}
+	}
+
+	// A freshly created invoice without an amount hasn't expired yet and
+	// reports a zero amount.
+	invoice, err := NewInvoice(
+		net, testPaymentHash, time.Now(), Description(testCupOfCoffee),
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+
+	summary := invoice.Summary()
+	if summary.Amount != 0 {
+		t.Fatalf("expected zero amount, got %v", summary.Amount)
+	}
+	if summary.Expired {
+		t.Fatalf("expected fresh invoice to not be expired")
+	}
+}
+
 func compareInvoices(expected, actual *Invoice) error {
 	if !reflect.DeepEqual(expected.Net, actual.Net) {
 		return fmt.Errorf("expected net %v, got %v",
@@ -853,3 +1029,101 @@ func compareRouteHints(a, b []HopHint) error {
 
 	return nil
 }
+
+// TestInvoiceWithExpiry tests that an invoice re-issued with a new expiry
+// decodes with the updated expiry, while preserving all other fields.
+func TestInvoiceWithExpiry(t *testing.T) {
+	t.Parallel()
+
+	amt := lnwire.MilliAtom(24000000000)
+	invoice, err := NewInvoice(
+		chaincfg.MainNetParams(), testPaymentHash,
+		time.Unix(1496314658, 0), Amount(amt),
+		Description(testCupOfCoffee), Expiry(testExpiry60),
+		RouteHint(testDoubleHop),
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	encoded, err := invoice.Encode(testMessageSigner)
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	decoded, err := Decode(encoded, chaincfg.MainNetParams())
+	if err != nil {
+		t.Fatalf("unable to decode invoice: %v", err)
+	}
+
+	// Bumping the expiry shouldn't modify the original invoice.
+	newExpiry := 24 * time.Hour
+	reissued := decoded.WithExpiry(newExpiry)
+	if decoded.Expiry() != testExpiry60 {
+		t.Fatalf("original invoice expiry modified: %v",
+			decoded.Expiry())
+	}
+
+	reencoded, err := reissued.Encode(testMessageSigner)
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+	if reencoded == encoded {
+		t.Fatalf("expected re-issued invoice to differ")
+	}
+
+	redecoded, err := Decode(reencoded, chaincfg.MainNetParams())
+	if err != nil {
+		t.Fatalf("unable to decode invoice: %v", err)
+	}
+	if redecoded.Expiry() != newExpiry {
+		t.Fatalf("expected expiry %v, got %v", newExpiry,
+			redecoded.Expiry())
+	}
+
+	// Apart from the expiry, the re-issued invoice should match the
+	// original one.
+	redecoded.expiry = decoded.expiry
+	if err := compareInvoices(decoded, redecoded); err != nil {
+		t.Fatalf("re-issued invoice doesn't match original: %v", err)
+	}
+}
+
+// TestInvoiceFallbackAddrs asserts that invoices carrying multiple fallback
+// addresses are encoded and decoded with all of them, in order.
+func TestInvoiceFallbackAddrs(t *testing.T) {
+	t.Parallel()
+
+	fallbackAddrs := []dcrutil.Address{testRustyAddr, testAddrMainnetP2SH}
+	invoice, err := NewInvoice(
+		chaincfg.MainNetParams(), testPaymentHash,
+		time.Unix(1496314658, 0), Amount(testMilliAt20mDCR),
+		DescriptionHash(testDescriptionHash),
+		FallbackAddrs(fallbackAddrs...),
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	encoded, err := invoice.Encode(testMessageSigner)
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	decoded, err := Decode(encoded, chaincfg.MainNetParams())
+	if err != nil {
+		t.Fatalf("unable to decode invoice: %v", err)
+	}
+	if err := compareInvoices(invoice, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first address remains available as the invoice's fallback
+	// address, while all of them are returned in order.
+	if !reflect.DeepEqual(decoded.FallbackAddr, testRustyAddr) {
+		t.Fatalf("expected fallback address %v, got %v",
+			testRustyAddr, decoded.FallbackAddr)
+	}
+	if !reflect.DeepEqual(decoded.FallbackAddrs(), fallbackAddrs) {
+		t.Fatalf("expected fallback addresses %v, got %v",
+			fallbackAddrs, decoded.FallbackAddrs())
+	}
+}